@@ -1,16 +1,12 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 
-	"os"
-	"strings"
-
 	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type BlocklessRequest struct {
@@ -47,28 +43,18 @@ type LossesPayload struct {
 	Inferences []emissionstypes.ValueBundle `json:"inferences"`
 }
 
-func generateLossesRequest(
-	ctx sdk.Context,
-	inferences *emissionstypes.ValueBundle,
-	functionId string,
-	functionMethod string,
-	topicId uint64,
-	topicAllowsNegative bool,
-	blockHeight emissionstypes.Nonce,
-	blockHeightEval emissionstypes.Nonce,
-	blocktime uint64) {
-
-	inferencesPayloadJSON, err := json.Marshal(inferences)
+// Builds the Blockless request that asks the reputers of a topic for their losses
+func NewReputerBlocklessRequest(request *emissionstypes.EventReputerRequest) (BlocklessRequest, error) {
+	inferencesPayloadJSON, err := json.Marshal(request.ValueBundle)
 	if err != nil {
-		Logger(ctx).Warn(fmt.Sprintf("Error marshalling JSON: %s", err.Error()))
-		return
+		return BlocklessRequest{}, err
 	}
 
 	stdin := string(inferencesPayloadJSON)
-	topicIdStr := strconv.FormatUint(topicId, 10) + "/reputer"
-	calcWeightsReq := BlocklessRequest{
-		FunctionID: functionId,
-		Method:     functionMethod,
+	topicIdStr := strconv.FormatUint(request.TopicId, 10) + "/reputer"
+	return BlocklessRequest{
+		FunctionID: request.LossLogic,
+		Method:     request.LossMethod,
 		TopicID:    topicIdStr,
 		Config: Config{
 			Stdin: &stdin,
@@ -79,53 +65,34 @@ func generateLossesRequest(
 				},
 				{
 					Name:  "ALLORA_ARG_PARAMS",
-					Value: strconv.FormatUint(blocktime, 10),
+					Value: strconv.FormatUint(request.ApproxTime, 10),
 				},
 				{
 					Name:  "ALLORA_BLOCK_HEIGHT_CURRENT",
-					Value: strconv.FormatInt(blockHeight.BlockHeight, 10),
+					Value: strconv.FormatInt(request.ReputerRequestNonce.ReputerNonce.BlockHeight, 10),
 				},
 				{
 					Name:  "ALLORA_BLOCK_HEIGHT_EVAL",
-					Value: strconv.FormatInt(blockHeightEval.BlockHeight, 10),
+					Value: strconv.FormatInt(request.ReputerRequestNonce.WorkerNonce.BlockHeight, 10),
 				},
 				{
 					Name:  "LOSS_FUNCTION_ALLOWS_NEGATIVE",
-					Value: strconv.FormatBool(topicAllowsNegative),
+					Value: strconv.FormatBool(request.AllowNegative),
 				},
 			},
 			NodeCount:          -1,     // use all nodes that reported, no minimum / max
 			Timeout:            2,      // seconds to time out before rollcall complete
 			ConsensusAlgorithm: "pbft", // forces worker leader write to chain through pbft
 		},
-	}
-
-	payload, err := json.Marshal(calcWeightsReq)
-	if err != nil {
-		Logger(ctx).Warn(fmt.Sprintf("Error marshalling outer JSON: %s", err.Error()))
-		return
-	}
-	payloadStr := string(payload)
-	Logger(ctx).Debug(fmt.Sprintf("Making API call - losses, with payload: %s", payloadStr))
-	err = makeApiCall(payloadStr)
-	if err != nil {
-		Logger(ctx).Warn("Error making API call - losses: " + err.Error())
-	}
+	}, nil
 }
 
-func generateInferencesRequest(
-	ctx sdk.Context,
-	functionId string,
-	functionMethod string,
-	param string,
-	topicId uint64,
-	topicAllowsNegative bool,
-	nonce emissionstypes.Nonce) {
-
-	payloadJson := BlocklessRequest{
-		FunctionID: functionId,
-		Method:     functionMethod,
-		TopicID:    strconv.FormatUint(topicId, 10),
+// Builds the Blockless request that asks the workers of a topic for their inferences and forecasts
+func NewWorkerBlocklessRequest(request *emissionstypes.EventWorkerRequest) BlocklessRequest {
+	return BlocklessRequest{
+		FunctionID: request.InferenceLogic,
+		Method:     request.InferenceMethod,
+		TopicID:    strconv.FormatUint(request.TopicId, 10),
 		Config: Config{
 			Environment: []EnvVar{
 				{
@@ -134,15 +101,15 @@ func generateInferencesRequest(
 				},
 				{
 					Name:  "ALLORA_ARG_PARAMS",
-					Value: param,
+					Value: request.DefaultArg,
 				},
 				{
 					Name:  "ALLORA_BLOCK_HEIGHT_CURRENT",
-					Value: strconv.FormatInt(nonce.BlockHeight, 10),
+					Value: strconv.FormatInt(request.Nonce.BlockHeight, 10),
 				},
 				{
 					Name:  "LOSS_FUNCTION_ALLOWS_NEGATIVE",
-					Value: strconv.FormatBool(topicAllowsNegative),
+					Value: strconv.FormatBool(request.AllowNegative),
 				},
 			},
 			NodeCount:          -1,     // use all nodes that reported, no minimum / max
@@ -150,31 +117,23 @@ func generateInferencesRequest(
 			ConsensusAlgorithm: "pbft", // forces worker leader write to chain through pbft
 		},
 	}
-	payload, err := json.Marshal(payloadJson)
-	if err != nil {
-		Logger(ctx).Warn(fmt.Sprintf("Error marshalling outer JSON: %s", err.Error()))
-	}
-	payloadStr := string(payload)
+}
 
-	Logger(ctx).Debug(fmt.Sprintf("Making API call - inferences, with payload: %s", payloadStr))
-	err = makeApiCall(payloadStr)
+// POSTs a request to the Blockless API at the given url
+func SendBlocklessRequest(url string, request BlocklessRequest) error {
+	payload, err := json.Marshal(request)
 	if err != nil {
-		Logger(ctx).Warn(fmt.Sprintf("Error making API call: %s", err.Error()))
+		return err
 	}
-}
-
-func makeApiCall(payload string) error {
-	url := os.Getenv("BLOCKLESS_API_URL")
-	method := "POST"
 
-	client := &http.Client{}
-	req, err := http.NewRequest(method, url, strings.NewReader(payload))
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 	req.Header.Add("Accept", "application/json, text/plain, */*")
 	req.Header.Add("Content-Type", "application/json;charset=UTF-8")
 
+	client := &http.Client{}
 	res, err := client.Do(req)
 	if err != nil {
 		return err
@@ -16,6 +16,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/spf13/cast"
 
 	"cosmossdk.io/core/appconfig"
 	"cosmossdk.io/depinject"
@@ -220,6 +221,9 @@ func NewAlloraApp(
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
 	}
+	if err := app.registerEmissionsArchive(appOpts); err != nil {
+		return nil, err
+	}
 
 	/****  Module Options ****/
 	app.ModuleManager.SetOrderPreBlockers(
@@ -259,8 +263,12 @@ func NewAlloraApp(
 	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, make(map[string]module.AppModuleSimulation, 0))
 	app.sm.RegisterStoreDecoders()
 
-	topicsHandler := NewTopicsHandler(app.EmissionsKeeper)
-	app.SetPrepareProposal(topicsHandler.PrepareProposalHandler())
+	// The requests are triggered unless they are turned off, as they were before the flag existed
+	prepareProposalRequests := appOpts.Get(FlagPrepareProposalRequests)
+	if prepareProposalRequests == nil || cast.ToBool(prepareProposalRequests) {
+		topicsHandler := NewTopicsHandler(app.EmissionsKeeper)
+		app.SetPrepareProposal(topicsHandler.PrepareProposalHandler())
+	}
 
 	app.setupUpgradeHandlers()
 
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/x/emissions/archiver"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// Start flags, also settable in app.toml, that make the node archive the inferences, forecasts and losses committed
+// to the emissions store, so that they are kept once pruned from the state. A relative directory is in the home.
+const (
+	FlagArchiveDir         = "emissions.archive-dir"
+	FlagArchiveMaxFileSize = "emissions.archive-max-file-size"
+)
+
+// Streams the changes of the emissions store to an archiver.Listener when an archive directory is set
+func (app *AlloraApp) registerEmissionsArchive(appOpts servertypes.AppOptions) error {
+	dir := cast.ToString(appOpts.Get(FlagArchiveDir))
+	if dir == "" {
+		return nil
+	}
+	// The streaming manager holds a single listener, the one of the plugin would be replaced
+	pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIPluginTomlKey)
+	if plugin := cast.ToString(appOpts.Get(pluginKey)); plugin != "" {
+		return fmt.Errorf("%s can not be set along with the %s streaming plugin", FlagArchiveDir, plugin)
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+	maxFileSize := cast.ToInt64(appOpts.Get(FlagArchiveMaxFileSize))
+	if maxFileSize == 0 {
+		maxFileSize = archiver.DefaultMaxFileSize
+	}
+
+	writer, err := archiver.NewWriter(dir, maxFileSize)
+	if err != nil {
+		return err
+	}
+	app.CommitMultiStore().AddListeners([]storetypes.StoreKey{app.GetKey(emissionstypes.StoreKey)})
+	app.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: []storetypes.ABCIListener{archiver.NewListener(app.appCodec, writer)},
+	})
+	return nil
+}
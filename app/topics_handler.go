@@ -2,19 +2,23 @@ package app
 
 import (
 	"fmt"
-	"sync"
+	"os"
+	"time"
 
 	"cosmossdk.io/log"
 	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
 
 	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
-	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	emissionsmodule "github.com/allora-network/allora-chain/x/emissions/module"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const secondsInAMonth uint64 = 2592000
+// Start flag, also settable in app.toml, that makes the block proposer trigger worker and reputer requests
+// on the Blockless API at BLOCKLESS_API_URL when preparing its proposal. It is on by default; when it is
+// turned off, requests are only emitted as EventWorkerRequest and EventReputerRequest events at the end of each block.
+const FlagPrepareProposalRequests = "emissions.prepare-proposal-requests"
 
 type TopicsHandler struct {
 	emissionsKeeper emissionskeeper.Keeper
@@ -28,106 +32,63 @@ func NewTopicsHandler(emissionsKeeper emissionskeeper.Keeper) *TopicsHandler {
 	}
 }
 
-// Calculate approximate time for the previous block as epoch timestamp
-func (th *TopicsHandler) calculatePreviousBlockApproxTime(ctx sdk.Context, inferenceBlockHeight int64, groundTruthLag int64) (uint64, error) {
-	emissionsParams, err := th.emissionsKeeper.GetParams(ctx)
-	if err != nil {
-		return 0, err
-	}
-	approximateTimePerBlockSeconds := secondsInAMonth / emissionsParams.BlocksPerMonth
-	timeDifferenceInBlocks := ctx.BlockHeight() - inferenceBlockHeight
-	// Ensure no time in the future is calculated because of ground truth lag
-	if groundTruthLag > timeDifferenceInBlocks {
-		timeDifferenceInBlocks = 0
-	} else {
-		timeDifferenceInBlocks -= groundTruthLag
-	}
-
-	timeDifferenceInSeconds := uint64(timeDifferenceInBlocks) * approximateTimePerBlockSeconds
-	previousBlockApproxTime := uint64(ctx.BlockTime().Unix()) - timeDifferenceInSeconds
-	return previousBlockApproxTime, nil
-}
-
-func (th *TopicsHandler) requestTopicWorkers(ctx sdk.Context, topic emissionstypes.Topic) {
+func (th *TopicsHandler) requestTopicWorkers(ctx sdk.Context, topic emissionstypes.Topic, url string) {
 	Logger(ctx).Debug(fmt.Sprintf("Triggering inference generation for topic: %v metadata: %s default arg: %s. \n",
 		topic.Id, topic.Metadata, topic.DefaultArg))
 
-	workerNonces, err := th.emissionsKeeper.GetUnfulfilledWorkerNonces(ctx, topic.Id)
+	requests, err := emissionsmodule.GetWorkerRequests(ctx, th.emissionsKeeper, topic)
 	if err != nil {
-		Logger(ctx).Error("Error getting worker nonces: " + err.Error())
+		Logger(ctx).Error("Error getting worker requests: " + err.Error())
 		return
 	}
-	// Filter workerNonces to only include those that are within the epoch length
-	// This is to avoid requesting inferences for epochs that have already ended
-	workerNonces = synth.FilterNoncesWithinEpochLength(workerNonces, ctx.BlockHeight(), topic.EpochLength)
-
-	maxRetriesToFulfilNoncesWorker := emissionstypes.DefaultParams().MaxRetriesToFulfilNoncesWorker
-	emissionsParams, err := th.emissionsKeeper.GetParams(ctx)
-	if err != nil {
-		Logger(ctx).Warn(fmt.Sprintf("Error getting max retries to fulfil nonces for worker requests (using default), err: %s", err.Error()))
-	} else {
-		maxRetriesToFulfilNoncesWorker = emissionsParams.MaxRetriesToFulfilNoncesWorker
-	}
-	sortedWorkerNonces := synth.SelectTopNWorkerNonces(workerNonces, int(maxRetriesToFulfilNoncesWorker))
-	Logger(ctx).Debug(fmt.Sprintf("Iterating Top N Worker Nonces: %d", len(sortedWorkerNonces)))
-	// iterate over all the worker nonces to find if this is unfulfilled
-	for _, nonce := range sortedWorkerNonces {
-		nonceCopy := nonce
-		Logger(ctx).Debug(fmt.Sprintf("Current Worker block height has been found unfulfilled, requesting inferences %v", nonceCopy))
-		go generateInferencesRequest(ctx, topic.InferenceLogic, topic.InferenceMethod, topic.DefaultArg, topic.Id, topic.AllowNegative, *nonceCopy)
+	Logger(ctx).Debug(fmt.Sprintf("Iterating Top N Worker Nonces: %d", len(requests)))
+	for i := range requests {
+		request := requests[i]
+		Logger(ctx).Debug(fmt.Sprintf("Current Worker block height has been found unfulfilled, requesting inferences %v", request.Nonce))
+		go func() {
+			start := time.Now()
+			err := SendBlocklessRequest(url, NewWorkerBlocklessRequest(&request))
+			emissionstypes.MeasureBlocklessRequest(emissionstypes.MetricRequestWorker, start, err)
+			if err != nil {
+				Logger(ctx).Warn(fmt.Sprintf("Error making API call: %s", err.Error()))
+			}
+		}()
 	}
 }
 
-func (th *TopicsHandler) requestTopicReputers(ctx sdk.Context, topic emissionstypes.Topic) {
-	currentBlockHeight := ctx.BlockHeight()
+func (th *TopicsHandler) requestTopicReputers(ctx sdk.Context, topic emissionstypes.Topic, url string) {
 	Logger(ctx).Debug(fmt.Sprintf("Triggering Losses cadence met for topic: %v metadata: %s default arg: %s \n",
 		topic.Id, topic.Metadata, topic.DefaultArg))
-	reputerNonces, err := th.emissionsKeeper.GetUnfulfilledReputerNonces(ctx, topic.Id)
+
+	requests, err := emissionsmodule.GetReputerRequests(ctx, th.emissionsKeeper, topic)
 	if err != nil {
-		Logger(ctx).Error("Error getting reputer nonces: " + err.Error())
+		Logger(ctx).Error("Error getting reputer requests: " + err.Error())
 		return
 	}
-	// No filtering - reputation of previous rounds can still be retried if work has been done.
-	maxRetriesToFulfilNoncesReputer := emissionstypes.DefaultParams().MaxRetriesToFulfilNoncesReputer
-	emissionsParams, err := th.emissionsKeeper.GetParams(ctx)
-	if err != nil {
-		Logger(ctx).Warn(fmt.Sprintf("Error getting max num of retries to fulfil nonces for worker requests (using default), err: %s", err.Error()))
-	} else {
-		maxRetriesToFulfilNoncesReputer = emissionsParams.MaxRetriesToFulfilNoncesReputer
-	}
-	topNReputerNonces := synth.SelectTopNReputerNonces(&reputerNonces, int(maxRetriesToFulfilNoncesReputer), currentBlockHeight, topic.GroundTruthLag)
-	Logger(ctx).Warn(fmt.Sprintf("Iterating Top N Reputer Nonces: %v", len(topNReputerNonces)))
-	// iterate over all the reputer nonces to find if this is unfulfilled
-	for _, nonce := range topNReputerNonces {
-		nonceCopy := nonce
-		Logger(ctx).Warn(fmt.Sprintf("Reputer block height found unfulfilled, requesting reputers for block: %v, worker: %v", nonceCopy.ReputerNonce.BlockHeight, nonceCopy.WorkerNonce.BlockHeight))
-		reputerValueBundle, err := synth.GetNetworkInferencesAtBlock(
-			ctx,
-			th.emissionsKeeper,
-			topic.Id,
-			nonceCopy.ReputerNonce.BlockHeight,
-			nonceCopy.WorkerNonce.BlockHeight,
-		)
-		if err != nil {
-			Logger(ctx).Error(fmt.Sprintf("Error getting latest inferences at block: %d  error: %s", nonceCopy.ReputerNonce.BlockHeight, err.Error()))
-			continue
-		}
-		if reputerValueBundle == nil || len(reputerValueBundle.InfererValues) == 0 {
-			Logger(ctx).Error("ReputerValueBundle cannot be nil")
-			continue
-		}
-
-		previousBlockApproxTime, err := th.calculatePreviousBlockApproxTime(ctx, nonceCopy.ReputerNonce.BlockHeight, topic.GroundTruthLag)
+	Logger(ctx).Debug(fmt.Sprintf("Iterating Top N Reputer Nonces: %v", len(requests)))
+	for i := range requests {
+		request := requests[i]
+		Logger(ctx).Debug(fmt.Sprintf("Requesting losses for topic: %d reputer nonce: %d worker nonce: %d previous block approx time: %d",
+			topic.Id, request.ReputerRequestNonce.ReputerNonce.BlockHeight, request.ReputerRequestNonce.WorkerNonce.BlockHeight, request.ApproxTime))
+		blocklessRequest, err := NewReputerBlocklessRequest(&request)
 		if err != nil {
-			Logger(ctx).Error("Error calculating previous block approx time: " + err.Error())
+			Logger(ctx).Warn(fmt.Sprintf("Error marshalling JSON: %s", err.Error()))
 			continue
 		}
-		Logger(ctx).Debug(fmt.Sprintf("Requesting losses for topic: %d reputer nonce: %d worker nonce: %d previous block approx time: %d",
-			topic.Id, nonceCopy.ReputerNonce, nonceCopy.WorkerNonce, previousBlockApproxTime))
-		go generateLossesRequest(ctx, reputerValueBundle, topic.LossLogic, topic.LossMethod, topic.Id, topic.AllowNegative, *nonceCopy.ReputerNonce, *nonceCopy.WorkerNonce, previousBlockApproxTime)
+		go func() {
+			start := time.Now()
+			err := SendBlocklessRequest(url, blocklessRequest)
+			emissionstypes.MeasureBlocklessRequest(emissionstypes.MetricRequestReputer, start, err)
+			if err != nil {
+				Logger(ctx).Warn("Error making API call - losses: " + err.Error())
+			}
+		}()
 	}
 }
 
+// Triggers the requests of the churnable topics on the Blockless API when this node proposes a block.
+// Only used unless FlagPrepareProposalRequests is turned off; the store is read sequentially and only the
+// API calls are made in the background.
 func (th *TopicsHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
 		Logger(ctx).Debug("\n ---------------- TopicsHandler ------------------- \n")
@@ -137,23 +98,16 @@ func (th *TopicsHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 			return nil, err
 		}
 
-		var wg sync.WaitGroup
-		// Loop over and run epochs on topics whose inferences are demanded enough to be served
-		// Within each loop, execute the inference and weight cadence checks and trigger the inference and weight generation
+		url := os.Getenv("BLOCKLESS_API_URL")
 		for _, churnableTopicId := range churnableTopics {
-			wg.Add(1)
-			go func(topicId TopicId) {
-				defer wg.Done()
-				topic, err := th.emissionsKeeper.GetTopic(ctx, topicId)
-				if err != nil {
-					Logger(ctx).Error("Error getting topic: " + err.Error())
-					return
-				}
-				th.requestTopicWorkers(ctx, topic)
-				th.requestTopicReputers(ctx, topic)
-			}(churnableTopicId)
+			topic, err := th.emissionsKeeper.GetTopic(ctx, churnableTopicId)
+			if err != nil {
+				Logger(ctx).Error("Error getting topic: " + err.Error())
+				continue
+			}
+			th.requestTopicWorkers(ctx, topic, url)
+			th.requestTopicReputers(ctx, topic, url)
 		}
-		wg.Wait()
 		// Return the transactions as they came
 		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
 	}
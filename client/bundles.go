@@ -0,0 +1,85 @@
+package client
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/allora-network/allora-chain/app/params"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type bundle interface {
+	XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
+}
+
+// Signs the bytes of a bundle the emissions module verifies its signature against,
+// returning the signature, the hex encoded public key and the address of the key
+func signBundle(kr keyring.Keyring, name string, b bundle) ([]byte, string, string, error) {
+	src, err := b.XXX_Marshal(make([]byte, 0), true)
+	if err != nil {
+		return nil, "", "", err
+	}
+	sig, pubKey, err := kr.Sign(name, src, signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return nil, "", "", err
+	}
+	address, err := sdk.Bech32ifyAddressBytes(params.Bech32PrefixAccAddr, pubKey.Address())
+	if err != nil {
+		return nil, "", "", err
+	}
+	return sig, hex.EncodeToString(pubKey.Bytes()), address, nil
+}
+
+// Signs the inference and forecast of a worker with the key of the worker, the worker being the inferer
+// of the inference and the forecaster of the forecast
+func SignWorkerDataBundle(
+	kr keyring.Keyring,
+	name string,
+	b *emissionstypes.InferenceForecastBundle,
+) (*emissionstypes.WorkerDataBundle, error) {
+	if b == nil {
+		return nil, fmt.Errorf("inference forecast bundle cannot be nil")
+	}
+	sig, pubKey, worker, err := signBundle(kr, name, b)
+	if err != nil {
+		return nil, err
+	}
+	if b.Inference != nil && b.Inference.Inferer != worker {
+		return nil, fmt.Errorf("inferer %s is not the worker %s of key %s", b.Inference.Inferer, worker, name)
+	}
+	if b.Forecast != nil && b.Forecast.Forecaster != worker {
+		return nil, fmt.Errorf("forecaster %s is not the worker %s of key %s", b.Forecast.Forecaster, worker, name)
+	}
+	return &emissionstypes.WorkerDataBundle{
+		Worker:                             worker,
+		InferenceForecastsBundle:           b,
+		InferencesForecastsBundleSignature: sig,
+		Pubkey:                             pubKey,
+	}, nil
+}
+
+// Signs the losses a reputer reports with the key of the reputer
+func SignReputerValueBundle(
+	kr keyring.Keyring,
+	name string,
+	b *emissionstypes.ValueBundle,
+) (*emissionstypes.ReputerValueBundle, error) {
+	if b == nil {
+		return nil, fmt.Errorf("value bundle cannot be nil")
+	}
+	sig, pubKey, reputer, err := signBundle(kr, name, b)
+	if err != nil {
+		return nil, err
+	}
+	if b.Reputer != reputer {
+		return nil, fmt.Errorf("reputer %s is not the reputer %s of key %s", b.Reputer, reputer, name)
+	}
+	return &emissionstypes.ReputerValueBundle{
+		ValueBundle: b,
+		Signature:   sig,
+		Pubkey:      pubKey,
+	}, nil
+}
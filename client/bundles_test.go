@@ -0,0 +1,92 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/client"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T, kr keyring.Keyring, name string) string {
+	record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+	return sdk.MustBech32ifyAddressBytes(params.Bech32PrefixAccAddr, address)
+}
+
+func newKeyring(t *testing.T) keyring.Keyring {
+	registry, err := client.NewInterfaceRegistry()
+	require.NoError(t, err)
+	return keyring.NewInMemory(codec.NewProtoCodec(registry))
+}
+
+func TestSignWorkerDataBundle(t *testing.T) {
+	kr := newKeyring(t)
+	worker := newKey(t, kr, "worker")
+	other := newKey(t, kr, "other")
+
+	bundle := &emissionstypes.InferenceForecastBundle{
+		Inference: &emissionstypes.Inference{
+			TopicId:     1,
+			BlockHeight: 10,
+			Inferer:     worker,
+			Value:       alloraMath.MustNewDecFromString("3.5"),
+		},
+		Forecast: &emissionstypes.Forecast{
+			TopicId:     1,
+			BlockHeight: 10,
+			Forecaster:  worker,
+			ForecastElements: []*emissionstypes.ForecastElement{
+				{Inferer: other, Value: alloraMath.MustNewDecFromString("0.2")},
+			},
+		},
+	}
+	signed, err := client.SignWorkerDataBundle(kr, "worker", bundle)
+	require.NoError(t, err)
+	require.Equal(t, worker, signed.Worker)
+	require.NoError(t, signed.Validate())
+
+	// The signature covers the bundle, so changing it afterwards is caught
+	bundle.Inference.Value = alloraMath.MustNewDecFromString("4")
+	require.Error(t, signed.Validate())
+
+	_, err = client.SignWorkerDataBundle(kr, "other", bundle)
+	require.Error(t, err)
+}
+
+func TestSignReputerValueBundle(t *testing.T) {
+	kr := newKeyring(t)
+	reputer := newKey(t, kr, "reputer")
+	worker := newKey(t, kr, "worker")
+
+	bundle := &emissionstypes.ValueBundle{
+		TopicId:       1,
+		Reputer:       reputer,
+		CombinedValue: alloraMath.MustNewDecFromString("0.1"),
+		NaiveValue:    alloraMath.MustNewDecFromString("0.2"),
+		InfererValues: []*emissionstypes.WorkerAttributedValue{
+			{Worker: worker, Value: alloraMath.MustNewDecFromString("0.3")},
+		},
+		ReputerRequestNonce: &emissionstypes.ReputerRequestNonce{
+			ReputerNonce: &emissionstypes.Nonce{BlockHeight: 20},
+			WorkerNonce:  &emissionstypes.Nonce{BlockHeight: 10},
+		},
+	}
+	signed, err := client.SignReputerValueBundle(kr, "reputer", bundle)
+	require.NoError(t, err)
+	require.NoError(t, signed.Validate())
+
+	bundle.CombinedValue = alloraMath.MustNewDecFromString("0")
+	require.Error(t, signed.Validate())
+
+	_, err = client.SignReputerValueBundle(kr, "worker", bundle)
+	require.Error(t, err)
+}
@@ -0,0 +1,200 @@
+// Package client is a typed Go client for the emissions and mint modules of an Allora chain. It wraps every
+// Query and Msg of both modules behind their generated gRPC interfaces, signing and broadcasting Msgs with keys
+// of a keyring, keeping track of the account sequence of every signer and retrying transient failures.
+//
+//	c, err := client.New(ctx, client.Config{NodeAddress: "tcp://localhost:26657", Keyring: kr})
+//	topic, err := c.Emissions.GetTopic(ctx, &emissionstypes.QueryTopicRequest{TopicId: 1})
+//	_, err = c.EmissionsTx.AddStake(ctx, &emissionstypes.MsgAddStake{Sender: reputer, TopicId: 1, Amount: amount})
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/x/tx/signing"
+	"github.com/allora-network/allora-chain/app/params"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	DefaultGasAdjustment = 1.2
+	DefaultMaxRetries    = 5
+	DefaultRetryDelay    = time.Second
+	DefaultPollInterval  = 500 * time.Millisecond
+	DefaultTxTimeout     = time.Minute
+)
+
+type Config struct {
+	NodeAddress string // CometBFT RPC endpoint of the node, e.g. tcp://localhost:26657
+	ChainID     string // chain id the transactions are signed for, read from the node if empty
+	// Keyring holding the keys of the signers. Msgs are signed with the key of the address of their signer.
+	// Only needed to broadcast Msgs.
+	Keyring       keyring.Keyring
+	Gas           uint64  // gas limit of every transaction, estimated by simulating it if 0
+	GasAdjustment float64 // factor the simulated gas is multiplied by, DefaultGasAdjustment if 0
+	GasPrices     string  // e.g. 10uallo, mutually exclusive with Fees
+	Fees          string  // fees paid by every transaction, e.g. 1000uallo
+	MaxRetries    int     // times a transaction failing transiently is retried, DefaultMaxRetries if 0
+	RetryDelay    time.Duration
+	PollInterval  time.Duration // how often to check whether a broadcast transaction is included
+	// How long to wait for a transaction to be included when the context has no deadline
+	TxTimeout time.Duration
+}
+
+type Client struct {
+	Emissions emissionstypes.QueryClient
+	Mint      minttypes.QueryClient
+	Auth      authtypes.QueryClient
+	Bank      banktypes.QueryClient
+	// Every Msg sent through these is signed by its signer, broadcast and waited for, and the Msg response
+	// of the included transaction returned. Msgs failing on chain return a *TxError.
+	EmissionsTx emissionstypes.MsgClient
+	MintTx      minttypes.MsgClient
+
+	cfg      Config
+	rpc      rpcclient.Client
+	cdc      codec.Codec
+	registry codectypes.InterfaceRegistry
+	txConfig sdkclient.TxConfig
+	queries  *queryConn
+
+	signersMutex sync.Mutex
+	signers      map[string]*signer // by address
+}
+
+// Connects to the node and reads its chain id unless the config sets it
+func New(ctx context.Context, cfg Config) (*Client, error) {
+	if cfg.GasAdjustment == 0 {
+		cfg.GasAdjustment = DefaultGasAdjustment
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.RetryDelay == 0 {
+		cfg.RetryDelay = DefaultRetryDelay
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.TxTimeout == 0 {
+		cfg.TxTimeout = DefaultTxTimeout
+	}
+	if cfg.GasPrices != "" && cfg.Fees != "" {
+		return nil, fmt.Errorf("gas prices and fees are mutually exclusive")
+	}
+	if _, err := sdk.ParseCoinsNormalized(cfg.Fees); err != nil {
+		return nil, fmt.Errorf("invalid fees %q: %w", cfg.Fees, err)
+	}
+	if _, err := sdk.ParseDecCoins(cfg.GasPrices); err != nil {
+		return nil, fmt.Errorf("invalid gas prices %q: %w", cfg.GasPrices, err)
+	}
+
+	rpc, err := rpchttp.New(cfg.NodeAddress, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", cfg.NodeAddress, err)
+	}
+	if cfg.ChainID == "" {
+		status, err := rpc.Status(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the chain id from %s: %w", cfg.NodeAddress, err)
+		}
+		cfg.ChainID = status.NodeInfo.Network
+	}
+
+	registry, err := NewInterfaceRegistry()
+	if err != nil {
+		return nil, err
+	}
+	cdc := codec.NewProtoCodec(registry)
+	c := &Client{
+		cfg:      cfg,
+		rpc:      rpc,
+		cdc:      cdc,
+		registry: registry,
+		txConfig: authtx.NewTxConfig(cdc, authtx.DefaultSignModes),
+		signers:  make(map[string]*signer),
+	}
+	c.queries = &queryConn{rpc: rpc, registry: registry}
+	c.Emissions = emissionstypes.NewQueryClient(c.queries)
+	c.Mint = minttypes.NewQueryClient(c.queries)
+	c.Auth = authtypes.NewQueryClient(c.queries)
+	c.Bank = banktypes.NewQueryClient(c.queries)
+	c.EmissionsTx = emissionstypes.NewMsgClient(&txConn{c: c})
+	c.MintTx = minttypes.NewMsgClient(&txConn{c: c})
+	return c, nil
+}
+
+// Interface registry knowing the Msgs and accounts the client signs and decodes, with Allora addresses
+func NewInterfaceRegistry() (codectypes.InterfaceRegistry, error) {
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addresscodec.NewBech32Codec(params.Bech32PrefixAccAddr),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(params.Bech32PrefixValAddr),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	emissionstypes.RegisterInterfaces(registry)
+	minttypes.RegisterInterfaces(registry)
+	return registry, nil
+}
+
+func (c *Client) ChainID() string {
+	return c.cfg.ChainID
+}
+
+func (c *Client) Codec() codec.Codec {
+	return c.cdc
+}
+
+// Height of the latest block of the node
+func (c *Client) BlockHeight(ctx context.Context) (int64, error) {
+	status, err := c.rpc.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// Waits until the node has committed a block at height or above
+func (c *Client) WaitForBlockHeight(ctx context.Context, height int64) error {
+	ticker := time.NewTicker(c.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		latest, err := c.BlockHeight(ctx)
+		if err != nil {
+			return err
+		}
+		if latest >= height {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package client_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/client"
+	"github.com/allora-network/allora-chain/test/testnet"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAgainstNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the in-process network in short mode")
+	}
+	network := testnet.New(t, testnet.DefaultConfig())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	registry, err := client.NewInterfaceRegistry()
+	require.NoError(t, err)
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, network.AlloraHomeDir, nil, codec.NewProtoCodec(registry))
+	require.NoError(t, err)
+	c, err := client.New(ctx, client.Config{NodeAddress: network.RpcAddress, Keyring: kr})
+	require.NoError(t, err)
+	require.Equal(t, "allora-testnet-1", c.ChainID())
+
+	topic, err := c.Emissions.GetTopic(ctx, &emissionstypes.QueryTopicRequest{TopicId: network.TopicId})
+	require.NoError(t, err)
+	require.Equal(t, network.TopicId, topic.Topic.Id)
+	_, err = c.Mint.Params(ctx, &minttypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	_, err = c.Emissions.GetTopic(ctx, &emissionstypes.QueryTopicRequest{TopicId: 1000})
+	require.Error(t, err)
+
+	record, err := kr.Key(network.ReputerNames[0])
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+	reputer := address.String()
+
+	registered, err := c.EmissionsTx.Register(ctx, &emissionstypes.MsgRegister{
+		Sender:       reputer,
+		Owner:        reputer,
+		LibP2PKey:    "reputerkey",
+		MultiAddress: "reputermultiaddress",
+		TopicId:      network.TopicId,
+		IsReputer:    true,
+	})
+	require.NoError(t, err)
+	require.True(t, registered.Success)
+
+	// Module errors of failing Msgs can be matched
+	_, err = c.EmissionsTx.AddStake(ctx, &emissionstypes.MsgAddStake{Sender: reputer, TopicId: 1000, Amount: cosmosMath.NewInt(1)})
+	require.ErrorIs(t, err, emissionstypes.ErrTopicDoesNotExist)
+
+	// Concurrent Msgs of the same signer each get their own sequence
+	const stakes = 5
+	var wg sync.WaitGroup
+	errs := make([]error, stakes)
+	for i := 0; i < stakes; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = c.EmissionsTx.AddStake(ctx, &emissionstypes.MsgAddStake{
+				Sender:  reputer,
+				TopicId: network.TopicId,
+				Amount:  cosmosMath.NewInt(100),
+			})
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	stake, err := c.Emissions.GetReputerStakeInTopic(ctx, &emissionstypes.QueryReputerStakeInTopicRequest{
+		Address: reputer,
+		TopicId: network.TopicId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, cosmosMath.NewInt(100*stakes), stake.Amount)
+
+	_, err = c.ActiveTopics(1).All(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+)
+
+// Sends queries to the gRPC query router of the node through ABCI queries, as the node may not expose gRPC
+type queryConn struct {
+	rpc      rpcclient.Client
+	registry codectypes.InterfaceRegistry
+}
+
+func (q *queryConn) Invoke(ctx context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	req, ok := args.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%s: request %T is not a proto message", method, args)
+	}
+	bz, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	res, err := q.rpc.ABCIQueryWithOptions(ctx, method, bz, rpcclient.ABCIQueryOptions{})
+	if err != nil {
+		return err
+	}
+	if !res.Response.IsOK() {
+		return errorsmod.ABCIError(res.Response.Codespace, res.Response.Code, res.Response.Log)
+	}
+	if err := proto.Unmarshal(res.Response.Value, reply.(proto.Message)); err != nil {
+		return err
+	}
+	return codectypes.UnpackInterfaces(reply, q.registry)
+}
+
+func (q *queryConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("streaming is not supported")
+}
+
+// Turns the calls of a MsgClient into transactions, returning the Msg response of the included transaction
+type txConn struct {
+	c *Client
+}
+
+func (t *txConn) Invoke(ctx context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	msg, ok := args.(sdk.Msg)
+	if !ok {
+		return fmt.Errorf("%s: request %T is not a Msg", method, args)
+	}
+	res, err := t.c.broadcast(ctx, msg)
+	if err != nil {
+		return err
+	}
+	var msgData sdk.TxMsgData
+	if err := proto.Unmarshal(res.TxResult.Data, &msgData); err != nil {
+		return err
+	}
+	if len(msgData.MsgResponses) != 1 {
+		return fmt.Errorf("%s: expected 1 Msg response, got %d", method, len(msgData.MsgResponses))
+	}
+	return proto.Unmarshal(msgData.MsgResponses[0].Value, reply.(proto.Message))
+}
+
+func (t *txConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("streaming is not supported")
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// DefaultPageSize is the number of items an iterator fetches at once when none is given
+const DefaultPageSize = 100
+
+// Fetches the page of a cursor-paginated query starting at the cursor of the request
+type PageFunc[T any] func(
+	ctx context.Context,
+	pagination *emissionstypes.SimpleCursorPaginationRequest,
+) ([]T, *emissionstypes.SimpleCursorPaginationResponse, error)
+
+// Iterator walks through every item of a cursor-paginated query, fetching a page at a time:
+//
+//	it := c.ActiveTopics(0)
+//	for it.Next(ctx) {
+//		topic := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch    PageFunc[T]
+	pageSize uint64
+	page     []T
+	index    int
+	nextKey  []byte
+	started  bool
+	done     bool
+	err      error
+}
+
+func NewIterator[T any](pageSize uint64, fetch PageFunc[T]) *Iterator[T] {
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	return &Iterator[T]{fetch: fetch, pageSize: pageSize, index: -1}
+}
+
+// Advances to the next item, fetching the next page when the current one is exhausted.
+// Returns false once every item has been visited or fetching a page failed.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.page) {
+		if it.done {
+			return false
+		}
+		// An empty cursor past the first page means there are no more pages
+		if it.started && len(it.nextKey) == 0 {
+			it.done = true
+			return false
+		}
+		page, res, err := it.fetch(ctx, &emissionstypes.SimpleCursorPaginationRequest{Key: it.nextKey, Limit: it.pageSize})
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.started = true
+		it.page = page
+		it.index = 0
+		it.nextKey = nil
+		if res != nil {
+			it.nextKey = res.NextKey
+		}
+		if len(page) == 0 {
+			it.done = true
+		}
+	}
+	return true
+}
+
+// Item the iterator is at
+func (it *Iterator[T]) Value() T {
+	return it.page[it.index]
+}
+
+// Error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Collects the items left to visit
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	items := []T{}
+	for it.Next(ctx) {
+		items = append(items, it.Value())
+	}
+	return items, it.Err()
+}
+
+// Iterates over the active topics, fetching pageSize topics at a time, or DefaultPageSize if 0
+func (c *Client) ActiveTopics(pageSize uint64) *Iterator[*emissionstypes.Topic] {
+	return NewIterator(pageSize, func(
+		ctx context.Context,
+		pagination *emissionstypes.SimpleCursorPaginationRequest,
+	) ([]*emissionstypes.Topic, *emissionstypes.SimpleCursorPaginationResponse, error) {
+		res, err := c.Emissions.GetActiveTopics(ctx, &emissionstypes.QueryActiveTopicsRequest{Pagination: pagination})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Topics, res.Pagination, nil
+	})
+}
@@ -0,0 +1,84 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/allora-network/allora-chain/client"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Pages through the numbers below n with the cursor being the next number, as the topic queries do
+func numbersBelow(n int, calls *int) client.PageFunc[int] {
+	return func(
+		_ context.Context,
+		pagination *emissionstypes.SimpleCursorPaginationRequest,
+	) ([]int, *emissionstypes.SimpleCursorPaginationResponse, error) {
+		*calls++
+		start := 0
+		if len(pagination.Key) > 0 {
+			var err error
+			if start, err = strconv.Atoi(string(pagination.Key)); err != nil {
+				return nil, nil, err
+			}
+		}
+		end := min(start+int(pagination.Limit), n)
+		page := []int{}
+		for i := start; i < end; i++ {
+			page = append(page, i)
+		}
+		nextKey := []byte{}
+		if end < n {
+			nextKey = []byte(strconv.Itoa(end))
+		}
+		return page, &emissionstypes.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+	}
+}
+
+func TestIteratorVisitsEveryPage(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		n, pageSize, calls int
+	}{
+		{n: 0, pageSize: 3, calls: 1},
+		{n: 2, pageSize: 3, calls: 1},
+		{n: 6, pageSize: 3, calls: 2},
+		{n: 7, pageSize: 3, calls: 3},
+	} {
+		calls := 0
+		items, err := client.NewIterator(uint64(tc.pageSize), numbersBelow(tc.n, &calls)).All(ctx)
+		require.NoError(t, err)
+		require.Len(t, items, tc.n)
+		for i, item := range items {
+			require.Equal(t, i, item)
+		}
+		require.Equal(t, tc.calls, calls, "n=%d", tc.n)
+	}
+}
+
+func TestIteratorStopsOnError(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	pages := numbersBelow(10, &calls)
+	failing := func(
+		ctx context.Context,
+		pagination *emissionstypes.SimpleCursorPaginationRequest,
+	) ([]int, *emissionstypes.SimpleCursorPaginationResponse, error) {
+		if len(pagination.Key) > 0 {
+			return nil, nil, errors.New("node unavailable")
+		}
+		return pages(ctx, pagination)
+	}
+
+	it := client.NewIterator(4, failing)
+	visited := 0
+	for it.Next(ctx) {
+		visited++
+	}
+	require.Equal(t, 4, visited)
+	require.EqualError(t, it.Err(), "node unavailable")
+	require.False(t, it.Next(ctx))
+}
@@ -0,0 +1,308 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/cometbft/cometbft/mempool"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// A transaction that was rejected by the mempool or failed once included in a block.
+// It unwraps to the registered error of its codespace and code, so errors.Is matches module errors.
+type TxError struct {
+	TxHash    string
+	Codespace string
+	Code      uint32
+	Log       string
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("transaction %s failed with code %d in codespace %s: %s", e.TxHash, e.Code, e.Codespace, e.Log)
+}
+
+func (e *TxError) Unwrap() error {
+	return errorsmod.ABCIError(e.Codespace, e.Code, e.Log)
+}
+
+// Account a key of the keyring signs with. Transactions of the same signer are built and broadcast one at a
+// time so each gets the next sequence, which is only read from the chain again when it goes out of sync.
+type signer struct {
+	mu            sync.Mutex
+	name          string // name of the key in the keyring
+	address       string
+	loaded        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+// Signs msgs with the key of their signer, broadcasts them in a transaction and waits for it to be included.
+// The transaction response is returned along with a *TxError if it failed on chain.
+func (c *Client) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := c.broadcast(ctx, msgs...)
+	if res == nil {
+		return nil, err
+	}
+	return sdk.NewResponseResultTx(res, nil, ""), err
+}
+
+func (c *Client) broadcast(ctx context.Context, msgs ...sdk.Msg) (*coretypes.ResultTx, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.TxTimeout)
+		defer cancel()
+	}
+	s, err := c.signerOf(msgs)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := c.submit(ctx, s, msgs)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.waitForTx(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if res.TxResult.Code != 0 {
+		return res, &TxError{
+			TxHash:    res.Hash.String(),
+			Codespace: res.TxResult.Codespace,
+			Code:      res.TxResult.Code,
+			Log:       res.TxResult.Log,
+		}
+	}
+	return res, nil
+}
+
+// The signer of msgs, which must all be signed by the same key of the keyring
+func (c *Client) signerOf(msgs []sdk.Msg) (*signer, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no msgs to broadcast")
+	}
+	if c.cfg.Keyring == nil {
+		return nil, fmt.Errorf("a keyring is required to broadcast msgs")
+	}
+	var signerAddress []byte
+	for _, msg := range msgs {
+		signers, _, err := c.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range signers {
+			if signerAddress == nil {
+				signerAddress = address
+			} else if !sdk.AccAddress(signerAddress).Equals(sdk.AccAddress(address)) {
+				return nil, fmt.Errorf("msgs with several signers are not supported")
+			}
+		}
+	}
+	if signerAddress == nil {
+		return nil, fmt.Errorf("msgs have no signer")
+	}
+	address, err := sdk.Bech32ifyAddressBytes(params.Bech32PrefixAccAddr, signerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	c.signersMutex.Lock()
+	defer c.signersMutex.Unlock()
+	if s, ok := c.signers[address]; ok {
+		return s, nil
+	}
+	record, err := c.cfg.Keyring.KeyByAddress(sdk.AccAddress(signerAddress))
+	if err != nil {
+		return nil, fmt.Errorf("no key for signer %s in the keyring: %w", address, err)
+	}
+	s := &signer{name: record.Name, address: address}
+	c.signers[address] = s
+	return s, nil
+}
+
+// Broadcasts a transaction of msgs until the mempool accepts it, returning its hash.
+// The account sequence is read again when it is out of sync, and transactions rejected because the
+// mempool is full or that could not be sent are sent again after a delay.
+func (c *Client) submit(ctx context.Context, s *signer, msgs []sdk.Msg) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var txBytes []byte
+	for attempt := 0; ; attempt++ {
+		err := c.trySubmit(ctx, s, msgs, &txBytes)
+		if err == nil {
+			return cmttypes.Tx(txBytes).Hash(), nil
+		}
+		var retry *retryableError
+		if !errors.As(err, &retry) {
+			return nil, err
+		}
+		if attempt >= c.cfg.MaxRetries {
+			return nil, retry.err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.cfg.RetryDelay):
+		}
+	}
+}
+
+// A failure the transaction may succeed after
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+// Makes a single attempt at broadcasting a transaction of msgs. The signed transaction is kept in txBytes so
+// that it is sent as is again, unless the sequence it was signed with turns out to be out of sync.
+func (c *Client) trySubmit(ctx context.Context, s *signer, msgs []sdk.Msg, txBytes *[]byte) error {
+	if !s.loaded {
+		if err := c.loadAccount(ctx, s); err != nil {
+			return &retryableError{err}
+		}
+	}
+	if *txBytes == nil {
+		bz, err := c.buildTx(ctx, s, msgs)
+		if errors.Is(err, sdkerrors.ErrWrongSequence) {
+			s.loaded = false
+			return &retryableError{err}
+		}
+		if err != nil {
+			return err
+		}
+		*txBytes = bz
+	}
+
+	res, err := c.rpc.BroadcastTxSync(ctx, *txBytes)
+	switch {
+	case err != nil && strings.Contains(err.Error(), mempool.ErrTxInCache.Error()):
+		// An earlier attempt made it to the mempool after all
+		s.sequence++
+		return nil
+	case err != nil:
+		return &retryableError{err}
+	case res.Code == 0:
+		s.sequence++
+		return nil
+	}
+
+	err = &TxError{TxHash: res.Hash.String(), Codespace: res.Codespace, Code: res.Code, Log: res.Log}
+	if errors.Is(err, sdkerrors.ErrWrongSequence) {
+		s.loaded = false
+		*txBytes = nil
+		return &retryableError{err}
+	}
+	if errors.Is(err, sdkerrors.ErrMempoolIsFull) {
+		return &retryableError{err}
+	}
+	return err
+}
+
+func (c *Client) loadAccount(ctx context.Context, s *signer) error {
+	res, err := c.Auth.Account(ctx, &authtypes.QueryAccountRequest{Address: s.address})
+	if err != nil {
+		return fmt.Errorf("failed to read account %s: %w", s.address, err)
+	}
+	var account sdk.AccountI
+	if err := c.registry.UnpackAny(res.Account, &account); err != nil {
+		return err
+	}
+	s.accountNumber = account.GetAccountNumber()
+	s.sequence = account.GetSequence()
+	s.loaded = true
+	return nil
+}
+
+// Builds and signs a transaction of msgs with the next sequence of the signer, simulating it to estimate
+// its gas unless the config sets it
+func (c *Client) buildTx(ctx context.Context, s *signer, msgs []sdk.Msg) ([]byte, error) {
+	txf := tx.Factory{}.
+		WithTxConfig(c.txConfig).
+		WithKeybase(c.cfg.Keyring).
+		WithChainID(c.cfg.ChainID).
+		WithFromName(s.name).
+		WithAccountNumber(s.accountNumber).
+		WithSequence(s.sequence).
+		WithGasAdjustment(c.cfg.GasAdjustment).
+		WithSimulateAndExecute(c.cfg.Gas == 0).
+		WithFees(c.cfg.Fees).
+		WithGasPrices(c.cfg.GasPrices)
+
+	gas := c.cfg.Gas
+	if gas == 0 {
+		simTx, err := txf.BuildSimTx(msgs...)
+		if err != nil {
+			return nil, err
+		}
+		gasUsed, err := c.simulate(ctx, simTx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to simulate the transaction: %w", err)
+		}
+		gas = uint64(c.cfg.GasAdjustment * float64(gasUsed))
+	}
+	txf = txf.WithGas(gas)
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(ctx, txf, s.name, builder, true); err != nil {
+		return nil, err
+	}
+	return c.txConfig.TxEncoder()(builder.GetTx())
+}
+
+// Returns the gas a transaction uses. The app simulate query is used rather than the tx service so that
+// failures keep the codespace and code of their error.
+func (c *Client) simulate(ctx context.Context, txBytes []byte) (uint64, error) {
+	res, err := c.rpc.ABCIQueryWithOptions(ctx, "/app/simulate", txBytes, rpcclient.ABCIQueryOptions{})
+	if err != nil {
+		return 0, err
+	}
+	if !res.Response.IsOK() {
+		return 0, errorsmod.ABCIError(res.Response.Codespace, res.Response.Code, res.Response.Log)
+	}
+	var simRes struct {
+		GasInfo struct {
+			GasUsed string `json:"gas_used"`
+		} `json:"gas_info"`
+	}
+	if err := json.Unmarshal(res.Response.Value, &simRes); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(simRes.GasInfo.GasUsed, 10, 64)
+}
+
+// Polls the node until the transaction is included in a block
+func (c *Client) waitForTx(ctx context.Context, hash []byte) (*coretypes.ResultTx, error) {
+	ticker := time.NewTicker(c.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		res, err := c.rpc.Tx(ctx, hash, false)
+		if err == nil {
+			return res, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %X was not included: %w", hash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
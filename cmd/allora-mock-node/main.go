@@ -0,0 +1,390 @@
+// allora-mock-node is an offline reference worker and reputer node for local testing. It registers its
+// workers and reputers on the configured topics, listens for the EventWorkerRequest and EventReputerRequest
+// events the emissions module emits at the end of every block, and answers them as leader with
+// deterministic synthetic inferences, forecasts and losses, so devnets earn real rewards without Blockless.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app"
+	"github.com/allora-network/allora-chain/app/params"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ignite/cli/v28/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v28/ignite/pkg/cosmosclient"
+)
+
+const subscriber = "allora-mock-node"
+
+type MockNode struct {
+	client    cosmosclient.Client
+	queries   emissionstypes.QueryClient
+	gen       *Generator
+	topics    map[uint64]bool
+	workers   []Actor
+	reputers  []Actor
+	submitted map[string]bool // requests already answered, as the same request can be emitted in several blocks
+}
+
+func main() {
+	node := flag.String("node", "tcp://localhost:26657", "CometBFT RPC endpoint of the node to subscribe and submit to")
+	home := flag.String("home", app.DefaultNodeHome, "directory holding the keyring of the workers and reputers")
+	keyringBackend := flag.String("keyring-backend", string(cosmosaccount.KeyringTest), "keyring backend")
+	fees := flag.String("fees", "", "fees paid by every transaction, e.g. 10uallo")
+	topics := flag.String("topics", "1", "comma separated ids of the topics to work on")
+	workers := flag.String("workers", "", "comma separated key names of the workers, the first one is the worker leader")
+	reputers := flag.String("reputers", "", "comma separated key names of the reputers, the first one is the reputer leader")
+	reputerStake := flag.String("reputer-stake", "", "stake in uallo each reputer keeps in each topic, defaults to the required minimum stake")
+	register := flag.Bool("register", true, "register the workers and reputers on the topics and stake the reputers on startup")
+	seed := flag.Int64("seed", 1, "seed of the synthetic data, the same seed always produces the same data")
+	step := flag.Float64("step", 1, "standard deviation of each block step of the ground truth random walk")
+	noise := flag.Float64("noise", 1, "standard deviation of the error of honest workers and reputers")
+	adversarialMode := flag.String("adversarial-mode", string(AdversarialNone), "how adversarial actors misbehave: none, biased, constant or random")
+	adversarialWorkers := flag.Int("adversarial-workers", 0, "number of workers, counted from the end of --workers, that are adversarial")
+	adversarialReputers := flag.Int("adversarial-reputers", 0, "number of reputers, counted from the end of --reputers, that are adversarial")
+	flag.Parse()
+
+	mode, err := ParseAdversarialMode(*adversarialMode)
+	if err != nil {
+		log.Fatal(err)
+	}
+	topicIds, err := parseTopicIds(*topics)
+	if err != nil {
+		log.Fatal(err)
+	}
+	workerNames := parseNames(*workers)
+	reputerNames := parseNames(*reputers)
+	if len(workerNames) == 0 && len(reputerNames) == 0 {
+		log.Fatal("no workers or reputers, set --workers and/or --reputers")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := cosmosclient.New(
+		ctx,
+		cosmosclient.WithNodeAddress(*node),
+		cosmosclient.WithAddressPrefix(params.HumanCoinUnit),
+		cosmosclient.WithHome(*home),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(*keyringBackend)),
+		cosmosclient.WithGas("auto"),
+		cosmosclient.WithGasAdjustment(1.2),
+		cosmosclient.WithFees(*fees),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	n, err := NewMockNode(
+		client,
+		NewGenerator(*seed, *step, *noise, mode),
+		topicIds,
+		workerNames,
+		reputerNames,
+		*adversarialWorkers,
+		*adversarialReputers,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := n.checkTopics(ctx, topicIds); err != nil {
+		log.Fatal(err)
+	}
+	if *register {
+		if err := n.registerActors(ctx, topicIds, *reputerStake); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := n.run(ctx, *node); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func NewMockNode(
+	client cosmosclient.Client,
+	gen *Generator,
+	topicIds []uint64,
+	workerNames []string,
+	reputerNames []string,
+	adversarialWorkers int,
+	adversarialReputers int,
+) (*MockNode, error) {
+	n := &MockNode{
+		client:    client,
+		queries:   emissionstypes.NewQueryClient(client.Context()),
+		gen:       gen,
+		topics:    make(map[uint64]bool),
+		submitted: make(map[string]bool),
+	}
+	for _, topicId := range topicIds {
+		n.topics[topicId] = true
+	}
+	var err error
+	if n.workers, err = n.actors(workerNames, adversarialWorkers); err != nil {
+		return nil, err
+	}
+	if n.reputers, err = n.actors(reputerNames, adversarialReputers); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func parseNames(s string) []string {
+	names := make([]string, 0)
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func parseTopicIds(s string) ([]uint64, error) {
+	topicIds := make([]uint64, 0)
+	for _, name := range parseNames(s) {
+		topicId, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid topic id %q: %w", name, err)
+		}
+		topicIds = append(topicIds, topicId)
+	}
+	return topicIds, nil
+}
+
+// Looks up the keys of the actors, the last numAdversarial of them being adversarial
+func (n *MockNode) actors(names []string, numAdversarial int) ([]Actor, error) {
+	actors := make([]Actor, len(names))
+	for i, name := range names {
+		account, err := n.client.Account(name)
+		if err != nil {
+			return nil, err
+		}
+		address, err := account.Address(params.HumanCoinUnit)
+		if err != nil {
+			return nil, err
+		}
+		actors[i] = Actor{
+			Name:        name,
+			Address:     address,
+			Adversarial: i >= len(names)-numAdversarial,
+		}
+	}
+	return actors, nil
+}
+
+// Commit-reveal topics only accept inferences that were committed to beforehand, which the mock node does not do
+func (n *MockNode) checkTopics(ctx context.Context, topicIds []uint64) error {
+	for _, topicId := range topicIds {
+		response, err := n.queries.GetTopic(ctx, &emissionstypes.QueryTopicRequest{TopicId: topicId})
+		if err != nil {
+			return fmt.Errorf("topic %d: %w", topicId, err)
+		}
+		if response.Topic.IsCommitReveal() {
+			return fmt.Errorf("topic %d uses commit-reveal, which the mock node does not support", topicId)
+		}
+	}
+	return nil
+}
+
+func (n *MockNode) broadcast(ctx context.Context, actor Actor, msgs ...sdk.Msg) error {
+	account, err := n.client.Account(actor.Name)
+	if err != nil {
+		return err
+	}
+	response, err := n.client.BroadcastTx(ctx, account, msgs...)
+	if err != nil {
+		return err
+	}
+	_, err = n.client.WaitForTx(ctx, response.TxHash)
+	return err
+}
+
+// Registers every worker and reputer on the topics it is not registered on yet,
+// and tops up the stake of every reputer to the requested stake
+func (n *MockNode) registerActors(ctx context.Context, topicIds []uint64, reputerStake string) error {
+	stake := cosmosMath.ZeroInt()
+	if reputerStake == "" {
+		response, err := n.queries.Params(ctx, &emissionstypes.QueryParamsRequest{})
+		if err != nil {
+			return err
+		}
+		stake = response.Params.RequiredMinimumStake
+	} else {
+		var ok bool
+		if stake, ok = cosmosMath.NewIntFromString(reputerStake); !ok {
+			return fmt.Errorf("invalid reputer stake %q", reputerStake)
+		}
+	}
+
+	for _, topicId := range topicIds {
+		for _, worker := range n.workers {
+			registered, err := n.queries.IsWorkerRegisteredInTopicId(ctx, &emissionstypes.QueryIsWorkerRegisteredInTopicIdRequest{
+				TopicId: topicId,
+				Address: worker.Address,
+			})
+			if err != nil {
+				return err
+			}
+			if registered.IsRegistered {
+				continue
+			}
+			log.Printf("registering worker %s on topic %d", worker.Name, topicId)
+			err = n.broadcast(ctx, worker, &emissionstypes.MsgRegister{
+				Sender:       worker.Address,
+				Owner:        worker.Address,
+				LibP2PKey:    worker.Name,
+				MultiAddress: subscriber,
+				TopicId:      topicId,
+				IsReputer:    false,
+			})
+			if err != nil {
+				return fmt.Errorf("registering worker %s on topic %d: %w", worker.Name, topicId, err)
+			}
+		}
+
+		for _, reputer := range n.reputers {
+			registered, err := n.queries.IsReputerRegisteredInTopicId(ctx, &emissionstypes.QueryIsReputerRegisteredInTopicIdRequest{
+				TopicId: topicId,
+				Address: reputer.Address,
+			})
+			if err != nil {
+				return err
+			}
+			if !registered.IsRegistered {
+				log.Printf("registering reputer %s on topic %d", reputer.Name, topicId)
+				err = n.broadcast(ctx, reputer, &emissionstypes.MsgRegister{
+					Sender:       reputer.Address,
+					Owner:        reputer.Address,
+					LibP2PKey:    reputer.Name,
+					MultiAddress: subscriber,
+					TopicId:      topicId,
+					IsReputer:    true,
+				})
+				if err != nil {
+					return fmt.Errorf("registering reputer %s on topic %d: %w", reputer.Name, topicId, err)
+				}
+			}
+
+			currentStake, err := n.queries.GetReputerStakeInTopic(ctx, &emissionstypes.QueryReputerStakeInTopicRequest{
+				TopicId: topicId,
+				Address: reputer.Address,
+			})
+			if err != nil {
+				return err
+			}
+			if currentStake.Amount.GTE(stake) {
+				continue
+			}
+			log.Printf("staking %s on reputer %s in topic %d", stake.Sub(currentStake.Amount), reputer.Name, topicId)
+			err = n.broadcast(ctx, reputer, &emissionstypes.MsgAddStake{
+				Sender:  reputer.Address,
+				TopicId: topicId,
+				Amount:  stake.Sub(currentStake.Amount),
+			})
+			if err != nil {
+				return fmt.Errorf("staking reputer %s on topic %d: %w", reputer.Name, topicId, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (n *MockNode) run(ctx context.Context, node string) error {
+	client, err := rpchttp.New(node, "/websocket")
+	if err != nil {
+		return err
+	}
+	if err := client.Start(); err != nil {
+		return err
+	}
+	defer client.Stop() //nolint:errcheck
+
+	// End block events are delivered with the block they were emitted in
+	blocks, err := client.Subscribe(ctx, subscriber, cmttypes.QueryForEvent(cmttypes.EventNewBlock).String())
+	if err != nil {
+		return err
+	}
+	defer client.UnsubscribeAll(context.Background(), subscriber) //nolint:errcheck
+
+	log.Printf("answering requests from %s with %d workers and %d reputers", node, len(n.workers), len(n.reputers))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case result, ok := <-blocks:
+			if !ok {
+				return fmt.Errorf("subscription to %s closed", node)
+			}
+			block, ok := result.Data.(cmttypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+			// Requests are answered one at a time, as the leaders submit them all from the same accounts
+			for _, event := range block.ResultFinalizeBlock.Events {
+				if err := n.handleEvent(ctx, event); err != nil {
+					log.Printf("block %d: %s", block.Block.Height, err)
+				}
+			}
+		}
+	}
+}
+
+// Answers the worker and reputer request events of the configured topics, ignoring other events
+func (n *MockNode) handleEvent(ctx context.Context, event abci.Event) error {
+	if event.Type != proto.MessageName(&emissionstypes.EventWorkerRequest{}) &&
+		event.Type != proto.MessageName(&emissionstypes.EventReputerRequest{}) {
+		return nil
+	}
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return err
+	}
+
+	switch request := msg.(type) {
+	case *emissionstypes.EventWorkerRequest:
+		key := fmt.Sprintf("worker/%d/%d", request.TopicId, request.Nonce.BlockHeight)
+		if len(n.workers) == 0 || !n.topics[request.TopicId] || n.submitted[key] {
+			return nil
+		}
+		payload, err := BuildWorkerPayload(n.client.Context().Keyring, n.gen, n.workers[0], n.workers, request)
+		if err != nil {
+			return err
+		}
+		if err := n.broadcast(ctx, n.workers[0], payload); err != nil {
+			return fmt.Errorf("topic %d: submitting worker payload for nonce %d: %w", request.TopicId, request.Nonce.BlockHeight, err)
+		}
+		n.submitted[key] = true
+		log.Printf("topic %d: submitted %d inferences for nonce %d", request.TopicId, len(payload.WorkerDataBundles), request.Nonce.BlockHeight)
+
+	case *emissionstypes.EventReputerRequest:
+		nonce := request.ReputerRequestNonce.ReputerNonce.BlockHeight
+		key := fmt.Sprintf("reputer/%d/%d", request.TopicId, nonce)
+		if len(n.reputers) == 0 || !n.topics[request.TopicId] || n.submitted[key] {
+			return nil
+		}
+		payload, err := BuildReputerPayload(n.client.Context().Keyring, n.gen, n.reputers[0], n.reputers, request)
+		if err != nil {
+			return err
+		}
+		if err := n.broadcast(ctx, n.reputers[0], payload); err != nil {
+			return fmt.Errorf("topic %d: submitting reputer payload for nonce %d: %w", request.TopicId, nonce, err)
+		}
+		n.submitted[key] = true
+		log.Printf("topic %d: submitted %d loss bundles for nonce %d", request.TopicId, len(payload.ReputerValueBundles), nonce)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"github.com/allora-network/allora-chain/client"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// A worker or reputer the mock node acts for
+type Actor struct {
+	Name        string // name of its key in the keyring
+	Address     string
+	Adversarial bool // misbehaves according to the adversarial mode of the generator
+}
+
+// Builds the bulk worker payload the leader submits for a worker request, with a signed inference
+// from every worker and, when there are several workers, a signed forecast of the losses of the others
+func BuildWorkerPayload(
+	kr keyring.Keyring,
+	gen *Generator,
+	leader Actor,
+	workers []Actor,
+	request *emissionstypes.EventWorkerRequest,
+) (*emissionstypes.MsgInsertBulkWorkerPayload, error) {
+	topicId := request.TopicId
+	blockHeight := request.Nonce.BlockHeight
+
+	inferences := make(map[string]float64, len(workers))
+	for _, worker := range workers {
+		inferences[worker.Address] = gen.Inference(worker.Address, worker.Adversarial, topicId, blockHeight)
+	}
+
+	bundles := make([]*emissionstypes.WorkerDataBundle, 0, len(workers))
+	for _, worker := range workers {
+		bundle := &emissionstypes.InferenceForecastBundle{
+			Inference: &emissionstypes.Inference{
+				TopicId:     topicId,
+				BlockHeight: blockHeight,
+				Inferer:     worker.Address,
+				Value:       ToDec(inferences[worker.Address]),
+			},
+		}
+		forecastElements := make([]*emissionstypes.ForecastElement, 0, len(workers)-1)
+		for _, inferer := range workers {
+			if inferer.Address == worker.Address {
+				continue
+			}
+			loss := gen.ForecastedLoss(worker.Address, worker.Adversarial, inferer.Address, inferences[inferer.Address], topicId, blockHeight)
+			forecastElements = append(forecastElements, &emissionstypes.ForecastElement{
+				Inferer: inferer.Address,
+				Value:   ToDec(loss),
+			})
+		}
+		if len(forecastElements) > 0 {
+			bundle.Forecast = &emissionstypes.Forecast{
+				TopicId:          topicId,
+				BlockHeight:      blockHeight,
+				Forecaster:       worker.Address,
+				ForecastElements: forecastElements,
+			}
+		}
+
+		workerBundle, err := client.SignWorkerDataBundle(kr, worker.Name, bundle)
+		if err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, workerBundle)
+	}
+
+	return &emissionstypes.MsgInsertBulkWorkerPayload{
+		Sender:            leader.Address,
+		Nonce:             &emissionstypes.Nonce{BlockHeight: blockHeight},
+		TopicId:           topicId,
+		WorkerDataBundles: bundles,
+	}, nil
+}
+
+// Builds the bulk reputer payload the leader submits for a reputer request,
+// with the signed losses every reputer computes for the network inferences of the request
+func BuildReputerPayload(
+	kr keyring.Keyring,
+	gen *Generator,
+	leader Actor,
+	reputers []Actor,
+	request *emissionstypes.EventReputerRequest,
+) (*emissionstypes.MsgInsertBulkReputerPayload, error) {
+	topicId := request.TopicId
+	blockHeight := request.ReputerRequestNonce.ReputerNonce.BlockHeight
+
+	bundles := make([]*emissionstypes.ReputerValueBundle, 0, len(reputers))
+	for _, reputer := range reputers {
+		truth := gen.ReputerGroundTruth(reputer.Address, reputer.Adversarial, topicId, blockHeight)
+		valueBundle, err := lossBundle(request.ValueBundle, truth)
+		if err != nil {
+			return nil, err
+		}
+		valueBundle.TopicId = topicId
+		valueBundle.Reputer = reputer.Address
+		valueBundle.ReputerRequestNonce = request.ReputerRequestNonce
+
+		reputerBundle, err := client.SignReputerValueBundle(kr, reputer.Name, valueBundle)
+		if err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, reputerBundle)
+	}
+
+	return &emissionstypes.MsgInsertBulkReputerPayload{
+		Sender:              leader.Address,
+		TopicId:             topicId,
+		ReputerRequestNonce: request.ReputerRequestNonce,
+		ReputerValueBundles: bundles,
+	}, nil
+}
+
+// Replaces every network inference of a value bundle by its loss against the ground truth
+func lossBundle(networkInferences *emissionstypes.ValueBundle, truth float64) (*emissionstypes.ValueBundle, error) {
+	loss := func(value alloraMath.Dec) (alloraMath.Dec, error) {
+		v, err := FromDec(value)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		return ToDec(Loss(v, truth)), nil
+	}
+	attributedLosses := func(values []*emissionstypes.WorkerAttributedValue) ([]*emissionstypes.WorkerAttributedValue, error) {
+		losses := make([]*emissionstypes.WorkerAttributedValue, len(values))
+		for i, value := range values {
+			l, err := loss(value.Value)
+			if err != nil {
+				return nil, err
+			}
+			losses[i] = &emissionstypes.WorkerAttributedValue{Worker: value.Worker, Value: l}
+		}
+		return losses, nil
+	}
+	withheldLosses := func(values []*emissionstypes.WithheldWorkerAttributedValue) ([]*emissionstypes.WithheldWorkerAttributedValue, error) {
+		losses := make([]*emissionstypes.WithheldWorkerAttributedValue, len(values))
+		for i, value := range values {
+			l, err := loss(value.Value)
+			if err != nil {
+				return nil, err
+			}
+			losses[i] = &emissionstypes.WithheldWorkerAttributedValue{Worker: value.Worker, Value: l}
+		}
+		return losses, nil
+	}
+
+	bundle := &emissionstypes.ValueBundle{}
+	var err error
+	if bundle.CombinedValue, err = loss(networkInferences.CombinedValue); err != nil {
+		return nil, err
+	}
+	if bundle.NaiveValue, err = loss(networkInferences.NaiveValue); err != nil {
+		return nil, err
+	}
+	if bundle.InfererValues, err = attributedLosses(networkInferences.InfererValues); err != nil {
+		return nil, err
+	}
+	if bundle.ForecasterValues, err = attributedLosses(networkInferences.ForecasterValues); err != nil {
+		return nil, err
+	}
+	if bundle.OneInForecasterValues, err = attributedLosses(networkInferences.OneInForecasterValues); err != nil {
+		return nil, err
+	}
+	if bundle.OneOutInfererValues, err = withheldLosses(networkInferences.OneOutInfererValues); err != nil {
+		return nil, err
+	}
+	if bundle.OneOutForecasterValues, err = withheldLosses(networkInferences.OneOutForecasterValues); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
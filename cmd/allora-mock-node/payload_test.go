@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newActors(t *testing.T, kr keyring.Keyring, names []string, numAdversarial int) []Actor {
+	actors := make([]Actor, len(names))
+	for i, name := range names {
+		record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		address, err := record.GetAddress()
+		require.NoError(t, err)
+		actors[i] = Actor{
+			Name:        name,
+			Address:     sdk.MustBech32ifyAddressBytes(params.Bech32PrefixAccAddr, address),
+			Adversarial: i >= len(names)-numAdversarial,
+		}
+	}
+	return actors
+}
+
+func TestGeneratorIsDeterministic(t *testing.T) {
+	a := NewGenerator(7, 1, 1, AdversarialBiased)
+	b := NewGenerator(7, 1, 1, AdversarialBiased)
+	other := NewGenerator(8, 1, 1, AdversarialBiased)
+
+	// Walking the heights in a different order must not change the walk
+	require.Equal(t, a.GroundTruth(1, 50), b.GroundTruth(1, 50))
+	require.Equal(t, a.GroundTruth(1, 10), b.GroundTruth(1, 10))
+	require.NotEqual(t, a.GroundTruth(1, 50), other.GroundTruth(1, 50))
+	require.NotEqual(t, a.GroundTruth(1, 50), a.GroundTruth(2, 50))
+
+	require.Equal(t, a.Inference("worker", false, 1, 20), b.Inference("worker", false, 1, 20))
+	require.Equal(t, a.Inference("worker", true, 1, 20), b.Inference("worker", true, 1, 20))
+	require.NotEqual(t, a.Inference("worker", false, 1, 20), a.Inference("worker", true, 1, 20))
+}
+
+func TestPayloadsPassBundleValidation(t *testing.T) {
+	kr := keyring.NewInMemory(moduletestutil.MakeTestEncodingConfig().Codec)
+	gen := NewGenerator(1, 1, 1, AdversarialRandom)
+	workers := newActors(t, kr, []string{"worker0", "worker1", "worker2"}, 1)
+	reputers := newActors(t, kr, []string{"reputer0", "reputer1"}, 1)
+
+	workerPayload, err := BuildWorkerPayload(kr, gen, workers[0], workers, &emissionstypes.EventWorkerRequest{
+		TopicId: 1,
+		Nonce:   &emissionstypes.Nonce{BlockHeight: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, workerPayload.WorkerDataBundles, len(workers))
+	for _, bundle := range workerPayload.WorkerDataBundles {
+		require.NoError(t, bundle.Validate())
+		require.Len(t, bundle.InferenceForecastsBundle.Forecast.ForecastElements, len(workers)-1)
+	}
+
+	networkInferences := &emissionstypes.ValueBundle{
+		CombinedValue: alloraMath.MustNewDecFromString("100.5"),
+		NaiveValue:    alloraMath.MustNewDecFromString("99"),
+	}
+	for _, worker := range workers {
+		networkInferences.InfererValues = append(networkInferences.InfererValues, &emissionstypes.WorkerAttributedValue{
+			Worker: worker.Address,
+			Value:  alloraMath.MustNewDecFromString("101"),
+		})
+		networkInferences.OneOutInfererValues = append(networkInferences.OneOutInfererValues, &emissionstypes.WithheldWorkerAttributedValue{
+			Worker: worker.Address,
+			Value:  alloraMath.MustNewDecFromString("98"),
+		})
+	}
+	reputerNonce := &emissionstypes.ReputerRequestNonce{
+		ReputerNonce: &emissionstypes.Nonce{BlockHeight: 10},
+	}
+	reputerPayload, err := BuildReputerPayload(kr, gen, reputers[0], reputers, &emissionstypes.EventReputerRequest{
+		TopicId:             1,
+		ReputerRequestNonce: reputerNonce,
+		ValueBundle:         networkInferences,
+	})
+	require.NoError(t, err)
+	require.Len(t, reputerPayload.ReputerValueBundles, len(reputers))
+	for _, bundle := range reputerPayload.ReputerValueBundles {
+		require.NoError(t, bundle.Validate())
+		require.Len(t, bundle.ValueBundle.InfererValues, len(workers))
+		require.True(t, bundle.ValueBundle.CombinedValue.Gt(alloraMath.ZeroDec()))
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// How an adversarial actor corrupts the values it reports
+type AdversarialMode string
+
+const (
+	AdversarialNone     AdversarialMode = "none"     // reports honestly
+	AdversarialBiased   AdversarialMode = "biased"   // shifts every value by a fixed bias
+	AdversarialConstant AdversarialMode = "constant" // always reports the same value, ignoring the ground truth
+	AdversarialRandom   AdversarialMode = "random"   // reports values drawn far around the ground truth
+)
+
+func ParseAdversarialMode(s string) (AdversarialMode, error) {
+	switch mode := AdversarialMode(s); mode {
+	case AdversarialNone, AdversarialBiased, AdversarialConstant, AdversarialRandom:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown adversarial mode %q, expected one of none, biased, constant, random", s)
+}
+
+// Generates deterministic synthetic data: the same seed always yields the same ground truth,
+// inferences, forecasts and losses for the same actors, topics and block heights.
+type Generator struct {
+	Seed      int64
+	StepSize  float64         // standard deviation of each step of the ground truth random walk
+	Noise     float64         // standard deviation of the error honest actors make
+	Mode      AdversarialMode // how adversarial actors misbehave
+	Bias      float64         // shift applied by biased adversarial actors
+	Constant  float64         // value reported by constant adversarial actors
+	Initial   float64         // value the ground truth random walk starts at
+	walksLock sync.Mutex
+	walks     map[uint64][]float64 // cached random walk of each topic, indexed by block height
+}
+
+func NewGenerator(seed int64, stepSize float64, noise float64, mode AdversarialMode) *Generator {
+	return &Generator{
+		Seed:     seed,
+		StepSize: stepSize,
+		Noise:    noise,
+		Mode:     mode,
+		Bias:     10 * noise,
+		Constant: 0,
+		Initial:  100,
+		walks:    make(map[uint64][]float64),
+	}
+}
+
+// Source of randomness fixed by the seed and the given keys
+func (g *Generator) rand(keys ...string) *rand.Rand {
+	h := fnv.New64a()
+	seed := make([]byte, 8)
+	binary.BigEndian.PutUint64(seed, uint64(g.Seed))
+	h.Write(seed) //nolint:errcheck
+	for _, key := range keys {
+		h.Write([]byte(key)) //nolint:errcheck
+		h.Write([]byte{0})   //nolint:errcheck
+	}
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// Value of the random walk of a topic at a block height
+func (g *Generator) GroundTruth(topicId uint64, blockHeight int64) float64 {
+	if blockHeight < 0 {
+		blockHeight = 0
+	}
+	g.walksLock.Lock()
+	defer g.walksLock.Unlock()
+
+	walk := g.walks[topicId]
+	if len(walk) == 0 {
+		walk = append(walk, g.Initial)
+	}
+	for height := int64(len(walk)); height <= blockHeight; height++ {
+		step := g.rand("walk", strconv.FormatUint(topicId, 10), strconv.FormatInt(height, 10)).NormFloat64() * g.StepSize
+		walk = append(walk, walk[height-1]+step)
+	}
+	g.walks[topicId] = walk
+	return walk[blockHeight]
+}
+
+// What an actor reports for a value it estimates, honestly or according to the adversarial mode
+func (g *Generator) report(value float64, adversarial bool, keys ...string) float64 {
+	r := g.rand(keys...)
+	if !adversarial {
+		return value + r.NormFloat64()*g.Noise
+	}
+	switch g.Mode {
+	case AdversarialBiased:
+		return value + g.Bias + r.NormFloat64()*g.Noise
+	case AdversarialConstant:
+		return g.Constant
+	case AdversarialRandom:
+		return value + (r.Float64()*2-1)*100*g.Noise
+	}
+	return value + r.NormFloat64()*g.Noise
+}
+
+// Inference of a worker for a topic at a block height
+func (g *Generator) Inference(worker string, adversarial bool, topicId uint64, blockHeight int64) float64 {
+	truth := g.GroundTruth(topicId, blockHeight)
+	return g.report(truth, adversarial, "inference", worker, strconv.FormatUint(topicId, 10), strconv.FormatInt(blockHeight, 10))
+}
+
+// Loss a forecaster expects the inference of an inferer to have.
+// Honest forecasters know how far off the inference is, up to their own noise.
+func (g *Generator) ForecastedLoss(forecaster string, adversarial bool, inferer string, inference float64, topicId uint64, blockHeight int64) float64 {
+	truth := g.GroundTruth(topicId, blockHeight)
+	estimatedTruth := g.report(truth, adversarial, "forecast", forecaster, inferer, strconv.FormatUint(topicId, 10), strconv.FormatInt(blockHeight, 10))
+	return Loss(inference, estimatedTruth)
+}
+
+// Ground truth a reputer evaluates losses against. Adversarial reputers evaluate against a corrupted one.
+func (g *Generator) ReputerGroundTruth(reputer string, adversarial bool, topicId uint64, blockHeight int64) float64 {
+	truth := g.GroundTruth(topicId, blockHeight)
+	if !adversarial {
+		return truth
+	}
+	return g.report(truth, adversarial, "loss", reputer, strconv.FormatUint(topicId, 10), strconv.FormatInt(blockHeight, 10))
+}
+
+// Squared error of a value, kept strictly positive so it is a valid loss for every topic
+func Loss(value float64, truth float64) float64 {
+	return math.Max((value-truth)*(value-truth), 1e-12)
+}
+
+func ToDec(value float64) alloraMath.Dec {
+	return alloraMath.MustNewDecFromString(strconv.FormatFloat(value, 'g', 12, 64))
+}
+
+func FromDec(value alloraMath.Dec) (float64, error) {
+	return strconv.ParseFloat(value.String(), 64)
+}
@@ -0,0 +1,114 @@
+// allora-request-relay is a reference off-chain orchestrator. It subscribes to the
+// EventWorkerRequest and EventReputerRequest events that the emissions module emits
+// at the end of every block and replays them to the Blockless API.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/allora-network/allora-chain/app"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const subscriber = "allora-request-relay"
+
+func main() {
+	node := flag.String("node", "tcp://localhost:26657", "CometBFT RPC endpoint of a node to subscribe to")
+	blocklessUrl := flag.String("blockless-url", os.Getenv("BLOCKLESS_API_URL"), "Blockless API url the requests are sent to")
+	flag.Parse()
+
+	if *blocklessUrl == "" {
+		log.Fatal("no Blockless API url, set --blockless-url or BLOCKLESS_API_URL")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := run(ctx, *node, *blocklessUrl); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, node string, blocklessUrl string) error {
+	client, err := rpchttp.New(node, "/websocket")
+	if err != nil {
+		return err
+	}
+	if err := client.Start(); err != nil {
+		return err
+	}
+	defer client.Stop() //nolint:errcheck
+
+	// End block events are delivered with the block they were emitted in
+	blocks, err := client.Subscribe(ctx, subscriber, cmttypes.QueryForEvent(cmttypes.EventNewBlock).String())
+	if err != nil {
+		return err
+	}
+	defer client.UnsubscribeAll(context.Background(), subscriber) //nolint:errcheck
+
+	log.Printf("relaying requests from %s to %s", node, blocklessUrl)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case result, ok := <-blocks:
+			if !ok {
+				return fmt.Errorf("subscription to %s closed", node)
+			}
+			block, ok := result.Data.(cmttypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+			requests, err := BlocklessRequestsFromEvents(block.ResultFinalizeBlock.Events)
+			if err != nil {
+				log.Printf("block %d: %s", block.Block.Height, err)
+			}
+			for _, request := range requests {
+				go func(request app.BlocklessRequest) {
+					if err := app.SendBlocklessRequest(blocklessUrl, request); err != nil {
+						log.Printf("block %d: error sending request for topic %s: %s", block.Block.Height, request.TopicID, err)
+					}
+				}(request)
+			}
+		}
+	}
+}
+
+// Converts the worker and reputer request events of a block into Blockless requests, ignoring other events
+func BlocklessRequestsFromEvents(events []abci.Event) ([]app.BlocklessRequest, error) {
+	workerRequestType := proto.MessageName(&emissionstypes.EventWorkerRequest{})
+	reputerRequestType := proto.MessageName(&emissionstypes.EventReputerRequest{})
+
+	requests := make([]app.BlocklessRequest, 0)
+	for _, event := range events {
+		if event.Type != workerRequestType && event.Type != reputerRequestType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return requests, err
+		}
+		switch request := msg.(type) {
+		case *emissionstypes.EventWorkerRequest:
+			requests = append(requests, app.NewWorkerBlocklessRequest(request))
+		case *emissionstypes.EventReputerRequest:
+			blocklessRequest, err := app.NewReputerBlocklessRequest(request)
+			if err != nil {
+				return requests, err
+			}
+			requests = append(requests, blocklessRequest)
+		}
+	}
+	return requests, nil
+}
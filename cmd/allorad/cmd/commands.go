@@ -25,6 +25,7 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
 	"github.com/allora-network/allora-chain/app"
+	"github.com/allora-network/allora-chain/x/emissions/archiver"
 )
 
 func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager module.BasicManager) {
@@ -36,7 +37,7 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager
 		snapshot.Cmd(newApp),
 	)
 
-	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport, func(startCmd *cobra.Command) {})
+	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport, addModuleInitFlags)
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
@@ -45,9 +46,16 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		emissionsCommand(),
 	)
 }
 
+func addModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(app.FlagPrepareProposalRequests, true, "Trigger worker and reputer requests on the Blockless API at BLOCKLESS_API_URL when proposing a block")
+	startCmd.Flags().String(app.FlagArchiveDir, "", "Archive the inferences, forecasts and losses committed to the emissions store as newline-delimited JSON in this directory")
+	startCmd.Flags().Int64(app.FlagArchiveMaxFileSize, archiver.DefaultMaxFileSize, "Size in bytes an emissions archive file grows to before a new one is started")
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
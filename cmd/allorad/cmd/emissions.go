@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/allora-network/allora-chain/app"
+	"github.com/allora-network/allora-chain/x/emissions/archiver"
+	"github.com/allora-network/allora-chain/x/emissions/inspector"
+	"github.com/allora-network/allora-chain/x/emissions/simulator"
+)
+
+const (
+	flagFormat          = "format"
+	flagOutput          = "output"
+	flagHeight          = "height"
+	flagTopicId         = "topic-id"
+	flagFromBlockHeight = "from-block-height"
+	flagToBlockHeight   = "to-block-height"
+	flagCollections     = "collections"
+)
+
+// Offline tooling for the emissions module, running without a node
+func emissionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "emissions",
+		Short:                      "Offline emissions module subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		simulateCommand(),
+		inspectCommand(),
+		archiveCommand(),
+	)
+
+	return cmd
+}
+
+func simulateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [scenario-file]",
+		Short: "Simulate the rewards of a topic over the epochs of a scenario",
+		Long: `Load a JSON scenario of a topic (params, actors, stakes and the inferences, forecasts and losses of every epoch)
+into an in-memory emissions keeper, and play it out epoch by epoch with the network inference synthesis, topic
+weight and reward distribution of the chain. Writes the topic weight, scores, regrets and rewards of every actor
+in every epoch, as CSV or JSON.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if format != "csv" && format != "json" {
+				return fmt.Errorf("unknown format %q, expected csv or json", format)
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			scenario, err := simulator.LoadScenario(args[0])
+			if err != nil {
+				return err
+			}
+			sim, err := simulator.NewSimulator(scenario)
+			if err != nil {
+				return err
+			}
+			results, err := sim.Run()
+			if err != nil {
+				return err
+			}
+
+			w, closeOutput, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOutput()
+			if format == "json" {
+				return simulator.WriteJSON(w, results)
+			}
+			return simulator.WriteCSV(w, results)
+		},
+	}
+
+	cmd.Flags().String(flagFormat, "csv", "output format, csv or json")
+	cmd.Flags().String(flagOutput, "", "file to write the results to, instead of stdout")
+
+	return cmd
+}
+
+func inspectCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect [topics|stakes|delegations|scores|nonces|all]",
+		Short: "Report the emissions state of a stopped node",
+		Long: `Open the application DB of the node in --home, which must not be running, without writing to it, and
+report the emissions state as of a height: the topics with their stake, weight, fee revenue and unfulfilled nonces,
+the stakes of the reputers, the stakes delegated to them, the latest scores of every actor and the unfulfilled worker
+and reputer nonces. Each report is written as CSV or JSON; all the reports at once are written as JSON only.`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{"topics", "stakes", "delegations", "scores", "nonces", "all"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if format != "csv" && format != "json" {
+				return fmt.Errorf("unknown format %q, expected csv or json", format)
+			}
+			if args[0] == "all" && format != "json" {
+				return fmt.Errorf("all the reports at once can only be written as json")
+			}
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			inspect, err := inspector.Open(dataDir, server.GetAppDBBackend(serverCtx.Viper), height)
+			if err != nil {
+				return err
+			}
+			defer inspect.Close()
+
+			w, closeOutput, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOutput()
+
+			switch args[0] {
+			case "topics":
+				return writeReport(w, format, inspect.Topics)
+			case "stakes":
+				return writeReport(w, format, inspect.Stakes)
+			case "delegations":
+				return writeReport(w, format, inspect.Delegations)
+			case "scores":
+				return writeReport(w, format, inspect.Scores)
+			case "nonces":
+				return writeReport(w, format, inspect.Nonces)
+			case "all":
+				report, err := inspect.Report()
+				if err != nil {
+					return err
+				}
+				return inspector.WriteJSON(w, report)
+			default:
+				return fmt.Errorf("unknown report %q", args[0])
+			}
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "height to read the state at, the latest height if 0")
+	cmd.Flags().String(flagFormat, "json", "output format, csv or json")
+	cmd.Flags().String(flagOutput, "", "file to write the report to, instead of stdout")
+
+	return cmd
+}
+
+func archiveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive [archive-dir]",
+		Short: "Read the inferences, forecasts and losses archived by a node",
+		Long: fmt.Sprintf(`Read the archive a node started with --%s writes the inferences, forecasts, loss bundles
+and network loss bundles committed to the emissions store to, and write the records of a topic whose nonces are
+in a range of block heights as newline-delimited JSON, in the order they were committed in.`, app.FlagArchiveDir),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var q archiver.Query
+			var err error
+			if q.TopicId, err = cmd.Flags().GetUint64(flagTopicId); err != nil {
+				return err
+			}
+			if q.FromBlockHeight, err = cmd.Flags().GetInt64(flagFromBlockHeight); err != nil {
+				return err
+			}
+			if q.ToBlockHeight, err = cmd.Flags().GetInt64(flagToBlockHeight); err != nil {
+				return err
+			}
+			if q.Collections, err = cmd.Flags().GetStringSlice(flagCollections); err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			w, closeOutput, err := openOutput(cmd, output)
+			if err != nil {
+				return err
+			}
+			defer closeOutput()
+			encoder := json.NewEncoder(w)
+			return archiver.Read(args[0], q, func(record archiver.Record) error {
+				return encoder.Encode(record)
+			})
+		},
+	}
+
+	cmd.Flags().Uint64(flagTopicId, 0, "topic to read the records of, every topic if 0")
+	cmd.Flags().Int64(flagFromBlockHeight, 0, "lowest block height of the nonces to read the records of")
+	cmd.Flags().Int64(flagToBlockHeight, 0, "highest block height of the nonces to read the records of, no limit if 0")
+	cmd.Flags().StringSlice(flagCollections, nil, "collections to read the records of, among inferences, forecasts, loss_bundles and network_loss_bundles, all if empty")
+	cmd.Flags().String(flagOutput, "", "file to write the records to, instead of stdout")
+
+	return cmd
+}
+
+func writeReport[R inspector.Row](w io.Writer, format string, report func() ([]R, error)) error {
+	rows, err := report()
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		return inspector.WriteJSON(w, rows)
+	}
+	return inspector.WriteCSV(w, rows)
+}
+
+// Writer to the output file, or to stdout if there is none
+func openOutput(cmd *cobra.Command, output string) (io.Writer, func() error, error) {
+	if output == "" {
+		return cmd.OutOrStdout(), func() error { return nil }, nil
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Close, nil
+}
@@ -5,25 +5,34 @@ import (
 	"testing"
 
 	testCommon "github.com/allora-network/allora-chain/test/common"
+	"github.com/allora-network/allora-chain/test/testnet"
 )
 
 func TestExternalTestSuite(t *testing.T) {
-	if _, isIntegration := os.LookupEnv("INTEGRATION"); isIntegration == false {
-		t.Skip("Skipping Integration Test Outside CI")
+	_, isIntegration := os.LookupEnv("INTEGRATION")
+	if !isIntegration && testing.Short() {
+		t.Skip("Skipping Integration Test in short mode")
 	}
-	t.Log(">>> Setting up connection to local node <<<")
 
 	seed := testCommon.LookupEnvInt(t, "SEED", 0)
-	rpcMode := testCommon.LookupRpcMode(t, "RPC_MODE", testCommon.SingleRpc)
-	rpcEndpoints := testCommon.LookupEnvStringArray("RPC_URLS", []string{"http://localhost:26657"})
+	var testConfig testCommon.TestConfig
+	if isIntegration {
+		t.Log(">>> Setting up connection to local node <<<")
+		rpcMode := testCommon.LookupRpcMode(t, "RPC_MODE", testCommon.SingleRpc)
+		rpcEndpoints := testCommon.LookupEnvStringArray("RPC_URLS", []string{"http://localhost:26657"})
 
-	testConfig := testCommon.NewTestConfig(
-		t,
-		rpcMode,
-		rpcEndpoints,
-		"../devnet/genesis",
-		seed,
-	)
+		testConfig = testCommon.NewTestConfig(
+			t,
+			rpcMode,
+			rpcEndpoints,
+			"../devnet/genesis",
+			seed,
+		)
+	} else {
+		t.Log(">>> Starting in-process test network <<<")
+		network := testnet.New(t, testnet.DefaultConfig())
+		testConfig = network.TestConfig(t, seed)
+	}
 
 	t.Log(">>> Test Getting Chain Params <<<")
 	GetParams(testConfig)
@@ -41,6 +50,11 @@ func TestExternalTestSuite(t *testing.T) {
 	TopicFundingChecks(testConfig)
 	t.Log(">>> Test Making Inference <<<")
 	WorkerInferenceAndForecastChecks(testConfig)
-	t.Log(">>> Test Upgrading Emissions Module Version")
-	UpgradeChecks(testConfig)
+	if isIntegration {
+		t.Log(">>> Test Upgrading Emissions Module Version")
+		UpgradeChecks(testConfig)
+	} else {
+		// The upgrade swaps the allorad binary through cosmovisor, which an in-process network cannot do
+		t.Log(">>> Skipping Upgrading Emissions Module Version on the in-process test network")
+	}
 }
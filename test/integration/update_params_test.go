@@ -4,6 +4,7 @@ import (
 	alloraMath "github.com/allora-network/allora-chain/math"
 	testCommon "github.com/allora-network/allora-chain/test/common"
 	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/ignite/cli/v28/ignite/pkg/cosmosaccount"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,6 +21,24 @@ func checkIfAdmin(m testCommon.TestConfig, address string) bool {
 	return p.IsAdmin
 }
 
+// Schedules a params update of a whitelist admin as early as it may apply, and waits for it to apply
+func scheduleParamsUpdateAndWait(m testCommon.TestConfig, acc cosmosaccount.Account, sender string, newParams *emissionstypes.OptionalParams) {
+	height, err := m.Client.BlockHeight(m.Ctx)
+	require.NoError(m.T, err)
+	// The tx is included in a later block than the latest one
+	effectiveBlockHeight := height + GetEmissionsParams(m).MinAdminParamsUpdateDelay + 5
+	txResp, err := m.Client.BroadcastTx(m.Ctx, acc, &emissionstypes.MsgUpdateParams{
+		Sender:               sender,
+		Params:               newParams,
+		EffectiveBlockHeight: effectiveBlockHeight,
+	})
+	require.NoError(m.T, err)
+	_, err = m.Client.WaitForTx(m.Ctx, txResp.TxHash)
+	require.NoError(m.T, err)
+	err = m.Client.WaitForBlockHeight(m.Ctx, effectiveBlockHeight+1)
+	require.NoError(m.T, err)
+}
+
 // Test that whitelisted admin can successfully update params and others cannot
 func UpdateParamsChecks(m testCommon.TestConfig) {
 	// Ensure Alice is in the whitelist and Bob is not
@@ -30,22 +49,25 @@ func UpdateParamsChecks(m testCommon.TestConfig) {
 	oldParams := GetEmissionsParams(m)
 	oldEpsilon := oldParams.Epsilon
 
-	// Should succeed for Alice because she's a whitelist admin
+	// Should fail for Alice at once because whitelist admins must schedule updates
 	newEpsilon := alloraMath.NewDecFinite(1, 99)
 	input := []alloraMath.Dec{newEpsilon}
+	newParams := &emissionstypes.OptionalParams{
+		Epsilon: input,
+		// These are set for subsequent tests
+		MaxTopReputersToReward: []uint64{24},
+		MinEpochLength:         []int64{1},
+	}
 	updateParamRequest := &emissionstypes.MsgUpdateParams{
 		Sender: m.AliceAddr,
-		Params: &emissionstypes.OptionalParams{
-			Epsilon: input,
-			// These are set for subsequent tests
-			MaxTopReputersToReward: []uint64{24},
-			MinEpochLength:         []int64{1},
-		},
+		Params: newParams,
 	}
-	txResp, err := m.Client.BroadcastTx(m.Ctx, m.AliceAcc, updateParamRequest)
-	require.NoError(m.T, err)
-	_, err = m.Client.WaitForTx(m.Ctx, txResp.TxHash)
-	require.NoError(m.T, err)
+	_, err := m.Client.BroadcastTx(m.Ctx, m.AliceAcc, updateParamRequest)
+	require.Error(m.T, err)
+	require.Contains(m.T, err.Error(), "min admin params update delay")
+
+	// Should succeed for Alice once scheduled because she's a whitelist admin
+	scheduleParamsUpdateAndWait(m, m.AliceAcc, m.AliceAddr, newParams)
 
 	// Should fail for Bob because he's not a whitelist admin
 	input = []alloraMath.Dec{alloraMath.NewDecFinite(1, 2)}
@@ -66,14 +88,7 @@ func UpdateParamsChecks(m testCommon.TestConfig) {
 
 	// Set the epsilon back to the original value
 	input = []alloraMath.Dec{oldEpsilon}
-	updateParamRequest = &emissionstypes.MsgUpdateParams{
-		Sender: m.AliceAddr,
-		Params: &emissionstypes.OptionalParams{
-			Epsilon: input,
-		},
-	}
-	txResp, err = m.Client.BroadcastTx(m.Ctx, m.AliceAcc, updateParamRequest)
-	require.NoError(m.T, err)
-	_, err = m.Client.WaitForTx(m.Ctx, txResp.TxHash)
-	require.NoError(m.T, err)
+	scheduleParamsUpdateAndWait(m, m.AliceAcc, m.AliceAddr, &emissionstypes.OptionalParams{
+		Epsilon: input,
+	})
 }
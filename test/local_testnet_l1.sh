@@ -74,6 +74,10 @@ genesis_file="${LOCALNET_DATADIR}/genesis/config/genesis.json"
 tmp_file=$(mktemp)
 jq '.app_state.gov.params.expedited_voting_period = "20s" | .app_state.gov.params.voting_period = "20s"' "$genesis_file" > "$tmp_file" && mv "$tmp_file" "$genesis_file"
 
+echo "Updating min_admin_params_update_delay in genesis.json"
+tmp_file=$(mktemp)
+jq '.app_state.emissions.params.min_admin_params_update_delay = "5"' "$genesis_file" > "$tmp_file" && mv "$tmp_file" "$genesis_file"
+
 echo "Whitelist faucet account"
 FAUCET_ADDRESS=$(docker run -t \
     -u $(id -u):$(id -g) \
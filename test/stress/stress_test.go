@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	testCommon "github.com/allora-network/allora-chain/test/common"
+	"github.com/allora-network/allora-chain/test/testnet"
 )
 
 func TestStressTestSuite(t *testing.T) {
@@ -17,19 +18,25 @@ func TestStressTestSuite(t *testing.T) {
 	gomaxprocs := runtime.GOMAXPROCS(0)
 	t.Logf("Number of logical CPUs: %d, GOMAXPROCS %d \n", numCPUs, gomaxprocs)
 
-	t.Log(">>> Setting up connection to local node <<<")
-
 	seed := testCommon.LookupEnvInt(t, "SEED", 0)
-	rpcMode := testCommon.LookupRpcMode(t, "RPC_MODE", testCommon.SingleRpc)
-	rpcEndpoints := testCommon.LookupEnvStringArray("RPC_URLS", []string{"http://localhost:26657"})
+	var testConfig testCommon.TestConfig
+	if testCommon.LookupEnvBool(t, "IN_PROCESS", false) {
+		t.Log(">>> Starting in-process test network <<<")
+		network := testnet.New(t, testnet.DefaultConfig())
+		testConfig = network.TestConfig(t, seed)
+	} else {
+		t.Log(">>> Setting up connection to local node <<<")
+		rpcMode := testCommon.LookupRpcMode(t, "RPC_MODE", testCommon.SingleRpc)
+		rpcEndpoints := testCommon.LookupEnvStringArray("RPC_URLS", []string{"http://localhost:26657"})
 
-	testConfig := testCommon.NewTestConfig(
-		t,
-		rpcMode,
-		rpcEndpoints,
-		"../devnet/genesis",
-		seed,
-	)
+		testConfig = testCommon.NewTestConfig(
+			t,
+			rpcMode,
+			rpcEndpoints,
+			"../devnet/genesis",
+			seed,
+		)
+	}
 
 	// Read env vars with defaults
 	reputersPerIteration := testCommon.LookupEnvInt(t, "REPUTERS_PER_ITERATION", 1)
@@ -0,0 +1,268 @@
+package testnet
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"github.com/allora-network/allora-chain/app"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	testcommon "github.com/allora-network/allora-chain/test/common"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ignite/cli/v28/ignite/pkg/cosmosaccount"
+	"github.com/stretchr/testify/require"
+)
+
+// Config defines how the in-process test network is set up
+type Config struct {
+	NumValidators int            // number of validators to boot, the test suites expect at least 3
+	NumWorkers    int            // number of pre-funded worker keys, named worker0, worker1, ...
+	NumReputers   int            // number of pre-funded reputer keys, named reputer0, reputer1, ...
+	AccountTokens cosmosMath.Int // uallo each non-validator key is funded with at genesis
+	FaucetTokens  cosmosMath.Int // uallo the faucet is funded with at genesis, on top of AccountTokens
+	TimeoutCommit time.Duration  // consensus commit timeout, which roughly sets the block time
+	VotingPeriod  time.Duration  // gov voting period, both regular and expedited
+	EnableLogging bool           // print the validator logs to stdout
+}
+
+// DefaultConfig mirrors the devnet launched by test/local_testnet_l1.sh
+func DefaultConfig() Config {
+	return Config{
+		NumValidators: 3,
+		NumWorkers:    5,
+		NumReputers:   5,
+		AccountTokens: cosmosMath.NewInt(1000000).Mul(cosmosMath.NewInt(1e18)),
+		// The mint module only emits once the total supply exceeds the locked investor and team supply
+		FaucetTokens:  cosmosMath.NewInt(500000000).Mul(cosmosMath.NewInt(1e18)),
+		TimeoutCommit: time.Second,
+		VotingPeriod:  20 * time.Second,
+		EnableLogging: false,
+	}
+}
+
+// Network is an in-process allorad test network
+type Network struct {
+	*network.Network
+	AlloraHomeDir string   // home directory holding the keyring and a copy of the genesis file
+	RpcAddress    string   // RPC address of the first validator, the only one that exposes one
+	TopicId       uint64   // id of the topic created once the network is up
+	WorkerNames   []string // names of the pre-funded worker keys in the keyring
+	ReputerNames  []string // names of the pre-funded reputer keys in the keyring
+}
+
+// New boots an in-process network of allorad validators with pre-funded keys and a pre-created topic.
+// The network is shut down when the test finishes. Only one network can run at a time.
+func New(t *testing.T, cfg Config) *Network {
+	t.Helper()
+	require.GreaterOrEqual(t, cfg.NumValidators, 1, "must have at least one validator")
+
+	// Default genesis states use the sdk bond denom, so point it at allo before building them
+	sdk.DefaultBondDenom = params.DefaultBondDenom
+
+	baseDir := t.TempDir()
+	alloraHomeDir := filepath.Join(baseDir, "allora")
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringServiceName(sdk.KeyringServiceName()),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringTest),
+		cosmosaccount.WithHome(alloraHomeDir),
+	)
+	require.NoError(t, err)
+
+	netCfg := network.DefaultConfig(newTestFixture(t))
+	netCfg.NumValidators = cfg.NumValidators
+	netCfg.BondDenom = params.DefaultBondDenom
+	netCfg.MinGasPrices = "0" + params.DefaultBondDenom
+	netCfg.TimeoutCommit = cfg.TimeoutCommit
+	netCfg.EnableLogging = cfg.EnableLogging
+	netCfg.ChainID = "allora-testnet-1"
+	netCfg.AppConstructor = newAppConstructor(netCfg.ChainID)
+
+	// The validator operator keys share the keyring of the other accounts so the test suites can vote with them
+	netCfg.Mnemonics = make([]string, cfg.NumValidators)
+	for i := 0; i < cfg.NumValidators; i++ {
+		_, mnemonic, err := registry.Create(fmt.Sprintf("validator%d", i))
+		require.NoError(t, err)
+		netCfg.Mnemonics[i] = mnemonic
+	}
+
+	n := &Network{AlloraHomeDir: alloraHomeDir}
+	accountNames := []string{"faucet", "upshot"}
+	for i := 0; i < cfg.NumWorkers; i++ {
+		n.WorkerNames = append(n.WorkerNames, fmt.Sprintf("worker%d", i))
+	}
+	for i := 0; i < cfg.NumReputers; i++ {
+		n.ReputerNames = append(n.ReputerNames, fmt.Sprintf("reputer%d", i))
+	}
+	accountNames = append(accountNames, n.WorkerNames...)
+	accountNames = append(accountNames, n.ReputerNames...)
+
+	addresses := make([]string, len(accountNames))
+	for i, name := range accountNames {
+		acc, _, err := registry.Create(name)
+		require.NoError(t, err)
+		addresses[i], err = acc.Address(params.HumanCoinUnit)
+		require.NoError(t, err)
+	}
+	// The faucet is the whitelist admin, as on the devnet
+	setGenesisState(t, netCfg, addresses, addresses[0], cfg)
+
+	n.Network, err = network.New(t, baseDir, netCfg)
+	require.NoError(t, err)
+	t.Cleanup(n.Network.Cleanup)
+
+	rpcUrl, err := url.Parse(n.Validators[0].RPCAddress)
+	require.NoError(t, err)
+	n.RpcAddress = fmt.Sprintf("http://127.0.0.1:%s", rpcUrl.Port())
+
+	// Keep a copy of the genesis file where the test suites expect it
+	genesis, err := os.ReadFile(n.Validators[0].Ctx.Config.GenesisFile())
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(alloraHomeDir, "config"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(alloraHomeDir, "config", "genesis.json"), genesis, 0o600))
+
+	_, err = n.WaitForHeight(1)
+	require.NoError(t, err)
+	n.TopicId = n.createTopic(t)
+	return n
+}
+
+// TestConfig connects a testcommon.TestConfig to the network, as the external test suites do for a devnet
+func (n *Network) TestConfig(t *testing.T, seed int) testcommon.TestConfig {
+	return testcommon.NewTestConfig(
+		t,
+		testcommon.SingleRpc,
+		[]string{n.RpcAddress},
+		n.AlloraHomeDir,
+		seed,
+	)
+}
+
+// Creates the topic the test suites use, owned by the faucet
+func (n *Network) createTopic(t *testing.T) uint64 {
+	m := n.TestConfig(t, 0)
+	createTopicRequest := &emissionstypes.MsgCreateNewTopic{
+		Creator:         m.FaucetAddr,
+		Metadata:        "ETH 24h Prediction",
+		LossLogic:       "bafybeid7mmrv5qr4w5un6c64a6kt2y4vce2vylsmfvnjt7z2wodngknway",
+		LossMethod:      "loss-calculation-eth.wasm",
+		InferenceLogic:  "bafybeigx43n7kho3gslauwtsenaxehki6ndjo3s63ahif3yc5pltno3pyq",
+		InferenceMethod: "allora-inference-function.wasm",
+		EpochLength:     5,
+		GroundTruthLag:  20,
+		DefaultArg:      "ETH",
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		AlphaRegret:     alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:   true,
+	}
+	txResp, err := m.Client.BroadcastTx(m.Ctx, m.FaucetAcc, createTopicRequest)
+	require.NoError(t, err)
+	_, err = m.Client.WaitForTx(m.Ctx, txResp.TxHash)
+	require.NoError(t, err)
+	createTopicResponse := &emissionstypes.MsgCreateNewTopicResponse{}
+	err = txResp.Decode(createTopicResponse)
+	require.NoError(t, err)
+	return createTopicResponse.TopicId
+}
+
+// Builds the codecs and default genesis from a throwaway app instance
+func newTestFixture(t *testing.T) network.TestFixtureFactory {
+	return func() network.TestFixture {
+		tempApp, err := app.NewAlloraApp(
+			log.NewNopLogger(),
+			dbm.NewMemDB(),
+			nil,
+			true,
+			simtestutil.EmptyAppOptions{},
+		)
+		require.NoError(t, err)
+		return network.TestFixture{
+			GenesisState: tempApp.DefaultGenesis(),
+			EncodingConfig: moduletestutil.TestEncodingConfig{
+				InterfaceRegistry: tempApp.AppCodec().InterfaceRegistry(),
+				Codec:             tempApp.AppCodec(),
+				TxConfig:          tempApp.GetTxConfig(),
+				Amino:             tempApp.LegacyAmino(),
+			},
+		}
+	}
+}
+
+// Builds a fresh app instance for every validator
+func newAppConstructor(chainId string) network.AppConstructor {
+	return func(val network.ValidatorI) servertypes.Application {
+		alloraApp, err := app.NewAlloraApp(
+			val.GetCtx().Logger,
+			dbm.NewMemDB(),
+			nil,
+			true,
+			simtestutil.EmptyAppOptions{},
+			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(chainId),
+		)
+		if err != nil {
+			panic(err)
+		}
+		return alloraApp
+	}
+}
+
+// Funds the accounts, whitelists the admin and shortens the epochs and gov voting periods in the genesis state
+func setGenesisState(t *testing.T, netCfg network.Config, addresses []string, admin string, cfg Config) {
+	cdc := netCfg.Codec
+	genesisState := netCfg.GenesisState
+
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenState)
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenState)
+	genAccounts := make([]authtypes.GenesisAccount, len(addresses))
+	for i, address := range addresses {
+		addr := sdk.MustAccAddressFromBech32(address)
+		genAccounts[i] = authtypes.NewBaseAccount(addr, nil, 0, 0)
+		tokens := cfg.AccountTokens
+		if address == admin {
+			tokens = tokens.Add(cfg.FaucetTokens)
+		}
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{
+			Address: address,
+			Coins:   sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, tokens)),
+		})
+	}
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	require.NoError(t, err)
+	authGenState.Accounts = append(authGenState.Accounts, accounts...)
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+
+	var emissionsGenState emissionstypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[emissionstypes.ModuleName], &emissionsGenState)
+	emissionsGenState.CoreTeamAddresses = append(emissionsGenState.CoreTeamAddresses, admin)
+	// The test suites run topics with short epochs to keep the runs short
+	emissionsGenState.Params.MinEpochLength = 1
+	genesisState[emissionstypes.ModuleName] = cdc.MustMarshalJSON(&emissionsGenState)
+
+	var govGenState govtypesv1.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[govtypes.ModuleName], &govGenState)
+	govGenState.Params.VotingPeriod = &cfg.VotingPeriod
+	govGenState.Params.ExpeditedVotingPeriod = &cfg.VotingPeriod
+	genesisState[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenState)
+}
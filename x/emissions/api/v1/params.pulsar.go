@@ -57,6 +57,15 @@ var (
 	fd_Params_f_tolerance                          protoreflect.FieldDescriptor
 	fd_Params_c_norm                               protoreflect.FieldDescriptor
 	fd_Params_topic_fee_revenue_decay_rate         protoreflect.FieldDescriptor
+	fd_Params_liveness_window                      protoreflect.FieldDescriptor
+	fd_Params_max_missed_epochs                    protoreflect.FieldDescriptor
+	fd_Params_jail_duration                        protoreflect.FieldDescriptor
+	fd_Params_min_topic_params                     protoreflect.FieldDescriptor
+	fd_Params_max_topic_params                     protoreflect.FieldDescriptor
+	fd_Params_topic_creator_allowlist_enabled      protoreflect.FieldDescriptor
+	fd_Params_worker_rewards_claimable             protoreflect.FieldDescriptor
+	fd_Params_pruning                              protoreflect.FieldDescriptor
+	fd_Params_min_admin_params_update_delay        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -102,6 +111,15 @@ func init() {
 	fd_Params_f_tolerance = md_Params.Fields().ByName("f_tolerance")
 	fd_Params_c_norm = md_Params.Fields().ByName("c_norm")
 	fd_Params_topic_fee_revenue_decay_rate = md_Params.Fields().ByName("topic_fee_revenue_decay_rate")
+	fd_Params_liveness_window = md_Params.Fields().ByName("liveness_window")
+	fd_Params_max_missed_epochs = md_Params.Fields().ByName("max_missed_epochs")
+	fd_Params_jail_duration = md_Params.Fields().ByName("jail_duration")
+	fd_Params_min_topic_params = md_Params.Fields().ByName("min_topic_params")
+	fd_Params_max_topic_params = md_Params.Fields().ByName("max_topic_params")
+	fd_Params_topic_creator_allowlist_enabled = md_Params.Fields().ByName("topic_creator_allowlist_enabled")
+	fd_Params_worker_rewards_claimable = md_Params.Fields().ByName("worker_rewards_claimable")
+	fd_Params_pruning = md_Params.Fields().ByName("pruning")
+	fd_Params_min_admin_params_update_delay = md_Params.Fields().ByName("min_admin_params_update_delay")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -409,6 +427,60 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.LivenessWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LivenessWindow)
+		if !f(fd_Params_liveness_window, value) {
+			return
+		}
+	}
+	if x.MaxMissedEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedEpochs)
+		if !f(fd_Params_max_missed_epochs, value) {
+			return
+		}
+	}
+	if x.JailDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.JailDuration)
+		if !f(fd_Params_jail_duration, value) {
+			return
+		}
+	}
+	if x.MinTopicParams != nil {
+		value := protoreflect.ValueOfMessage(x.MinTopicParams.ProtoReflect())
+		if !f(fd_Params_min_topic_params, value) {
+			return
+		}
+	}
+	if x.MaxTopicParams != nil {
+		value := protoreflect.ValueOfMessage(x.MaxTopicParams.ProtoReflect())
+		if !f(fd_Params_max_topic_params, value) {
+			return
+		}
+	}
+	if x.TopicCreatorAllowlistEnabled != false {
+		value := protoreflect.ValueOfBool(x.TopicCreatorAllowlistEnabled)
+		if !f(fd_Params_topic_creator_allowlist_enabled, value) {
+			return
+		}
+	}
+	if x.WorkerRewardsClaimable != false {
+		value := protoreflect.ValueOfBool(x.WorkerRewardsClaimable)
+		if !f(fd_Params_worker_rewards_claimable, value) {
+			return
+		}
+	}
+	if x.Pruning != nil {
+		value := protoreflect.ValueOfMessage(x.Pruning.ProtoReflect())
+		if !f(fd_Params_pruning, value) {
+			return
+		}
+	}
+	if x.MinAdminParamsUpdateDelay != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinAdminParamsUpdateDelay)
+		if !f(fd_Params_min_admin_params_update_delay, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -504,6 +576,24 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.CNorm != ""
 	case "emissions.v1.Params.topic_fee_revenue_decay_rate":
 		return x.TopicFeeRevenueDecayRate != ""
+	case "emissions.v1.Params.liveness_window":
+		return x.LivenessWindow != uint64(0)
+	case "emissions.v1.Params.max_missed_epochs":
+		return x.MaxMissedEpochs != uint64(0)
+	case "emissions.v1.Params.jail_duration":
+		return x.JailDuration != int64(0)
+	case "emissions.v1.Params.min_topic_params":
+		return x.MinTopicParams != nil
+	case "emissions.v1.Params.max_topic_params":
+		return x.MaxTopicParams != nil
+	case "emissions.v1.Params.topic_creator_allowlist_enabled":
+		return x.TopicCreatorAllowlistEnabled != false
+	case "emissions.v1.Params.worker_rewards_claimable":
+		return x.WorkerRewardsClaimable != false
+	case "emissions.v1.Params.pruning":
+		return x.Pruning != nil
+	case "emissions.v1.Params.min_admin_params_update_delay":
+		return x.MinAdminParamsUpdateDelay != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -600,6 +690,24 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.CNorm = ""
 	case "emissions.v1.Params.topic_fee_revenue_decay_rate":
 		x.TopicFeeRevenueDecayRate = ""
+	case "emissions.v1.Params.liveness_window":
+		x.LivenessWindow = uint64(0)
+	case "emissions.v1.Params.max_missed_epochs":
+		x.MaxMissedEpochs = uint64(0)
+	case "emissions.v1.Params.jail_duration":
+		x.JailDuration = int64(0)
+	case "emissions.v1.Params.min_topic_params":
+		x.MinTopicParams = nil
+	case "emissions.v1.Params.max_topic_params":
+		x.MaxTopicParams = nil
+	case "emissions.v1.Params.topic_creator_allowlist_enabled":
+		x.TopicCreatorAllowlistEnabled = false
+	case "emissions.v1.Params.worker_rewards_claimable":
+		x.WorkerRewardsClaimable = false
+	case "emissions.v1.Params.pruning":
+		x.Pruning = nil
+	case "emissions.v1.Params.min_admin_params_update_delay":
+		x.MinAdminParamsUpdateDelay = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -736,6 +844,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "emissions.v1.Params.topic_fee_revenue_decay_rate":
 		value := x.TopicFeeRevenueDecayRate
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.liveness_window":
+		value := x.LivenessWindow
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.max_missed_epochs":
+		value := x.MaxMissedEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.jail_duration":
+		value := x.JailDuration
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.Params.min_topic_params":
+		value := x.MinTopicParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.Params.max_topic_params":
+		value := x.MaxTopicParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.Params.topic_creator_allowlist_enabled":
+		value := x.TopicCreatorAllowlistEnabled
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.Params.worker_rewards_claimable":
+		value := x.WorkerRewardsClaimable
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.Params.pruning":
+		value := x.Pruning
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.Params.min_admin_params_update_delay":
+		value := x.MinAdminParamsUpdateDelay
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -836,6 +971,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.CNorm = value.Interface().(string)
 	case "emissions.v1.Params.topic_fee_revenue_decay_rate":
 		x.TopicFeeRevenueDecayRate = value.Interface().(string)
+	case "emissions.v1.Params.liveness_window":
+		x.LivenessWindow = value.Uint()
+	case "emissions.v1.Params.max_missed_epochs":
+		x.MaxMissedEpochs = value.Uint()
+	case "emissions.v1.Params.jail_duration":
+		x.JailDuration = value.Int()
+	case "emissions.v1.Params.min_topic_params":
+		x.MinTopicParams = value.Message().Interface().(*TopicParams)
+	case "emissions.v1.Params.max_topic_params":
+		x.MaxTopicParams = value.Message().Interface().(*TopicParams)
+	case "emissions.v1.Params.topic_creator_allowlist_enabled":
+		x.TopicCreatorAllowlistEnabled = value.Bool()
+	case "emissions.v1.Params.worker_rewards_claimable":
+		x.WorkerRewardsClaimable = value.Bool()
+	case "emissions.v1.Params.pruning":
+		x.Pruning = value.Message().Interface().(*PruningParams)
+	case "emissions.v1.Params.min_admin_params_update_delay":
+		x.MinAdminParamsUpdateDelay = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -856,6 +1009,21 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v1.Params.min_topic_params":
+		if x.MinTopicParams == nil {
+			x.MinTopicParams = new(TopicParams)
+		}
+		return protoreflect.ValueOfMessage(x.MinTopicParams.ProtoReflect())
+	case "emissions.v1.Params.max_topic_params":
+		if x.MaxTopicParams == nil {
+			x.MaxTopicParams = new(TopicParams)
+		}
+		return protoreflect.ValueOfMessage(x.MaxTopicParams.ProtoReflect())
+	case "emissions.v1.Params.pruning":
+		if x.Pruning == nil {
+			x.Pruning = new(PruningParams)
+		}
+		return protoreflect.ValueOfMessage(x.Pruning.ProtoReflect())
 	case "emissions.v1.Params.version":
 		panic(fmt.Errorf("field version of message emissions.v1.Params is not mutable"))
 	case "emissions.v1.Params.max_serialized_msg_length":
@@ -936,6 +1104,18 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field c_norm of message emissions.v1.Params is not mutable"))
 	case "emissions.v1.Params.topic_fee_revenue_decay_rate":
 		panic(fmt.Errorf("field topic_fee_revenue_decay_rate of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.liveness_window":
+		panic(fmt.Errorf("field liveness_window of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_missed_epochs":
+		panic(fmt.Errorf("field max_missed_epochs of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.jail_duration":
+		panic(fmt.Errorf("field jail_duration of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.topic_creator_allowlist_enabled":
+		panic(fmt.Errorf("field topic_creator_allowlist_enabled of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.worker_rewards_claimable":
+		panic(fmt.Errorf("field worker_rewards_claimable of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.min_admin_params_update_delay":
+		panic(fmt.Errorf("field min_admin_params_update_delay of message emissions.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -1029,6 +1209,27 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.Params.topic_fee_revenue_decay_rate":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.liveness_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.max_missed_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.jail_duration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.Params.min_topic_params":
+		m := new(TopicParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.Params.max_topic_params":
+		m := new(TopicParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.Params.topic_creator_allowlist_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.Params.worker_rewards_claimable":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.Params.pruning":
+		m := new(PruningParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.Params.min_admin_params_update_delay":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -1241,6 +1442,36 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.LivenessWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.LivenessWindow))
+		}
+		if x.MaxMissedEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxMissedEpochs))
+		}
+		if x.JailDuration != 0 {
+			n += 2 + runtime.Sov(uint64(x.JailDuration))
+		}
+		if x.MinTopicParams != nil {
+			l = options.Size(x.MinTopicParams)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxTopicParams != nil {
+			l = options.Size(x.MaxTopicParams)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.TopicCreatorAllowlistEnabled {
+			n += 3
+		}
+		if x.WorkerRewardsClaimable {
+			n += 3
+		}
+		if x.Pruning != nil {
+			l = options.Size(x.Pruning)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinAdminParamsUpdateDelay != 0 {
+			n += 2 + runtime.Sov(uint64(x.MinAdminParamsUpdateDelay))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1270,6 +1501,106 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MinAdminParamsUpdateDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinAdminParamsUpdateDelay))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.Pruning != nil {
+			encoded, err := options.Marshal(x.Pruning)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.WorkerRewardsClaimable {
+			i--
+			if x.WorkerRewardsClaimable {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf8
+		}
+		if x.TopicCreatorAllowlistEnabled {
+			i--
+			if x.TopicCreatorAllowlistEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf0
+		}
+		if x.MaxTopicParams != nil {
+			encoded, err := options.Marshal(x.MaxTopicParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+		if x.MinTopicParams != nil {
+			encoded, err := options.Marshal(x.MinTopicParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+		if x.JailDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailDuration))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd8
+		}
+		if x.MaxMissedEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedEpochs))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd0
+		}
+		if x.LivenessWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LivenessWindow))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc8
+		}
 		if len(x.TopicFeeRevenueDecayRate) > 0 {
 			i -= len(x.TopicFeeRevenueDecayRate)
 			copy(dAtA[i:], x.TopicFeeRevenueDecayRate)
@@ -2674,84 +3005,2951 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.TopicFeeRevenueDecayRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 41:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LivenessWindow", wireType)
+				}
+				x.LivenessWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LivenessWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 42:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochs", wireType)
+				}
+				x.MaxMissedEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 43:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+				}
+				x.JailDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 44:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTopicParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinTopicParams == nil {
+					x.MinTopicParams = &TopicParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinTopicParams); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 45:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.MaxTopicParams == nil {
+					x.MaxTopicParams = &TopicParams{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v1/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params defines the parameters of the module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"` // version of the protocol should be in lockstep with
-	// github release tag version
-	MaxSerializedMsgLength int64  `protobuf:"varint,2,opt,name=max_serialized_msg_length,json=maxSerializedMsgLength,proto3" json:"max_serialized_msg_length,omitempty"` // max length of input data for msg and query server calls
-	MinTopicWeight         string `protobuf:"bytes,3,opt,name=min_topic_weight,json=minTopicWeight,proto3" json:"min_topic_weight,omitempty"`                            // total unmet demand for a topic < this => don't run inference
-	// solicatation or weight-adjustment
-	MaxTopicsPerBlock      uint64 `protobuf:"varint,4,opt,name=max_topics_per_block,json=maxTopicsPerBlock,proto3" json:"max_topics_per_block,omitempty"`                // max number of topics to run cadence for per block
-	RequiredMinimumStake   string `protobuf:"bytes,5,opt,name=required_minimum_stake,json=requiredMinimumStake,proto3" json:"required_minimum_stake,omitempty"`          // minimum amount of tokens to send to stake as a reputer or worker
-	RemoveStakeDelayWindow int64  `protobuf:"varint,6,opt,name=remove_stake_delay_window,json=removeStakeDelayWindow,proto3" json:"remove_stake_delay_window,omitempty"` // how long to wait before allowed to remove stake
-	MinEpochLength         int64  `protobuf:"varint,7,opt,name=min_epoch_length,json=minEpochLength,proto3" json:"min_epoch_length,omitempty"`                           // fastest allowable topic epoch and cadence of a
-	// repeating inference request
-	BetaEntropy          string `protobuf:"bytes,8,opt,name=beta_entropy,json=betaEntropy,proto3" json:"beta_entropy,omitempty"`                               // controls resilience of reward payouts against copycat workers
-	LearningRate         string `protobuf:"bytes,9,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`                            // speed of gradient descent
-	MaxGradientThreshold string `protobuf:"bytes,10,opt,name=max_gradient_threshold,json=maxGradientThreshold,proto3" json:"max_gradient_threshold,omitempty"` // gradient descent stops when gradient falls below this
-	MinStakeFraction     string `protobuf:"bytes,11,opt,name=min_stake_fraction,json=minStakeFraction,proto3" json:"min_stake_fraction,omitempty"`             // minimum fraction of stake to listen to when setting consensus listening
-	// coefficients
-	Epsilon                         string `protobuf:"bytes,12,opt,name=epsilon,proto3" json:"epsilon,omitempty"`                                                                                              // small number to prevent division by zero
-	MaxUnfulfilledWorkerRequests    uint64 `protobuf:"varint,13,opt,name=max_unfulfilled_worker_requests,json=maxUnfulfilledWorkerRequests,proto3" json:"max_unfulfilled_worker_requests,omitempty"`           // max num worker request nonces to keep track of per topic
-	MaxUnfulfilledReputerRequests   uint64 `protobuf:"varint,14,opt,name=max_unfulfilled_reputer_requests,json=maxUnfulfilledReputerRequests,proto3" json:"max_unfulfilled_reputer_requests,omitempty"`        // max num reputer request nonces to keep track of per topic
-	TopicRewardStakeImportance      string `protobuf:"bytes,15,opt,name=topic_reward_stake_importance,json=topicRewardStakeImportance,proto3" json:"topic_reward_stake_importance,omitempty"`                  // The exponent μ represents the importance of stake in the reward of a topic and has a fiducial value of 0.5
-	TopicRewardFeeRevenueImportance string `protobuf:"bytes,16,opt,name=topic_reward_fee_revenue_importance,json=topicRewardFeeRevenueImportance,proto3" json:"topic_reward_fee_revenue_importance,omitempty"` // The exponent ν represents the importance of fee revenue in the reward of a topic and has a fiducial value of
-	// 0.5
-	TopicRewardAlpha string `protobuf:"bytes,17,opt,name=topic_reward_alpha,json=topicRewardAlpha,proto3" json:"topic_reward_alpha,omitempty"` // global exponential moving average parameter. Fiducial value of 0.9375 on a monthly timescale, 0.5 for weekly
-	// updates
-	TaskRewardAlpha                 string `protobuf:"bytes,18,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3" json:"task_reward_alpha,omitempty"`                                                        // global exponential moving average parameter. Fiducial value of 0.1 used to calculate ~U_ij, ~V_ik, ~W_im
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxTopicParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 46:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicCreatorAllowlistEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.TopicCreatorAllowlistEnabled = bool(v != 0)
+			case 47:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerRewardsClaimable", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.WorkerRewardsClaimable = bool(v != 0)
+			case 48:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pruning", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pruning == nil {
+					x.Pruning = &PruningParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pruning); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 49:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinAdminParamsUpdateDelay", wireType)
+				}
+				x.MinAdminParamsUpdateDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinAdminParamsUpdateDelay |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Retention        protoreflect.MessageDescriptor
+	fd_Retention_epochs protoreflect.FieldDescriptor
+	fd_Retention_blocks protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_params_proto_init()
+	md_Retention = File_emissions_v1_params_proto.Messages().ByName("Retention")
+	fd_Retention_epochs = md_Retention.Fields().ByName("epochs")
+	fd_Retention_blocks = md_Retention.Fields().ByName("blocks")
+}
+
+var _ protoreflect.Message = (*fastReflection_Retention)(nil)
+
+type fastReflection_Retention Retention
+
+func (x *Retention) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Retention)(x)
+}
+
+func (x *Retention) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Retention_messageType fastReflection_Retention_messageType
+var _ protoreflect.MessageType = fastReflection_Retention_messageType{}
+
+type fastReflection_Retention_messageType struct{}
+
+func (x fastReflection_Retention_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Retention)(nil)
+}
+func (x fastReflection_Retention_messageType) New() protoreflect.Message {
+	return new(fastReflection_Retention)
+}
+func (x fastReflection_Retention_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Retention
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Retention) Descriptor() protoreflect.MessageDescriptor {
+	return md_Retention
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Retention) Type() protoreflect.MessageType {
+	return _fastReflection_Retention_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Retention) New() protoreflect.Message {
+	return new(fastReflection_Retention)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Retention) Interface() protoreflect.ProtoMessage {
+	return (*Retention)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Retention) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epochs)
+		if !f(fd_Retention_epochs, value) {
+			return
+		}
+	}
+	if x.Blocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Blocks)
+		if !f(fd_Retention_blocks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Retention) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.Retention.epochs":
+		return x.Epochs != uint64(0)
+	case "emissions.v1.Retention.blocks":
+		return x.Blocks != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Retention"))
+		}
+		panic(fmt.Errorf("message emissions.v1.Retention does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Retention) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.Retention.epochs":
+		x.Epochs = uint64(0)
+	case "emissions.v1.Retention.blocks":
+		x.Blocks = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Retention"))
+		}
+		panic(fmt.Errorf("message emissions.v1.Retention does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Retention) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.Retention.epochs":
+		value := x.Epochs
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Retention.blocks":
+		value := x.Blocks
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Retention"))
+		}
+		panic(fmt.Errorf("message emissions.v1.Retention does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Retention) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.Retention.epochs":
+		x.Epochs = value.Uint()
+	case "emissions.v1.Retention.blocks":
+		x.Blocks = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Retention"))
+		}
+		panic(fmt.Errorf("message emissions.v1.Retention does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Retention) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.Retention.epochs":
+		panic(fmt.Errorf("field epochs of message emissions.v1.Retention is not mutable"))
+	case "emissions.v1.Retention.blocks":
+		panic(fmt.Errorf("field blocks of message emissions.v1.Retention is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Retention"))
+		}
+		panic(fmt.Errorf("message emissions.v1.Retention does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Retention) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.Retention.epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Retention.blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Retention"))
+		}
+		panic(fmt.Errorf("message emissions.v1.Retention does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Retention) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.Retention", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Retention) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Retention) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Retention) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Retention) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Retention)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Epochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epochs))
+		}
+		if x.Blocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.Blocks))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Retention)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Blocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Blocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Epochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epochs))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Retention)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Retention: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Retention: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				}
+				x.Epochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				}
+				x.Blocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Blocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PruningParams                       protoreflect.MessageDescriptor
+	fd_PruningParams_max_records_per_block protoreflect.FieldDescriptor
+	fd_PruningParams_inferer_scores        protoreflect.FieldDescriptor
+	fd_PruningParams_forecaster_scores     protoreflect.FieldDescriptor
+	fd_PruningParams_reputer_scores        protoreflect.FieldDescriptor
+	fd_PruningParams_regrets               protoreflect.FieldDescriptor
+	fd_PruningParams_latest_scores         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_params_proto_init()
+	md_PruningParams = File_emissions_v1_params_proto.Messages().ByName("PruningParams")
+	fd_PruningParams_max_records_per_block = md_PruningParams.Fields().ByName("max_records_per_block")
+	fd_PruningParams_inferer_scores = md_PruningParams.Fields().ByName("inferer_scores")
+	fd_PruningParams_forecaster_scores = md_PruningParams.Fields().ByName("forecaster_scores")
+	fd_PruningParams_reputer_scores = md_PruningParams.Fields().ByName("reputer_scores")
+	fd_PruningParams_regrets = md_PruningParams.Fields().ByName("regrets")
+	fd_PruningParams_latest_scores = md_PruningParams.Fields().ByName("latest_scores")
+}
+
+var _ protoreflect.Message = (*fastReflection_PruningParams)(nil)
+
+type fastReflection_PruningParams PruningParams
+
+func (x *PruningParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PruningParams)(x)
+}
+
+func (x *PruningParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PruningParams_messageType fastReflection_PruningParams_messageType
+var _ protoreflect.MessageType = fastReflection_PruningParams_messageType{}
+
+type fastReflection_PruningParams_messageType struct{}
+
+func (x fastReflection_PruningParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PruningParams)(nil)
+}
+func (x fastReflection_PruningParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_PruningParams)
+}
+func (x fastReflection_PruningParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PruningParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PruningParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_PruningParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PruningParams) Type() protoreflect.MessageType {
+	return _fastReflection_PruningParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PruningParams) New() protoreflect.Message {
+	return new(fastReflection_PruningParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PruningParams) Interface() protoreflect.ProtoMessage {
+	return (*PruningParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PruningParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxRecordsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRecordsPerBlock)
+		if !f(fd_PruningParams_max_records_per_block, value) {
+			return
+		}
+	}
+	if x.InfererScores != nil {
+		value := protoreflect.ValueOfMessage(x.InfererScores.ProtoReflect())
+		if !f(fd_PruningParams_inferer_scores, value) {
+			return
+		}
+	}
+	if x.ForecasterScores != nil {
+		value := protoreflect.ValueOfMessage(x.ForecasterScores.ProtoReflect())
+		if !f(fd_PruningParams_forecaster_scores, value) {
+			return
+		}
+	}
+	if x.ReputerScores != nil {
+		value := protoreflect.ValueOfMessage(x.ReputerScores.ProtoReflect())
+		if !f(fd_PruningParams_reputer_scores, value) {
+			return
+		}
+	}
+	if x.Regrets != nil {
+		value := protoreflect.ValueOfMessage(x.Regrets.ProtoReflect())
+		if !f(fd_PruningParams_regrets, value) {
+			return
+		}
+	}
+	if x.LatestScores != nil {
+		value := protoreflect.ValueOfMessage(x.LatestScores.ProtoReflect())
+		if !f(fd_PruningParams_latest_scores, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PruningParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.PruningParams.max_records_per_block":
+		return x.MaxRecordsPerBlock != uint64(0)
+	case "emissions.v1.PruningParams.inferer_scores":
+		return x.InfererScores != nil
+	case "emissions.v1.PruningParams.forecaster_scores":
+		return x.ForecasterScores != nil
+	case "emissions.v1.PruningParams.reputer_scores":
+		return x.ReputerScores != nil
+	case "emissions.v1.PruningParams.regrets":
+		return x.Regrets != nil
+	case "emissions.v1.PruningParams.latest_scores":
+		return x.LatestScores != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PruningParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PruningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PruningParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.PruningParams.max_records_per_block":
+		x.MaxRecordsPerBlock = uint64(0)
+	case "emissions.v1.PruningParams.inferer_scores":
+		x.InfererScores = nil
+	case "emissions.v1.PruningParams.forecaster_scores":
+		x.ForecasterScores = nil
+	case "emissions.v1.PruningParams.reputer_scores":
+		x.ReputerScores = nil
+	case "emissions.v1.PruningParams.regrets":
+		x.Regrets = nil
+	case "emissions.v1.PruningParams.latest_scores":
+		x.LatestScores = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PruningParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PruningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PruningParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.PruningParams.max_records_per_block":
+		value := x.MaxRecordsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.PruningParams.inferer_scores":
+		value := x.InfererScores
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.PruningParams.forecaster_scores":
+		value := x.ForecasterScores
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.PruningParams.reputer_scores":
+		value := x.ReputerScores
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.PruningParams.regrets":
+		value := x.Regrets
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.PruningParams.latest_scores":
+		value := x.LatestScores
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PruningParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PruningParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PruningParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.PruningParams.max_records_per_block":
+		x.MaxRecordsPerBlock = value.Uint()
+	case "emissions.v1.PruningParams.inferer_scores":
+		x.InfererScores = value.Message().Interface().(*Retention)
+	case "emissions.v1.PruningParams.forecaster_scores":
+		x.ForecasterScores = value.Message().Interface().(*Retention)
+	case "emissions.v1.PruningParams.reputer_scores":
+		x.ReputerScores = value.Message().Interface().(*Retention)
+	case "emissions.v1.PruningParams.regrets":
+		x.Regrets = value.Message().Interface().(*Retention)
+	case "emissions.v1.PruningParams.latest_scores":
+		x.LatestScores = value.Message().Interface().(*Retention)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PruningParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PruningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PruningParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.PruningParams.inferer_scores":
+		if x.InfererScores == nil {
+			x.InfererScores = new(Retention)
+		}
+		return protoreflect.ValueOfMessage(x.InfererScores.ProtoReflect())
+	case "emissions.v1.PruningParams.forecaster_scores":
+		if x.ForecasterScores == nil {
+			x.ForecasterScores = new(Retention)
+		}
+		return protoreflect.ValueOfMessage(x.ForecasterScores.ProtoReflect())
+	case "emissions.v1.PruningParams.reputer_scores":
+		if x.ReputerScores == nil {
+			x.ReputerScores = new(Retention)
+		}
+		return protoreflect.ValueOfMessage(x.ReputerScores.ProtoReflect())
+	case "emissions.v1.PruningParams.regrets":
+		if x.Regrets == nil {
+			x.Regrets = new(Retention)
+		}
+		return protoreflect.ValueOfMessage(x.Regrets.ProtoReflect())
+	case "emissions.v1.PruningParams.latest_scores":
+		if x.LatestScores == nil {
+			x.LatestScores = new(Retention)
+		}
+		return protoreflect.ValueOfMessage(x.LatestScores.ProtoReflect())
+	case "emissions.v1.PruningParams.max_records_per_block":
+		panic(fmt.Errorf("field max_records_per_block of message emissions.v1.PruningParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PruningParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PruningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PruningParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.PruningParams.max_records_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.PruningParams.inferer_scores":
+		m := new(Retention)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.PruningParams.forecaster_scores":
+		m := new(Retention)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.PruningParams.reputer_scores":
+		m := new(Retention)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.PruningParams.regrets":
+		m := new(Retention)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.PruningParams.latest_scores":
+		m := new(Retention)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PruningParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PruningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PruningParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.PruningParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PruningParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PruningParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PruningParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PruningParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PruningParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxRecordsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRecordsPerBlock))
+		}
+		if x.InfererScores != nil {
+			l = options.Size(x.InfererScores)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ForecasterScores != nil {
+			l = options.Size(x.ForecasterScores)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReputerScores != nil {
+			l = options.Size(x.ReputerScores)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Regrets != nil {
+			l = options.Size(x.Regrets)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LatestScores != nil {
+			l = options.Size(x.LatestScores)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PruningParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LatestScores != nil {
+			encoded, err := options.Marshal(x.LatestScores)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Regrets != nil {
+			encoded, err := options.Marshal(x.Regrets)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ReputerScores != nil {
+			encoded, err := options.Marshal(x.ReputerScores)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ForecasterScores != nil {
+			encoded, err := options.Marshal(x.ForecasterScores)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.InfererScores != nil {
+			encoded, err := options.Marshal(x.InfererScores)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MaxRecordsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRecordsPerBlock))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PruningParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PruningParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PruningParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPerBlock", wireType)
+				}
+				x.MaxRecordsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRecordsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererScores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InfererScores == nil {
+					x.InfererScores = &Retention{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InfererScores); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterScores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ForecasterScores == nil {
+					x.ForecasterScores = &Retention{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecasterScores); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerScores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReputerScores == nil {
+					x.ReputerScores = &Retention{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerScores); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Regrets == nil {
+					x.Regrets = &Retention{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Regrets); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestScores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LatestScores == nil {
+					x.LatestScores = &Retention{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestScores); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TopicParams_1_list)(nil)
+
+type _TopicParams_1_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParams_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParams at list field MaxTopInferersToReward as it is not of Message kind"))
+}
+
+func (x *_TopicParams_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParams_1_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParams_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParams_2_list)(nil)
+
+type _TopicParams_2_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParams_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParams_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParams_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParams_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParams_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParams at list field MaxTopReputersToReward as it is not of Message kind"))
+}
+
+func (x *_TopicParams_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParams_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParams_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParams_3_list)(nil)
+
+type _TopicParams_3_list struct {
+	list *[]string
+}
+
+func (x *_TopicParams_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParams_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParams_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParams_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParams_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParams at list field RequiredMinimumStake as it is not of Message kind"))
+}
+
+func (x *_TopicParams_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParams_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParams_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParams_4_list)(nil)
+
+type _TopicParams_4_list struct {
+	list *[]string
+}
+
+func (x *_TopicParams_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParams_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParams_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParams_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParams_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParams at list field RegistrationFee as it is not of Message kind"))
+}
+
+func (x *_TopicParams_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParams_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParams_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParams_5_list)(nil)
+
+type _TopicParams_5_list struct {
+	list *[]string
+}
+
+func (x *_TopicParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParams_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParams at list field BetaEntropy as it is not of Message kind"))
+}
+
+func (x *_TopicParams_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParams_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParams_6_list)(nil)
+
+type _TopicParams_6_list struct {
+	list *[]string
+}
+
+func (x *_TopicParams_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParams_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParams_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParams_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParams_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParams at list field PRewardInference as it is not of Message kind"))
+}
+
+func (x *_TopicParams_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParams_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParams_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParams_7_list)(nil)
+
+type _TopicParams_7_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParams_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParams_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParams_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParams_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParams_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParams at list field MaxUnfulfilledWorkerRequests as it is not of Message kind"))
+}
+
+func (x *_TopicParams_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParams_7_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParams_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TopicParams                                 protoreflect.MessageDescriptor
+	fd_TopicParams_max_top_inferers_to_reward      protoreflect.FieldDescriptor
+	fd_TopicParams_max_top_reputers_to_reward      protoreflect.FieldDescriptor
+	fd_TopicParams_required_minimum_stake          protoreflect.FieldDescriptor
+	fd_TopicParams_registration_fee                protoreflect.FieldDescriptor
+	fd_TopicParams_beta_entropy                    protoreflect.FieldDescriptor
+	fd_TopicParams_p_reward_inference              protoreflect.FieldDescriptor
+	fd_TopicParams_max_unfulfilled_worker_requests protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_params_proto_init()
+	md_TopicParams = File_emissions_v1_params_proto.Messages().ByName("TopicParams")
+	fd_TopicParams_max_top_inferers_to_reward = md_TopicParams.Fields().ByName("max_top_inferers_to_reward")
+	fd_TopicParams_max_top_reputers_to_reward = md_TopicParams.Fields().ByName("max_top_reputers_to_reward")
+	fd_TopicParams_required_minimum_stake = md_TopicParams.Fields().ByName("required_minimum_stake")
+	fd_TopicParams_registration_fee = md_TopicParams.Fields().ByName("registration_fee")
+	fd_TopicParams_beta_entropy = md_TopicParams.Fields().ByName("beta_entropy")
+	fd_TopicParams_p_reward_inference = md_TopicParams.Fields().ByName("p_reward_inference")
+	fd_TopicParams_max_unfulfilled_worker_requests = md_TopicParams.Fields().ByName("max_unfulfilled_worker_requests")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicParams)(nil)
+
+type fastReflection_TopicParams TopicParams
+
+func (x *TopicParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicParams)(x)
+}
+
+func (x *TopicParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_params_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicParams_messageType fastReflection_TopicParams_messageType
+var _ protoreflect.MessageType = fastReflection_TopicParams_messageType{}
+
+type fastReflection_TopicParams_messageType struct{}
+
+func (x fastReflection_TopicParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicParams)(nil)
+}
+func (x fastReflection_TopicParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicParams)
+}
+func (x fastReflection_TopicParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicParams) Type() protoreflect.MessageType {
+	return _fastReflection_TopicParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicParams) New() protoreflect.Message {
+	return new(fastReflection_TopicParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicParams) Interface() protoreflect.ProtoMessage {
+	return (*TopicParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MaxTopInferersToReward) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParams_1_list{list: &x.MaxTopInferersToReward})
+		if !f(fd_TopicParams_max_top_inferers_to_reward, value) {
+			return
+		}
+	}
+	if len(x.MaxTopReputersToReward) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParams_2_list{list: &x.MaxTopReputersToReward})
+		if !f(fd_TopicParams_max_top_reputers_to_reward, value) {
+			return
+		}
+	}
+	if len(x.RequiredMinimumStake) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParams_3_list{list: &x.RequiredMinimumStake})
+		if !f(fd_TopicParams_required_minimum_stake, value) {
+			return
+		}
+	}
+	if len(x.RegistrationFee) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParams_4_list{list: &x.RegistrationFee})
+		if !f(fd_TopicParams_registration_fee, value) {
+			return
+		}
+	}
+	if len(x.BetaEntropy) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParams_5_list{list: &x.BetaEntropy})
+		if !f(fd_TopicParams_beta_entropy, value) {
+			return
+		}
+	}
+	if len(x.PRewardInference) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParams_6_list{list: &x.PRewardInference})
+		if !f(fd_TopicParams_p_reward_inference, value) {
+			return
+		}
+	}
+	if len(x.MaxUnfulfilledWorkerRequests) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParams_7_list{list: &x.MaxUnfulfilledWorkerRequests})
+		if !f(fd_TopicParams_max_unfulfilled_worker_requests, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParams.max_top_inferers_to_reward":
+		return len(x.MaxTopInferersToReward) != 0
+	case "emissions.v1.TopicParams.max_top_reputers_to_reward":
+		return len(x.MaxTopReputersToReward) != 0
+	case "emissions.v1.TopicParams.required_minimum_stake":
+		return len(x.RequiredMinimumStake) != 0
+	case "emissions.v1.TopicParams.registration_fee":
+		return len(x.RegistrationFee) != 0
+	case "emissions.v1.TopicParams.beta_entropy":
+		return len(x.BetaEntropy) != 0
+	case "emissions.v1.TopicParams.p_reward_inference":
+		return len(x.PRewardInference) != 0
+	case "emissions.v1.TopicParams.max_unfulfilled_worker_requests":
+		return len(x.MaxUnfulfilledWorkerRequests) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParams.max_top_inferers_to_reward":
+		x.MaxTopInferersToReward = nil
+	case "emissions.v1.TopicParams.max_top_reputers_to_reward":
+		x.MaxTopReputersToReward = nil
+	case "emissions.v1.TopicParams.required_minimum_stake":
+		x.RequiredMinimumStake = nil
+	case "emissions.v1.TopicParams.registration_fee":
+		x.RegistrationFee = nil
+	case "emissions.v1.TopicParams.beta_entropy":
+		x.BetaEntropy = nil
+	case "emissions.v1.TopicParams.p_reward_inference":
+		x.PRewardInference = nil
+	case "emissions.v1.TopicParams.max_unfulfilled_worker_requests":
+		x.MaxUnfulfilledWorkerRequests = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.TopicParams.max_top_inferers_to_reward":
+		if len(x.MaxTopInferersToReward) == 0 {
+			return protoreflect.ValueOfList(&_TopicParams_1_list{})
+		}
+		listValue := &_TopicParams_1_list{list: &x.MaxTopInferersToReward}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParams.max_top_reputers_to_reward":
+		if len(x.MaxTopReputersToReward) == 0 {
+			return protoreflect.ValueOfList(&_TopicParams_2_list{})
+		}
+		listValue := &_TopicParams_2_list{list: &x.MaxTopReputersToReward}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParams.required_minimum_stake":
+		if len(x.RequiredMinimumStake) == 0 {
+			return protoreflect.ValueOfList(&_TopicParams_3_list{})
+		}
+		listValue := &_TopicParams_3_list{list: &x.RequiredMinimumStake}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParams.registration_fee":
+		if len(x.RegistrationFee) == 0 {
+			return protoreflect.ValueOfList(&_TopicParams_4_list{})
+		}
+		listValue := &_TopicParams_4_list{list: &x.RegistrationFee}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParams.beta_entropy":
+		if len(x.BetaEntropy) == 0 {
+			return protoreflect.ValueOfList(&_TopicParams_5_list{})
+		}
+		listValue := &_TopicParams_5_list{list: &x.BetaEntropy}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParams.p_reward_inference":
+		if len(x.PRewardInference) == 0 {
+			return protoreflect.ValueOfList(&_TopicParams_6_list{})
+		}
+		listValue := &_TopicParams_6_list{list: &x.PRewardInference}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParams.max_unfulfilled_worker_requests":
+		if len(x.MaxUnfulfilledWorkerRequests) == 0 {
+			return protoreflect.ValueOfList(&_TopicParams_7_list{})
+		}
+		listValue := &_TopicParams_7_list{list: &x.MaxUnfulfilledWorkerRequests}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParams.max_top_inferers_to_reward":
+		lv := value.List()
+		clv := lv.(*_TopicParams_1_list)
+		x.MaxTopInferersToReward = *clv.list
+	case "emissions.v1.TopicParams.max_top_reputers_to_reward":
+		lv := value.List()
+		clv := lv.(*_TopicParams_2_list)
+		x.MaxTopReputersToReward = *clv.list
+	case "emissions.v1.TopicParams.required_minimum_stake":
+		lv := value.List()
+		clv := lv.(*_TopicParams_3_list)
+		x.RequiredMinimumStake = *clv.list
+	case "emissions.v1.TopicParams.registration_fee":
+		lv := value.List()
+		clv := lv.(*_TopicParams_4_list)
+		x.RegistrationFee = *clv.list
+	case "emissions.v1.TopicParams.beta_entropy":
+		lv := value.List()
+		clv := lv.(*_TopicParams_5_list)
+		x.BetaEntropy = *clv.list
+	case "emissions.v1.TopicParams.p_reward_inference":
+		lv := value.List()
+		clv := lv.(*_TopicParams_6_list)
+		x.PRewardInference = *clv.list
+	case "emissions.v1.TopicParams.max_unfulfilled_worker_requests":
+		lv := value.List()
+		clv := lv.(*_TopicParams_7_list)
+		x.MaxUnfulfilledWorkerRequests = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParams.max_top_inferers_to_reward":
+		if x.MaxTopInferersToReward == nil {
+			x.MaxTopInferersToReward = []uint64{}
+		}
+		value := &_TopicParams_1_list{list: &x.MaxTopInferersToReward}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParams.max_top_reputers_to_reward":
+		if x.MaxTopReputersToReward == nil {
+			x.MaxTopReputersToReward = []uint64{}
+		}
+		value := &_TopicParams_2_list{list: &x.MaxTopReputersToReward}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParams.required_minimum_stake":
+		if x.RequiredMinimumStake == nil {
+			x.RequiredMinimumStake = []string{}
+		}
+		value := &_TopicParams_3_list{list: &x.RequiredMinimumStake}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParams.registration_fee":
+		if x.RegistrationFee == nil {
+			x.RegistrationFee = []string{}
+		}
+		value := &_TopicParams_4_list{list: &x.RegistrationFee}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParams.beta_entropy":
+		if x.BetaEntropy == nil {
+			x.BetaEntropy = []string{}
+		}
+		value := &_TopicParams_5_list{list: &x.BetaEntropy}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParams.p_reward_inference":
+		if x.PRewardInference == nil {
+			x.PRewardInference = []string{}
+		}
+		value := &_TopicParams_6_list{list: &x.PRewardInference}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParams.max_unfulfilled_worker_requests":
+		if x.MaxUnfulfilledWorkerRequests == nil {
+			x.MaxUnfulfilledWorkerRequests = []uint64{}
+		}
+		value := &_TopicParams_7_list{list: &x.MaxUnfulfilledWorkerRequests}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParams.max_top_inferers_to_reward":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParams_1_list{list: &list})
+	case "emissions.v1.TopicParams.max_top_reputers_to_reward":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParams_2_list{list: &list})
+	case "emissions.v1.TopicParams.required_minimum_stake":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParams_3_list{list: &list})
+	case "emissions.v1.TopicParams.registration_fee":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParams_4_list{list: &list})
+	case "emissions.v1.TopicParams.beta_entropy":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParams_5_list{list: &list})
+	case "emissions.v1.TopicParams.p_reward_inference":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParams_6_list{list: &list})
+	case "emissions.v1.TopicParams.max_unfulfilled_worker_requests":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParams_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MaxTopInferersToReward) > 0 {
+			l = 0
+			for _, e := range x.MaxTopInferersToReward {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.MaxTopReputersToReward) > 0 {
+			l = 0
+			for _, e := range x.MaxTopReputersToReward {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.RequiredMinimumStake) > 0 {
+			for _, s := range x.RequiredMinimumStake {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RegistrationFee) > 0 {
+			for _, s := range x.RegistrationFee {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BetaEntropy) > 0 {
+			for _, s := range x.BetaEntropy {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PRewardInference) > 0 {
+			for _, s := range x.PRewardInference {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MaxUnfulfilledWorkerRequests) > 0 {
+			l = 0
+			for _, e := range x.MaxUnfulfilledWorkerRequests {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxUnfulfilledWorkerRequests) > 0 {
+			var pksize2 int
+			for _, num := range x.MaxUnfulfilledWorkerRequests {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.MaxUnfulfilledWorkerRequests {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.PRewardInference) > 0 {
+			for iNdEx := len(x.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PRewardInference[iNdEx])
+				copy(dAtA[i:], x.PRewardInference[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PRewardInference[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.BetaEntropy) > 0 {
+			for iNdEx := len(x.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BetaEntropy[iNdEx])
+				copy(dAtA[i:], x.BetaEntropy[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BetaEntropy[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.RegistrationFee) > 0 {
+			for iNdEx := len(x.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RegistrationFee[iNdEx])
+				copy(dAtA[i:], x.RegistrationFee[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistrationFee[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.RequiredMinimumStake) > 0 {
+			for iNdEx := len(x.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequiredMinimumStake[iNdEx])
+				copy(dAtA[i:], x.RequiredMinimumStake[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredMinimumStake[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.MaxTopReputersToReward) > 0 {
+			var pksize4 int
+			for _, num := range x.MaxTopReputersToReward {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num := range x.MaxTopReputersToReward {
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MaxTopInferersToReward) > 0 {
+			var pksize6 int
+			for _, num := range x.MaxTopInferersToReward {
+				pksize6 += runtime.Sov(uint64(num))
+			}
+			i -= pksize6
+			j5 := i
+			for _, num := range x.MaxTopInferersToReward {
+				for num >= 1<<7 {
+					dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j5++
+				}
+				dAtA[j5] = uint8(num)
+				j5++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxTopInferersToReward = append(x.MaxTopInferersToReward, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxTopInferersToReward) == 0 {
+						x.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxTopInferersToReward = append(x.MaxTopInferersToReward, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+				}
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxTopReputersToReward = append(x.MaxTopReputersToReward, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxTopReputersToReward) == 0 {
+						x.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxTopReputersToReward = append(x.MaxTopReputersToReward, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredMinimumStake = append(x.RequiredMinimumStake, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RegistrationFee = append(x.RegistrationFee, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BetaEntropy = append(x.BetaEntropy, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PRewardInference = append(x.PRewardInference, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxUnfulfilledWorkerRequests = append(x.MaxUnfulfilledWorkerRequests, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxUnfulfilledWorkerRequests) == 0 {
+						x.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxUnfulfilledWorkerRequests = append(x.MaxUnfulfilledWorkerRequests, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters of the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"` // version of the protocol should be in lockstep with
+	// github release tag version
+	MaxSerializedMsgLength int64  `protobuf:"varint,2,opt,name=max_serialized_msg_length,json=maxSerializedMsgLength,proto3" json:"max_serialized_msg_length,omitempty"` // max length of input data for msg and query server calls
+	MinTopicWeight         string `protobuf:"bytes,3,opt,name=min_topic_weight,json=minTopicWeight,proto3" json:"min_topic_weight,omitempty"`                            // total unmet demand for a topic < this => don't run inference
+	// solicatation or weight-adjustment
+	MaxTopicsPerBlock      uint64 `protobuf:"varint,4,opt,name=max_topics_per_block,json=maxTopicsPerBlock,proto3" json:"max_topics_per_block,omitempty"`                // max number of topics to run cadence for per block
+	RequiredMinimumStake   string `protobuf:"bytes,5,opt,name=required_minimum_stake,json=requiredMinimumStake,proto3" json:"required_minimum_stake,omitempty"`          // minimum amount of tokens to send to stake as a reputer or worker
+	RemoveStakeDelayWindow int64  `protobuf:"varint,6,opt,name=remove_stake_delay_window,json=removeStakeDelayWindow,proto3" json:"remove_stake_delay_window,omitempty"` // how long to wait before allowed to remove stake
+	MinEpochLength         int64  `protobuf:"varint,7,opt,name=min_epoch_length,json=minEpochLength,proto3" json:"min_epoch_length,omitempty"`                           // fastest allowable topic epoch and cadence of a
+	// repeating inference request
+	BetaEntropy          string `protobuf:"bytes,8,opt,name=beta_entropy,json=betaEntropy,proto3" json:"beta_entropy,omitempty"`                               // controls resilience of reward payouts against copycat workers
+	LearningRate         string `protobuf:"bytes,9,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`                            // speed of gradient descent
+	MaxGradientThreshold string `protobuf:"bytes,10,opt,name=max_gradient_threshold,json=maxGradientThreshold,proto3" json:"max_gradient_threshold,omitempty"` // gradient descent stops when gradient falls below this
+	MinStakeFraction     string `protobuf:"bytes,11,opt,name=min_stake_fraction,json=minStakeFraction,proto3" json:"min_stake_fraction,omitempty"`             // minimum fraction of stake to listen to when setting consensus listening
+	// coefficients
+	Epsilon                         string `protobuf:"bytes,12,opt,name=epsilon,proto3" json:"epsilon,omitempty"`                                                                                              // small number to prevent division by zero
+	MaxUnfulfilledWorkerRequests    uint64 `protobuf:"varint,13,opt,name=max_unfulfilled_worker_requests,json=maxUnfulfilledWorkerRequests,proto3" json:"max_unfulfilled_worker_requests,omitempty"`           // max num worker request nonces to keep track of per topic
+	MaxUnfulfilledReputerRequests   uint64 `protobuf:"varint,14,opt,name=max_unfulfilled_reputer_requests,json=maxUnfulfilledReputerRequests,proto3" json:"max_unfulfilled_reputer_requests,omitempty"`        // max num reputer request nonces to keep track of per topic
+	TopicRewardStakeImportance      string `protobuf:"bytes,15,opt,name=topic_reward_stake_importance,json=topicRewardStakeImportance,proto3" json:"topic_reward_stake_importance,omitempty"`                  // The exponent μ represents the importance of stake in the reward of a topic and has a fiducial value of 0.5
+	TopicRewardFeeRevenueImportance string `protobuf:"bytes,16,opt,name=topic_reward_fee_revenue_importance,json=topicRewardFeeRevenueImportance,proto3" json:"topic_reward_fee_revenue_importance,omitempty"` // The exponent ν represents the importance of fee revenue in the reward of a topic and has a fiducial value of
+	// 0.5
+	TopicRewardAlpha string `protobuf:"bytes,17,opt,name=topic_reward_alpha,json=topicRewardAlpha,proto3" json:"topic_reward_alpha,omitempty"` // global exponential moving average parameter. Fiducial value of 0.9375 on a monthly timescale, 0.5 for weekly
+	// updates
+	TaskRewardAlpha                 string `protobuf:"bytes,18,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3" json:"task_reward_alpha,omitempty"`                                                        // global exponential moving average parameter. Fiducial value of 0.1 used to calculate ~U_ij, ~V_ik, ~W_im
 	ValidatorsVsAlloraPercentReward string `protobuf:"bytes,19,opt,name=validators_vs_allora_percent_reward,json=validatorsVsAlloraPercentReward,proto3" json:"validators_vs_allora_percent_reward,omitempty"`    // percent of total supply rewarded to cosmos network validators, rest goes to allora reputers workers etc
 	MaxSamplesToScaleScores         uint64 `protobuf:"varint,20,opt,name=max_samples_to_scale_scores,json=maxSamplesToScaleScores,proto3" json:"max_samples_to_scale_scores,omitempty"`                           // number of scores to use for standard deviation calculation
 	MaxTopInferersToReward          uint64 `protobuf:"varint,21,opt,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`                              // max number of top inferers by score to reward
@@ -2767,15 +5965,24 @@ type Params struct {
 	// min number of epochs to keep network losses, reputer losses, inferences, forecasts
 	MinEpochLengthRecordLimit int64 `protobuf:"varint,31,opt,name=min_epoch_length_record_limit,json=minEpochLengthRecordLimit,proto3" json:"min_epoch_length_record_limit,omitempty"`
 	// block emission rate in number of blocks expected per month
-	BlocksPerMonth           uint64 `protobuf:"varint,32,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
-	PRewardInference         string `protobuf:"bytes,33,opt,name=p_reward_inference,json=pRewardInference,proto3" json:"p_reward_inference,omitempty"`
-	PRewardForecast          string `protobuf:"bytes,34,opt,name=p_reward_forecast,json=pRewardForecast,proto3" json:"p_reward_forecast,omitempty"`
-	PRewardReputer           string `protobuf:"bytes,35,opt,name=p_reward_reputer,json=pRewardReputer,proto3" json:"p_reward_reputer,omitempty"`
-	CRewardInference         string `protobuf:"bytes,36,opt,name=c_reward_inference,json=cRewardInference,proto3" json:"c_reward_inference,omitempty"`
-	CRewardForecast          string `protobuf:"bytes,37,opt,name=c_reward_forecast,json=cRewardForecast,proto3" json:"c_reward_forecast,omitempty"`
-	FTolerance               string `protobuf:"bytes,38,opt,name=f_tolerance,json=fTolerance,proto3" json:"f_tolerance,omitempty"`
-	CNorm                    string `protobuf:"bytes,39,opt,name=c_norm,json=cNorm,proto3" json:"c_norm,omitempty"`
-	TopicFeeRevenueDecayRate string `protobuf:"bytes,40,opt,name=topic_fee_revenue_decay_rate,json=topicFeeRevenueDecayRate,proto3" json:"topic_fee_revenue_decay_rate,omitempty"` // decay rate for topic fee revenue
+	BlocksPerMonth               uint64         `protobuf:"varint,32,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
+	PRewardInference             string         `protobuf:"bytes,33,opt,name=p_reward_inference,json=pRewardInference,proto3" json:"p_reward_inference,omitempty"`
+	PRewardForecast              string         `protobuf:"bytes,34,opt,name=p_reward_forecast,json=pRewardForecast,proto3" json:"p_reward_forecast,omitempty"`
+	PRewardReputer               string         `protobuf:"bytes,35,opt,name=p_reward_reputer,json=pRewardReputer,proto3" json:"p_reward_reputer,omitempty"`
+	CRewardInference             string         `protobuf:"bytes,36,opt,name=c_reward_inference,json=cRewardInference,proto3" json:"c_reward_inference,omitempty"`
+	CRewardForecast              string         `protobuf:"bytes,37,opt,name=c_reward_forecast,json=cRewardForecast,proto3" json:"c_reward_forecast,omitempty"`
+	FTolerance                   string         `protobuf:"bytes,38,opt,name=f_tolerance,json=fTolerance,proto3" json:"f_tolerance,omitempty"`
+	CNorm                        string         `protobuf:"bytes,39,opt,name=c_norm,json=cNorm,proto3" json:"c_norm,omitempty"`
+	TopicFeeRevenueDecayRate     string         `protobuf:"bytes,40,opt,name=topic_fee_revenue_decay_rate,json=topicFeeRevenueDecayRate,proto3" json:"topic_fee_revenue_decay_rate,omitempty"`            // decay rate for topic fee revenue
+	LivenessWindow               uint64         `protobuf:"varint,41,opt,name=liveness_window,json=livenessWindow,proto3" json:"liveness_window,omitempty"`                                               // number of most recent fulfilled nonces over which actor liveness is tracked, 0 disables
+	MaxMissedEpochs              uint64         `protobuf:"varint,42,opt,name=max_missed_epochs,json=maxMissedEpochs,proto3" json:"max_missed_epochs,omitempty"`                                          // actors that miss more than this many nonces within the window are jailed
+	JailDuration                 int64          `protobuf:"varint,43,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`                                                     // number of blocks a jailed actor must wait before unjailing
+	MinTopicParams               *TopicParams   `protobuf:"bytes,44,opt,name=min_topic_params,json=minTopicParams,proto3" json:"min_topic_params,omitempty"`                                              // lowest values topic creators may override params with
+	MaxTopicParams               *TopicParams   `protobuf:"bytes,45,opt,name=max_topic_params,json=maxTopicParams,proto3" json:"max_topic_params,omitempty"`                                              // highest values topic creators may override params with
+	TopicCreatorAllowlistEnabled bool           `protobuf:"varint,46,opt,name=topic_creator_allowlist_enabled,json=topicCreatorAllowlistEnabled,proto3" json:"topic_creator_allowlist_enabled,omitempty"` // only accounts in the topic creator allowlist may create topics
+	WorkerRewardsClaimable       bool           `protobuf:"varint,47,opt,name=worker_rewards_claimable,json=workerRewardsClaimable,proto3" json:"worker_rewards_claimable,omitempty"`                     // credit worker rewards to a ledger to be claimed instead of sending them each epoch
+	Pruning                      *PruningParams `protobuf:"bytes,48,opt,name=pruning,proto3" json:"pruning,omitempty"`                                                                                    // how long historical records are kept before they are pruned
+	MinAdminParamsUpdateDelay    int64          `protobuf:"varint,49,opt,name=min_admin_params_update_delay,json=minAdminParamsUpdateDelay,proto3" json:"min_admin_params_update_delay,omitempty"`        // blocks ahead whitelist admins must schedule params updates, only the authority applies them at once
 }
 
 func (x *Params) Reset() {
@@ -3078,6 +6285,282 @@ func (x *Params) GetTopicFeeRevenueDecayRate() string {
 	return ""
 }
 
+func (x *Params) GetLivenessWindow() uint64 {
+	if x != nil {
+		return x.LivenessWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxMissedEpochs() uint64 {
+	if x != nil {
+		return x.MaxMissedEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetJailDuration() int64 {
+	if x != nil {
+		return x.JailDuration
+	}
+	return 0
+}
+
+func (x *Params) GetMinTopicParams() *TopicParams {
+	if x != nil {
+		return x.MinTopicParams
+	}
+	return nil
+}
+
+func (x *Params) GetMaxTopicParams() *TopicParams {
+	if x != nil {
+		return x.MaxTopicParams
+	}
+	return nil
+}
+
+func (x *Params) GetTopicCreatorAllowlistEnabled() bool {
+	if x != nil {
+		return x.TopicCreatorAllowlistEnabled
+	}
+	return false
+}
+
+func (x *Params) GetWorkerRewardsClaimable() bool {
+	if x != nil {
+		return x.WorkerRewardsClaimable
+	}
+	return false
+}
+
+func (x *Params) GetPruning() *PruningParams {
+	if x != nil {
+		return x.Pruning
+	}
+	return nil
+}
+
+func (x *Params) GetMinAdminParamsUpdateDelay() int64 {
+	if x != nil {
+		return x.MinAdminParamsUpdateDelay
+	}
+	return 0
+}
+
+// How long the records of a collection are kept, counted back from the current block.
+// At most one of the two fields may be set; with neither set the records are kept forever.
+type Retention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochs uint64 `protobuf:"varint,1,opt,name=epochs,proto3" json:"epochs,omitempty"` // in epochs of the topic the records belong to
+	Blocks int64  `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *Retention) Reset() {
+	*x = Retention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Retention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retention) ProtoMessage() {}
+
+// Deprecated: Use Retention.ProtoReflect.Descriptor instead.
+func (*Retention) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Retention) GetEpochs() uint64 {
+	if x != nil {
+		return x.Epochs
+	}
+	return 0
+}
+
+func (x *Retention) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+// Retention of the historical records that are pruned incrementally at the end of every block.
+// Inferences, forecasts and loss bundles are pruned when their topic is rewarded instead, see min_epoch_length_record_limit.
+type PruningParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// most records visited by the pruner in a block, 0 disables pruning
+	MaxRecordsPerBlock uint64 `protobuf:"varint,1,opt,name=max_records_per_block,json=maxRecordsPerBlock,proto3" json:"max_records_per_block,omitempty"`
+	// scores of the inferers, forecasters and reputers at each block
+	InfererScores    *Retention `protobuf:"bytes,2,opt,name=inferer_scores,json=infererScores,proto3" json:"inferer_scores,omitempty"`
+	ForecasterScores *Retention `protobuf:"bytes,3,opt,name=forecaster_scores,json=forecasterScores,proto3" json:"forecaster_scores,omitempty"`
+	ReputerScores    *Retention `protobuf:"bytes,4,opt,name=reputer_scores,json=reputerScores,proto3" json:"reputer_scores,omitempty"`
+	// latest regrets of workers, pruned once they were last updated longer ago than this
+	Regrets *Retention `protobuf:"bytes,5,opt,name=regrets,proto3" json:"regrets,omitempty"`
+	// latest score of each actor, along with its previous reward fraction and listening coefficient,
+	// pruned once the actor was last scored longer ago than this
+	LatestScores *Retention `protobuf:"bytes,6,opt,name=latest_scores,json=latestScores,proto3" json:"latest_scores,omitempty"`
+}
+
+func (x *PruningParams) Reset() {
+	*x = PruningParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruningParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruningParams) ProtoMessage() {}
+
+// Deprecated: Use PruningParams.ProtoReflect.Descriptor instead.
+func (*PruningParams) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PruningParams) GetMaxRecordsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxRecordsPerBlock
+	}
+	return 0
+}
+
+func (x *PruningParams) GetInfererScores() *Retention {
+	if x != nil {
+		return x.InfererScores
+	}
+	return nil
+}
+
+func (x *PruningParams) GetForecasterScores() *Retention {
+	if x != nil {
+		return x.ForecasterScores
+	}
+	return nil
+}
+
+func (x *PruningParams) GetReputerScores() *Retention {
+	if x != nil {
+		return x.ReputerScores
+	}
+	return nil
+}
+
+func (x *PruningParams) GetRegrets() *Retention {
+	if x != nil {
+		return x.Regrets
+	}
+	return nil
+}
+
+func (x *PruningParams) GetLatestScores() *Retention {
+	if x != nil {
+		return x.LatestScores
+	}
+	return nil
+}
+
+// Overrides of the global params for a single topic. Every field is a repeated field
+// with at most one element, an empty field keeps the global value (see OptionalParams).
+// As bounds, a field may only be overridden if both its lowest and highest values are set.
+type TopicParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxTopInferersToReward       []uint64 `protobuf:"varint,1,rep,packed,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopReputersToReward       []uint64 `protobuf:"varint,2,rep,packed,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	RequiredMinimumStake         []string `protobuf:"bytes,3,rep,name=required_minimum_stake,json=requiredMinimumStake,proto3" json:"required_minimum_stake,omitempty"`
+	RegistrationFee              []string `protobuf:"bytes,4,rep,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`
+	BetaEntropy                  []string `protobuf:"bytes,5,rep,name=beta_entropy,json=betaEntropy,proto3" json:"beta_entropy,omitempty"`
+	PRewardInference             []string `protobuf:"bytes,6,rep,name=p_reward_inference,json=pRewardInference,proto3" json:"p_reward_inference,omitempty"`
+	MaxUnfulfilledWorkerRequests []uint64 `protobuf:"varint,7,rep,packed,name=max_unfulfilled_worker_requests,json=maxUnfulfilledWorkerRequests,proto3" json:"max_unfulfilled_worker_requests,omitempty"`
+}
+
+func (x *TopicParams) Reset() {
+	*x = TopicParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicParams) ProtoMessage() {}
+
+// Deprecated: Use TopicParams.ProtoReflect.Descriptor instead.
+func (*TopicParams) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TopicParams) GetMaxTopInferersToReward() []uint64 {
+	if x != nil {
+		return x.MaxTopInferersToReward
+	}
+	return nil
+}
+
+func (x *TopicParams) GetMaxTopReputersToReward() []uint64 {
+	if x != nil {
+		return x.MaxTopReputersToReward
+	}
+	return nil
+}
+
+func (x *TopicParams) GetRequiredMinimumStake() []string {
+	if x != nil {
+		return x.RequiredMinimumStake
+	}
+	return nil
+}
+
+func (x *TopicParams) GetRegistrationFee() []string {
+	if x != nil {
+		return x.RegistrationFee
+	}
+	return nil
+}
+
+func (x *TopicParams) GetBetaEntropy() []string {
+	if x != nil {
+		return x.BetaEntropy
+	}
+	return nil
+}
+
+func (x *TopicParams) GetPRewardInference() []string {
+	if x != nil {
+		return x.PRewardInference
+	}
+	return nil
+}
+
+func (x *TopicParams) GetMaxUnfulfilledWorkerRequests() []uint64 {
+	if x != nil {
+		return x.MaxUnfulfilledWorkerRequests
+	}
+	return nil
+}
+
 var File_emissions_v1_params_proto protoreflect.FileDescriptor
 
 var file_emissions_v1_params_proto_rawDesc = []byte{
@@ -3087,7 +6570,7 @@ var file_emissions_v1_params_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x19,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x1e,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
@@ -3295,19 +6778,119 @@ var file_emissions_v1_params_proto_rawDesc = []byte{
 	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
 	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x46, 0x65,
 	0x65, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x44, 0x65, 0x63, 0x61, 0x79, 0x52, 0x61, 0x74,
-	0x65, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x29, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x69, 0x76, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6a,
+	0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x10, 0x6d,
+	0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x45, 0x0a, 0x1f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x70, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x30, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x40, 0x0a, 0x1d, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x31, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x22, 0x3b, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x97,
+	0x03, 0x0a, 0x0d, 0x50, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x12, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x44, 0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x66, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x72, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x72,
+	0x65, 0x67, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x67,
+	0x72, 0x65, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xd4, 0x04, 0x0a, 0x0b, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61,
+	0x78, 0x54, 0x6f, 0x70, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70,
+	0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x66, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x5a, 0x0a, 0x0c, 0x62, 0x65, 0x74, 0x61, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x6f, 0x70, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x62, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x6f, 0x70,
+	0x79, 0x12, 0x65, 0x0a, 0x12, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f,
+	0x75, 0x6e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x1c, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x42,
+	0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3322,16 +6905,27 @@ func file_emissions_v1_params_proto_rawDescGZIP() []byte {
 	return file_emissions_v1_params_proto_rawDescData
 }
 
-var file_emissions_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_emissions_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_emissions_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: emissions.v1.Params
+	(*Params)(nil),        // 0: emissions.v1.Params
+	(*Retention)(nil),     // 1: emissions.v1.Retention
+	(*PruningParams)(nil), // 2: emissions.v1.PruningParams
+	(*TopicParams)(nil),   // 3: emissions.v1.TopicParams
 }
 var file_emissions_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: emissions.v1.Params.min_topic_params:type_name -> emissions.v1.TopicParams
+	3, // 1: emissions.v1.Params.max_topic_params:type_name -> emissions.v1.TopicParams
+	2, // 2: emissions.v1.Params.pruning:type_name -> emissions.v1.PruningParams
+	1, // 3: emissions.v1.PruningParams.inferer_scores:type_name -> emissions.v1.Retention
+	1, // 4: emissions.v1.PruningParams.forecaster_scores:type_name -> emissions.v1.Retention
+	1, // 5: emissions.v1.PruningParams.reputer_scores:type_name -> emissions.v1.Retention
+	1, // 6: emissions.v1.PruningParams.regrets:type_name -> emissions.v1.Retention
+	1, // 7: emissions.v1.PruningParams.latest_scores:type_name -> emissions.v1.Retention
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v1_params_proto_init() }
@@ -3352,6 +6946,42 @@ func file_emissions_v1_params_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruningParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3359,7 +6989,7 @@ func file_emissions_v1_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v1_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,10 +22,12 @@ const (
 	Query_Params_FullMethodName                                = "/emissions.v1.Query/Params"
 	Query_GetNextTopicId_FullMethodName                        = "/emissions.v1.Query/GetNextTopicId"
 	Query_GetTopic_FullMethodName                              = "/emissions.v1.Query/GetTopic"
+	Query_GetTopicParams_FullMethodName                        = "/emissions.v1.Query/GetTopicParams"
 	Query_GetActiveTopics_FullMethodName                       = "/emissions.v1.Query/GetActiveTopics"
 	Query_GetWorkerLatestInferenceByTopicId_FullMethodName     = "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId"
 	Query_GetInferencesAtBlock_FullMethodName                  = "/emissions.v1.Query/GetInferencesAtBlock"
 	Query_GetForecastsAtBlock_FullMethodName                   = "/emissions.v1.Query/GetForecastsAtBlock"
+	Query_GetWorkerCommitment_FullMethodName                   = "/emissions.v1.Query/GetWorkerCommitment"
 	Query_GetNetworkLossBundleAtBlock_FullMethodName           = "/emissions.v1.Query/GetNetworkLossBundleAtBlock"
 	Query_GetTotalStake_FullMethodName                         = "/emissions.v1.Query/GetTotalStake"
 	Query_GetReputerStakeInTopic_FullMethodName                = "/emissions.v1.Query/GetReputerStakeInTopic"
@@ -33,6 +35,14 @@ const (
 	Query_GetDelegateStakeInTopicInReputer_FullMethodName      = "/emissions.v1.Query/GetDelegateStakeInTopicInReputer"
 	Query_GetStakeFromDelegatorInTopicInReputer_FullMethodName = "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer"
 	Query_GetStakeFromDelegatorInTopic_FullMethodName          = "/emissions.v1.Query/GetStakeFromDelegatorInTopic"
+	Query_GetDelegateStakePendingReward_FullMethodName         = "/emissions.v1.Query/GetDelegateStakePendingReward"
+	Query_GetDelegatorPendingRewards_FullMethodName            = "/emissions.v1.Query/GetDelegatorPendingRewards"
+	Query_GetReputerCommission_FullMethodName                  = "/emissions.v1.Query/GetReputerCommission"
+	Query_GetRedelegationsFromReputer_FullMethodName           = "/emissions.v1.Query/GetRedelegationsFromReputer"
+	Query_GetReputerStakePool_FullMethodName                   = "/emissions.v1.Query/GetReputerStakePool"
+	Query_GetTopicRewardReport_FullMethodName                  = "/emissions.v1.Query/GetTopicRewardReport"
+	Query_SimulateTopicRewards_FullMethodName                  = "/emissions.v1.Query/SimulateTopicRewards"
+	Query_GetClaimableRewards_FullMethodName                   = "/emissions.v1.Query/GetClaimableRewards"
 	Query_GetTopicStake_FullMethodName                         = "/emissions.v1.Query/GetTopicStake"
 	Query_GetWorkerNodeInfo_FullMethodName                     = "/emissions.v1.Query/GetWorkerNodeInfo"
 	Query_GetReputerNodeInfo_FullMethodName                    = "/emissions.v1.Query/GetReputerNodeInfo"
@@ -40,8 +50,14 @@ const (
 	Query_GetReputerAddressByP2PKey_FullMethodName             = "/emissions.v1.Query/GetReputerAddressByP2PKey"
 	Query_IsWorkerRegisteredInTopicId_FullMethodName           = "/emissions.v1.Query/IsWorkerRegisteredInTopicId"
 	Query_IsReputerRegisteredInTopicId_FullMethodName          = "/emissions.v1.Query/IsReputerRegisteredInTopicId"
+	Query_GetWorkerLiveness_FullMethodName                     = "/emissions.v1.Query/GetWorkerLiveness"
+	Query_GetReputerLiveness_FullMethodName                    = "/emissions.v1.Query/GetReputerLiveness"
 	Query_GetNetworkInferencesAtBlock_FullMethodName           = "/emissions.v1.Query/GetNetworkInferencesAtBlock"
 	Query_IsWhitelistAdmin_FullMethodName                      = "/emissions.v1.Query/IsWhitelistAdmin"
+	Query_GetTopicAllowlist_FullMethodName                     = "/emissions.v1.Query/GetTopicAllowlist"
+	Query_GetTopicCreatorAllowlist_FullMethodName              = "/emissions.v1.Query/GetTopicCreatorAllowlist"
+	Query_GetScheduledParamsUpdates_FullMethodName             = "/emissions.v1.Query/GetScheduledParamsUpdates"
+	Query_GetStateRetention_FullMethodName                     = "/emissions.v1.Query/GetStateRetention"
 )
 
 // QueryClient is the client API for Query service.
@@ -52,10 +68,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error)
 	GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error)
+	GetTopicParams(ctx context.Context, in *QueryTopicParamsRequest, opts ...grpc.CallOption) (*QueryTopicParamsResponse, error)
 	GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error)
 	GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error)
 	GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error)
 	GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error)
+	GetWorkerCommitment(ctx context.Context, in *QueryWorkerCommitmentRequest, opts ...grpc.CallOption) (*QueryWorkerCommitmentResponse, error)
 	GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error)
 	GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error)
 	GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error)
@@ -63,6 +81,15 @@ type QueryClient interface {
 	GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error)
 	GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
 	GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error)
+	GetDelegateStakePendingReward(ctx context.Context, in *QueryDelegateStakePendingRewardRequest, opts ...grpc.CallOption) (*QueryDelegateStakePendingRewardResponse, error)
+	GetDelegatorPendingRewards(ctx context.Context, in *QueryDelegatorPendingRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingRewardsResponse, error)
+	GetReputerCommission(ctx context.Context, in *QueryReputerCommissionRequest, opts ...grpc.CallOption) (*QueryReputerCommissionResponse, error)
+	GetRedelegationsFromReputer(ctx context.Context, in *QueryRedelegationsFromReputerRequest, opts ...grpc.CallOption) (*QueryRedelegationsFromReputerResponse, error)
+	GetReputerStakePool(ctx context.Context, in *QueryReputerStakePoolRequest, opts ...grpc.CallOption) (*QueryReputerStakePoolResponse, error)
+	GetTopicRewardReport(ctx context.Context, in *QueryTopicRewardReportRequest, opts ...grpc.CallOption) (*QueryTopicRewardReportResponse, error)
+	// Runs the reward pipeline of a topic for a reward nonce without writing state
+	SimulateTopicRewards(ctx context.Context, in *QuerySimulateTopicRewardsRequest, opts ...grpc.CallOption) (*QuerySimulateTopicRewardsResponse, error)
+	GetClaimableRewards(ctx context.Context, in *QueryClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryClaimableRewardsResponse, error)
 	GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error)
 	GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error)
 	GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error)
@@ -70,8 +97,15 @@ type QueryClient interface {
 	GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error)
 	IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
 	IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error)
+	GetWorkerLiveness(ctx context.Context, in *QueryWorkerLivenessRequest, opts ...grpc.CallOption) (*QueryWorkerLivenessResponse, error)
+	GetReputerLiveness(ctx context.Context, in *QueryReputerLivenessRequest, opts ...grpc.CallOption) (*QueryReputerLivenessResponse, error)
 	GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error)
 	IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error)
+	GetTopicAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error)
+	GetTopicCreatorAllowlist(ctx context.Context, in *QueryTopicCreatorAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicCreatorAllowlistResponse, error)
+	GetScheduledParamsUpdates(ctx context.Context, in *QueryScheduledParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsUpdatesResponse, error)
+	// Walks every pruned collection to measure it, so it is expensive on a large state
+	GetStateRetention(ctx context.Context, in *QueryStateRetentionRequest, opts ...grpc.CallOption) (*QueryStateRetentionResponse, error)
 }
 
 type queryClient struct {
@@ -109,6 +143,15 @@ func (c *queryClient) GetTopic(ctx context.Context, in *QueryTopicRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) GetTopicParams(ctx context.Context, in *QueryTopicParamsRequest, opts ...grpc.CallOption) (*QueryTopicParamsResponse, error) {
+	out := new(QueryTopicParamsResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error) {
 	out := new(QueryActiveTopicsResponse)
 	err := c.cc.Invoke(ctx, Query_GetActiveTopics_FullMethodName, in, out, opts...)
@@ -145,6 +188,15 @@ func (c *queryClient) GetForecastsAtBlock(ctx context.Context, in *QueryForecast
 	return out, nil
 }
 
+func (c *queryClient) GetWorkerCommitment(ctx context.Context, in *QueryWorkerCommitmentRequest, opts ...grpc.CallOption) (*QueryWorkerCommitmentResponse, error) {
+	out := new(QueryWorkerCommitmentResponse)
+	err := c.cc.Invoke(ctx, Query_GetWorkerCommitment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error) {
 	out := new(QueryNetworkLossBundleAtBlockResponse)
 	err := c.cc.Invoke(ctx, Query_GetNetworkLossBundleAtBlock_FullMethodName, in, out, opts...)
@@ -208,6 +260,78 @@ func (c *queryClient) GetStakeFromDelegatorInTopic(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryClient) GetDelegateStakePendingReward(ctx context.Context, in *QueryDelegateStakePendingRewardRequest, opts ...grpc.CallOption) (*QueryDelegateStakePendingRewardResponse, error) {
+	out := new(QueryDelegateStakePendingRewardResponse)
+	err := c.cc.Invoke(ctx, Query_GetDelegateStakePendingReward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDelegatorPendingRewards(ctx context.Context, in *QueryDelegatorPendingRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingRewardsResponse, error) {
+	out := new(QueryDelegatorPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_GetDelegatorPendingRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetReputerCommission(ctx context.Context, in *QueryReputerCommissionRequest, opts ...grpc.CallOption) (*QueryReputerCommissionResponse, error) {
+	out := new(QueryReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, Query_GetReputerCommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRedelegationsFromReputer(ctx context.Context, in *QueryRedelegationsFromReputerRequest, opts ...grpc.CallOption) (*QueryRedelegationsFromReputerResponse, error) {
+	out := new(QueryRedelegationsFromReputerResponse)
+	err := c.cc.Invoke(ctx, Query_GetRedelegationsFromReputer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetReputerStakePool(ctx context.Context, in *QueryReputerStakePoolRequest, opts ...grpc.CallOption) (*QueryReputerStakePoolResponse, error) {
+	out := new(QueryReputerStakePoolResponse)
+	err := c.cc.Invoke(ctx, Query_GetReputerStakePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicRewardReport(ctx context.Context, in *QueryTopicRewardReportRequest, opts ...grpc.CallOption) (*QueryTopicRewardReportResponse, error) {
+	out := new(QueryTopicRewardReportResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicRewardReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateTopicRewards(ctx context.Context, in *QuerySimulateTopicRewardsRequest, opts ...grpc.CallOption) (*QuerySimulateTopicRewardsResponse, error) {
+	out := new(QuerySimulateTopicRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateTopicRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetClaimableRewards(ctx context.Context, in *QueryClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryClaimableRewardsResponse, error) {
+	out := new(QueryClaimableRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_GetClaimableRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error) {
 	out := new(QueryTopicStakeResponse)
 	err := c.cc.Invoke(ctx, Query_GetTopicStake_FullMethodName, in, out, opts...)
@@ -271,6 +395,24 @@ func (c *queryClient) IsReputerRegisteredInTopicId(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryClient) GetWorkerLiveness(ctx context.Context, in *QueryWorkerLivenessRequest, opts ...grpc.CallOption) (*QueryWorkerLivenessResponse, error) {
+	out := new(QueryWorkerLivenessResponse)
+	err := c.cc.Invoke(ctx, Query_GetWorkerLiveness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetReputerLiveness(ctx context.Context, in *QueryReputerLivenessRequest, opts ...grpc.CallOption) (*QueryReputerLivenessResponse, error) {
+	out := new(QueryReputerLivenessResponse)
+	err := c.cc.Invoke(ctx, Query_GetReputerLiveness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error) {
 	out := new(QueryNetworkInferencesAtBlockResponse)
 	err := c.cc.Invoke(ctx, Query_GetNetworkInferencesAtBlock_FullMethodName, in, out, opts...)
@@ -289,6 +431,42 @@ func (c *queryClient) IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelist
 	return out, nil
 }
 
+func (c *queryClient) GetTopicAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error) {
+	out := new(QueryTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicCreatorAllowlist(ctx context.Context, in *QueryTopicCreatorAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicCreatorAllowlistResponse, error) {
+	out := new(QueryTopicCreatorAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicCreatorAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetScheduledParamsUpdates(ctx context.Context, in *QueryScheduledParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsUpdatesResponse, error) {
+	out := new(QueryScheduledParamsUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_GetScheduledParamsUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStateRetention(ctx context.Context, in *QueryStateRetentionRequest, opts ...grpc.CallOption) (*QueryStateRetentionResponse, error) {
+	out := new(QueryStateRetentionResponse)
+	err := c.cc.Invoke(ctx, Query_GetStateRetention_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -297,10 +475,12 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	GetNextTopicId(context.Context, *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error)
 	GetTopic(context.Context, *QueryTopicRequest) (*QueryTopicResponse, error)
+	GetTopicParams(context.Context, *QueryTopicParamsRequest) (*QueryTopicParamsResponse, error)
 	GetActiveTopics(context.Context, *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error)
 	GetWorkerLatestInferenceByTopicId(context.Context, *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error)
 	GetInferencesAtBlock(context.Context, *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error)
 	GetForecastsAtBlock(context.Context, *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error)
+	GetWorkerCommitment(context.Context, *QueryWorkerCommitmentRequest) (*QueryWorkerCommitmentResponse, error)
 	GetNetworkLossBundleAtBlock(context.Context, *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error)
 	GetTotalStake(context.Context, *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error)
 	GetReputerStakeInTopic(context.Context, *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error)
@@ -308,6 +488,15 @@ type QueryServer interface {
 	GetDelegateStakeInTopicInReputer(context.Context, *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error)
 	GetStakeFromDelegatorInTopicInReputer(context.Context, *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
 	GetStakeFromDelegatorInTopic(context.Context, *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error)
+	GetDelegateStakePendingReward(context.Context, *QueryDelegateStakePendingRewardRequest) (*QueryDelegateStakePendingRewardResponse, error)
+	GetDelegatorPendingRewards(context.Context, *QueryDelegatorPendingRewardsRequest) (*QueryDelegatorPendingRewardsResponse, error)
+	GetReputerCommission(context.Context, *QueryReputerCommissionRequest) (*QueryReputerCommissionResponse, error)
+	GetRedelegationsFromReputer(context.Context, *QueryRedelegationsFromReputerRequest) (*QueryRedelegationsFromReputerResponse, error)
+	GetReputerStakePool(context.Context, *QueryReputerStakePoolRequest) (*QueryReputerStakePoolResponse, error)
+	GetTopicRewardReport(context.Context, *QueryTopicRewardReportRequest) (*QueryTopicRewardReportResponse, error)
+	// Runs the reward pipeline of a topic for a reward nonce without writing state
+	SimulateTopicRewards(context.Context, *QuerySimulateTopicRewardsRequest) (*QuerySimulateTopicRewardsResponse, error)
+	GetClaimableRewards(context.Context, *QueryClaimableRewardsRequest) (*QueryClaimableRewardsResponse, error)
 	GetTopicStake(context.Context, *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error)
 	GetWorkerNodeInfo(context.Context, *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error)
 	GetReputerNodeInfo(context.Context, *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error)
@@ -315,8 +504,15 @@ type QueryServer interface {
 	GetReputerAddressByP2PKey(context.Context, *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error)
 	IsWorkerRegisteredInTopicId(context.Context, *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
 	IsReputerRegisteredInTopicId(context.Context, *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error)
+	GetWorkerLiveness(context.Context, *QueryWorkerLivenessRequest) (*QueryWorkerLivenessResponse, error)
+	GetReputerLiveness(context.Context, *QueryReputerLivenessRequest) (*QueryReputerLivenessResponse, error)
 	GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error)
 	IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error)
+	GetTopicAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error)
+	GetTopicCreatorAllowlist(context.Context, *QueryTopicCreatorAllowlistRequest) (*QueryTopicCreatorAllowlistResponse, error)
+	GetScheduledParamsUpdates(context.Context, *QueryScheduledParamsUpdatesRequest) (*QueryScheduledParamsUpdatesResponse, error)
+	// Walks every pruned collection to measure it, so it is expensive on a large state
+	GetStateRetention(context.Context, *QueryStateRetentionRequest) (*QueryStateRetentionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -333,6 +529,9 @@ func (UnimplementedQueryServer) GetNextTopicId(context.Context, *QueryNextTopicI
 func (UnimplementedQueryServer) GetTopic(context.Context, *QueryTopicRequest) (*QueryTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
 }
+func (UnimplementedQueryServer) GetTopicParams(context.Context, *QueryTopicParamsRequest) (*QueryTopicParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicParams not implemented")
+}
 func (UnimplementedQueryServer) GetActiveTopics(context.Context, *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetActiveTopics not implemented")
 }
@@ -345,6 +544,9 @@ func (UnimplementedQueryServer) GetInferencesAtBlock(context.Context, *QueryInfe
 func (UnimplementedQueryServer) GetForecastsAtBlock(context.Context, *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetForecastsAtBlock not implemented")
 }
+func (UnimplementedQueryServer) GetWorkerCommitment(context.Context, *QueryWorkerCommitmentRequest) (*QueryWorkerCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerCommitment not implemented")
+}
 func (UnimplementedQueryServer) GetNetworkLossBundleAtBlock(context.Context, *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkLossBundleAtBlock not implemented")
 }
@@ -366,6 +568,30 @@ func (UnimplementedQueryServer) GetStakeFromDelegatorInTopicInReputer(context.Co
 func (UnimplementedQueryServer) GetStakeFromDelegatorInTopic(context.Context, *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopic not implemented")
 }
+func (UnimplementedQueryServer) GetDelegateStakePendingReward(context.Context, *QueryDelegateStakePendingRewardRequest) (*QueryDelegateStakePendingRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakePendingReward not implemented")
+}
+func (UnimplementedQueryServer) GetDelegatorPendingRewards(context.Context, *QueryDelegatorPendingRewardsRequest) (*QueryDelegatorPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorPendingRewards not implemented")
+}
+func (UnimplementedQueryServer) GetReputerCommission(context.Context, *QueryReputerCommissionRequest) (*QueryReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerCommission not implemented")
+}
+func (UnimplementedQueryServer) GetRedelegationsFromReputer(context.Context, *QueryRedelegationsFromReputerRequest) (*QueryRedelegationsFromReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedelegationsFromReputer not implemented")
+}
+func (UnimplementedQueryServer) GetReputerStakePool(context.Context, *QueryReputerStakePoolRequest) (*QueryReputerStakePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerStakePool not implemented")
+}
+func (UnimplementedQueryServer) GetTopicRewardReport(context.Context, *QueryTopicRewardReportRequest) (*QueryTopicRewardReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicRewardReport not implemented")
+}
+func (UnimplementedQueryServer) SimulateTopicRewards(context.Context, *QuerySimulateTopicRewardsRequest) (*QuerySimulateTopicRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTopicRewards not implemented")
+}
+func (UnimplementedQueryServer) GetClaimableRewards(context.Context, *QueryClaimableRewardsRequest) (*QueryClaimableRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimableRewards not implemented")
+}
 func (UnimplementedQueryServer) GetTopicStake(context.Context, *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicStake not implemented")
 }
@@ -387,12 +613,30 @@ func (UnimplementedQueryServer) IsWorkerRegisteredInTopicId(context.Context, *Qu
 func (UnimplementedQueryServer) IsReputerRegisteredInTopicId(context.Context, *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsReputerRegisteredInTopicId not implemented")
 }
+func (UnimplementedQueryServer) GetWorkerLiveness(context.Context, *QueryWorkerLivenessRequest) (*QueryWorkerLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLiveness not implemented")
+}
+func (UnimplementedQueryServer) GetReputerLiveness(context.Context, *QueryReputerLivenessRequest) (*QueryReputerLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerLiveness not implemented")
+}
 func (UnimplementedQueryServer) GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInferencesAtBlock not implemented")
 }
 func (UnimplementedQueryServer) IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistAdmin not implemented")
 }
+func (UnimplementedQueryServer) GetTopicAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicAllowlist not implemented")
+}
+func (UnimplementedQueryServer) GetTopicCreatorAllowlist(context.Context, *QueryTopicCreatorAllowlistRequest) (*QueryTopicCreatorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicCreatorAllowlist not implemented")
+}
+func (UnimplementedQueryServer) GetScheduledParamsUpdates(context.Context, *QueryScheduledParamsUpdatesRequest) (*QueryScheduledParamsUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledParamsUpdates not implemented")
+}
+func (UnimplementedQueryServer) GetStateRetention(context.Context, *QueryStateRetentionRequest) (*QueryStateRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateRetention not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -460,6 +704,24 @@ func _Query_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTopicParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicParams(ctx, req.(*QueryTopicParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetActiveTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryActiveTopicsRequest)
 	if err := dec(in); err != nil {
@@ -532,6 +794,24 @@ func _Query_GetForecastsAtBlock_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetWorkerCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetWorkerCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerCommitment(ctx, req.(*QueryWorkerCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetNetworkLossBundleAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNetworkLossBundleAtBlockRequest)
 	if err := dec(in); err != nil {
@@ -658,6 +938,150 @@ func _Query_GetStakeFromDelegatorInTopic_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetDelegateStakePendingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateStakePendingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateStakePendingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDelegateStakePendingReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateStakePendingReward(ctx, req.(*QueryDelegateStakePendingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDelegatorPendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegatorPendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDelegatorPendingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegatorPendingRewards(ctx, req.(*QueryDelegatorPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetReputerCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerCommission(ctx, req.(*QueryReputerCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRedelegationsFromReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationsFromReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRedelegationsFromReputer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRedelegationsFromReputer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRedelegationsFromReputer(ctx, req.(*QueryRedelegationsFromReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReputerStakePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerStakePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerStakePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetReputerStakePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerStakePool(ctx, req.(*QueryReputerStakePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicRewardReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicRewardReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicRewardReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicRewardReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicRewardReport(ctx, req.(*QueryTopicRewardReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateTopicRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTopicRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTopicRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateTopicRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTopicRewards(ctx, req.(*QuerySimulateTopicRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetClaimableRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetClaimableRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetClaimableRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetClaimableRewards(ctx, req.(*QueryClaimableRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetTopicStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTopicStakeRequest)
 	if err := dec(in); err != nil {
@@ -784,6 +1208,42 @@ func _Query_IsReputerRegisteredInTopicId_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetWorkerLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetWorkerLiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerLiveness(ctx, req.(*QueryWorkerLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReputerLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetReputerLiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerLiveness(ctx, req.(*QueryReputerLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetNetworkInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNetworkInferencesAtBlockRequest)
 	if err := dec(in); err != nil {
@@ -820,6 +1280,78 @@ func _Query_IsWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicAllowlist(ctx, req.(*QueryTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicCreatorAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicCreatorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicCreatorAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicCreatorAllowlist(ctx, req.(*QueryTopicCreatorAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetScheduledParamsUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetScheduledParamsUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetScheduledParamsUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetScheduledParamsUpdates(ctx, req.(*QueryScheduledParamsUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStateRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStateRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetStateRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStateRetention(ctx, req.(*QueryStateRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -839,6 +1371,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopic",
 			Handler:    _Query_GetTopic_Handler,
 		},
+		{
+			MethodName: "GetTopicParams",
+			Handler:    _Query_GetTopicParams_Handler,
+		},
 		{
 			MethodName: "GetActiveTopics",
 			Handler:    _Query_GetActiveTopics_Handler,
@@ -855,6 +1391,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetForecastsAtBlock",
 			Handler:    _Query_GetForecastsAtBlock_Handler,
 		},
+		{
+			MethodName: "GetWorkerCommitment",
+			Handler:    _Query_GetWorkerCommitment_Handler,
+		},
 		{
 			MethodName: "GetNetworkLossBundleAtBlock",
 			Handler:    _Query_GetNetworkLossBundleAtBlock_Handler,
@@ -883,6 +1423,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStakeFromDelegatorInTopic",
 			Handler:    _Query_GetStakeFromDelegatorInTopic_Handler,
 		},
+		{
+			MethodName: "GetDelegateStakePendingReward",
+			Handler:    _Query_GetDelegateStakePendingReward_Handler,
+		},
+		{
+			MethodName: "GetDelegatorPendingRewards",
+			Handler:    _Query_GetDelegatorPendingRewards_Handler,
+		},
+		{
+			MethodName: "GetReputerCommission",
+			Handler:    _Query_GetReputerCommission_Handler,
+		},
+		{
+			MethodName: "GetRedelegationsFromReputer",
+			Handler:    _Query_GetRedelegationsFromReputer_Handler,
+		},
+		{
+			MethodName: "GetReputerStakePool",
+			Handler:    _Query_GetReputerStakePool_Handler,
+		},
+		{
+			MethodName: "GetTopicRewardReport",
+			Handler:    _Query_GetTopicRewardReport_Handler,
+		},
+		{
+			MethodName: "SimulateTopicRewards",
+			Handler:    _Query_SimulateTopicRewards_Handler,
+		},
+		{
+			MethodName: "GetClaimableRewards",
+			Handler:    _Query_GetClaimableRewards_Handler,
+		},
 		{
 			MethodName: "GetTopicStake",
 			Handler:    _Query_GetTopicStake_Handler,
@@ -911,6 +1483,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsReputerRegisteredInTopicId",
 			Handler:    _Query_IsReputerRegisteredInTopicId_Handler,
 		},
+		{
+			MethodName: "GetWorkerLiveness",
+			Handler:    _Query_GetWorkerLiveness_Handler,
+		},
+		{
+			MethodName: "GetReputerLiveness",
+			Handler:    _Query_GetReputerLiveness_Handler,
+		},
 		{
 			MethodName: "GetNetworkInferencesAtBlock",
 			Handler:    _Query_GetNetworkInferencesAtBlock_Handler,
@@ -919,6 +1499,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsWhitelistAdmin",
 			Handler:    _Query_IsWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "GetTopicAllowlist",
+			Handler:    _Query_GetTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "GetTopicCreatorAllowlist",
+			Handler:    _Query_GetTopicCreatorAllowlist_Handler,
+		},
+		{
+			MethodName: "GetScheduledParamsUpdates",
+			Handler:    _Query_GetScheduledParamsUpdates_Handler,
+		},
+		{
+			MethodName: "GetStateRetention",
+			Handler:    _Query_GetStateRetention_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v1/query.proto",
@@ -19,22 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName               = "/emissions.v1.Msg/UpdateParams"
-	Msg_InsertBulkWorkerPayload_FullMethodName    = "/emissions.v1.Msg/InsertBulkWorkerPayload"
-	Msg_CreateNewTopic_FullMethodName             = "/emissions.v1.Msg/CreateNewTopic"
-	Msg_Register_FullMethodName                   = "/emissions.v1.Msg/Register"
-	Msg_RemoveRegistration_FullMethodName         = "/emissions.v1.Msg/RemoveRegistration"
-	Msg_InsertBulkReputerPayload_FullMethodName   = "/emissions.v1.Msg/InsertBulkReputerPayload"
-	Msg_AddStake_FullMethodName                   = "/emissions.v1.Msg/AddStake"
-	Msg_StartRemoveStake_FullMethodName           = "/emissions.v1.Msg/StartRemoveStake"
-	Msg_ConfirmRemoveStake_FullMethodName         = "/emissions.v1.Msg/ConfirmRemoveStake"
-	Msg_DelegateStake_FullMethodName              = "/emissions.v1.Msg/DelegateStake"
-	Msg_RewardDelegateStake_FullMethodName        = "/emissions.v1.Msg/RewardDelegateStake"
-	Msg_StartRemoveDelegateStake_FullMethodName   = "/emissions.v1.Msg/StartRemoveDelegateStake"
-	Msg_ConfirmRemoveDelegateStake_FullMethodName = "/emissions.v1.Msg/ConfirmRemoveDelegateStake"
-	Msg_FundTopic_FullMethodName                  = "/emissions.v1.Msg/FundTopic"
-	Msg_AddToWhitelistAdmin_FullMethodName        = "/emissions.v1.Msg/AddToWhitelistAdmin"
-	Msg_RemoveFromWhitelistAdmin_FullMethodName   = "/emissions.v1.Msg/RemoveFromWhitelistAdmin"
+	Msg_UpdateParams_FullMethodName                          = "/emissions.v1.Msg/UpdateParams"
+	Msg_InsertBulkWorkerPayload_FullMethodName               = "/emissions.v1.Msg/InsertBulkWorkerPayload"
+	Msg_CommitWorkerPayload_FullMethodName                   = "/emissions.v1.Msg/CommitWorkerPayload"
+	Msg_InsertWorkerPayload_FullMethodName                   = "/emissions.v1.Msg/InsertWorkerPayload"
+	Msg_InsertReputerPayload_FullMethodName                  = "/emissions.v1.Msg/InsertReputerPayload"
+	Msg_CreateNewTopic_FullMethodName                        = "/emissions.v1.Msg/CreateNewTopic"
+	Msg_UpdateTopicParams_FullMethodName                     = "/emissions.v1.Msg/UpdateTopicParams"
+	Msg_Register_FullMethodName                              = "/emissions.v1.Msg/Register"
+	Msg_RemoveRegistration_FullMethodName                    = "/emissions.v1.Msg/RemoveRegistration"
+	Msg_Unjail_FullMethodName                                = "/emissions.v1.Msg/Unjail"
+	Msg_InsertBulkReputerPayload_FullMethodName              = "/emissions.v1.Msg/InsertBulkReputerPayload"
+	Msg_AddStake_FullMethodName                              = "/emissions.v1.Msg/AddStake"
+	Msg_StartRemoveStake_FullMethodName                      = "/emissions.v1.Msg/StartRemoveStake"
+	Msg_ConfirmRemoveStake_FullMethodName                    = "/emissions.v1.Msg/ConfirmRemoveStake"
+	Msg_DelegateStake_FullMethodName                         = "/emissions.v1.Msg/DelegateStake"
+	Msg_RewardDelegateStake_FullMethodName                   = "/emissions.v1.Msg/RewardDelegateStake"
+	Msg_SetDelegatorAutoCompound_FullMethodName              = "/emissions.v1.Msg/SetDelegatorAutoCompound"
+	Msg_SetReputerCommission_FullMethodName                  = "/emissions.v1.Msg/SetReputerCommission"
+	Msg_StartRemoveDelegateStake_FullMethodName              = "/emissions.v1.Msg/StartRemoveDelegateStake"
+	Msg_ConfirmRemoveDelegateStake_FullMethodName            = "/emissions.v1.Msg/ConfirmRemoveDelegateStake"
+	Msg_RedelegateStake_FullMethodName                       = "/emissions.v1.Msg/RedelegateStake"
+	Msg_AddReputerStakePool_FullMethodName                   = "/emissions.v1.Msg/AddReputerStakePool"
+	Msg_StartRemoveReputerStakePool_FullMethodName           = "/emissions.v1.Msg/StartRemoveReputerStakePool"
+	Msg_ConfirmRemoveReputerStakePool_FullMethodName         = "/emissions.v1.Msg/ConfirmRemoveReputerStakePool"
+	Msg_SetReputerStakeAllocation_FullMethodName             = "/emissions.v1.Msg/SetReputerStakeAllocation"
+	Msg_ConfirmReputerStakeAllocationDecrease_FullMethodName = "/emissions.v1.Msg/ConfirmReputerStakeAllocationDecrease"
+	Msg_ClaimRewards_FullMethodName                          = "/emissions.v1.Msg/ClaimRewards"
+	Msg_SetRewardWithdrawAddress_FullMethodName              = "/emissions.v1.Msg/SetRewardWithdrawAddress"
+	Msg_FundTopic_FullMethodName                             = "/emissions.v1.Msg/FundTopic"
+	Msg_AddToWhitelistAdmin_FullMethodName                   = "/emissions.v1.Msg/AddToWhitelistAdmin"
+	Msg_RemoveFromWhitelistAdmin_FullMethodName              = "/emissions.v1.Msg/RemoveFromWhitelistAdmin"
+	Msg_AddToTopicAllowlist_FullMethodName                   = "/emissions.v1.Msg/AddToTopicAllowlist"
+	Msg_RemoveFromTopicAllowlist_FullMethodName              = "/emissions.v1.Msg/RemoveFromTopicAllowlist"
+	Msg_AddToTopicCreatorAllowlist_FullMethodName            = "/emissions.v1.Msg/AddToTopicCreatorAllowlist"
+	Msg_RemoveFromTopicCreatorAllowlist_FullMethodName       = "/emissions.v1.Msg/RemoveFromTopicCreatorAllowlist"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,20 +62,39 @@ const (
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error)
+	CommitWorkerPayload(ctx context.Context, in *MsgCommitWorkerPayload, opts ...grpc.CallOption) (*MsgCommitWorkerPayloadResponse, error)
+	InsertWorkerPayload(ctx context.Context, in *MsgInsertWorkerPayload, opts ...grpc.CallOption) (*MsgInsertWorkerPayloadResponse, error)
+	InsertReputerPayload(ctx context.Context, in *MsgInsertReputerPayload, opts ...grpc.CallOption) (*MsgInsertReputerPayloadResponse, error)
 	CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error)
+	UpdateTopicParams(ctx context.Context, in *MsgUpdateTopicParams, opts ...grpc.CallOption) (*MsgUpdateTopicParamsResponse, error)
 	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
 	RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error)
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
 	InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error)
 	AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error)
 	StartRemoveStake(ctx context.Context, in *MsgStartRemoveStake, opts ...grpc.CallOption) (*MsgStartRemoveStakeResponse, error)
 	ConfirmRemoveStake(ctx context.Context, in *MsgConfirmRemoveStake, opts ...grpc.CallOption) (*MsgConfirmRemoveStakeResponse, error)
 	DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error)
 	RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error)
+	SetDelegatorAutoCompound(ctx context.Context, in *MsgSetDelegatorAutoCompound, opts ...grpc.CallOption) (*MsgSetDelegatorAutoCompoundResponse, error)
+	SetReputerCommission(ctx context.Context, in *MsgSetReputerCommission, opts ...grpc.CallOption) (*MsgSetReputerCommissionResponse, error)
 	StartRemoveDelegateStake(ctx context.Context, in *MsgStartRemoveDelegateStake, opts ...grpc.CallOption) (*MsgStartRemoveDelegateStakeResponse, error)
 	ConfirmRemoveDelegateStake(ctx context.Context, in *MsgConfirmDelegateRemoveStake, opts ...grpc.CallOption) (*MsgConfirmRemoveDelegateStakeResponse, error)
+	RedelegateStake(ctx context.Context, in *MsgRedelegateStake, opts ...grpc.CallOption) (*MsgRedelegateStakeResponse, error)
+	AddReputerStakePool(ctx context.Context, in *MsgAddReputerStakePool, opts ...grpc.CallOption) (*MsgAddReputerStakePoolResponse, error)
+	StartRemoveReputerStakePool(ctx context.Context, in *MsgStartRemoveReputerStakePool, opts ...grpc.CallOption) (*MsgStartRemoveReputerStakePoolResponse, error)
+	ConfirmRemoveReputerStakePool(ctx context.Context, in *MsgConfirmRemoveReputerStakePool, opts ...grpc.CallOption) (*MsgConfirmRemoveReputerStakePoolResponse, error)
+	SetReputerStakeAllocation(ctx context.Context, in *MsgSetReputerStakeAllocation, opts ...grpc.CallOption) (*MsgSetReputerStakeAllocationResponse, error)
+	ConfirmReputerStakeAllocationDecrease(ctx context.Context, in *MsgConfirmReputerStakeAllocationDecrease, opts ...grpc.CallOption) (*MsgConfirmReputerStakeAllocationDecreaseResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error)
 	FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error)
 	AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error)
+	AddToTopicAllowlist(ctx context.Context, in *MsgAddToTopicAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(ctx context.Context, in *MsgRemoveFromTopicAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicAllowlistResponse, error)
+	AddToTopicCreatorAllowlist(ctx context.Context, in *MsgAddToTopicCreatorAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicCreatorAllowlistResponse, error)
+	RemoveFromTopicCreatorAllowlist(ctx context.Context, in *MsgRemoveFromTopicCreatorAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicCreatorAllowlistResponse, error)
 }
 
 type msgClient struct {
@@ -85,6 +123,33 @@ func (c *msgClient) InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBu
 	return out, nil
 }
 
+func (c *msgClient) CommitWorkerPayload(ctx context.Context, in *MsgCommitWorkerPayload, opts ...grpc.CallOption) (*MsgCommitWorkerPayloadResponse, error) {
+	out := new(MsgCommitWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitWorkerPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InsertWorkerPayload(ctx context.Context, in *MsgInsertWorkerPayload, opts ...grpc.CallOption) (*MsgInsertWorkerPayloadResponse, error) {
+	out := new(MsgInsertWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, Msg_InsertWorkerPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InsertReputerPayload(ctx context.Context, in *MsgInsertReputerPayload, opts ...grpc.CallOption) (*MsgInsertReputerPayloadResponse, error) {
+	out := new(MsgInsertReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, Msg_InsertReputerPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error) {
 	out := new(MsgCreateNewTopicResponse)
 	err := c.cc.Invoke(ctx, Msg_CreateNewTopic_FullMethodName, in, out, opts...)
@@ -94,6 +159,15 @@ func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateTopicParams(ctx context.Context, in *MsgUpdateTopicParams, opts ...grpc.CallOption) (*MsgUpdateTopicParamsResponse, error) {
+	out := new(MsgUpdateTopicParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTopicParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error) {
 	out := new(MsgRegisterResponse)
 	err := c.cc.Invoke(ctx, Msg_Register_FullMethodName, in, out, opts...)
@@ -112,6 +186,15 @@ func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistr
 	return out, nil
 }
 
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, Msg_Unjail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error) {
 	out := new(MsgInsertBulkReputerPayloadResponse)
 	err := c.cc.Invoke(ctx, Msg_InsertBulkReputerPayload_FullMethodName, in, out, opts...)
@@ -166,6 +249,24 @@ func (c *msgClient) RewardDelegateStake(ctx context.Context, in *MsgRewardDelega
 	return out, nil
 }
 
+func (c *msgClient) SetDelegatorAutoCompound(ctx context.Context, in *MsgSetDelegatorAutoCompound, opts ...grpc.CallOption) (*MsgSetDelegatorAutoCompoundResponse, error) {
+	out := new(MsgSetDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDelegatorAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReputerCommission(ctx context.Context, in *MsgSetReputerCommission, opts ...grpc.CallOption) (*MsgSetReputerCommissionResponse, error) {
+	out := new(MsgSetReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetReputerCommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) StartRemoveDelegateStake(ctx context.Context, in *MsgStartRemoveDelegateStake, opts ...grpc.CallOption) (*MsgStartRemoveDelegateStakeResponse, error) {
 	out := new(MsgStartRemoveDelegateStakeResponse)
 	err := c.cc.Invoke(ctx, Msg_StartRemoveDelegateStake_FullMethodName, in, out, opts...)
@@ -184,6 +285,78 @@ func (c *msgClient) ConfirmRemoveDelegateStake(ctx context.Context, in *MsgConfi
 	return out, nil
 }
 
+func (c *msgClient) RedelegateStake(ctx context.Context, in *MsgRedelegateStake, opts ...grpc.CallOption) (*MsgRedelegateStakeResponse, error) {
+	out := new(MsgRedelegateStakeResponse)
+	err := c.cc.Invoke(ctx, Msg_RedelegateStake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddReputerStakePool(ctx context.Context, in *MsgAddReputerStakePool, opts ...grpc.CallOption) (*MsgAddReputerStakePoolResponse, error) {
+	out := new(MsgAddReputerStakePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_AddReputerStakePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StartRemoveReputerStakePool(ctx context.Context, in *MsgStartRemoveReputerStakePool, opts ...grpc.CallOption) (*MsgStartRemoveReputerStakePoolResponse, error) {
+	out := new(MsgStartRemoveReputerStakePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_StartRemoveReputerStakePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmRemoveReputerStakePool(ctx context.Context, in *MsgConfirmRemoveReputerStakePool, opts ...grpc.CallOption) (*MsgConfirmRemoveReputerStakePoolResponse, error) {
+	out := new(MsgConfirmRemoveReputerStakePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_ConfirmRemoveReputerStakePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReputerStakeAllocation(ctx context.Context, in *MsgSetReputerStakeAllocation, opts ...grpc.CallOption) (*MsgSetReputerStakeAllocationResponse, error) {
+	out := new(MsgSetReputerStakeAllocationResponse)
+	err := c.cc.Invoke(ctx, Msg_SetReputerStakeAllocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmReputerStakeAllocationDecrease(ctx context.Context, in *MsgConfirmReputerStakeAllocationDecrease, opts ...grpc.CallOption) (*MsgConfirmReputerStakeAllocationDecreaseResponse, error) {
+	out := new(MsgConfirmReputerStakeAllocationDecreaseResponse)
+	err := c.cc.Invoke(ctx, Msg_ConfirmReputerStakeAllocationDecrease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error) {
+	out := new(MsgSetRewardWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRewardWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error) {
 	out := new(MsgFundTopicResponse)
 	err := c.cc.Invoke(ctx, Msg_FundTopic_FullMethodName, in, out, opts...)
@@ -211,26 +384,81 @@ func (c *msgClient) RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveF
 	return out, nil
 }
 
+func (c *msgClient) AddToTopicAllowlist(ctx context.Context, in *MsgAddToTopicAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicAllowlistResponse, error) {
+	out := new(MsgAddToTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_AddToTopicAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromTopicAllowlist(ctx context.Context, in *MsgRemoveFromTopicAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicAllowlistResponse, error) {
+	out := new(MsgRemoveFromTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFromTopicAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToTopicCreatorAllowlist(ctx context.Context, in *MsgAddToTopicCreatorAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicCreatorAllowlistResponse, error) {
+	out := new(MsgAddToTopicCreatorAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_AddToTopicCreatorAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromTopicCreatorAllowlist(ctx context.Context, in *MsgRemoveFromTopicCreatorAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicCreatorAllowlistResponse, error) {
+	out := new(MsgRemoveFromTopicCreatorAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFromTopicCreatorAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error)
+	CommitWorkerPayload(context.Context, *MsgCommitWorkerPayload) (*MsgCommitWorkerPayloadResponse, error)
+	InsertWorkerPayload(context.Context, *MsgInsertWorkerPayload) (*MsgInsertWorkerPayloadResponse, error)
+	InsertReputerPayload(context.Context, *MsgInsertReputerPayload) (*MsgInsertReputerPayloadResponse, error)
 	CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error)
+	UpdateTopicParams(context.Context, *MsgUpdateTopicParams) (*MsgUpdateTopicParamsResponse, error)
 	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
 	RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error)
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
 	InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error)
 	AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error)
 	StartRemoveStake(context.Context, *MsgStartRemoveStake) (*MsgStartRemoveStakeResponse, error)
 	ConfirmRemoveStake(context.Context, *MsgConfirmRemoveStake) (*MsgConfirmRemoveStakeResponse, error)
 	DelegateStake(context.Context, *MsgDelegateStake) (*MsgDelegateStakeResponse, error)
 	RewardDelegateStake(context.Context, *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error)
+	SetDelegatorAutoCompound(context.Context, *MsgSetDelegatorAutoCompound) (*MsgSetDelegatorAutoCompoundResponse, error)
+	SetReputerCommission(context.Context, *MsgSetReputerCommission) (*MsgSetReputerCommissionResponse, error)
 	StartRemoveDelegateStake(context.Context, *MsgStartRemoveDelegateStake) (*MsgStartRemoveDelegateStakeResponse, error)
 	ConfirmRemoveDelegateStake(context.Context, *MsgConfirmDelegateRemoveStake) (*MsgConfirmRemoveDelegateStakeResponse, error)
+	RedelegateStake(context.Context, *MsgRedelegateStake) (*MsgRedelegateStakeResponse, error)
+	AddReputerStakePool(context.Context, *MsgAddReputerStakePool) (*MsgAddReputerStakePoolResponse, error)
+	StartRemoveReputerStakePool(context.Context, *MsgStartRemoveReputerStakePool) (*MsgStartRemoveReputerStakePoolResponse, error)
+	ConfirmRemoveReputerStakePool(context.Context, *MsgConfirmRemoveReputerStakePool) (*MsgConfirmRemoveReputerStakePoolResponse, error)
+	SetReputerStakeAllocation(context.Context, *MsgSetReputerStakeAllocation) (*MsgSetReputerStakeAllocationResponse, error)
+	ConfirmReputerStakeAllocationDecrease(context.Context, *MsgConfirmReputerStakeAllocationDecrease) (*MsgConfirmReputerStakeAllocationDecreaseResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	SetRewardWithdrawAddress(context.Context, *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error)
 	FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error)
 	AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error)
+	AddToTopicAllowlist(context.Context, *MsgAddToTopicAllowlist) (*MsgAddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(context.Context, *MsgRemoveFromTopicAllowlist) (*MsgRemoveFromTopicAllowlistResponse, error)
+	AddToTopicCreatorAllowlist(context.Context, *MsgAddToTopicCreatorAllowlist) (*MsgAddToTopicCreatorAllowlistResponse, error)
+	RemoveFromTopicCreatorAllowlist(context.Context, *MsgRemoveFromTopicCreatorAllowlist) (*MsgRemoveFromTopicCreatorAllowlistResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -244,15 +472,30 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkWorkerPayload not implemented")
 }
+func (UnimplementedMsgServer) CommitWorkerPayload(context.Context, *MsgCommitWorkerPayload) (*MsgCommitWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitWorkerPayload not implemented")
+}
+func (UnimplementedMsgServer) InsertWorkerPayload(context.Context, *MsgInsertWorkerPayload) (*MsgInsertWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
+}
+func (UnimplementedMsgServer) InsertReputerPayload(context.Context, *MsgInsertReputerPayload) (*MsgInsertReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
+}
 func (UnimplementedMsgServer) CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
 }
+func (UnimplementedMsgServer) UpdateTopicParams(context.Context, *MsgUpdateTopicParams) (*MsgUpdateTopicParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicParams not implemented")
+}
 func (UnimplementedMsgServer) Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
 func (UnimplementedMsgServer) RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
 }
+func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
 func (UnimplementedMsgServer) InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkReputerPayload not implemented")
 }
@@ -271,12 +514,42 @@ func (UnimplementedMsgServer) DelegateStake(context.Context, *MsgDelegateStake)
 func (UnimplementedMsgServer) RewardDelegateStake(context.Context, *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
 }
+func (UnimplementedMsgServer) SetDelegatorAutoCompound(context.Context, *MsgSetDelegatorAutoCompound) (*MsgSetDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegatorAutoCompound not implemented")
+}
+func (UnimplementedMsgServer) SetReputerCommission(context.Context, *MsgSetReputerCommission) (*MsgSetReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReputerCommission not implemented")
+}
 func (UnimplementedMsgServer) StartRemoveDelegateStake(context.Context, *MsgStartRemoveDelegateStake) (*MsgStartRemoveDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartRemoveDelegateStake not implemented")
 }
 func (UnimplementedMsgServer) ConfirmRemoveDelegateStake(context.Context, *MsgConfirmDelegateRemoveStake) (*MsgConfirmRemoveDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRemoveDelegateStake not implemented")
 }
+func (UnimplementedMsgServer) RedelegateStake(context.Context, *MsgRedelegateStake) (*MsgRedelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateStake not implemented")
+}
+func (UnimplementedMsgServer) AddReputerStakePool(context.Context, *MsgAddReputerStakePool) (*MsgAddReputerStakePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReputerStakePool not implemented")
+}
+func (UnimplementedMsgServer) StartRemoveReputerStakePool(context.Context, *MsgStartRemoveReputerStakePool) (*MsgStartRemoveReputerStakePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRemoveReputerStakePool not implemented")
+}
+func (UnimplementedMsgServer) ConfirmRemoveReputerStakePool(context.Context, *MsgConfirmRemoveReputerStakePool) (*MsgConfirmRemoveReputerStakePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRemoveReputerStakePool not implemented")
+}
+func (UnimplementedMsgServer) SetReputerStakeAllocation(context.Context, *MsgSetReputerStakeAllocation) (*MsgSetReputerStakeAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReputerStakeAllocation not implemented")
+}
+func (UnimplementedMsgServer) ConfirmReputerStakeAllocationDecrease(context.Context, *MsgConfirmReputerStakeAllocationDecrease) (*MsgConfirmReputerStakeAllocationDecreaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReputerStakeAllocationDecrease not implemented")
+}
+func (UnimplementedMsgServer) ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (UnimplementedMsgServer) SetRewardWithdrawAddress(context.Context, *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardWithdrawAddress not implemented")
+}
 func (UnimplementedMsgServer) FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
 }
@@ -286,6 +559,18 @@ func (UnimplementedMsgServer) AddToWhitelistAdmin(context.Context, *MsgAddToWhit
 func (UnimplementedMsgServer) RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
 }
+func (UnimplementedMsgServer) AddToTopicAllowlist(context.Context, *MsgAddToTopicAllowlist) (*MsgAddToTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicAllowlist not implemented")
+}
+func (UnimplementedMsgServer) RemoveFromTopicAllowlist(context.Context, *MsgRemoveFromTopicAllowlist) (*MsgRemoveFromTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicAllowlist not implemented")
+}
+func (UnimplementedMsgServer) AddToTopicCreatorAllowlist(context.Context, *MsgAddToTopicCreatorAllowlist) (*MsgAddToTopicCreatorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicCreatorAllowlist not implemented")
+}
+func (UnimplementedMsgServer) RemoveFromTopicCreatorAllowlist(context.Context, *MsgRemoveFromTopicCreatorAllowlist) (*MsgRemoveFromTopicCreatorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicCreatorAllowlist not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -335,6 +620,60 @@ func _Msg_InsertBulkWorkerPayload_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitWorkerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitWorkerPayload(ctx, req.(*MsgCommitWorkerPayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertWorkerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InsertWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InsertWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertWorkerPayload(ctx, req.(*MsgInsertWorkerPayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertReputerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InsertReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InsertReputerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertReputerPayload(ctx, req.(*MsgInsertReputerPayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateNewTopic)
 	if err := dec(in); err != nil {
@@ -353,6 +692,24 @@ func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTopicParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTopicParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicParams(ctx, req.(*MsgUpdateTopicParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRegister)
 	if err := dec(in); err != nil {
@@ -389,6 +746,24 @@ func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unjail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgInsertBulkReputerPayload)
 	if err := dec(in); err != nil {
@@ -497,6 +872,42 @@ func _Msg_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegatorAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDelegatorAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegatorAutoCompound(ctx, req.(*MsgSetDelegatorAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReputerCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetReputerCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReputerCommission(ctx, req.(*MsgSetReputerCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_StartRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgStartRemoveDelegateStake)
 	if err := dec(in); err != nil {
@@ -533,6 +944,150 @@ func _Msg_ConfirmRemoveDelegateStake_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RedelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedelegateStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateStake(ctx, req.(*MsgRedelegateStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddReputerStakePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddReputerStakePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddReputerStakePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddReputerStakePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddReputerStakePool(ctx, req.(*MsgAddReputerStakePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartRemoveReputerStakePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartRemoveReputerStakePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartRemoveReputerStakePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StartRemoveReputerStakePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartRemoveReputerStakePool(ctx, req.(*MsgStartRemoveReputerStakePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfirmRemoveReputerStakePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmRemoveReputerStakePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmRemoveReputerStakePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConfirmRemoveReputerStakePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmRemoveReputerStakePool(ctx, req.(*MsgConfirmRemoveReputerStakePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReputerStakeAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReputerStakeAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReputerStakeAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetReputerStakeAllocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReputerStakeAllocation(ctx, req.(*MsgSetReputerStakeAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfirmReputerStakeAllocationDecrease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmReputerStakeAllocationDecrease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmReputerStakeAllocationDecrease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConfirmReputerStakeAllocationDecrease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmReputerStakeAllocationDecrease(ctx, req.(*MsgConfirmReputerStakeAllocationDecrease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRewardWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, req.(*MsgSetRewardWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgFundTopic)
 	if err := dec(in); err != nil {
@@ -587,6 +1142,78 @@ func _Msg_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddToTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToTopicAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddToTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToTopicAllowlist(ctx, req.(*MsgAddToTopicAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromTopicAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFromTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromTopicAllowlist(ctx, req.(*MsgRemoveFromTopicAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToTopicCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToTopicCreatorAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToTopicCreatorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddToTopicCreatorAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToTopicCreatorAllowlist(ctx, req.(*MsgAddToTopicCreatorAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromTopicCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromTopicCreatorAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromTopicCreatorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFromTopicCreatorAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromTopicCreatorAllowlist(ctx, req.(*MsgRemoveFromTopicCreatorAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -602,10 +1229,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InsertBulkWorkerPayload",
 			Handler:    _Msg_InsertBulkWorkerPayload_Handler,
 		},
+		{
+			MethodName: "CommitWorkerPayload",
+			Handler:    _Msg_CommitWorkerPayload_Handler,
+		},
+		{
+			MethodName: "InsertWorkerPayload",
+			Handler:    _Msg_InsertWorkerPayload_Handler,
+		},
+		{
+			MethodName: "InsertReputerPayload",
+			Handler:    _Msg_InsertReputerPayload_Handler,
+		},
 		{
 			MethodName: "CreateNewTopic",
 			Handler:    _Msg_CreateNewTopic_Handler,
 		},
+		{
+			MethodName: "UpdateTopicParams",
+			Handler:    _Msg_UpdateTopicParams_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _Msg_Register_Handler,
@@ -614,6 +1257,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRegistration",
 			Handler:    _Msg_RemoveRegistration_Handler,
 		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
 		{
 			MethodName: "InsertBulkReputerPayload",
 			Handler:    _Msg_InsertBulkReputerPayload_Handler,
@@ -638,6 +1285,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RewardDelegateStake",
 			Handler:    _Msg_RewardDelegateStake_Handler,
 		},
+		{
+			MethodName: "SetDelegatorAutoCompound",
+			Handler:    _Msg_SetDelegatorAutoCompound_Handler,
+		},
+		{
+			MethodName: "SetReputerCommission",
+			Handler:    _Msg_SetReputerCommission_Handler,
+		},
 		{
 			MethodName: "StartRemoveDelegateStake",
 			Handler:    _Msg_StartRemoveDelegateStake_Handler,
@@ -646,6 +1301,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmRemoveDelegateStake",
 			Handler:    _Msg_ConfirmRemoveDelegateStake_Handler,
 		},
+		{
+			MethodName: "RedelegateStake",
+			Handler:    _Msg_RedelegateStake_Handler,
+		},
+		{
+			MethodName: "AddReputerStakePool",
+			Handler:    _Msg_AddReputerStakePool_Handler,
+		},
+		{
+			MethodName: "StartRemoveReputerStakePool",
+			Handler:    _Msg_StartRemoveReputerStakePool_Handler,
+		},
+		{
+			MethodName: "ConfirmRemoveReputerStakePool",
+			Handler:    _Msg_ConfirmRemoveReputerStakePool_Handler,
+		},
+		{
+			MethodName: "SetReputerStakeAllocation",
+			Handler:    _Msg_SetReputerStakeAllocation_Handler,
+		},
+		{
+			MethodName: "ConfirmReputerStakeAllocationDecrease",
+			Handler:    _Msg_ConfirmReputerStakeAllocationDecrease_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "SetRewardWithdrawAddress",
+			Handler:    _Msg_SetRewardWithdrawAddress_Handler,
+		},
 		{
 			MethodName: "FundTopic",
 			Handler:    _Msg_FundTopic_Handler,
@@ -658,6 +1345,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFromWhitelistAdmin",
 			Handler:    _Msg_RemoveFromWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "AddToTopicAllowlist",
+			Handler:    _Msg_AddToTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicAllowlist",
+			Handler:    _Msg_RemoveFromTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "AddToTopicCreatorAllowlist",
+			Handler:    _Msg_AddToTopicCreatorAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicCreatorAllowlist",
+			Handler:    _Msg_RemoveFromTopicCreatorAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v1/tx.proto",
@@ -0,0 +1,116 @@
+// Package archiver keeps the inferences, forecasts and losses of the emissions module off-chain once they are
+// pruned from the state. A node streams the changes committed to the emissions store to a Listener, which appends
+// the records of these collections to a rotated newline-delimited JSON archive, read back with Read.
+package archiver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// A record of the archive, a line of its files
+type Record struct {
+	// Height of the block the record was committed in
+	Height     int64  `json:"height"`
+	Collection string `json:"collection"`
+	TopicId    uint64 `json:"topic_id"`
+	// Block height of the nonce the record is for
+	BlockHeight int64 `json:"block_height"`
+	// The value of the record in the store as JSON, such as types.Inferences for the inferences
+	Value json.RawMessage `json:"value"`
+}
+
+type archivedCollection struct {
+	name     string
+	prefix   collections.Prefix
+	newValue func() proto.Message
+}
+
+// Collections keyed by (topic, block_height) that are archived, named as in the state retention query
+var archivedCollections = []archivedCollection{
+	{types.PrunedCollectionInferences, types.AllInferencesKey, func() proto.Message { return &types.Inferences{} }},
+	{types.PrunedCollectionForecasts, types.AllForecastsKey, func() proto.Message { return &types.Forecasts{} }},
+	{types.PrunedCollectionLossBundles, types.AllLossBundlesKey, func() proto.Message { return &types.ReputerValueBundles{} }},
+	{types.PrunedCollectionNetworkLossBundles, types.NetworkLossBundlesKey, func() proto.Message { return &types.ValueBundle{} }},
+}
+
+var archivedKeyCodec = collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key)
+
+// Listener archives the records set in the emissions store at each commit. The store must be listened to by
+// the commit multistore for its changes to reach the listener. Removed records are not archived, so that the
+// archive keeps what the pruning removes from the state.
+type Listener struct {
+	cdc    codec.Codec
+	writer *Writer
+}
+
+var _ storetypes.ABCIListener = &Listener{}
+
+func NewListener(cdc codec.Codec, writer *Writer) *Listener {
+	return &Listener{cdc: cdc, writer: writer}
+}
+
+func (l *Listener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+func (l *Listener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	records, err := l.records(height, changeSet)
+	if err != nil {
+		return err
+	}
+	return l.writer.WriteBlock(height, records)
+}
+
+func (l *Listener) records(height int64, changeSet []*storetypes.StoreKVPair) ([]Record, error) {
+	records := make([]Record, 0)
+	for _, pair := range changeSet {
+		if pair.StoreKey != types.StoreKey || pair.Delete {
+			continue
+		}
+		c, ok := findArchivedCollection(pair.Key)
+		if !ok {
+			continue
+		}
+		_, key, err := archivedKeyCodec.Decode(pair.Key[len(c.prefix):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key of %s: %w", c.name, err)
+		}
+		value := c.newValue()
+		if err := l.cdc.Unmarshal(pair.Value, value); err != nil {
+			return nil, fmt.Errorf("failed to decode %s of topic %d at %d: %w", c.name, key.K1(), key.K2(), err)
+		}
+		valueJSON, err := l.cdc.MarshalJSON(value)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, Record{
+			Height:      height,
+			Collection:  c.name,
+			TopicId:     key.K1(),
+			BlockHeight: key.K2(),
+			Value:       valueJSON,
+		})
+	}
+	return records, nil
+}
+
+func findArchivedCollection(key []byte) (archivedCollection, bool) {
+	for _, c := range archivedCollections {
+		if bytes.HasPrefix(key, c.prefix) {
+			return c, true
+		}
+	}
+	return archivedCollection{}, false
+}
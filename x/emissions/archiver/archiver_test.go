@@ -0,0 +1,177 @@
+package archiver_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/archiver"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+const worker = "allo1worker"
+
+func readAll(t *testing.T, dir string, q archiver.Query) []archiver.Record {
+	records := make([]archiver.Record, 0)
+	err := archiver.Read(dir, q, func(record archiver.Record) error {
+		records = append(records, record)
+		return nil
+	})
+	require.NoError(t, err)
+	return records
+}
+
+func inferences(topicId uint64, blockHeight int64) types.Inferences {
+	return types.Inferences{Inferences: []*types.Inference{
+		{TopicId: topicId, BlockHeight: blockHeight, Inferer: worker, Value: alloraMath.MustNewDecFromString("1.5")},
+	}}
+}
+
+// Commits blocks to a store listened to by an archiver.Listener, as a node does
+func TestListenerArchivesRecordsKeptAfterPruning(t *testing.T) {
+	dir := t.TempDir()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.AddListeners([]storetypes.StoreKey{key})
+	k := keeper.NewKeeper(
+		cdc,
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		runtime.NewKVStoreService(key),
+		nil,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	writer, err := archiver.NewWriter(dir, archiver.DefaultMaxFileSize)
+	require.NoError(t, err)
+	defer writer.Close()
+	listener := archiver.NewListener(cdc, writer)
+	commit := func(ctx sdk.Context) {
+		cms.Commit()
+		require.NoError(t, listener.ListenCommit(ctx, abci.ResponseCommit{}, cms.PopStateCache()))
+	}
+
+	// Block 10: the inferences, forecasts and losses of nonce 10 of topic 1, and a score that is not archived
+	ctx := sdk.NewContext(cms, cmtproto.Header{Height: 10}, false, log.NewNopLogger())
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	require.NoError(t, k.InsertInferences(ctx, 1, types.Nonce{BlockHeight: 10}, inferences(1, 10)))
+	forecasts := types.Forecasts{Forecasts: []*types.Forecast{{TopicId: 1, BlockHeight: 10, Forecaster: worker}}}
+	require.NoError(t, k.InsertForecasts(ctx, 1, types.Nonce{BlockHeight: 10}, forecasts))
+	lossBundle := types.ValueBundle{TopicId: 1, Reputer: worker, CombinedValue: alloraMath.MustNewDecFromString("0.25")}
+	require.NoError(t, k.InsertNetworkLossBundleAtBlock(ctx, 1, 10, lossBundle))
+	score := types.Score{TopicId: 1, BlockHeight: 10, Address: worker, Score: alloraMath.OneDec()}
+	require.NoError(t, k.InsertWorkerInferenceScore(ctx, 1, 10, score))
+	commit(ctx)
+
+	// Block 20: the records of topic 1 are pruned and topic 2 gets inferences
+	ctx = sdk.NewContext(cms, cmtproto.Header{Height: 20}, false, log.NewNopLogger())
+	require.NoError(t, k.PruneRecordsAfterRewards(ctx, 1, 10))
+	require.NoError(t, k.InsertInferences(ctx, 2, types.Nonce{BlockHeight: 20}, inferences(2, 20)))
+	commit(ctx)
+
+	pruned, err := k.GetInferencesAtBlock(ctx, 1, 10)
+	require.Error(t, err, "the inferences should be pruned from the state")
+	require.Nil(t, pruned)
+
+	records := readAll(t, dir, archiver.Query{TopicId: 1})
+	require.Len(t, records, 3)
+	collections := make([]string, 0)
+	for _, record := range records {
+		require.Equal(t, int64(10), record.Height)
+		require.Equal(t, int64(10), record.BlockHeight)
+		collections = append(collections, record.Collection)
+	}
+	require.ElementsMatch(t, []string{
+		types.PrunedCollectionInferences, types.PrunedCollectionForecasts, types.PrunedCollectionNetworkLossBundles,
+	}, collections)
+
+	records = readAll(t, dir, archiver.Query{Collections: []string{types.PrunedCollectionInferences}})
+	require.Len(t, records, 2)
+	var archived types.Inferences
+	require.NoError(t, cdc.UnmarshalJSON(records[1].Value, &archived))
+	require.Equal(t, uint64(2), records[1].TopicId)
+	require.Equal(t, worker, archived.Inferences[0].Inferer)
+	require.True(t, alloraMath.MustNewDecFromString("1.5").Equal(archived.Inferences[0].Value))
+}
+
+func TestReadFiltersByBlockHeightAcrossRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	// Every block starts a new file
+	writer, err := archiver.NewWriter(dir, 1)
+	require.NoError(t, err)
+	for _, height := range []int64{10, 20, 30} {
+		records := []archiver.Record{
+			{Height: height, Collection: types.PrunedCollectionInferences, TopicId: 1, BlockHeight: height, Value: []byte(`{}`)},
+			// Losses are committed after the nonce they are for
+			{Height: height, Collection: types.PrunedCollectionLossBundles, TopicId: 1, BlockHeight: height - 5, Value: []byte(`{}`)},
+		}
+		require.NoError(t, writer.WriteBlock(height, records))
+	}
+	require.NoError(t, writer.WriteBlock(40, nil))
+	require.NoError(t, writer.Close())
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3, "a block without records should not start a file")
+
+	records := readAll(t, dir, archiver.Query{FromBlockHeight: 20, ToBlockHeight: 25})
+	require.Len(t, records, 2)
+	require.Equal(t, int64(20), records[0].BlockHeight)
+	require.Equal(t, int64(25), records[1].BlockHeight)
+	require.Equal(t, int64(30), records[1].Height)
+}
+
+func TestWriterRecoversLineCutShort(t *testing.T) {
+	dir := t.TempDir()
+	writer, err := archiver.NewWriter(dir, archiver.DefaultMaxFileSize)
+	require.NoError(t, err)
+	record := archiver.Record{Height: 10, Collection: types.PrunedCollectionInferences, TopicId: 1, BlockHeight: 10, Value: []byte(`{}`)}
+	require.NoError(t, writer.WriteBlock(10, []archiver.Record{record}))
+	require.NoError(t, writer.Close())
+
+	// The node stops while writing block 11
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	path := filepath.Join(dir, files[0].Name())
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"height":11,"collection":"infer`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Len(t, readAll(t, dir, archiver.Query{}), 1)
+
+	// and replays block 10 once restarted, which is already archived, before writing block 11
+	writer, err = archiver.NewWriter(dir, archiver.DefaultMaxFileSize)
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteBlock(10, []archiver.Record{record}))
+	nextRecord := archiver.Record{Height: 11, Collection: types.PrunedCollectionInferences, TopicId: 1, BlockHeight: 11, Value: []byte(`{}`)}
+	require.NoError(t, writer.WriteBlock(11, []archiver.Record{nextRecord}))
+	require.NoError(t, writer.Close())
+	records := readAll(t, dir, archiver.Query{})
+	require.Equal(t, []archiver.Record{record, nextRecord}, records)
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1, "The last file of the archive is resumed")
+}
@@ -0,0 +1,121 @@
+package archiver
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Selects records of an archive. Zero values select every record.
+type Query struct {
+	TopicId uint64
+	// Block heights of the nonces the records are for, inclusive
+	FromBlockHeight int64
+	ToBlockHeight   int64
+	Collections     []string
+}
+
+func (q Query) matches(record Record) bool {
+	if q.TopicId != 0 && record.TopicId != q.TopicId {
+		return false
+	}
+	if q.FromBlockHeight != 0 && record.BlockHeight < q.FromBlockHeight {
+		return false
+	}
+	if q.ToBlockHeight != 0 && record.BlockHeight > q.ToBlockHeight {
+		return false
+	}
+	if len(q.Collections) == 0 {
+		return true
+	}
+	for _, c := range q.Collections {
+		if c == record.Collection {
+			return true
+		}
+	}
+	return false
+}
+
+type archiveFile struct {
+	path        string
+	firstHeight int64
+}
+
+// Lists the files of an archive by the height of their first block
+func listFiles(dir string) ([]archiveFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]archiveFile, 0)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileExtension) {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, filePrefix), fileExtension), 10, 64)
+		if err != nil {
+			continue
+		}
+		files = append(files, archiveFile{path: filepath.Join(dir, name), firstHeight: height})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].firstHeight < files[j].firstHeight })
+	return files, nil
+}
+
+// Calls fn with the records of the archive in dir that match the query, in the order they were committed in.
+// Reading stops early when fn returns an error.
+func Read(dir string, q Query, fn func(Record) error) error {
+	files, err := listFiles(dir)
+	if err != nil {
+		return fmt.Errorf("failed to list archive files in %s: %w", dir, err)
+	}
+	for i, file := range files {
+		// Records are committed at or after the height of their nonce, so a file whose blocks all come before
+		// the range holds none of it
+		if q.FromBlockHeight != 0 && i+1 < len(files) && files[i+1].firstHeight <= q.FromBlockHeight {
+			continue
+		}
+		if err := readFile(file.path, q, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readFile(path string, q Query, fn func(Record) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// Lines are read whole, however large the records of a block
+	reader := bufio.NewReader(f)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A last line without its newline was cut short while being written
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("failed to decode record at %s:%d: %w", path, lineNumber, err)
+		}
+		if q.matches(record) {
+			if err := fn(record); err != nil {
+				return err
+			}
+		}
+	}
+}
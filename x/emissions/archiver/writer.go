@@ -0,0 +1,195 @@
+package archiver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Files of the archive are named after the height of the first block they hold, so they sort by height
+const (
+	filePrefix    = "emissions-"
+	fileExtension = ".ndjson"
+)
+
+// Default size a file of the archive grows to before the next block starts a new one
+const DefaultMaxFileSize = 128 << 20
+
+func fileName(height int64) string {
+	return fmt.Sprintf("%s%012d%s", filePrefix, height, fileExtension)
+}
+
+// Writer appends the records of each block to the current file of an archive, and starts a new file at the
+// first block written after it grows over the max file size, so that the records of a block are never split.
+// It resumes the last file of the archive, and skips the blocks replayed after a restart that are already in it.
+type Writer struct {
+	dir         string
+	maxFileSize int64
+	file        *os.File
+	buf         *bufio.Writer
+	size        int64
+	// Height of the last block in the archive
+	lastHeight int64
+}
+
+func NewWriter(dir string, maxFileSize int64) (*Writer, error) {
+	if maxFileSize <= 0 {
+		return nil, fmt.Errorf("max file size must be greater than zero, got %d", maxFileSize)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory %s: %w", dir, err)
+	}
+	return &Writer{dir: dir, maxFileSize: maxFileSize}, nil
+}
+
+// Writes the records of a block, and flushes them to the file
+func (w *Writer) WriteBlock(height int64, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if w.file == nil {
+		if err := w.resume(); err != nil {
+			return err
+		}
+	}
+	if height <= w.lastHeight {
+		return nil
+	}
+	if w.file == nil || w.size >= w.maxFileSize {
+		if err := w.rotate(height); err != nil {
+			return err
+		}
+	}
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		n, err := w.buf.Write(append(line, '\n'))
+		w.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	w.lastHeight = height
+	return nil
+}
+
+// Opens the last file of the archive, if there is one, to append to it
+func (w *Writer) resume() error {
+	files, err := listFiles(w.dir)
+	if err != nil {
+		return fmt.Errorf("failed to list archive files in %s: %w", w.dir, err)
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	return w.open(files[len(files)-1].path)
+}
+
+// Closes the current file and opens the file starting at height
+func (w *Writer) rotate(height int64) error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return w.open(filepath.Join(w.dir, fileName(height)))
+}
+
+func (w *Writer) open(path string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open archive file %s: %w", path, err)
+	}
+	size, err := truncateIncompleteLine(file)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to recover archive file %s: %w", path, err)
+	}
+	line, err := lastLine(file, size)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to recover archive file %s: %w", path, err)
+	}
+	if len(line) > 0 {
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to decode last record of %s: %w", path, err)
+		}
+		w.lastHeight = max(w.lastHeight, record.Height)
+	}
+	w.file = file
+	w.buf = bufio.NewWriter(file)
+	w.size = size
+	return nil
+}
+
+// Removes the end of a file after its last newline, left by a write cut short, and returns the new size
+func truncateIncompleteLine(file *os.File) (int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	end := info.Size()
+	chunk := make([]byte, 4096)
+	for end > 0 {
+		start := max(end-int64(len(chunk)), 0)
+		n, err := file.ReadAt(chunk[:end-start], start)
+		if err != nil {
+			return 0, err
+		}
+		if i := bytes.LastIndexByte(chunk[:n], '\n'); i >= 0 {
+			end = start + int64(i) + 1
+			break
+		}
+		end = start
+	}
+	if end == info.Size() {
+		return end, nil
+	}
+	return end, file.Truncate(end)
+}
+
+// Returns the last line of a file of the given size that ends with a newline, without the newline
+func lastLine(file *os.File, size int64) ([]byte, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	end := size - 1
+	start := end
+	chunk := make([]byte, 4096)
+	for start > 0 {
+		from := max(start-int64(len(chunk)), 0)
+		n, err := file.ReadAt(chunk[:start-from], from)
+		if err != nil {
+			return nil, err
+		}
+		if i := bytes.LastIndexByte(chunk[:n], '\n'); i >= 0 {
+			start = from + int64(i) + 1
+			break
+		}
+		start = from
+	}
+	line := make([]byte, end-start)
+	_, err := file.ReadAt(line, start)
+	return line, err
+}
+
+func (w *Writer) Close() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.buf.Flush()
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	w.file = nil
+	w.buf = nil
+	return err
+}
@@ -0,0 +1,112 @@
+// Package inspector reads the emissions state out of the application DB of a stopped node, at its latest
+// or an earlier height, and reports it as rows that can be written out as JSON or CSV.
+package inspector
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// Name of the application DB in the data directory of a node
+const appDBName = "application"
+
+type Inspector struct {
+	db     dbm.DB
+	ctx    sdk.Context
+	k      keeper.Keeper
+	height int64
+}
+
+// Opens the application DB in the data directory of a node, which must not be running, and reads the
+// emissions state as of height, or as of the latest height when height is 0.
+// The DB is never written to, and goleveldb DBs are opened read-only.
+func Open(dataDir string, backend dbm.BackendType, height int64) (*Inspector, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("height must not be negative, got %d", height)
+	}
+	db, err := openDB(dataDir, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s DB in %s: %w", appDBName, dataDir, err)
+	}
+	inspector, err := newInspector(db, height)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return inspector, nil
+}
+
+func openDB(dataDir string, backend dbm.BackendType) (dbm.DB, error) {
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts(appDBName, dataDir, &opt.Options{ReadOnly: true})
+	}
+	if backend == dbm.MemDBBackend {
+		return nil, fmt.Errorf("the %s backend keeps nothing on disk", backend)
+	}
+	return dbm.NewDB(appDBName, backend, filepath.Clean(dataDir))
+}
+
+func newInspector(db dbm.DB, height int64) (*Inspector, error) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	// Upgrading the IAVL trees to fast nodes would write to the DB
+	cms.SetIAVLDisableFastNode(true)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, fmt.Errorf("failed to load the application state: %w", err)
+	}
+	latest := cms.LastCommitID().Version
+	if latest == 0 {
+		return nil, fmt.Errorf("the application DB holds no committed state")
+	}
+	if height == 0 {
+		height = latest
+	}
+	if height > latest {
+		return nil, fmt.Errorf("height %d is above the latest height %d", height, latest)
+	}
+	cacheMs, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the application state at height %d: %w", height, err)
+	}
+	ctx := sdk.NewContext(cacheMs, cmtproto.Header{Height: height}, false, log.NewNopLogger())
+
+	// Reading the state moves no tokens, so the keeper does without the account and bank keepers
+	k := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		runtime.NewKVStoreService(key),
+		nil,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	return &Inspector{db: db, ctx: ctx, k: k, height: height}, nil
+}
+
+// Height the state is read at
+func (i *Inspector) Height() int64 {
+	return i.height
+}
+
+func (i *Inspector) Close() error {
+	return i.db.Close()
+}
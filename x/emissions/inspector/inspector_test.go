@@ -0,0 +1,135 @@
+package inspector_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/inspector"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	reputer   = "allo1reputer"
+	delegator = "allo1delegator"
+	worker    = "allo1worker"
+)
+
+// Commits the emissions state of two blocks to an application DB in dataDir, as a node would
+func commitState(t *testing.T, dataDir string) {
+	db, err := dbm.NewGoLevelDB("application", dataDir, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	k := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		runtime.NewKVStoreService(key),
+		nil,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Block 1: a topic with a staked reputer and an open worker nonce
+	ctx := sdk.NewContext(cms, cmtproto.Header{Height: 1}, false, log.NewNopLogger())
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	require.NoError(t, k.SetTotalStake(ctx, cosmosMath.ZeroInt()))
+	topic := types.Topic{Id: 1, Creator: worker, Metadata: "eth price", EpochLength: 10}
+	require.NoError(t, k.SetTopic(ctx, topic.Id, topic))
+	require.NoError(t, k.AddStake(ctx, topic.Id, reputer, cosmosMath.NewInt(1000)))
+	require.NoError(t, k.AddWorkerNonce(ctx, topic.Id, &types.Nonce{BlockHeight: 1}))
+	cms.Commit()
+
+	// Block 2: the topic is activated, stake is delegated to the reputer and the epoch is scored
+	ctx = sdk.NewContext(cms, cmtproto.Header{Height: 2}, false, log.NewNopLogger())
+	require.NoError(t, k.ActivateTopic(ctx, topic.Id))
+	require.NoError(t, k.AddDelegateStake(ctx, topic.Id, delegator, reputer, cosmosMath.NewInt(500)))
+	score := types.Score{TopicId: topic.Id, BlockHeight: 2, Address: worker, Score: alloraMath.MustNewDecFromString("0.5")}
+	require.NoError(t, k.SetLatestInfererScore(ctx, topic.Id, worker, score))
+	score.Address = reputer
+	require.NoError(t, k.SetLatestReputerScore(ctx, topic.Id, reputer, score))
+	require.NoError(t, k.AddReputerNonce(ctx, topic.Id, &types.Nonce{BlockHeight: 2}, &types.Nonce{BlockHeight: 1}))
+	cms.Commit()
+}
+
+func TestInspectAtHeight(t *testing.T) {
+	dataDir := t.TempDir()
+	commitState(t, dataDir)
+
+	previous, err := inspector.Open(dataDir, dbm.GoLevelDBBackend, 1)
+	require.NoError(t, err)
+	report, err := previous.Report()
+	require.NoError(t, err)
+	require.NoError(t, previous.Close())
+
+	require.Equal(t, int64(1), report.Height)
+	require.Len(t, report.Topics, 1)
+	require.False(t, report.Topics[0].Active)
+	require.Equal(t, "1000", report.Topics[0].Stake)
+	require.Equal(t, 1, report.Topics[0].UnfulfilledWorkerNonces)
+	require.Equal(t, []inspector.StakeRow{{TopicId: 1, Reputer: reputer, Amount: "1000"}}, report.Stakes)
+	require.Empty(t, report.Delegations)
+	require.Empty(t, report.Scores)
+	require.Equal(t, []inspector.NonceRow{{TopicId: 1, Type: "worker", BlockHeight: 1}}, report.Nonces)
+
+	latest, err := inspector.Open(dataDir, dbm.GoLevelDBBackend, 0)
+	require.NoError(t, err)
+	defer latest.Close()
+	report, err = latest.Report()
+	require.NoError(t, err)
+
+	require.Equal(t, int64(2), report.Height)
+	require.True(t, report.Topics[0].Active)
+	require.Equal(t, 1, report.Topics[0].UnfulfilledReputerNonces)
+	require.Len(t, report.Delegations, 1)
+	require.Equal(t, reputer, report.Delegations[0].Reputer)
+	require.Equal(t, delegator, report.Delegations[0].Delegator)
+	require.Equal(t, "500", report.Delegations[0].Amount)
+	require.Equal(t, []inspector.ScoreRow{
+		{TopicId: 1, Type: "inferer", Address: worker, BlockHeight: 2, Score: "0.5"},
+		{TopicId: 1, Type: "reputer", Address: reputer, BlockHeight: 2, Score: "0.5"},
+	}, report.Scores)
+	require.Contains(t, report.Nonces, inspector.NonceRow{TopicId: 1, Type: "reputer", BlockHeight: 2, WorkerNonce: 1})
+
+	var buf bytes.Buffer
+	require.NoError(t, inspector.WriteCSV(&buf, report.Scores))
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, "topic_id", rows[0][0])
+}
+
+func TestInspectInvalidHeight(t *testing.T) {
+	dataDir := t.TempDir()
+	commitState(t, dataDir)
+
+	_, err := inspector.Open(dataDir, dbm.GoLevelDBBackend, 3)
+	require.Error(t, err)
+	_, err = inspector.Open(dataDir, dbm.GoLevelDBBackend, -1)
+	require.Error(t, err)
+	_, err = inspector.Open(t.TempDir(), dbm.GoLevelDBBackend, 0)
+	require.Error(t, err)
+}
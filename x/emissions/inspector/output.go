@@ -0,0 +1,35 @@
+package inspector
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+)
+
+// A row of a report that can be written out as CSV
+type Row interface {
+	csvHeader() []string
+	csvValues() []string
+}
+
+func WriteJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// Writes a header followed by a line for each row
+func WriteCSV[R Row](w io.Writer, rows []R) error {
+	writer := csv.NewWriter(w)
+	var zero R
+	if err := writer.Write(zero.csvHeader()); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writer.Write(row.csvValues()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,295 @@
+package inspector
+
+import (
+	"strconv"
+	"strings"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+type TopicRow struct {
+	TopicId        uint64 `json:"topic_id"`
+	Creator        string `json:"creator"`
+	Metadata       string `json:"metadata"`
+	EpochLength    int64  `json:"epoch_length"`
+	EpochLastEnded int64  `json:"epoch_last_ended"`
+	Active         bool   `json:"active"`
+	Workers        int    `json:"workers"`
+	Reputers       int    `json:"reputers"`
+	Stake          string `json:"stake"`
+	// Weight of the topic as of the last time its rewards were calculated
+	Weight     string `json:"weight"`
+	FeeRevenue string `json:"fee_revenue"`
+	// Block height of the worker nonce whose rewards are being calculated, 0 if none
+	RewardNonce              int64 `json:"reward_nonce"`
+	UnfulfilledWorkerNonces  int   `json:"unfulfilled_worker_nonces"`
+	UnfulfilledReputerNonces int   `json:"unfulfilled_reputer_nonces"`
+}
+
+// Stake a reputer placed in a topic itself, delegated stake excluded
+type StakeRow struct {
+	TopicId uint64 `json:"topic_id"`
+	Reputer string `json:"reputer"`
+	Amount  string `json:"amount"`
+}
+
+type DelegationRow struct {
+	TopicId    uint64 `json:"topic_id"`
+	Reputer    string `json:"reputer"`
+	Delegator  string `json:"delegator"`
+	Amount     string `json:"amount"`
+	RewardDebt string `json:"reward_debt"`
+}
+
+// Latest score of an actor in one of its roles in a topic
+type ScoreRow struct {
+	TopicId     uint64 `json:"topic_id"`
+	Type        string `json:"type"` // inferer, forecaster or reputer
+	Address     string `json:"address"`
+	BlockHeight int64  `json:"block_height"`
+	Score       string `json:"score"`
+}
+
+type NonceRow struct {
+	TopicId     uint64 `json:"topic_id"`
+	Type        string `json:"type"` // worker or reputer
+	BlockHeight int64  `json:"block_height"`
+	// Worker nonce a reputer nonce asks the losses of, 0 for worker nonces
+	WorkerNonce int64 `json:"worker_nonce"`
+}
+
+// Every report at once
+type Report struct {
+	Height      int64           `json:"height"`
+	Topics      []TopicRow      `json:"topics"`
+	Stakes      []StakeRow      `json:"stakes"`
+	Delegations []DelegationRow `json:"delegations"`
+	Scores      []ScoreRow      `json:"scores"`
+	Nonces      []NonceRow      `json:"nonces"`
+}
+
+func (i *Inspector) Topics() ([]TopicRow, error) {
+	rows := []TopicRow{}
+	err := i.k.WalkTopics(i.ctx, func(topic types.Topic) (bool, error) {
+		row, err := i.topicRow(topic)
+		if err != nil {
+			return true, err
+		}
+		rows = append(rows, row)
+		return false, nil
+	})
+	return rows, err
+}
+
+func (i *Inspector) topicRow(topic types.Topic) (TopicRow, error) {
+	active, err := i.k.IsTopicActive(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	workers, err := i.k.GetTopicWorkers(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	reputers, err := i.k.GetTopicReputers(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	stake, err := i.k.GetTopicStake(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	weight, _, err := i.k.GetPreviousTopicWeight(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	feeRevenue, err := i.k.GetTopicFeeRevenue(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	rewardNonce, err := i.k.GetTopicRewardNonce(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	workerNonces, err := i.k.GetUnfulfilledWorkerNonces(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	reputerNonces, err := i.k.GetUnfulfilledReputerNonces(i.ctx, topic.Id)
+	if err != nil {
+		return TopicRow{}, err
+	}
+	return TopicRow{
+		TopicId:                  topic.Id,
+		Creator:                  topic.Creator,
+		Metadata:                 topic.Metadata,
+		EpochLength:              topic.EpochLength,
+		EpochLastEnded:           topic.EpochLastEnded,
+		Active:                   active,
+		Workers:                  len(workers),
+		Reputers:                 len(reputers),
+		Stake:                    stake.String(),
+		Weight:                   weight.String(),
+		FeeRevenue:               feeRevenue.Revenue.String(),
+		RewardNonce:              rewardNonce,
+		UnfulfilledWorkerNonces:  len(workerNonces.Nonces),
+		UnfulfilledReputerNonces: len(reputerNonces.Nonces),
+	}, nil
+}
+
+func (i *Inspector) Stakes() ([]StakeRow, error) {
+	rows := []StakeRow{}
+	err := i.k.WalkReputerStakes(i.ctx, func(topicId uint64, reputer string, stake cosmosMath.Int) (bool, error) {
+		rows = append(rows, StakeRow{TopicId: topicId, Reputer: reputer, Amount: stake.String()})
+		return false, nil
+	})
+	return rows, err
+}
+
+func (i *Inspector) Delegations() ([]DelegationRow, error) {
+	rows := []DelegationRow{}
+	err := i.k.WalkDelegateStakePlacements(
+		i.ctx,
+		func(topicId uint64, delegator string, reputer string, info types.DelegatorInfo) (bool, error) {
+			rows = append(rows, DelegationRow{
+				TopicId:    topicId,
+				Reputer:    reputer,
+				Delegator:  delegator,
+				Amount:     info.Amount.String(),
+				RewardDebt: info.RewardDebt.String(),
+			})
+			return false, nil
+		},
+	)
+	return rows, err
+}
+
+func (i *Inspector) Scores() ([]ScoreRow, error) {
+	rows := []ScoreRow{}
+	err := i.k.WalkLatestScores(i.ctx, func(actorType types.ActorType, score types.Score) (bool, error) {
+		rows = append(rows, ScoreRow{
+			TopicId:     score.TopicId,
+			Type:        strings.ToLower(actorType.String()),
+			Address:     score.Address,
+			BlockHeight: score.BlockHeight,
+			Score:       score.Score.String(),
+		})
+		return false, nil
+	})
+	return rows, err
+}
+
+// Unfulfilled worker nonces of every topic, then unfulfilled reputer nonces of every topic
+func (i *Inspector) Nonces() ([]NonceRow, error) {
+	rows := []NonceRow{}
+	err := i.k.WalkUnfulfilledWorkerNonces(i.ctx, func(topicId uint64, nonces types.Nonces) (bool, error) {
+		for _, nonce := range nonces.Nonces {
+			rows = append(rows, NonceRow{TopicId: topicId, Type: "worker", BlockHeight: nonce.BlockHeight})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = i.k.WalkUnfulfilledReputerNonces(i.ctx, func(topicId uint64, nonces types.ReputerRequestNonces) (bool, error) {
+		for _, nonce := range nonces.Nonces {
+			row := NonceRow{TopicId: topicId, Type: "reputer"}
+			if nonce.ReputerNonce != nil {
+				row.BlockHeight = nonce.ReputerNonce.BlockHeight
+			}
+			if nonce.WorkerNonce != nil {
+				row.WorkerNonce = nonce.WorkerNonce.BlockHeight
+			}
+			rows = append(rows, row)
+		}
+		return false, nil
+	})
+	return rows, err
+}
+
+func (i *Inspector) Report() (Report, error) {
+	report := Report{Height: i.height}
+	var err error
+	if report.Topics, err = i.Topics(); err != nil {
+		return Report{}, err
+	}
+	if report.Stakes, err = i.Stakes(); err != nil {
+		return Report{}, err
+	}
+	if report.Delegations, err = i.Delegations(); err != nil {
+		return Report{}, err
+	}
+	if report.Scores, err = i.Scores(); err != nil {
+		return Report{}, err
+	}
+	if report.Nonces, err = i.Nonces(); err != nil {
+		return Report{}, err
+	}
+	return report, nil
+}
+
+func (TopicRow) csvHeader() []string {
+	return []string{
+		"topic_id", "creator", "metadata", "epoch_length", "epoch_last_ended", "active", "workers", "reputers",
+		"stake", "weight", "fee_revenue", "reward_nonce", "unfulfilled_worker_nonces", "unfulfilled_reputer_nonces",
+	}
+}
+
+func (r TopicRow) csvValues() []string {
+	return []string{
+		formatUint(r.TopicId),
+		r.Creator,
+		r.Metadata,
+		formatInt(r.EpochLength),
+		formatInt(r.EpochLastEnded),
+		strconv.FormatBool(r.Active),
+		strconv.Itoa(r.Workers),
+		strconv.Itoa(r.Reputers),
+		r.Stake,
+		r.Weight,
+		r.FeeRevenue,
+		formatInt(r.RewardNonce),
+		strconv.Itoa(r.UnfulfilledWorkerNonces),
+		strconv.Itoa(r.UnfulfilledReputerNonces),
+	}
+}
+
+func (StakeRow) csvHeader() []string {
+	return []string{"topic_id", "reputer", "amount"}
+}
+
+func (r StakeRow) csvValues() []string {
+	return []string{formatUint(r.TopicId), r.Reputer, r.Amount}
+}
+
+func (DelegationRow) csvHeader() []string {
+	return []string{"topic_id", "reputer", "delegator", "amount", "reward_debt"}
+}
+
+func (r DelegationRow) csvValues() []string {
+	return []string{formatUint(r.TopicId), r.Reputer, r.Delegator, r.Amount, r.RewardDebt}
+}
+
+func (ScoreRow) csvHeader() []string {
+	return []string{"topic_id", "type", "address", "block_height", "score"}
+}
+
+func (r ScoreRow) csvValues() []string {
+	return []string{formatUint(r.TopicId), r.Type, r.Address, formatInt(r.BlockHeight), r.Score}
+}
+
+func (NonceRow) csvHeader() []string {
+	return []string{"topic_id", "type", "block_height", "worker_nonce"}
+}
+
+func (r NonceRow) csvValues() []string {
+	return []string{formatUint(r.TopicId), r.Type, formatInt(r.BlockHeight), formatInt(r.WorkerNonce)}
+}
+
+func formatUint(n uint64) string {
+	return strconv.FormatUint(n, 10)
+}
+
+func formatInt(n int64) string {
+	return strconv.FormatInt(n, 10)
+}
@@ -10,6 +10,13 @@ import (
 
 // InitGenesis initializes the module state from a genesis state.
 func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		return err
+	}
+
 	// ensure the module account exists
 	stakingModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraStakingAccountName)
 	k.authKeeper.SetModuleAccount(ctx, stakingModuleAccount)
@@ -17,6 +24,8 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 	k.authKeeper.SetModuleAccount(ctx, alloraRewardsModuleAccount)
 	alloraPendingRewardsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraPendingRewardForDelegatorAccountName)
 	k.authKeeper.SetModuleAccount(ctx, alloraPendingRewardsModuleAccount)
+	alloraClaimableRewardsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraClaimableRewardsAccountName)
+	k.authKeeper.SetModuleAccount(ctx, alloraClaimableRewardsModuleAccount)
 	if err := k.SetTotalStake(ctx, cosmosMath.ZeroInt()); err != nil {
 		return err
 	}
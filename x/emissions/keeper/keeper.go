@@ -32,6 +32,8 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	addressCodec     address.Codec
 	feeCollectorName string
+	// the address capable of executing privileged messages, typically the x/gov module account
+	authority string
 
 	/// TYPES
 
@@ -40,6 +42,9 @@ type Keeper struct {
 	authKeeper AccountKeeper
 	bankKeeper BankKeeper
 
+	// map of block height -> params updates to apply at the end of that block
+	scheduledParamsUpdates collections.Map[BlockHeight, types.ScheduledParamsUpdates]
+
 	/// TOPIC
 
 	// the next topic id to be used, equal to the number of topics that have been created
@@ -57,6 +62,8 @@ type Keeper struct {
 	topicReputers collections.KeySet[collections.Pair[TopicId, ActorId]]
 	// map of (topic) -> nonce/block height
 	topicRewardNonce collections.Map[TopicId, BlockHeight]
+	// map of (topic) -> overrides of the global params set by the topic creator
+	topicParams collections.Map[TopicId, types.TopicParams]
 
 	/// SCORES
 
@@ -93,6 +100,12 @@ type Keeper struct {
 	stakeRemoval collections.Map[collections.Pair[TopicId, ActorId], types.StakeRemoval]
 	// map of (delegator) -> removal information for that delegator
 	delegateStakeRemoval collections.Map[collections.Triple[TopicId, ActorId, ActorId], types.DelegateStakeRemoval]
+	// map of (source topic, source reputer, delegator) -> delegate stake moved away from that position
+	redelegations collections.Map[collections.Triple[TopicId, ActorId, ActorId], types.Redelegations]
+	// map of (destination topic, destination reputer, delegator) -> delegate stake moved to that position
+	redelegationsTo collections.Map[collections.Triple[TopicId, ActorId, ActorId], types.Redelegations]
+	// map of (block started, delegator) -> redelegations started in that block, to prune them once they are over
+	redelegationsByBlock collections.Map[collections.Pair[BlockHeight, ActorId], types.Redelegations]
 	// map of (delegator) -> amount of stake that has been placed by that delegator
 	stakeFromDelegator collections.Map[collections.Pair[TopicId, ActorId], cosmosMath.Int]
 	// map of (delegator, target) -> amount of stake that has been placed by that delegator on that target
@@ -101,6 +114,20 @@ type Keeper struct {
 	stakeUponReputer collections.Map[collections.Pair[TopicId, ActorId], cosmosMath.Int]
 	// map of (topicId, reputer) -> share of delegate reward
 	delegateRewardPerShare collections.Map[collections.Pair[TopicId, ActorId], alloraMath.Dec]
+	// set of delegators whose rewards are added to their delegated stake as they settle
+	delegatorAutoCompound collections.KeySet[ActorId]
+	// set of (topicId, reputer, delegator) stake placements of the delegators in delegatorAutoCompound
+	autoCompoundPositions collections.KeySet[collections.Triple[TopicId, ActorId, ActorId]]
+	// map of (reputer) -> commission the reputer takes from the rewards of the stake delegated to it
+	reputerCommissions collections.Map[ActorId, types.ReputerCommission]
+	// map of (reputer) -> topic-agnostic stake of the reputer, allocated across topics as fractions
+	reputerStakePool collections.Map[ActorId, cosmosMath.Int]
+	// map of (reputer, topic) -> fraction of the reputer stake pool allocated to the topic
+	reputerStakeAllocations collections.Map[collections.Pair[ActorId, TopicId], alloraMath.Dec]
+	// map of (reputer, topic) -> lower allocation waiting for the stake removal delay
+	reputerStakeAllocationDecreases collections.Map[collections.Pair[ActorId, TopicId], types.ReputerStakeAllocationDecrease]
+	// map of (reputer) -> stake leaving the reputer stake pool, waiting for the stake removal delay
+	reputerStakePoolRemovals collections.Map[ActorId, types.ReputerStakePoolRemoval]
 
 	/// MISC GLOBAL STATE
 
@@ -134,6 +161,9 @@ type Keeper struct {
 	// map of (topic, block_height) -> ValueBundle (1 network wide bundle per timestep)
 	networkLossBundles collections.Map[collections.Pair[TopicId, BlockHeight], types.ValueBundle]
 
+	// map of (topic, reward nonce) -> how the reward of the topic was distributed for that nonce
+	topicRewardReports collections.Map[collections.Pair[TopicId, BlockHeight], types.TopicRewardReport]
+
 	// Percentage of all rewards, paid out to staked reputers, during the previous reward cadence. Used by mint module
 	previousPercentageRewardToStakedReputers collections.Item[alloraMath.Dec]
 
@@ -145,6 +175,23 @@ type Keeper struct {
 	// map of (topic) -> unfulfilled nonces
 	unfulfilledReputerNonces collections.Map[TopicId, types.ReputerRequestNonces]
 
+	// map of (topic, block_height, worker) -> commitment to the worker's payload for that nonce (commit-reveal topics only)
+	workerCommitments collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], []byte]
+
+	// map of (topic, worker nonce block_height, worker) -> payload submitted directly by the worker, awaiting fulfillment of the nonce
+	pendingWorkerPayloads collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], types.WorkerDataBundle]
+
+	// map of (topic, reputer nonce block_height, reputer) -> payload submitted directly by the reputer, awaiting fulfillment of the nonce
+	pendingReputerPayloads collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], types.ReputerValueBundle]
+
+	/// LIVENESS
+
+	// map of (topic, worker) -> liveness of the worker over the most recent fulfilled worker nonces
+	workerLiveness collections.Map[collections.Pair[TopicId, ActorId], types.ActorLiveness]
+
+	// map of (topic, reputer) -> liveness of the reputer over the most recent fulfilled reputer nonces
+	reputerLiveness collections.Map[collections.Pair[TopicId, ActorId], types.ActorLiveness]
+
 	/// REGRETS
 
 	// map of (topic, worker) -> regret of worker from comparing loss of worker relative to loss of other inferers
@@ -157,9 +204,27 @@ type Keeper struct {
 	// the forecaster (2nd index) regrets made under the regime of the same forecaster as a one-in forecaster
 	latestOneInForecasterSelfNetworkRegrets collections.Map[collections.Pair[TopicId, ActorId], types.TimestampedValue]
 
+	/// CLAIMABLE REWARDS
+
+	// map of (actor, denom) -> rewards credited to the actor and not claimed yet
+	claimableRewards collections.Map[collections.Pair[ActorId, string], cosmosMath.Int]
+	// map of (actor) -> address its claimed rewards are sent to instead of itself
+	rewardWithdrawAddresses collections.Map[ActorId, ActorId]
+
 	/// WHITELISTS
 
 	whitelistAdmins collections.KeySet[ActorId]
+	// set of (topic, worker) allowed to register as workers in a permissioned topic
+	topicWorkerAllowlist collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// set of (topic, reputer) allowed to register as reputers in a permissioned topic
+	topicReputerAllowlist collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// accounts allowed to create topics while the topic creator allowlist is enabled
+	topicCreatorAllowlist collections.KeySet[ActorId]
+
+	/// PRUNING
+
+	// position the pruning of historical records resumes from at the end of the next block
+	pruningCursor collections.Item[types.PruningCursor]
 }
 
 func NewKeeper(
@@ -169,6 +234,7 @@ func NewKeeper(
 	ak AccountKeeper,
 	bk BankKeeper,
 	feeCollectorName string,
+	authority string,
 ) Keeper {
 
 	sb := collections.NewSchemaBuilder(storeService)
@@ -176,7 +242,9 @@ func NewKeeper(
 		cdc:                                      cdc,
 		addressCodec:                             addressCodec,
 		feeCollectorName:                         feeCollectorName,
+		authority:                                authority,
 		params:                                   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		scheduledParamsUpdates:                   collections.NewMap(sb, types.ScheduledParamsUpdatesKey, "scheduled_params_updates", collections.Int64Key, codec.CollValue[types.ScheduledParamsUpdates](cdc)),
 		authKeeper:                               ak,
 		bankKeeper:                               bk,
 		totalStake:                               collections.NewItem(sb, types.TotalStakeKey, "total_stake", sdk.IntValue),
@@ -191,10 +259,20 @@ func NewKeeper(
 		stakeByReputerAndTopicId:                 collections.NewMap(sb, types.StakeByReputerAndTopicIdKey, "stake_by_reputer_and_TopicId", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		stakeRemoval:                             collections.NewMap(sb, types.StakeRemovalKey, "stake_removal_queue", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.StakeRemoval](cdc)),
 		delegateStakeRemoval:                     collections.NewMap(sb, types.DelegateStakeRemovalKey, "delegate_stake_removal_queue", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.DelegateStakeRemoval](cdc)),
+		redelegations:                            collections.NewMap(sb, types.RedelegationsKey, "redelegations", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.Redelegations](cdc)),
+		redelegationsTo:                          collections.NewMap(sb, types.RedelegationsToKey, "redelegations_to", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.Redelegations](cdc)),
+		redelegationsByBlock:                     collections.NewMap(sb, types.RedelegationsByBlockKey, "redelegations_by_block", collections.PairKeyCodec(collections.Int64Key, collections.StringKey), codec.CollValue[types.Redelegations](cdc)),
 		stakeFromDelegator:                       collections.NewMap(sb, types.DelegatorStakeKey, "stake_from_delegator", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegateStakePlacement:                   collections.NewMap(sb, types.DelegateStakePlacementKey, "delegate_stake_placement", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.DelegatorInfo](cdc)),
 		stakeUponReputer:                         collections.NewMap(sb, types.TargetStakeKey, "stake_upon_reputer", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegateRewardPerShare:                   collections.NewMap(sb, types.DelegateRewardPerShare, "delegate_reward_per_share", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
+		delegatorAutoCompound:                    collections.NewKeySet(sb, types.DelegatorAutoCompoundKey, "delegator_auto_compound", collections.StringKey),
+		autoCompoundPositions:                    collections.NewKeySet(sb, types.AutoCompoundPositionsKey, "auto_compound_positions", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
+		reputerCommissions:                       collections.NewMap(sb, types.ReputerCommissionsKey, "reputer_commissions", collections.StringKey, codec.CollValue[types.ReputerCommission](cdc)),
+		reputerStakePool:                         collections.NewMap(sb, types.ReputerStakePoolKey, "reputer_stake_pool", collections.StringKey, sdk.IntValue),
+		reputerStakeAllocations:                  collections.NewMap(sb, types.ReputerStakeAllocationsKey, "reputer_stake_allocations", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), alloraMath.DecValue),
+		reputerStakeAllocationDecreases:          collections.NewMap(sb, types.ReputerStakeAllocationDecreasesKey, "reputer_stake_allocation_decreases", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.ReputerStakeAllocationDecrease](cdc)),
+		reputerStakePoolRemovals:                 collections.NewMap(sb, types.ReputerStakePoolRemovalsKey, "reputer_stake_pool_removals", collections.StringKey, codec.CollValue[types.ReputerStakePoolRemoval](cdc)),
 		topicFeeRevenue:                          collections.NewMap(sb, types.TopicFeeRevenueKey, "topic_fee_revenue", collections.Uint64Key, codec.CollValue[types.TopicFeeRevenue](cdc)),
 		previousTopicWeight:                      collections.NewMap(sb, types.PreviousTopicWeightKey, "previous_topic_weight", collections.Uint64Key, alloraMath.DecValue),
 		inferences:                               collections.NewMap(sb, types.InferencesKey, "inferences", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Inference](cdc)),
@@ -205,12 +283,18 @@ func NewKeeper(
 		allForecasts:                             collections.NewMap(sb, types.AllForecastsKey, "forecasts_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Forecasts](cdc)),
 		allLossBundles:                           collections.NewMap(sb, types.AllLossBundlesKey, "value_bundles_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ReputerValueBundles](cdc)),
 		networkLossBundles:                       collections.NewMap(sb, types.NetworkLossBundlesKey, "value_bundles_network", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ValueBundle](cdc)),
+		topicRewardReports:                       collections.NewMap(sb, types.TopicRewardReportsKey, "topic_reward_reports", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.TopicRewardReport](cdc)),
 		previousPercentageRewardToStakedReputers: collections.NewItem(sb, types.PreviousPercentageRewardToStakedReputersKey, "previous_percentage_reward_to_staked_reputers", alloraMath.DecValue),
 		latestInfererNetworkRegrets:              collections.NewMap(sb, types.InfererNetworkRegretsKey, "inferer_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestForecasterNetworkRegrets:           collections.NewMap(sb, types.ForecasterNetworkRegretsKey, "forecaster_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestOneInForecasterNetworkRegrets:      collections.NewMap(sb, types.OneInForecasterNetworkRegretsKey, "one_in_forecaster_network_regrets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestOneInForecasterSelfNetworkRegrets:  collections.NewMap(sb, types.OneInForecasterSelfNetworkRegretsKey, "one_in_forecaster_self_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		whitelistAdmins:                          collections.NewKeySet(sb, types.WhitelistAdminsKey, "whitelist_admins", collections.StringKey),
+		topicWorkerAllowlist:                     collections.NewKeySet(sb, types.TopicWorkerAllowlistKey, "topic_worker_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		topicReputerAllowlist:                    collections.NewKeySet(sb, types.TopicReputerAllowlistKey, "topic_reputer_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		topicCreatorAllowlist:                    collections.NewKeySet(sb, types.TopicCreatorAllowlistKey, "topic_creator_allowlist", collections.StringKey),
+		claimableRewards:                         collections.NewMap(sb, types.ClaimableRewardsKey, "claimable_rewards", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		rewardWithdrawAddresses:                  collections.NewMap(sb, types.RewardWithdrawAddressesKey, "reward_withdraw_addresses", collections.StringKey, collections.StringValue),
 		infererScoresByBlock:                     collections.NewMap(sb, types.InferenceScoresKey, "inferer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		forecasterScoresByBlock:                  collections.NewMap(sb, types.ForecastScoresKey, "forecaster_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		latestInfererScoresByWorker:              collections.NewMap(sb, types.LatestInfererScoresByWorkerKey, "latest_inferer_scores_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
@@ -224,6 +308,13 @@ func NewKeeper(
 		unfulfilledWorkerNonces:                  collections.NewMap(sb, types.UnfulfilledWorkerNoncesKey, "unfulfilled_worker_nonces", collections.Uint64Key, codec.CollValue[types.Nonces](cdc)),
 		unfulfilledReputerNonces:                 collections.NewMap(sb, types.UnfulfilledReputerNoncesKey, "unfulfilled_reputer_nonces", collections.Uint64Key, codec.CollValue[types.ReputerRequestNonces](cdc)),
 		topicRewardNonce:                         collections.NewMap(sb, types.TopicRewardNonceKey, "topic_reward_nonce", collections.Uint64Key, collections.Int64Value),
+		topicParams:                              collections.NewMap(sb, types.TopicParamsKey, "topic_params", collections.Uint64Key, codec.CollValue[types.TopicParams](cdc)),
+		workerCommitments:                        collections.NewMap(sb, types.WorkerCommitmentsKey, "worker_commitments", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), collections.BytesValue),
+		pendingWorkerPayloads:                    collections.NewMap(sb, types.PendingWorkerPayloadsKey, "pending_worker_payloads", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), codec.CollValue[types.WorkerDataBundle](cdc)),
+		pendingReputerPayloads:                   collections.NewMap(sb, types.PendingReputerPayloadsKey, "pending_reputer_payloads", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), codec.CollValue[types.ReputerValueBundle](cdc)),
+		workerLiveness:                           collections.NewMap(sb, types.WorkerLivenessKey, "worker_liveness", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ActorLiveness](cdc)),
+		reputerLiveness:                          collections.NewMap(sb, types.ReputerLivenessKey, "reputer_liveness", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ActorLiveness](cdc)),
+		pruningCursor:                            collections.NewItem(sb, types.PruningCursorKey, "pruning_cursor", codec.CollValue[types.PruningCursor](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -256,6 +347,7 @@ func (k *Keeper) FulfillWorkerNonce(ctx context.Context, topicId TopicId, nonce
 			if err != nil {
 				return false, err
 			}
+			types.EmitNewWorkerNonceFulfilledEvent(sdk.UnwrapSDKContext(ctx), topicId, nonce)
 			return true, nil
 		}
 	}
@@ -282,6 +374,7 @@ func (k *Keeper) FulfillReputerNonce(ctx context.Context, topicId TopicId, nonce
 			if err != nil {
 				return false, err
 			}
+			types.EmitNewReputerNonceFulfilledEvent(sdk.UnwrapSDKContext(ctx), topicId, nonce)
 			return true, nil
 		}
 	}
@@ -348,21 +441,34 @@ func (k *Keeper) AddWorkerNonce(ctx context.Context, topicId TopicId, nonce *typ
 	}
 	nonces.Nonces = append([]*types.Nonce{nonce}, nonces.Nonces...)
 
-	moduleParams, err := k.GetParams(ctx)
+	moduleParams, err := k.GetParamsForTopic(ctx, topicId)
 	if err != nil {
 		return err
 	}
 	maxUnfulfilledRequests := moduleParams.MaxUnfulfilledWorkerRequests
 
+	var droppedNonces []*types.Nonce
 	lenNonces := uint64(len(nonces.Nonces))
 	if lenNonces > maxUnfulfilledRequests {
 		diff := uint64(len(nonces.Nonces)) - maxUnfulfilledRequests
 		if diff > 0 {
+			droppedNonces = nonces.Nonces[maxUnfulfilledRequests:]
 			nonces.Nonces = nonces.Nonces[:maxUnfulfilledRequests]
 		}
 	}
 
-	return k.unfulfilledWorkerNonces.Set(ctx, topicId, nonces)
+	err = k.unfulfilledWorkerNonces.Set(ctx, topicId, nonces)
+	if err != nil {
+		return err
+	}
+	for _, dropped := range droppedNonces {
+		err = k.dropWorkerNonce(ctx, topicId, *dropped)
+		if err != nil {
+			return err
+		}
+	}
+	types.EmitNewWorkerNonceAddedEvent(sdk.UnwrapSDKContext(ctx), topicId, nonce, droppedNonces)
+	return nil
 }
 
 // Adds a nonce to the unfulfilled nonces for the topic if it is not yet added (idempotent).
@@ -402,14 +508,48 @@ func (k *Keeper) AddReputerNonce(ctx context.Context, topicId TopicId, nonce *ty
 		return err
 	}
 	maxUnfulfilledRequests := moduleParams.MaxUnfulfilledReputerRequests
+	var droppedNonces []*types.ReputerRequestNonce
 	lenNonces := uint64(len(nonces.Nonces))
 	if lenNonces > maxUnfulfilledRequests {
 		diff := uint64(len(nonces.Nonces)) - maxUnfulfilledRequests
 		if diff > 0 {
+			droppedNonces = nonces.Nonces[maxUnfulfilledRequests:]
 			nonces.Nonces = nonces.Nonces[:maxUnfulfilledRequests]
 		}
 	}
-	return k.unfulfilledReputerNonces.Set(ctx, topicId, nonces)
+	err = k.unfulfilledReputerNonces.Set(ctx, topicId, nonces)
+	if err != nil {
+		return err
+	}
+	for _, dropped := range droppedNonces {
+		err = k.dropReputerNonce(ctx, topicId, *dropped.ReputerNonce)
+		if err != nil {
+			return err
+		}
+	}
+	types.EmitNewReputerNonceAddedEvent(sdk.UnwrapSDKContext(ctx), topicId, reputerRequestNonce, droppedNonces)
+	return nil
+}
+
+// Settles the liveness of a worker nonce that will no longer be fulfilled, then deletes the pending payloads
+// and commitments submitted for it
+func (k *Keeper) dropWorkerNonce(ctx context.Context, topicId TopicId, nonce types.Nonce) error {
+	if err := k.HandleDroppedWorkerNonce(ctx, topicId, nonce); err != nil {
+		return err
+	}
+	if err := k.DeletePendingWorkerPayloads(ctx, topicId, nonce); err != nil {
+		return err
+	}
+	return k.DeleteWorkerCommitments(ctx, topicId, nonce)
+}
+
+// Settles the liveness of a reputer nonce that will no longer be fulfilled, then deletes the pending payloads
+// submitted for it
+func (k *Keeper) dropReputerNonce(ctx context.Context, topicId TopicId, reputerNonce types.Nonce) error {
+	if err := k.HandleDroppedReputerNonce(ctx, topicId, reputerNonce); err != nil {
+		return err
+	}
+	return k.DeletePendingReputerPayloads(ctx, topicId, reputerNonce)
 }
 
 func (k *Keeper) GetUnfulfilledWorkerNonces(ctx context.Context, topicId TopicId) (types.Nonces, error) {
@@ -442,6 +582,145 @@ func (k *Keeper) DeleteUnfulfilledReputerNonces(ctx context.Context, topicId Top
 	return k.unfulfilledReputerNonces.Set(ctx, topicId, types.ReputerRequestNonces{})
 }
 
+/// COMMIT-REVEAL
+
+// Stores a worker's commitment to its payload for a worker nonce, overwriting any previous commitment
+func (k *Keeper) SetWorkerCommitment(ctx context.Context, topicId TopicId, nonce types.Nonce, worker ActorId, commitment []byte) error {
+	key := collections.Join3(topicId, nonce.BlockHeight, worker)
+	return k.workerCommitments.Set(ctx, key, commitment)
+}
+
+// Returns the worker's commitment for a worker nonce, or nil if the worker has not committed
+func (k *Keeper) GetWorkerCommitment(ctx context.Context, topicId TopicId, nonce types.Nonce, worker ActorId) ([]byte, error) {
+	key := collections.Join3(topicId, nonce.BlockHeight, worker)
+	commitment, err := k.workerCommitments.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return commitment, nil
+}
+
+// Removes all worker commitments made for a worker nonce
+func (k *Keeper) DeleteWorkerCommitments(ctx context.Context, topicId TopicId, nonce types.Nonce) error {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonce.BlockHeight)
+	return k.workerCommitments.Clear(ctx, rng)
+}
+
+/// PENDING PAYLOADS
+
+// Adds a payload submitted directly by a worker to the pending set of the nonce.
+// A later submission from the same worker for the same nonce replaces the earlier one.
+func (k *Keeper) AddPendingWorkerPayload(ctx context.Context, topicId TopicId, nonce types.Nonce, bundle types.WorkerDataBundle) error {
+	key := collections.Join3(topicId, nonce.BlockHeight, bundle.Worker)
+	return k.pendingWorkerPayloads.Set(ctx, key, bundle)
+}
+
+// Returns the payloads submitted directly by workers for the nonce, ordered by worker
+func (k *Keeper) GetPendingWorkerPayloads(ctx context.Context, topicId TopicId, nonce types.Nonce) ([]*types.WorkerDataBundle, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonce.BlockHeight)
+	iter, err := k.pendingWorkerPayloads.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	values, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+	bundles := make([]*types.WorkerDataBundle, len(values))
+	for i := range values {
+		bundles[i] = &values[i]
+	}
+	return bundles, nil
+}
+
+func (k *Keeper) DeletePendingWorkerPayloads(ctx context.Context, topicId TopicId, nonce types.Nonce) error {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonce.BlockHeight)
+	return k.pendingWorkerPayloads.Clear(ctx, rng)
+}
+
+// Adds a payload submitted directly by a reputer to the pending set of the reputer nonce.
+// A later submission from the same reputer for the same nonce replaces the earlier one.
+func (k *Keeper) AddPendingReputerPayload(ctx context.Context, topicId TopicId, reputerNonce types.Nonce, bundle types.ReputerValueBundle) error {
+	key := collections.Join3(topicId, reputerNonce.BlockHeight, bundle.ValueBundle.Reputer)
+	return k.pendingReputerPayloads.Set(ctx, key, bundle)
+}
+
+// Returns the payloads submitted directly by reputers for the reputer nonce, ordered by reputer
+func (k *Keeper) GetPendingReputerPayloads(ctx context.Context, topicId TopicId, reputerNonce types.Nonce) ([]*types.ReputerValueBundle, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, reputerNonce.BlockHeight)
+	iter, err := k.pendingReputerPayloads.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	values, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+	bundles := make([]*types.ReputerValueBundle, len(values))
+	for i := range values {
+		bundles[i] = &values[i]
+	}
+	return bundles, nil
+}
+
+func (k *Keeper) DeletePendingReputerPayloads(ctx context.Context, topicId TopicId, reputerNonce types.Nonce) error {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, reputerNonce.BlockHeight)
+	return k.pendingReputerPayloads.Clear(ctx, rng)
+}
+
+/// LIVENESS
+
+// Returns the liveness of a worker in a topic, or an empty record if none has been tracked yet
+func (k *Keeper) GetWorkerLiveness(ctx context.Context, topicId TopicId, worker ActorId) (types.ActorLiveness, error) {
+	key := collections.Join(topicId, worker)
+	liveness, err := k.workerLiveness.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ActorLiveness{}, nil
+		}
+		return types.ActorLiveness{}, err
+	}
+	return liveness, nil
+}
+
+func (k *Keeper) SetWorkerLiveness(ctx context.Context, topicId TopicId, worker ActorId, liveness types.ActorLiveness) error {
+	key := collections.Join(topicId, worker)
+	return k.workerLiveness.Set(ctx, key, liveness)
+}
+
+// Forgets the liveness of a worker in a topic, including any jail it is serving
+func (k *Keeper) RemoveWorkerLiveness(ctx context.Context, topicId TopicId, worker ActorId) error {
+	key := collections.Join(topicId, worker)
+	return k.workerLiveness.Remove(ctx, key)
+}
+
+// Returns the liveness of a reputer in a topic, or an empty record if none has been tracked yet
+func (k *Keeper) GetReputerLiveness(ctx context.Context, topicId TopicId, reputer ActorId) (types.ActorLiveness, error) {
+	key := collections.Join(topicId, reputer)
+	liveness, err := k.reputerLiveness.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ActorLiveness{}, nil
+		}
+		return types.ActorLiveness{}, err
+	}
+	return liveness, nil
+}
+
+func (k *Keeper) SetReputerLiveness(ctx context.Context, topicId TopicId, reputer ActorId, liveness types.ActorLiveness) error {
+	key := collections.Join(topicId, reputer)
+	return k.reputerLiveness.Set(ctx, key, liveness)
+}
+
+// Forgets the liveness of a reputer in a topic, including any jail it is serving
+func (k *Keeper) RemoveReputerLiveness(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	key := collections.Join(topicId, reputer)
+	return k.reputerLiveness.Remove(ctx, key)
+}
+
 /// REGRETS
 
 func (k *Keeper) SetInfererNetworkRegret(ctx context.Context, topicId TopicId, worker ActorId, regret types.TimestampedValue) error {
@@ -547,6 +826,99 @@ func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
 	return ret, nil
 }
 
+// The address that may always update params and manage the whitelist admins
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Schedule a params update to be applied at the end of its effective block height
+func (k *Keeper) ScheduleParamsUpdate(ctx context.Context, update types.ScheduledParamsUpdate) error {
+	updates, err := k.scheduledParamsUpdates.Get(ctx, update.EffectiveBlockHeight)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		updates = types.ScheduledParamsUpdates{}
+	}
+	updates.Updates = append(updates.Updates, &update)
+	return k.scheduledParamsUpdates.Set(ctx, update.EffectiveBlockHeight, updates)
+}
+
+// All scheduled params updates, ordered by effective block height then by scheduling order
+func (k Keeper) GetScheduledParamsUpdates(ctx context.Context) ([]*types.ScheduledParamsUpdate, error) {
+	iter, err := k.scheduledParamsUpdates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	values, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*types.ScheduledParamsUpdate, 0)
+	for _, updates := range values {
+		ret = append(ret, updates.Updates...)
+	}
+	return ret, nil
+}
+
+// Remove and return the params updates scheduled at or before the given block height, in the order they must be applied
+func (k *Keeper) PopScheduledParamsUpdates(ctx context.Context, blockHeight BlockHeight) ([]*types.ScheduledParamsUpdate, error) {
+	rng := new(collections.Range[BlockHeight]).EndInclusive(blockHeight)
+	iter, err := k.scheduledParamsUpdates.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*types.ScheduledParamsUpdate, 0)
+	for _, kv := range kvs {
+		ret = append(ret, kv.Value.Updates...)
+		err = k.scheduledParamsUpdates.Remove(ctx, kv.Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+/// TOPIC PARAMS
+
+// Overrides of the global params set for the topic, empty if there are none
+func (k Keeper) GetTopicParams(ctx context.Context, topicId TopicId) (types.TopicParams, error) {
+	topicParams, err := k.topicParams.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TopicParams{}, nil
+		}
+		return types.TopicParams{}, err
+	}
+	return topicParams, nil
+}
+
+func (k *Keeper) SetTopicParams(ctx context.Context, topicId TopicId, topicParams types.TopicParams) error {
+	return k.topicParams.Set(ctx, topicId, topicParams)
+}
+
+// The params in effect for a topic: the global params with the overrides of the topic applied.
+// Anything that reads an overridable param on behalf of a topic should go through here.
+// Overrides that governance has since moved out of bounds are ignored until the topic creator updates them.
+func (k Keeper) GetParamsForTopic(ctx context.Context, topicId TopicId) (types.Params, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Params{}, err
+	}
+	topicParams, err := k.GetTopicParams(ctx, topicId)
+	if err != nil {
+		return types.Params{}, err
+	}
+	if err := topicParams.ValidateWithinBounds(moduleParams.MinTopicParams, moduleParams.MaxTopicParams); err != nil {
+		return moduleParams, nil
+	}
+	return topicParams.ApplyTo(moduleParams), nil
+}
+
 /// INFERENCES, FORECASTS
 
 func (k *Keeper) GetInferencesAtBlock(ctx context.Context, topicId TopicId, block BlockHeight) (*types.Inferences, error) {
@@ -665,6 +1037,16 @@ func (k *Keeper) GetNetworkLossBundleAtBlock(ctx context.Context, topicId TopicI
 	return &lossBundle, nil
 }
 
+func (k *Keeper) SetTopicRewardReport(ctx context.Context, report types.TopicRewardReport) error {
+	key := collections.Join(report.TopicId, report.BlockHeight)
+	return k.topicRewardReports.Set(ctx, key, report)
+}
+
+// Returns how the reward of a topic was distributed for a reward nonce
+func (k *Keeper) GetTopicRewardReport(ctx context.Context, topicId TopicId, block BlockHeight) (types.TopicRewardReport, error) {
+	return k.topicRewardReports.Get(ctx, collections.Join(topicId, block))
+}
+
 /// STAKING
 
 // Adds stake to the system for a given topic and reputer
@@ -716,6 +1098,7 @@ func (k *Keeper) AddStake(ctx context.Context, topicId TopicId, reputer ActorId,
 		return errorsmod.Wrapf(err, "Setting total stake failed -- rolling back reputer and topic stake")
 	}
 
+	types.EmitNewStakeAddedEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, stake)
 	return nil
 }
 
@@ -805,6 +1188,7 @@ func (k *Keeper) AddDelegateStake(ctx context.Context, topicId TopicId, delegato
 		return err
 	}
 
+	types.EmitNewDelegateStakeAddedEvent(sdk.UnwrapSDKContext(ctx), topicId, delegator, reputer, stake)
 	return nil
 }
 
@@ -890,6 +1274,7 @@ func (k *Keeper) RemoveStake(
 		return errorsmod.Wrapf(err, "Setting total stake failed")
 	}
 
+	types.EmitNewStakeRemovedEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, stake)
 	return nil
 }
 
@@ -996,6 +1381,7 @@ func (k *Keeper) RemoveDelegateStake(
 		return errorsmod.Wrapf(err, "Setting delegate stake upon reputer failed")
 	}
 
+	types.EmitNewDelegateStakeRemovedEvent(sdk.UnwrapSDKContext(ctx), topicId, delegator, reputer, unStake)
 	return nil
 }
 
@@ -1082,9 +1468,23 @@ func (k *Keeper) GetDelegateStakePlacement(ctx context.Context, topicId TopicId,
 // Sets the amount of stake placed by a specific delegator on a specific target.
 func (k *Keeper) SetDelegateStakePlacement(ctx context.Context, topicId TopicId, delegator ActorId, target ActorId, stake types.DelegatorInfo) error {
 	key := collections.Join3(topicId, delegator, target)
+	positionKey := collections.Join3(topicId, target, delegator)
 	if stake.Amount.IsZero() {
+		// A position without stake no longer compounds
+		if err := k.autoCompoundPositions.Remove(ctx, positionKey); err != nil {
+			return err
+		}
 		return k.delegateStakePlacement.Remove(ctx, key)
 	}
+	autoCompound, err := k.IsDelegatorAutoCompound(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if autoCompound {
+		if err := k.autoCompoundPositions.Set(ctx, positionKey); err != nil {
+			return err
+		}
+	}
 	return k.delegateStakePlacement.Set(ctx, key, stake)
 }
 
@@ -1107,85 +1507,722 @@ func (k *Keeper) SetDelegateRewardPerShare(ctx context.Context, topicId TopicId,
 	return k.delegateRewardPerShare.Set(ctx, key, share)
 }
 
-// Returns the amount of stake placed on a specific target.
-func (k *Keeper) GetDelegateStakeUponReputer(ctx context.Context, topicId TopicId, target ActorId) (cosmosMath.Int, error) {
-	key := collections.Join(topicId, target)
-	stake, err := k.stakeUponReputer.Get(ctx, key)
+// Returns the reward accrued by the stake a delegator placed on a reputer in a topic that has not been paid out yet
+func (k *Keeper) GetDelegateStakePendingReward(ctx context.Context, topicId TopicId, delegator ActorId, reputer ActorId) (alloraMath.Dec, error) {
+	stakePlacement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return cosmosMath.NewInt(0), nil
-		}
-		return cosmosMath.Int{}, err
+		return alloraMath.Dec{}, err
 	}
-	return stake, nil
-}
-
-// Sets the amount of stake placed on a specific target.
-func (k *Keeper) SetDelegateStakeUponReputer(ctx context.Context, topicId TopicId, target ActorId, stake cosmosMath.Int) error {
-	key := collections.Join(topicId, target)
-	if stake.IsZero() {
-		return k.stakeUponReputer.Remove(ctx, key)
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return alloraMath.Dec{}, err
 	}
-	return k.stakeUponReputer.Set(ctx, key, stake)
-}
-
-// For a given topic id and reputer address, get their stake removal information
-func (k *Keeper) GetStakeRemovalByTopicAndAddress(ctx context.Context, topicId TopicId, address ActorId) (types.StakeRemoval, error) {
-	key := collections.Join(topicId, address)
-	return k.stakeRemoval.Get(ctx, key)
-}
-
-// For a given address, adds their stake removal information to the removal queue for delay waiting
-// The topic used will be the topic set in the `removalInfo`
-// This completely overrides the existing stake removal
-func (k *Keeper) SetStakeRemoval(ctx context.Context, address ActorId, removalInfo types.StakeRemoval) error {
-	key := collections.Join(removalInfo.Placement.TopicId, address)
-	return k.stakeRemoval.Set(ctx, key, removalInfo)
-}
-
-// For a given topic id and reputer address, get their stake removal information
-func (k *Keeper) GetDelegateStakeRemovalByTopicAndAddress(ctx context.Context, topicId TopicId, reputer ActorId, delegator ActorId) (types.DelegateStakeRemoval, error) {
-	key := collections.Join3(topicId, reputer, delegator)
-	return k.delegateStakeRemoval.Get(ctx, key)
+	pendingReward, err := stakePlacement.Amount.Mul(share)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return pendingReward.Sub(stakePlacement.RewardDebt)
 }
 
-// For a given address, adds their stake removal information to the removal queue for delay waiting
-// The topic used will be the topic set in the `removalInfo`
-// This completely overrides the existing stake removal
-func (k *Keeper) SetDelegateStakeRemoval(ctx context.Context, removalInfo types.DelegateStakeRemoval) error {
-	key := collections.Join3(removalInfo.Placement.TopicId, removalInfo.Placement.Reputer, removalInfo.Placement.Delegator)
-	return k.delegateStakeRemoval.Set(ctx, key, removalInfo)
+// Returns the pending reward of every stake placement of a delegator, ordered by topic then reputer
+func (k *Keeper) GetDelegatorPendingRewards(ctx context.Context, delegator ActorId) ([]types.DelegatorPendingReward, error) {
+	nextTopicId, err := k.GetNextTopicId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pendingRewards := make([]types.DelegatorPendingReward, 0)
+	for topicId := TopicId(0); topicId < nextTopicId; topicId++ {
+		rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, delegator)
+		iter, err := k.delegateStakePlacement.Iterate(ctx, rng)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := iter.Keys()
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			reputer := key.K3()
+			stakePlacement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+			if err != nil {
+				return nil, err
+			}
+			pendingReward, err := k.GetDelegateStakePendingReward(ctx, topicId, delegator, reputer)
+			if err != nil {
+				return nil, err
+			}
+			pendingRewards = append(pendingRewards, types.DelegatorPendingReward{
+				TopicId:       topicId,
+				Reputer:       reputer,
+				Amount:        stakePlacement.Amount,
+				PendingReward: pendingReward,
+			})
+		}
+	}
+	return pendingRewards, nil
 }
 
-/// REPUTERS
-
-// Adds a new reputer to the reputer tracking data structures, reputers and topicReputers
-func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer ActorId, reputerInfo types.OffchainNode) error {
-	topicKey := collections.Join(topicId, reputer)
-	err := k.topicReputers.Set(ctx, topicKey)
+// Adds the pending reward of a delegator on a reputer in a topic to the stake it delegated to that reputer,
+// moving the tokens from the pending rewards account to the staking account
+func (k *Keeper) CompoundDelegateStakeReward(ctx context.Context, topicId TopicId, delegator ActorId, reputer ActorId) error {
+	pendingReward, err := k.GetDelegateStakePendingReward(ctx, topicId, delegator, reputer)
 	if err != nil {
 		return err
 	}
-	err = k.reputers.Set(ctx, reputerInfo.LibP2PKey, reputerInfo)
+	reward := pendingReward.SdkIntTrim()
+	if !reward.IsPositive() {
+		return nil
+	}
+	err = k.SendCoinsFromModuleToModule(
+		ctx,
+		types.AlloraPendingRewardForDelegatorAccountName,
+		types.AlloraStakingAccountName,
+		sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, reward)),
+	)
 	if err != nil {
 		return err
 	}
-	return nil
-}
 
-// Remove a reputer to the reputer tracking data structures and topicReputers
-func (k *Keeper) RemoveReputer(ctx context.Context, topicId TopicId, reputer ActorId) error {
-	topicKey := collections.Join(topicId, reputer)
-	err := k.topicReputers.Remove(ctx, topicKey)
+	// Settle the reward debt first so that adding the stake does not also pay the reward out
+	stakePlacement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
 	if err != nil {
 		return err
 	}
-	return nil
-}
-
-func (k *Keeper) GetReputerByLibp2pKey(ctx sdk.Context, reputerKey string) (types.OffchainNode, error) {
-	return k.reputers.Get(ctx, reputerKey)
-}
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	stakePlacement.RewardDebt, err = stakePlacement.Amount.Mul(share)
+	if err != nil {
+		return err
+	}
+	if err := k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, stakePlacement); err != nil {
+		return err
+	}
+
+	if err := k.AddStake(ctx, topicId, reputer, reward); err != nil {
+		return err
+	}
+	if err := k.AddDelegateStake(ctx, topicId, delegator, reputer, reward); err != nil {
+		return err
+	}
+
+	// Only whole tokens are compounded, the fraction of a token left over stays pending
+	rewardDec, err := alloraMath.NewDecFromSdkInt(reward)
+	if err != nil {
+		return err
+	}
+	remainder, err := pendingReward.Sub(rewardDec)
+	if err != nil {
+		return err
+	}
+	if remainder.IsZero() {
+		return nil
+	}
+	stakePlacement, err = k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return err
+	}
+	stakePlacement.RewardDebt, err = stakePlacement.RewardDebt.Sub(remainder)
+	if err != nil {
+		return err
+	}
+	return k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, stakePlacement)
+}
+
+func (k *Keeper) IsDelegatorAutoCompound(ctx context.Context, delegator ActorId) (bool, error) {
+	return k.delegatorAutoCompound.Has(ctx, delegator)
+}
+
+// Turns the auto-compounding of the rewards of a delegator on or off, for all the stake it has placed and
+// will place. It can only be turned on by a delegator that has placed stake.
+func (k *Keeper) SetDelegatorAutoCompound(ctx context.Context, delegator ActorId, autoCompound bool) error {
+	nextTopicId, err := k.GetNextTopicId(ctx)
+	if err != nil {
+		return err
+	}
+	hasPlacement := false
+	for topicId := TopicId(0); topicId < nextTopicId; topicId++ {
+		rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, delegator)
+		iter, err := k.delegateStakePlacement.Iterate(ctx, rng)
+		if err != nil {
+			return err
+		}
+		keys, err := iter.Keys()
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			hasPlacement = true
+			positionKey := collections.Join3(topicId, key.K3(), delegator)
+			if autoCompound {
+				err = k.autoCompoundPositions.Set(ctx, positionKey)
+			} else {
+				err = k.autoCompoundPositions.Remove(ctx, positionKey)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if !autoCompound {
+		return k.delegatorAutoCompound.Remove(ctx, delegator)
+	}
+	if !hasPlacement {
+		return types.ErrNoDelegateStakePlacement
+	}
+	return k.delegatorAutoCompound.Set(ctx, delegator)
+}
+
+// Returns the commission of a reputer, or collections.ErrNotFound if the reputer never set one
+func (k *Keeper) GetReputerCommission(ctx context.Context, reputer ActorId) (types.ReputerCommission, error) {
+	return k.reputerCommissions.Get(ctx, reputer)
+}
+
+func (k *Keeper) SetReputerCommission(ctx context.Context, reputer ActorId, commission types.ReputerCommission) error {
+	return k.reputerCommissions.Set(ctx, reputer, commission)
+}
+
+// Returns the commission rate of a reputer, which is zero if the reputer never set a commission
+func (k *Keeper) GetReputerCommissionRate(ctx context.Context, reputer ActorId) (alloraMath.Dec, error) {
+	commission, err := k.reputerCommissions.Get(ctx, reputer)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), nil
+		}
+		return alloraMath.Dec{}, err
+	}
+	return commission.Rate, nil
+}
+
+// Returns the delegators of a reputer in a topic whose rewards are added to their stake as they settle,
+// ordered by address
+func (k *Keeper) GetAutoCompoundDelegators(ctx context.Context, topicId TopicId, reputer ActorId) ([]ActorId, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, reputer)
+	iter, err := k.autoCompoundPositions.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	delegators := make([]ActorId, 0, len(keys))
+	for _, key := range keys {
+		delegators = append(delegators, key.K3())
+	}
+	return delegators, nil
+}
+
+// Returns the amount of stake placed on a specific target.
+func (k *Keeper) GetDelegateStakeUponReputer(ctx context.Context, topicId TopicId, target ActorId) (cosmosMath.Int, error) {
+	key := collections.Join(topicId, target)
+	stake, err := k.stakeUponReputer.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return cosmosMath.NewInt(0), nil
+		}
+		return cosmosMath.Int{}, err
+	}
+	return stake, nil
+}
+
+// Sets the amount of stake placed on a specific target.
+func (k *Keeper) SetDelegateStakeUponReputer(ctx context.Context, topicId TopicId, target ActorId, stake cosmosMath.Int) error {
+	key := collections.Join(topicId, target)
+	if stake.IsZero() {
+		return k.stakeUponReputer.Remove(ctx, key)
+	}
+	return k.stakeUponReputer.Set(ctx, key, stake)
+}
+
+// For a given topic id and reputer address, get their stake removal information
+func (k *Keeper) GetStakeRemovalByTopicAndAddress(ctx context.Context, topicId TopicId, address ActorId) (types.StakeRemoval, error) {
+	key := collections.Join(topicId, address)
+	return k.stakeRemoval.Get(ctx, key)
+}
+
+// For a given address, adds their stake removal information to the removal queue for delay waiting
+// The topic used will be the topic set in the `removalInfo`
+// This completely overrides the existing stake removal
+func (k *Keeper) SetStakeRemoval(ctx context.Context, address ActorId, removalInfo types.StakeRemoval) error {
+	key := collections.Join(removalInfo.Placement.TopicId, address)
+	return k.stakeRemoval.Set(ctx, key, removalInfo)
+}
+
+// For a given topic id and reputer address, get their stake removal information
+func (k *Keeper) GetDelegateStakeRemovalByTopicAndAddress(ctx context.Context, topicId TopicId, reputer ActorId, delegator ActorId) (types.DelegateStakeRemoval, error) {
+	key := collections.Join3(topicId, reputer, delegator)
+	return k.delegateStakeRemoval.Get(ctx, key)
+}
+
+// For a given address, adds their stake removal information to the removal queue for delay waiting
+// The topic used will be the topic set in the `removalInfo`
+// This completely overrides the existing stake removal
+func (k *Keeper) SetDelegateStakeRemoval(ctx context.Context, removalInfo types.DelegateStakeRemoval) error {
+	key := collections.Join3(removalInfo.Placement.TopicId, removalInfo.Placement.Reputer, removalInfo.Placement.Delegator)
+	return k.delegateStakeRemoval.Set(ctx, key, removalInfo)
+}
+
+// Moves delegate stake from a reputer in a topic to another reputer or topic at once.
+// The pending rewards of both positions are paid out to the delegator before their amounts change,
+// and the move is recorded against the source position for the stake removal delay window.
+func (k *Keeper) RedelegateStake(
+	ctx context.Context,
+	delegator ActorId,
+	srcTopicId TopicId,
+	srcReputer ActorId,
+	dstTopicId TopicId,
+	dstReputer ActorId,
+	stake cosmosMath.Int,
+) error {
+	// Removing the delegate stake first leaves the stake to remove from the source reputer as its own
+	if err := k.RemoveDelegateStake(ctx, srcTopicId, delegator, srcReputer, stake); err != nil {
+		return err
+	}
+	if err := k.RemoveStake(ctx, srcTopicId, srcReputer, stake); err != nil {
+		return err
+	}
+	if err := k.AddStake(ctx, dstTopicId, dstReputer, stake); err != nil {
+		return err
+	}
+	if err := k.AddDelegateStake(ctx, dstTopicId, delegator, dstReputer, stake); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	redelegation := types.Redelegation{
+		Delegator:    delegator,
+		SrcTopicId:   srcTopicId,
+		SrcReputer:   srcReputer,
+		DstTopicId:   dstTopicId,
+		DstReputer:   dstReputer,
+		Amount:       stake,
+		BlockStarted: sdkCtx.BlockHeight(),
+	}
+	if err := appendRedelegation(ctx, k.redelegations, collections.Join3(srcTopicId, srcReputer, delegator), redelegation); err != nil {
+		return err
+	}
+	if err := appendRedelegation(ctx, k.redelegationsTo, collections.Join3(dstTopicId, dstReputer, delegator), redelegation); err != nil {
+		return err
+	}
+	return appendRedelegation(ctx, k.redelegationsByBlock, collections.Join(redelegation.BlockStarted, delegator), redelegation)
+}
+
+func appendRedelegation[K any](
+	ctx context.Context,
+	m collections.Map[K, types.Redelegations],
+	key K,
+	redelegation types.Redelegation,
+) error {
+	redelegations, err := m.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	redelegations.Redelegations = append(redelegations.Redelegations, redelegation)
+	return m.Set(ctx, key, redelegations)
+}
+
+// Returns whether stake of a delegator was redelegated to a position within the stake removal delay window,
+// in which case the stake of that position can not be redelegated again until the window ends
+func (k *Keeper) HasInFlightRedelegationTo(ctx context.Context, topicId TopicId, reputer ActorId, delegator ActorId) (bool, error) {
+	redelegations, err := k.GetInFlightRedelegationsTo(ctx, topicId, reputer, delegator)
+	if err != nil {
+		return false, err
+	}
+	return len(redelegations) > 0, nil
+}
+
+// Returns the redelegations to a position of a delegator that are still within the stake removal delay window.
+// The stake they moved is still bound to the reputer it came from until the window ends.
+func (k *Keeper) GetInFlightRedelegationsTo(ctx context.Context, topicId TopicId, reputer ActorId, delegator ActorId) ([]types.Redelegation, error) {
+	redelegations, err := k.redelegationsTo.Get(ctx, collections.Join3(topicId, reputer, delegator))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return []types.Redelegation{}, nil
+		}
+		return nil, err
+	}
+	return k.filterInFlightRedelegations(ctx, redelegations.Redelegations)
+}
+
+// Returns the redelegations away from a position of a delegator that are still within the stake removal delay window
+func (k *Keeper) GetInFlightRedelegations(ctx context.Context, topicId TopicId, reputer ActorId, delegator ActorId) ([]types.Redelegation, error) {
+	redelegations, err := k.redelegations.Get(ctx, collections.Join3(topicId, reputer, delegator))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return []types.Redelegation{}, nil
+		}
+		return nil, err
+	}
+	return k.filterInFlightRedelegations(ctx, redelegations.Redelegations)
+}
+
+// Returns the redelegations away from a reputer in a topic that are still within the stake removal delay window
+func (k *Keeper) GetInFlightRedelegationsFromReputer(ctx context.Context, topicId TopicId, reputer ActorId) ([]types.Redelegation, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, reputer)
+	iter, err := k.redelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	values, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+	redelegations := make([]types.Redelegation, 0)
+	for _, value := range values {
+		redelegations = append(redelegations, value.Redelegations...)
+	}
+	return k.filterInFlightRedelegations(ctx, redelegations)
+}
+
+func (k *Keeper) filterInFlightRedelegations(ctx context.Context, redelegations []types.Redelegation) ([]types.Redelegation, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	inFlight := make([]types.Redelegation, 0, len(redelegations))
+	for _, redelegation := range redelegations {
+		if redelegation.BlockStarted+moduleParams.RemoveStakeDelayWindow >= blockHeight {
+			inFlight = append(inFlight, redelegation)
+		}
+	}
+	return inFlight, nil
+}
+
+// Removes the records of the redelegations whose stake removal delay window is over at the block height
+func (k *Keeper) PruneExpiredRedelegations(ctx context.Context, blockHeight BlockHeight) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	lastExpiredBlock := blockHeight - moduleParams.RemoveStakeDelayWindow - 1
+	rng := collections.NewPrefixUntilPairRange[BlockHeight, ActorId](lastExpiredBlock)
+	iter, err := k.redelegationsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		for _, redelegation := range kv.Value.Redelegations {
+			srcKey := collections.Join3(redelegation.SrcTopicId, redelegation.SrcReputer, redelegation.Delegator)
+			if err := pruneRedelegations(ctx, k.redelegations, srcKey, lastExpiredBlock); err != nil {
+				return err
+			}
+			dstKey := collections.Join3(redelegation.DstTopicId, redelegation.DstReputer, redelegation.Delegator)
+			if err := pruneRedelegations(ctx, k.redelegationsTo, dstKey, lastExpiredBlock); err != nil {
+				return err
+			}
+		}
+		if err := k.redelegationsByBlock.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Drops the redelegations of a position that started at or before lastExpiredBlock
+func pruneRedelegations(
+	ctx context.Context,
+	m collections.Map[collections.Triple[TopicId, ActorId, ActorId], types.Redelegations],
+	key collections.Triple[TopicId, ActorId, ActorId],
+	lastExpiredBlock BlockHeight,
+) error {
+	redelegations, err := m.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	kept := make([]types.Redelegation, 0, len(redelegations.Redelegations))
+	for _, redelegation := range redelegations.Redelegations {
+		if redelegation.BlockStarted > lastExpiredBlock {
+			kept = append(kept, redelegation)
+		}
+	}
+	if len(kept) == 0 {
+		return m.Remove(ctx, key)
+	}
+	return m.Set(ctx, key, types.Redelegations{Redelegations: kept})
+}
+
+/// REPUTER STAKE POOL
+
+// Returns the topic-agnostic stake of a reputer, which is zero if the reputer never added any
+func (k *Keeper) GetReputerStakePool(ctx context.Context, reputer ActorId) (cosmosMath.Int, error) {
+	pool, err := k.reputerStakePool.Get(ctx, reputer)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return cosmosMath.ZeroInt(), nil
+		}
+		return cosmosMath.Int{}, err
+	}
+	return pool, nil
+}
+
+// Returns the fraction of its stake pool a reputer allocates to a topic, which is zero if none is allocated
+func (k *Keeper) GetReputerStakeAllocation(ctx context.Context, reputer ActorId, topicId TopicId) (alloraMath.Dec, error) {
+	fraction, err := k.reputerStakeAllocations.Get(ctx, collections.Join(reputer, topicId))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), nil
+		}
+		return alloraMath.Dec{}, err
+	}
+	return fraction, nil
+}
+
+// Returns the allocations of the stake pool of a reputer, ordered by topic id
+func (k *Keeper) GetReputerStakeAllocations(ctx context.Context, reputer ActorId) ([]types.ReputerStakeAllocation, error) {
+	rng := collections.NewPrefixedPairRange[ActorId, TopicId](reputer)
+	iter, err := k.reputerStakeAllocations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+	allocations := make([]types.ReputerStakeAllocation, 0, len(kvs))
+	for _, kv := range kvs {
+		allocations = append(allocations, types.ReputerStakeAllocation{TopicId: kv.Key.K2(), Fraction: kv.Value})
+	}
+	return allocations, nil
+}
+
+// Returns the part of the stake of a reputer in a topic that comes from its stake pool
+func (k *Keeper) GetAllocatedStakeOfReputerInTopic(ctx context.Context, topicId TopicId, reputer ActorId) (cosmosMath.Int, error) {
+	pool, err := k.GetReputerStakePool(ctx, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	fraction, err := k.GetReputerStakeAllocation(ctx, reputer, topicId)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return allocatedStake(pool, fraction)
+}
+
+func allocatedStake(pool cosmosMath.Int, fraction alloraMath.Dec) (cosmosMath.Int, error) {
+	poolDec, err := alloraMath.NewDecFromSdkInt(pool)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	stake, err := poolDec.Mul(fraction)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return stake.SdkIntTrim(), nil
+}
+
+// Brings the stake of a reputer in a topic from one allocated amount to another.
+// The stake of the topic and the total stake follow, as they would for stake placed directly.
+func (k *Keeper) moveAllocatedStake(ctx context.Context, topicId TopicId, reputer ActorId, from cosmosMath.Int, to cosmosMath.Int) error {
+	if to.GT(from) {
+		return k.AddStake(ctx, topicId, reputer, to.Sub(from))
+	}
+	return k.RemoveStake(ctx, topicId, reputer, from.Sub(to))
+}
+
+// Sets the stake pool of a reputer and derives the stake it has allocated to each topic from the new pool
+func (k *Keeper) setReputerStakePool(ctx context.Context, reputer ActorId, pool cosmosMath.Int) error {
+	oldPool, err := k.GetReputerStakePool(ctx, reputer)
+	if err != nil {
+		return err
+	}
+	allocations, err := k.GetReputerStakeAllocations(ctx, reputer)
+	if err != nil {
+		return err
+	}
+	for _, allocation := range allocations {
+		from, err := allocatedStake(oldPool, allocation.Fraction)
+		if err != nil {
+			return err
+		}
+		to, err := allocatedStake(pool, allocation.Fraction)
+		if err != nil {
+			return err
+		}
+		if err := k.moveAllocatedStake(ctx, allocation.TopicId, reputer, from, to); err != nil {
+			return err
+		}
+	}
+	if pool.IsZero() {
+		return k.reputerStakePool.Remove(ctx, reputer)
+	}
+	return k.reputerStakePool.Set(ctx, reputer, pool)
+}
+
+// Adds stake to the stake pool of a reputer, raising its stake in every topic it allocates to
+func (k *Keeper) AddReputerStakePool(ctx context.Context, reputer ActorId, stake cosmosMath.Int) error {
+	pool, err := k.GetReputerStakePool(ctx, reputer)
+	if err != nil {
+		return err
+	}
+	return k.setReputerStakePool(ctx, reputer, pool.Add(stake))
+}
+
+// Removes stake from the stake pool of a reputer, lowering its stake in every topic it allocates to
+func (k *Keeper) RemoveReputerStakePool(ctx context.Context, reputer ActorId, stake cosmosMath.Int) error {
+	pool, err := k.GetReputerStakePool(ctx, reputer)
+	if err != nil {
+		return err
+	}
+	if stake.GT(pool) {
+		return types.ErrInsufficientReputerStakePool
+	}
+	return k.setReputerStakePool(ctx, reputer, pool.Sub(stake))
+}
+
+// Sets the fraction of its stake pool a reputer allocates to a topic and moves its stake in the topic to match.
+// The fractions allocated by a reputer across all topics may not sum to more than 1.
+func (k *Keeper) SetReputerStakeAllocation(ctx context.Context, reputer ActorId, topicId TopicId, fraction alloraMath.Dec) error {
+	if fraction.IsNegative() || fraction.Gt(alloraMath.OneDec()) {
+		return types.ErrInvalidReputerStakeAllocation
+	}
+	allocations, err := k.GetReputerStakeAllocations(ctx, reputer)
+	if err != nil {
+		return err
+	}
+	sum := fraction
+	oldFraction := alloraMath.ZeroDec()
+	for _, allocation := range allocations {
+		if allocation.TopicId == topicId {
+			oldFraction = allocation.Fraction
+			continue
+		}
+		sum, err = sum.Add(allocation.Fraction)
+		if err != nil {
+			return err
+		}
+	}
+	if sum.Gt(alloraMath.OneDec()) {
+		return types.ErrInvalidReputerStakeAllocation
+	}
+
+	pool, err := k.GetReputerStakePool(ctx, reputer)
+	if err != nil {
+		return err
+	}
+	from, err := allocatedStake(pool, oldFraction)
+	if err != nil {
+		return err
+	}
+	to, err := allocatedStake(pool, fraction)
+	if err != nil {
+		return err
+	}
+	if err := k.moveAllocatedStake(ctx, topicId, reputer, from, to); err != nil {
+		return err
+	}
+
+	key := collections.Join(reputer, topicId)
+	if fraction.IsZero() {
+		return k.reputerStakeAllocations.Remove(ctx, key)
+	}
+	return k.reputerStakeAllocations.Set(ctx, key, fraction)
+}
+
+// Returns the pending lower allocation of a reputer to a topic
+func (k *Keeper) GetReputerStakeAllocationDecrease(ctx context.Context, reputer ActorId, topicId TopicId) (types.ReputerStakeAllocationDecrease, error) {
+	return k.reputerStakeAllocationDecreases.Get(ctx, collections.Join(reputer, topicId))
+}
+
+// Returns the pending lower allocations of a reputer, ordered by topic id
+func (k *Keeper) GetReputerStakeAllocationDecreases(ctx context.Context, reputer ActorId) ([]types.ReputerStakeAllocationDecrease, error) {
+	rng := collections.NewPrefixedPairRange[ActorId, TopicId](reputer)
+	iter, err := k.reputerStakeAllocationDecreases.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// Queues a lower allocation of a reputer to a topic, overriding any lower allocation already queued
+func (k *Keeper) SetReputerStakeAllocationDecrease(ctx context.Context, decrease types.ReputerStakeAllocationDecrease) error {
+	key := collections.Join(decrease.Reputer, decrease.Allocation.TopicId)
+	return k.reputerStakeAllocationDecreases.Set(ctx, key, decrease)
+}
+
+func (k *Keeper) DeleteReputerStakeAllocationDecrease(ctx context.Context, reputer ActorId, topicId TopicId) error {
+	return k.reputerStakeAllocationDecreases.Remove(ctx, collections.Join(reputer, topicId))
+}
+
+// Returns the pending removal from the stake pool of a reputer
+func (k *Keeper) GetReputerStakePoolRemoval(ctx context.Context, reputer ActorId) (types.ReputerStakePoolRemoval, error) {
+	return k.reputerStakePoolRemovals.Get(ctx, reputer)
+}
+
+// Queues a removal from the stake pool of a reputer, overriding any removal already queued
+func (k *Keeper) SetReputerStakePoolRemoval(ctx context.Context, removal types.ReputerStakePoolRemoval) error {
+	return k.reputerStakePoolRemovals.Set(ctx, removal.Reputer, removal)
+}
+
+func (k *Keeper) DeleteReputerStakePoolRemoval(ctx context.Context, reputer ActorId) error {
+	return k.reputerStakePoolRemovals.Remove(ctx, reputer)
+}
+
+/// REPUTERS
+
+// Adds a new reputer to the reputer tracking data structures, reputers and topicReputers
+func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer ActorId, reputerInfo types.OffchainNode) error {
+	topicKey := collections.Join(topicId, reputer)
+	err := k.topicReputers.Set(ctx, topicKey)
+	if err != nil {
+		return err
+	}
+	err = k.reputers.Set(ctx, reputerInfo.LibP2PKey, reputerInfo)
+	if err != nil {
+		return err
+	}
+	types.EmitNewActorRegisteredEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, true, reputerInfo)
+	return nil
+}
+
+// Remove a reputer to the reputer tracking data structures and topicReputers
+func (k *Keeper) RemoveReputer(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	topicKey := collections.Join(topicId, reputer)
+	registered, err := k.topicReputers.Has(ctx, topicKey)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		return nil
+	}
+	err = k.topicReputers.Remove(ctx, topicKey)
+	if err != nil {
+		return err
+	}
+	types.EmitNewActorDeregisteredEvent(sdk.UnwrapSDKContext(ctx), topicId, reputer, true)
+	return nil
+}
+
+// Returns the reputers registered in a topic, ordered by address
+func (k *Keeper) GetTopicReputers(ctx context.Context, topicId TopicId) ([]ActorId, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	iter, err := k.topicReputers.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	reputers := make([]ActorId, len(keys))
+	for i, key := range keys {
+		reputers[i] = key.K2()
+	}
+	return reputers, nil
+}
+
+func (k *Keeper) GetReputerByLibp2pKey(ctx sdk.Context, reputerKey string) (types.OffchainNode, error) {
+	return k.reputers.Get(ctx, reputerKey)
+}
 
 /// WORKERS
 
@@ -1200,19 +2237,46 @@ func (k *Keeper) InsertWorker(ctx context.Context, topicId TopicId, worker Actor
 	if err != nil {
 		return err
 	}
+	types.EmitNewActorRegisteredEvent(sdk.UnwrapSDKContext(ctx), topicId, worker, false, workerInfo)
 	return nil
 }
 
 // Remove a worker to the worker tracking data structures and topicWorkers
 func (k *Keeper) RemoveWorker(ctx context.Context, topicId TopicId, worker ActorId) error {
 	topicKey := collections.Join(topicId, worker)
-	err := k.topicWorkers.Remove(ctx, topicKey)
+	registered, err := k.topicWorkers.Has(ctx, topicKey)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		return nil
+	}
+	err = k.topicWorkers.Remove(ctx, topicKey)
 	if err != nil {
 		return err
 	}
+	types.EmitNewActorDeregisteredEvent(sdk.UnwrapSDKContext(ctx), topicId, worker, false)
 	return nil
 }
 
+// Returns the workers registered in a topic, ordered by address
+func (k *Keeper) GetTopicWorkers(ctx context.Context, topicId TopicId) ([]ActorId, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	iter, err := k.topicWorkers.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	workers := make([]ActorId, len(keys))
+	for i, key := range keys {
+		workers[i] = key.K2()
+	}
+	return workers, nil
+}
+
 func (k *Keeper) GetWorkerByLibp2pKey(ctx sdk.Context, workerKey string) (types.OffchainNode, error) {
 	return k.workers.Get(ctx, workerKey)
 }
@@ -1279,6 +2343,7 @@ func (k *Keeper) InactivateTopic(ctx context.Context, topicId TopicId) error {
 		if err != nil {
 			return err
 		}
+		types.EmitNewTopicInactivatedEvent(sdk.UnwrapSDKContext(ctx), topicId)
 	}
 
 	return nil
@@ -1295,9 +2360,18 @@ func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
 		return nil
 	}
 
+	isActive, err := k.activeTopics.Has(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if isActive {
+		return nil
+	}
+
 	if err := k.activeTopics.Set(ctx, topicId); err != nil {
 		return err
 	}
+	types.EmitNewTopicActivatedEvent(sdk.UnwrapSDKContext(ctx), topicId)
 	return nil
 }
 
@@ -1330,6 +2404,21 @@ func (k *Keeper) IsTopicActive(ctx context.Context, topicId TopicId) (bool, erro
 	return k.activeTopics.Has(ctx, topicId)
 }
 
+// Returns the number of active topics
+func (k *Keeper) GetActiveTopicCount(ctx context.Context) (int, error) {
+	iter, err := k.activeTopics.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
 func (k Keeper) GetIdsOfActiveTopics(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) ([]TopicId, *types.SimpleCursorPaginationResponse, error) {
 	limit, start, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
 	if err != nil {
@@ -1410,7 +2499,12 @@ func (k *Keeper) AddTopicFeeRevenue(ctx context.Context, topicId TopicId, amount
 		Epoch:   topicFeeRevenue.Epoch,
 		Revenue: topicFeeRevenue.Revenue.Add(amount),
 	}
-	return k.topicFeeRevenue.Set(ctx, topicId, newTopicFeeRevenue)
+	err = k.topicFeeRevenue.Set(ctx, topicId, newTopicFeeRevenue)
+	if err != nil {
+		return err
+	}
+	types.EmitNewTopicFeeRevenueAddedEvent(sdk.UnwrapSDKContext(ctx), topicId, amount)
+	return nil
 }
 
 // Drop the fee revenue by the global Ecosystem bucket drip amount
@@ -1437,7 +2531,18 @@ func (k *Keeper) DripTopicFeeRevenue(ctx context.Context, topicId TopicId, block
 		}
 		newTopicFeeRevenue.Revenue = val.SdkIntTrim()
 	}
-	return k.topicFeeRevenue.Set(ctx, topicId, newTopicFeeRevenue)
+	err = k.topicFeeRevenue.Set(ctx, topicId, newTopicFeeRevenue)
+	if err != nil {
+		return err
+	}
+	types.EmitNewTopicFeeRevenueDrippedEvent(
+		sdk.UnwrapSDKContext(ctx),
+		topicId,
+		block,
+		topicFeeRevenue.Revenue.Sub(newTopicFeeRevenue.Revenue),
+		newTopicFeeRevenue.Revenue,
+	)
+	return nil
 }
 
 /// CHURNABLE TOPICS
@@ -1856,6 +2961,148 @@ func (k *Keeper) RemoveWhitelistAdmin(ctx context.Context, admin ActorId) error
 	return k.whitelistAdmins.Remove(ctx, admin)
 }
 
+func (k *Keeper) topicAllowlist(isReputer bool) collections.KeySet[collections.Pair[TopicId, ActorId]] {
+	if isReputer {
+		return k.topicReputerAllowlist
+	}
+	return k.topicWorkerAllowlist
+}
+
+func (k *Keeper) IsInTopicAllowlist(ctx context.Context, topicId TopicId, address ActorId, isReputer bool) (bool, error) {
+	return k.topicAllowlist(isReputer).Has(ctx, collections.Join(topicId, address))
+}
+
+func (k *Keeper) AddToTopicAllowlist(ctx context.Context, topicId TopicId, address ActorId, isReputer bool) error {
+	return k.topicAllowlist(isReputer).Set(ctx, collections.Join(topicId, address))
+}
+
+func (k *Keeper) RemoveFromTopicAllowlist(ctx context.Context, topicId TopicId, address ActorId, isReputer bool) error {
+	return k.topicAllowlist(isReputer).Remove(ctx, collections.Join(topicId, address))
+}
+
+// Returns the workers or reputers allowed to register in a permissioned topic, ordered by address
+func (k *Keeper) GetTopicAllowlist(ctx context.Context, topicId TopicId, isReputer bool) ([]ActorId, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	iter, err := k.topicAllowlist(isReputer).Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]ActorId, len(keys))
+	for i, key := range keys {
+		addresses[i] = key.K2()
+	}
+	return addresses, nil
+}
+
+func (k *Keeper) IsInTopicCreatorAllowlist(ctx context.Context, address ActorId) (bool, error) {
+	return k.topicCreatorAllowlist.Has(ctx, address)
+}
+
+func (k *Keeper) AddToTopicCreatorAllowlist(ctx context.Context, address ActorId) error {
+	return k.topicCreatorAllowlist.Set(ctx, address)
+}
+
+func (k *Keeper) RemoveFromTopicCreatorAllowlist(ctx context.Context, address ActorId) error {
+	return k.topicCreatorAllowlist.Remove(ctx, address)
+}
+
+// Returns the accounts allowed to create topics while the topic creator allowlist is enabled, ordered by address
+func (k *Keeper) GetTopicCreatorAllowlist(ctx context.Context) ([]ActorId, error) {
+	iter, err := k.topicCreatorAllowlist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
+
+/// CLAIMABLE REWARDS
+
+// Credits rewards to an actor, to be claimed later. The coins must already be in the claimable rewards account.
+func (k *Keeper) CreditClaimableRewards(ctx context.Context, actor ActorId, rewards sdk.Coins) error {
+	for _, coin := range rewards {
+		key := collections.Join(actor, coin.Denom)
+		credited, err := k.claimableRewards.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			credited = cosmosMath.ZeroInt()
+		}
+		err = k.claimableRewards.Set(ctx, key, credited.Add(coin.Amount))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the rewards credited to an actor and not claimed yet
+func (k *Keeper) GetClaimableRewards(ctx context.Context, actor ActorId) (sdk.Coins, error) {
+	rng := collections.NewPrefixedPairRange[ActorId, string](actor)
+	iter, err := k.claimableRewards.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+	rewards := sdk.NewCoins()
+	for _, kv := range kvs {
+		rewards = rewards.Add(sdk.NewCoin(kv.Key.K2(), kv.Value))
+	}
+	return rewards, nil
+}
+
+// Sends all the rewards credited to an actor to its withdraw address and returns them
+func (k *Keeper) ClaimRewards(ctx context.Context, actor ActorId) (sdk.Coins, error) {
+	rewards, err := k.GetClaimableRewards(ctx, actor)
+	if err != nil {
+		return nil, err
+	}
+	if rewards.IsZero() {
+		return nil, types.ErrNoClaimableRewards
+	}
+	for _, coin := range rewards {
+		err = k.claimableRewards.Remove(ctx, collections.Join(actor, coin.Denom))
+		if err != nil {
+			return nil, err
+		}
+	}
+	withdrawAddress, err := k.GetRewardWithdrawAddress(ctx, actor)
+	if err != nil {
+		return nil, err
+	}
+	err = k.SendCoinsFromModuleToAccount(ctx, types.AlloraClaimableRewardsAccountName, withdrawAddress, rewards)
+	if err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+// Returns the address the claimed rewards of an actor are sent to, which is the actor itself unless it set another
+func (k *Keeper) GetRewardWithdrawAddress(ctx context.Context, actor ActorId) (ActorId, error) {
+	withdrawAddress, err := k.rewardWithdrawAddresses.Get(ctx, actor)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return actor, nil
+		}
+		return "", err
+	}
+	return withdrawAddress, nil
+}
+
+func (k *Keeper) SetRewardWithdrawAddress(ctx context.Context, actor ActorId, withdrawAddress ActorId) error {
+	if withdrawAddress == actor {
+		return k.rewardWithdrawAddresses.Remove(ctx, actor)
+	}
+	return k.rewardWithdrawAddresses.Set(ctx, actor, withdrawAddress)
+}
+
 /// BANK KEEPER WRAPPERS
 
 // SendCoinsFromModuleToModule
@@ -1958,6 +3205,23 @@ func (k *Keeper) PruneRecordsAfterRewards(ctx context.Context, topicId TopicId,
 	if err != nil {
 		return err
 	}
+	err = k.topicRewardReports.Clear(ctx, blockRange)
+	if err != nil {
+		return err
+	}
+	// Commitments and pending payloads of nonces that were never fulfilled
+	err = pruneTopicBlockActorMap(ctx, k.workerCommitments, topicId, blockHeight)
+	if err != nil {
+		return err
+	}
+	err = pruneTopicBlockActorMap(ctx, k.pendingWorkerPayloads, topicId, blockHeight)
+	if err != nil {
+		return err
+	}
+	err = pruneTopicBlockActorMap(ctx, k.pendingReputerPayloads, topicId, blockHeight)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -1978,6 +3242,34 @@ func (k *Keeper) pruneNetworkLosses(ctx context.Context, blockRange *collections
 	return k.networkLossBundles.Clear(ctx, blockRange)
 }
 
+// Removes the entries of a (topic, block_height, actor) map up to and including blockHeight
+func pruneTopicBlockActorMap[V any](
+	ctx context.Context,
+	m collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], V],
+	topicId TopicId,
+	blockHeight BlockHeight,
+) error {
+	rng := collections.NewPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId)
+	iter, err := m.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		// Keys are ordered by block height within a topic
+		if key.K2() > blockHeight {
+			break
+		}
+		if err := m.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHeightThreshold int64) error {
 	nonces, err := k.unfulfilledWorkerNonces.Get(ctx, topicId)
 	if err != nil {
@@ -1986,9 +3278,12 @@ func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHei
 
 	// Filter Nonces based on block_height
 	filteredNonces := make([]*types.Nonce, 0)
+	expiredNonces := make([]*types.Nonce, 0)
 	for _, nonce := range nonces.Nonces {
 		if nonce.BlockHeight >= blockHeightThreshold {
 			filteredNonces = append(filteredNonces, nonce)
+		} else {
+			expiredNonces = append(expiredNonces, nonce)
 		}
 	}
 
@@ -1998,6 +3293,12 @@ func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHei
 		return err
 	}
 
+	for _, nonce := range expiredNonces {
+		if err := k.dropWorkerNonce(ctx, topicId, *nonce); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -2009,9 +3310,12 @@ func (k *Keeper) PruneReputerNonces(ctx context.Context, topicId uint64, blockHe
 
 	// Filter Nonces based on block_height
 	filteredNonces := make([]*types.ReputerRequestNonce, 0)
+	expiredNonces := make([]*types.ReputerRequestNonce, 0)
 	for _, nonce := range nonces.Nonces {
 		if nonce.ReputerNonce.BlockHeight >= blockHeightThreshold {
 			filteredNonces = append(filteredNonces, nonce)
+		} else {
+			expiredNonces = append(expiredNonces, nonce)
 		}
 	}
 
@@ -2021,6 +3325,12 @@ func (k *Keeper) PruneReputerNonces(ctx context.Context, topicId uint64, blockHe
 		return err
 	}
 
+	for _, nonce := range expiredNonces {
+		if err := k.dropReputerNonce(ctx, topicId, *nonce.ReputerNonce); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"encoding/binary"
 	"errors"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -23,6 +24,8 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
@@ -60,7 +63,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.authKeeper = emissionstestutil.NewMockAccountKeeper(ctrl)
 
 	s.ctx = ctx
-	s.emissionsKeeper = keeper.NewKeeper(encCfg.Codec, addressCodec, storeService, s.authKeeper, s.bankKeeper, "fee_collector")
+	s.emissionsKeeper = keeper.NewKeeper(encCfg.Codec, addressCodec, storeService, s.authKeeper, s.bankKeeper, "fee_collector", authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	s.msgServer = msgserver.NewMsgServerImpl(s.emissionsKeeper)
 	s.mockCtrl = ctrl
 	s.key = key
@@ -101,6 +104,29 @@ func (s *KeeperTestSuite) TestAddWorkerNonce() {
 	s.Require().Equal(newNonce.BlockHeight, unfulfilledNonces.Nonces[0].BlockHeight, "Unfulfilled nonces should contain the new nonce")
 }
 
+func (s *KeeperTestSuite) TestAddWorkerNonceUsesTopicMaxUnfulfilledWorkerRequests() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	otherTopicId := uint64(2)
+
+	err := keeper.SetTopicParams(ctx, topicId, types.TopicParams{MaxUnfulfilledWorkerRequests: []uint64{2}})
+	s.Require().NoError(err)
+
+	for blockHeight := int64(1); blockHeight <= 5; blockHeight++ {
+		s.Require().NoError(keeper.AddWorkerNonce(ctx, topicId, &types.Nonce{BlockHeight: blockHeight}))
+		s.Require().NoError(keeper.AddWorkerNonce(ctx, otherTopicId, &types.Nonce{BlockHeight: blockHeight}))
+	}
+
+	unfulfilledNonces, err := keeper.GetUnfulfilledWorkerNonces(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Len(unfulfilledNonces.Nonces, 2, "Only the most recent nonces allowed by the topic should be kept")
+	s.Require().Equal(int64(5), unfulfilledNonces.Nonces[0].BlockHeight)
+	unfulfilledNonces, err = keeper.GetUnfulfilledWorkerNonces(ctx, otherTopicId)
+	s.Require().NoError(err)
+	s.Require().Len(unfulfilledNonces.Nonces, 5)
+}
+
 func (s *KeeperTestSuite) TestNewlyAddedWorkerNonceIsUnfulfilled() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -2658,6 +2684,29 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 	s.Require().Error(err, "Should return error for non-existent data")
 }
 
+func (s *KeeperTestSuite) TestPruneRecordsAfterRewardsRemovesStaleWorkerCommitments() {
+	topicId := uint64(1)
+	worker := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	commitment := make([]byte, types.WorkerCommitmentLength)
+	oldNonce := types.Nonce{BlockHeight: 100}
+	newNonce := types.Nonce{BlockHeight: 200}
+
+	err := s.emissionsKeeper.SetWorkerCommitment(s.ctx, topicId, oldNonce, worker, commitment)
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.SetWorkerCommitment(s.ctx, topicId, newNonce, worker, commitment)
+	s.Require().NoError(err)
+
+	err = s.emissionsKeeper.PruneRecordsAfterRewards(s.ctx, topicId, oldNonce.BlockHeight)
+	s.Require().NoError(err)
+
+	pruned, err := s.emissionsKeeper.GetWorkerCommitment(s.ctx, topicId, oldNonce, worker)
+	s.Require().NoError(err)
+	s.Require().Nil(pruned, "Commitment at or before the pruned block should be removed")
+	kept, err := s.emissionsKeeper.GetWorkerCommitment(s.ctx, topicId, newNonce, worker)
+	s.Require().NoError(err)
+	s.Require().Equal(commitment, kept, "Commitment after the pruned block should be kept")
+}
+
 func (s *KeeperTestSuite) TestPruneWorkerNoncesLogicCorrectness() {
 	tests := []struct {
 		name                 string
@@ -2953,3 +3002,379 @@ func (s *KeeperTestSuite) TestGetCurrentTopicWeight() {
 	s.T().Log("revenue ", cosmosMath.NewInt(500), revenue)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestHandleWorkerLivenessJailsWorkerAfterTooManyMisses() {
+	ctx := s.ctx.WithBlockHeight(100)
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	idleWorker := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	liveWorker := "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.LivenessWindow = 4
+	params.MaxMissedEpochs = 2
+	params.JailDuration = 10
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	for _, worker := range []string{idleWorker, liveWorker} {
+		err = keeper.InsertWorker(ctx, topicId, worker, types.OffchainNode{LibP2PKey: worker})
+		s.Require().NoError(err)
+	}
+
+	submitted := map[string]bool{liveWorker: true}
+	for i := 0; i < 2; i++ {
+		err = keeper.HandleWorkerLiveness(ctx, topicId, submitted)
+		s.Require().NoError(err)
+	}
+	liveness, err := keeper.GetWorkerLiveness(ctx, topicId, idleWorker)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), liveness.MissedEpochsCounter)
+	isRegistered, err := keeper.IsWorkerRegisteredInTopic(ctx, topicId, idleWorker)
+	s.Require().NoError(err)
+	s.Require().True(isRegistered, "Worker should not be jailed until it misses more than the max")
+
+	err = keeper.HandleWorkerLiveness(ctx, topicId, submitted)
+	s.Require().NoError(err)
+
+	liveness, err = keeper.GetWorkerLiveness(ctx, topicId, idleWorker)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(110), liveness.JailedUntil)
+	s.Require().Zero(liveness.MissedEpochsCounter, "Liveness should be tracked afresh after jailing")
+	isRegistered, err = keeper.IsWorkerRegisteredInTopic(ctx, topicId, idleWorker)
+	s.Require().NoError(err)
+	s.Require().False(isRegistered, "Jailed worker should be removed from the topic")
+
+	liveness, err = keeper.GetWorkerLiveness(ctx, topicId, liveWorker)
+	s.Require().NoError(err)
+	s.Require().Zero(liveness.MissedEpochsCounter)
+	s.Require().Equal(uint64(3), liveness.IndexOffset)
+	isRegistered, err = keeper.IsWorkerRegisteredInTopic(ctx, topicId, liveWorker)
+	s.Require().NoError(err)
+	s.Require().True(isRegistered)
+}
+
+func (s *KeeperTestSuite) TestDroppedAndExpiredWorkerNoncesCountAsMisses() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	idleWorker := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	liveWorker := "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.LivenessWindow = 10
+	params.MaxMissedEpochs = 10
+	params.MaxUnfulfilledWorkerRequests = 1
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	for _, worker := range []string{idleWorker, liveWorker} {
+		err = keeper.InsertWorker(ctx, topicId, worker, types.OffchainNode{LibP2PKey: worker})
+		s.Require().NoError(err)
+	}
+
+	// The live worker commits and submits for both nonces, which are never fulfilled
+	for _, nonce := range []types.Nonce{{BlockHeight: 1}, {BlockHeight: 2}} {
+		err = keeper.SetWorkerCommitment(ctx, topicId, nonce, liveWorker, []byte("commitment"))
+		s.Require().NoError(err)
+		err = keeper.AddPendingWorkerPayload(ctx, topicId, nonce, types.WorkerDataBundle{Worker: liveWorker})
+		s.Require().NoError(err)
+	}
+	err = keeper.AddWorkerNonce(ctx, topicId, &types.Nonce{BlockHeight: 1})
+	s.Require().NoError(err)
+	// Nonce 1 is dropped to make room for nonce 2
+	err = keeper.AddWorkerNonce(ctx, topicId, &types.Nonce{BlockHeight: 2})
+	s.Require().NoError(err)
+	// Nonce 2 expires
+	err = keeper.PruneWorkerNonces(ctx, topicId, 3)
+	s.Require().NoError(err)
+
+	liveness, err := keeper.GetWorkerLiveness(ctx, topicId, idleWorker)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), liveness.IndexOffset)
+	s.Require().Equal(uint64(2), liveness.MissedEpochsCounter)
+
+	liveness, err = keeper.GetWorkerLiveness(ctx, topicId, liveWorker)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), liveness.IndexOffset)
+	s.Require().Zero(liveness.MissedEpochsCounter, "Pending payloads count as submissions")
+
+	// The payloads and commitments of the dropped and expired nonces are deleted with them
+	for _, nonce := range []types.Nonce{{BlockHeight: 1}, {BlockHeight: 2}} {
+		payloads, err := keeper.GetPendingWorkerPayloads(ctx, topicId, nonce)
+		s.Require().NoError(err)
+		s.Require().Empty(payloads)
+		commitment, err := keeper.GetWorkerCommitment(ctx, topicId, nonce, liveWorker)
+		s.Require().NoError(err)
+		s.Require().Nil(commitment)
+	}
+}
+
+func (s *KeeperTestSuite) TestExpiredReputerNoncesCountAsMisses() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.LivenessWindow = 10
+	params.MaxMissedEpochs = 10
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	err = keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{LibP2PKey: reputer})
+	s.Require().NoError(err)
+	err = keeper.AddReputerNonce(ctx, topicId, &types.Nonce{BlockHeight: 2}, &types.Nonce{BlockHeight: 1})
+	s.Require().NoError(err)
+	err = keeper.PruneReputerNonces(ctx, topicId, 3)
+	s.Require().NoError(err)
+
+	liveness, err := keeper.GetReputerLiveness(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), liveness.IndexOffset)
+	s.Require().Equal(uint64(1), liveness.MissedEpochsCounter)
+}
+
+func (s *KeeperTestSuite) TestDroppedAndExpiredReputerNoncesDeletePendingPayloads() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.MaxUnfulfilledReputerRequests = 1
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	bundle := types.ReputerValueBundle{ValueBundle: &types.ValueBundle{Reputer: reputer}}
+	for _, nonce := range []types.Nonce{{BlockHeight: 2}, {BlockHeight: 3}} {
+		err = keeper.AddPendingReputerPayload(ctx, topicId, nonce, bundle)
+		s.Require().NoError(err)
+	}
+	err = keeper.AddReputerNonce(ctx, topicId, &types.Nonce{BlockHeight: 2}, &types.Nonce{BlockHeight: 1})
+	s.Require().NoError(err)
+	// Nonce 2 is dropped to make room for nonce 3
+	err = keeper.AddReputerNonce(ctx, topicId, &types.Nonce{BlockHeight: 3}, &types.Nonce{BlockHeight: 2})
+	s.Require().NoError(err)
+	payloads, err := keeper.GetPendingReputerPayloads(ctx, topicId, types.Nonce{BlockHeight: 2})
+	s.Require().NoError(err)
+	s.Require().Empty(payloads)
+	payloads, err = keeper.GetPendingReputerPayloads(ctx, topicId, types.Nonce{BlockHeight: 3})
+	s.Require().NoError(err)
+	s.Require().Len(payloads, 1)
+
+	// Nonce 3 expires
+	err = keeper.PruneReputerNonces(ctx, topicId, 4)
+	s.Require().NoError(err)
+	payloads, err = keeper.GetPendingReputerPayloads(ctx, topicId, types.Nonce{BlockHeight: 3})
+	s.Require().NoError(err)
+	s.Require().Empty(payloads)
+}
+
+func (s *KeeperTestSuite) TestHandleReputerLivenessForgetsMissesOutsideWindow() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.LivenessWindow = 3
+	params.MaxMissedEpochs = 2
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	err = keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{LibP2PKey: reputer})
+	s.Require().NoError(err)
+
+	// miss, miss, submit, submit: the first miss falls out of the window of 3
+	for _, submitted := range []bool{false, false, true, true} {
+		err = keeper.HandleReputerLiveness(ctx, topicId, map[string]bool{reputer: submitted})
+		s.Require().NoError(err)
+	}
+
+	liveness, err := keeper.GetReputerLiveness(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), liveness.MissedEpochsCounter)
+	s.Require().Equal(uint64(4), liveness.IndexOffset)
+	uptime, err := liveness.Uptime(params.LivenessWindow)
+	s.Require().NoError(err)
+	expectedUptime, err := alloraMath.NewDecFromInt64(2).Quo(alloraMath.NewDecFromInt64(3))
+	s.Require().NoError(err)
+	s.Require().True(expectedUptime.Equal(uptime), "Uptime should be the fraction of tracked nonces not missed")
+}
+
+/// PRUNING
+
+// Sets the pruning params and creates topics 1 to numTopics with the given epoch length
+func (s *KeeperTestSuite) setupPruning(ctx sdk.Context, pruning types.PruningParams, numTopics int, epochLength int64) {
+	keeper := s.emissionsKeeper
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.Pruning = pruning
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	// topic 0 is reserved for the root network
+	_, err = keeper.IncrementTopicId(ctx)
+	s.Require().NoError(err)
+	for i := 0; i < numTopics; i++ {
+		topicId, err := keeper.IncrementTopicId(ctx)
+		s.Require().NoError(err)
+		err = keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, EpochLength: epochLength})
+		s.Require().NoError(err)
+	}
+}
+
+func (s *KeeperTestSuite) TestPruneHistoricalRecordsPrunesScoresOlderThanRetention() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	worker := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	s.setupPruning(ctx, types.PruningParams{
+		MaxRecordsPerBlock: 100,
+		InfererScores:      types.Retention{Blocks: 50},
+		ForecasterScores:   types.Retention{Epochs: 2},
+	}, 2, 30)
+
+	for _, topicId := range []uint64{1, 2} {
+		for height := int64(10); height <= 100; height += 10 {
+			score := types.Score{TopicId: topicId, BlockHeight: height, Address: worker, Score: alloraMath.OneDec()}
+			s.Require().NoError(keeper.InsertWorkerInferenceScore(ctx, topicId, height, score))
+			s.Require().NoError(keeper.InsertWorkerForecastScore(ctx, topicId, height, score))
+			s.Require().NoError(keeper.InsertReputerScore(ctx, topicId, height, score))
+		}
+	}
+
+	err := keeper.PruneHistoricalRecords(ctx, 100)
+	s.Require().NoError(err)
+
+	for _, topicId := range []uint64{1, 2} {
+		for height := int64(10); height <= 100; height += 10 {
+			inferenceScores, err := keeper.GetWorkerInferenceScoresAtBlock(ctx, topicId, height)
+			s.Require().NoError(err)
+			s.Require().Equal(height > 50, len(inferenceScores.Scores) > 0, "inference scores of topic %d at %d", topicId, height)
+			// 2 epochs of 30 blocks
+			forecastScores, err := keeper.GetWorkerForecastScoresAtBlock(ctx, topicId, height)
+			s.Require().NoError(err)
+			s.Require().Equal(height > 40, len(forecastScores.Scores) > 0, "forecast scores of topic %d at %d", topicId, height)
+			reputerScores, err := keeper.GetReputersScoresAtBlock(ctx, topicId, height)
+			s.Require().NoError(err)
+			s.Require().Len(reputerScores.Scores, 1, "reputer scores are kept forever")
+		}
+	}
+	cursor, err := keeper.GetPruningCursor(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PruningCursor{}, cursor, "a pass over every collection should start over")
+}
+
+func (s *KeeperTestSuite) TestPruneHistoricalRecordsRespectsMaxRecordsPerBlock() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	worker := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	s.setupPruning(ctx, types.PruningParams{
+		MaxRecordsPerBlock: 3,
+		InfererScores:      types.Retention{Blocks: 10},
+	}, 1, 10)
+
+	for height := int64(1); height <= 10; height++ {
+		score := types.Score{TopicId: 1, BlockHeight: height, Address: worker, Score: alloraMath.OneDec()}
+		s.Require().NoError(keeper.InsertWorkerInferenceScore(ctx, 1, height, score))
+	}
+	countScores := func() int {
+		scores, err := keeper.GetInferenceScoresUntilBlock(ctx, 1, 10)
+		s.Require().NoError(err)
+		return len(scores)
+	}
+
+	err := keeper.PruneHistoricalRecords(ctx, 100)
+	s.Require().NoError(err)
+	s.Require().Equal(7, countScores())
+	cursor, err := keeper.GetPruningCursor(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), cursor.Collection)
+	s.Require().Equal(uint64(1), cursor.TopicId, "the pruning should resume in the same topic")
+
+	for _, remaining := range []int{4, 1, 0} {
+		err = keeper.PruneHistoricalRecords(ctx, 100)
+		s.Require().NoError(err)
+		s.Require().Equal(remaining, countScores())
+	}
+}
+
+func (s *KeeperTestSuite) TestPruneHistoricalRecordsResumesAfterLastKey() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	s.setupPruning(ctx, types.PruningParams{
+		MaxRecordsPerBlock: 2,
+		Regrets:            types.Retention{Blocks: 10},
+	}, 1, 10)
+
+	workers := []string{
+		"allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve",
+		"allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh",
+		"allo1w28k2n4w3fj2z7gyk8lgpmnp6pxmwyzqj0qvfz",
+		"allo1ze8vz5ysry8wr8qqqydws2pdxgmhrvv6ze5a8n",
+	}
+	sort.Strings(workers)
+	// Only the second worker in key order has a recent regret
+	for i, worker := range workers {
+		regret := types.TimestampedValue{BlockHeight: 5, Value: alloraMath.OneDec()}
+		if i == 1 {
+			regret.BlockHeight = 95
+		}
+		s.Require().NoError(keeper.SetInfererNetworkRegret(ctx, 1, worker, regret))
+	}
+	hasRegret := func(worker string) bool {
+		_, noPrior, err := keeper.GetInfererNetworkRegret(ctx, 1, worker)
+		s.Require().NoError(err)
+		return !noPrior
+	}
+
+	err := keeper.PruneHistoricalRecords(ctx, 100)
+	s.Require().NoError(err)
+	s.Require().False(hasRegret(workers[0]))
+	s.Require().True(hasRegret(workers[1]))
+	s.Require().True(hasRegret(workers[2]), "the budget should be spent on the first 2 regrets")
+	cursor, err := keeper.GetPruningCursor(ctx)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(cursor.LastKey)
+
+	err = keeper.PruneHistoricalRecords(ctx, 100)
+	s.Require().NoError(err)
+	s.Require().True(hasRegret(workers[1]))
+	s.Require().False(hasRegret(workers[2]))
+	s.Require().False(hasRegret(workers[3]))
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	ctx := s.ctx
+
+	// The params of version 2 lack the ones added in version 3
+	params := types.DefaultParams()
+	params.MaxTopicsPerBlock = 42
+	params.LivenessWindow = 0
+	params.MaxMissedEpochs = 0
+	params.JailDuration = 0
+	params.MinTopicParams = types.TopicParams{}
+	params.MaxTopicParams = types.TopicParams{}
+	params.Pruning = types.PruningParams{}
+	params.MinAdminParamsUpdateDelay = 0
+	s.Require().NoError(s.emissionsKeeper.SetParams(ctx, params))
+
+	claimableRewardsModuleAccount := authtypes.NewEmptyModuleAccount(types.AlloraClaimableRewardsAccountName)
+	s.authKeeper.EXPECT().GetModuleAccount(ctx, types.AlloraClaimableRewardsAccountName).Return(claimableRewardsModuleAccount)
+	s.authKeeper.EXPECT().SetModuleAccount(ctx, claimableRewardsModuleAccount)
+
+	err := keeper.NewMigrator(s.emissionsKeeper).Migrate2to3(ctx)
+	s.Require().NoError(err)
+
+	migratedParams, err := s.emissionsKeeper.GetParams(ctx)
+	s.Require().NoError(err)
+	expectedParams := types.DefaultParams()
+	expectedParams.MaxTopicsPerBlock = 42
+	s.Require().Equal(expectedParams, migratedParams)
+}
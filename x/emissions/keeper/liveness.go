@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Records, for every worker registered in the topic, whether it submitted a payload for a worker nonce.
+// Workers that missed more than MaxMissedEpochs of the last LivenessWindow nonces are jailed:
+// they are removed from the topic and may only unjail once JailDuration blocks have passed.
+func (k *Keeper) HandleWorkerLiveness(ctx context.Context, topicId TopicId, submitted map[ActorId]bool) error {
+	workers, err := k.GetTopicWorkers(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	return k.handleLiveness(ctx, topicId, workers, submitted, k.GetWorkerLiveness, k.SetWorkerLiveness, k.RemoveWorker)
+}
+
+// Records, for every reputer registered in the topic, whether it submitted a payload for a reputer nonce.
+// Reputers are jailed under the same conditions as workers.
+func (k *Keeper) HandleReputerLiveness(ctx context.Context, topicId TopicId, submitted map[ActorId]bool) error {
+	reputers, err := k.GetTopicReputers(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	return k.handleLiveness(ctx, topicId, reputers, submitted, k.GetReputerLiveness, k.SetReputerLiveness, k.RemoveReputer)
+}
+
+// Records a worker nonce that was dropped or expired before it was fulfilled.
+// Workers with a pending payload for the nonce submitted it, every other worker registered in the topic missed it.
+func (k *Keeper) HandleDroppedWorkerNonce(ctx context.Context, topicId TopicId, nonce types.Nonce) error {
+	bundles, err := k.GetPendingWorkerPayloads(ctx, topicId, nonce)
+	if err != nil {
+		return err
+	}
+	submitted := make(map[ActorId]bool, len(bundles))
+	for _, bundle := range bundles {
+		submitted[bundle.Worker] = true
+	}
+	return k.HandleWorkerLiveness(ctx, topicId, submitted)
+}
+
+// Records a reputer nonce that was dropped or expired before it was fulfilled, as for worker nonces
+func (k *Keeper) HandleDroppedReputerNonce(ctx context.Context, topicId TopicId, reputerNonce types.Nonce) error {
+	bundles, err := k.GetPendingReputerPayloads(ctx, topicId, reputerNonce)
+	if err != nil {
+		return err
+	}
+	submitted := make(map[ActorId]bool, len(bundles))
+	for _, bundle := range bundles {
+		submitted[bundle.ValueBundle.Reputer] = true
+	}
+	return k.HandleReputerLiveness(ctx, topicId, submitted)
+}
+
+func (k *Keeper) handleLiveness(
+	ctx context.Context,
+	topicId TopicId,
+	actors []ActorId,
+	submitted map[ActorId]bool,
+	getLiveness func(context.Context, TopicId, ActorId) (types.ActorLiveness, error),
+	setLiveness func(context.Context, TopicId, ActorId, types.ActorLiveness) error,
+	removeActor func(context.Context, TopicId, ActorId) error,
+) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if moduleParams.LivenessWindow == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, actor := range actors {
+		liveness, err := getLiveness(ctx, topicId, actor)
+		if err != nil {
+			return err
+		}
+		liveness.RecordNonce(!submitted[actor], moduleParams.LivenessWindow)
+
+		if liveness.MissedEpochsCounter > moduleParams.MaxMissedEpochs {
+			err = removeActor(ctx, topicId, actor)
+			if err != nil {
+				return err
+			}
+			liveness.Jail(sdkCtx.BlockHeight() + moduleParams.JailDuration)
+			sdkCtx.Logger().Info(fmt.Sprintf("Jailed %s in topic %d until block %d", actor, topicId, liveness.JailedUntil))
+		}
+
+		err = setLiveness(ctx, topicId, actor, liveness)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Registers a jailed worker back in the topic it was jailed from
+func (k *Keeper) UnjailWorker(ctx context.Context, topicId TopicId, worker ActorId) error {
+	liveness, err := k.GetWorkerLiveness(ctx, topicId, worker)
+	if err != nil {
+		return err
+	}
+	err = k.topicWorkers.Set(ctx, collections.Join(topicId, worker))
+	if err != nil {
+		return err
+	}
+	liveness.JailedUntil = 0
+	return k.SetWorkerLiveness(ctx, topicId, worker, liveness)
+}
+
+// Registers a jailed reputer back in the topic it was jailed from
+func (k *Keeper) UnjailReputer(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	liveness, err := k.GetReputerLiveness(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	err = k.topicReputers.Set(ctx, collections.Join(topicId, reputer))
+	if err != nil {
+		return err
+	}
+	liveness.JailedUntil = 0
+	return k.SetReputerLiveness(ctx, topicId, reputer, liveness)
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	v2 "github.com/allora-network/allora-chain/x/emissions/migrations/v2"
+	v3 "github.com/allora-network/allora-chain/x/emissions/migrations/v3"
+	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -24,3 +26,29 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx)
 }
+
+// Migrate2to3 migrates the emissions module state from the consensus version 2 to
+// version 3. It sets the params added in version 3 to their defaults and creates the
+// module account worker rewards are held in until they are claimed.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := m.keeper.SetParams(ctx, v3.MigrateParams(params)); err != nil {
+		return err
+	}
+	claimableRewardsModuleAccount := m.keeper.authKeeper.GetModuleAccount(ctx, types.AlloraClaimableRewardsAccountName)
+	m.keeper.authKeeper.SetModuleAccount(ctx, claimableRewardsModuleAccount)
+	return m.validateParams(ctx)
+}
+
+// The last migration must leave the module with params that pass validation. Intermediate migrations
+// must not validate, as the params they leave lack the fields added by the later ones.
+func (m Migrator) validateParams(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	return params.Validate()
+}
@@ -0,0 +1,225 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type reputerStakeKey struct {
+	topicId uint64
+	reputer string
+}
+
+type delegatorStakeKey struct {
+	topicId   uint64
+	delegator string
+}
+
+type delegateStakePlacementKey struct {
+	topicId   uint64
+	delegator string
+	reputer   string
+}
+
+// Reference indexer rebuilding the stake maps of the keeper from the stake events alone
+type stakeIndexer struct {
+	reputerStakes           map[reputerStakeKey]cosmosMath.Int
+	topicStakes             map[uint64]cosmosMath.Int
+	totalStake              cosmosMath.Int
+	delegatorStakes         map[delegatorStakeKey]cosmosMath.Int
+	delegateStakePlacements map[delegateStakePlacementKey]cosmosMath.Int
+	delegateStakesUpon      map[reputerStakeKey]cosmosMath.Int
+}
+
+func newStakeIndexer() *stakeIndexer {
+	return &stakeIndexer{
+		reputerStakes:           make(map[reputerStakeKey]cosmosMath.Int),
+		topicStakes:             make(map[uint64]cosmosMath.Int),
+		totalStake:              cosmosMath.ZeroInt(),
+		delegatorStakes:         make(map[delegatorStakeKey]cosmosMath.Int),
+		delegateStakePlacements: make(map[delegateStakePlacementKey]cosmosMath.Int),
+		delegateStakesUpon:      make(map[reputerStakeKey]cosmosMath.Int),
+	}
+}
+
+// Adds delta to the entry of a map, dropping the entry once it is zero as the keeper does
+func addToStake[K comparable](m map[K]cosmosMath.Int, key K, delta cosmosMath.Int) {
+	stake := indexedStake(m, key).Add(delta)
+	if stake.IsZero() {
+		delete(m, key)
+		return
+	}
+	m[key] = stake
+}
+
+func indexedStake[K comparable](m map[K]cosmosMath.Int, key K) cosmosMath.Int {
+	stake, ok := m[key]
+	if !ok {
+		return cosmosMath.ZeroInt()
+	}
+	return stake
+}
+
+func (i *stakeIndexer) addStake(topicId uint64, reputer string, delta cosmosMath.Int) {
+	addToStake(i.reputerStakes, reputerStakeKey{topicId, reputer}, delta)
+	addToStake(i.topicStakes, topicId, delta)
+	i.totalStake = i.totalStake.Add(delta)
+}
+
+func (i *stakeIndexer) addDelegateStake(topicId uint64, delegator, reputer string, delta cosmosMath.Int) {
+	addToStake(i.delegatorStakes, delegatorStakeKey{topicId, delegator}, delta)
+	addToStake(i.delegateStakePlacements, delegateStakePlacementKey{topicId, delegator, reputer}, delta)
+	addToStake(i.delegateStakesUpon, reputerStakeKey{topicId, reputer}, delta)
+}
+
+// Applies the stake events among events, ignoring the others
+func (i *stakeIndexer) apply(events sdk.Events) {
+	for _, event := range events {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			// not a typed event, such as the events of the bank module
+			continue
+		}
+		switch e := msg.(type) {
+		case *types.EventStakeAdded:
+			i.addStake(e.TopicId, e.Reputer, e.Amount)
+		case *types.EventStakeRemoved:
+			i.addStake(e.TopicId, e.Reputer, e.Amount.Neg())
+		case *types.EventDelegateStakeAdded:
+			i.addDelegateStake(e.TopicId, e.Delegator, e.Reputer, e.Amount)
+		case *types.EventDelegateStakeRemoved:
+			i.addDelegateStake(e.TopicId, e.Delegator, e.Reputer, e.Amount.Neg())
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestStakeEventsRebuildStakeMaps() {
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.ctx = ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputer := sdk.AccAddress(PKS[0].Address())
+	poolReputer := sdk.AccAddress(PKS[1].Address()).String()
+	worker := sdk.AccAddress(PKS[2].Address()).String()
+	delegator1 := sdk.AccAddress(PKS[3].Address())
+	delegator2 := sdk.AccAddress(PKS[4].Address())
+
+	topicId1 := s.commonStakingSetup(ctx, reputer.String(), worker, cosmosMath.NewInt(1000))
+	topicId2 := s.commonStakingSetup(ctx, poolReputer, worker, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(reputer, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(poolReputer), cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(delegator1, cosmosMath.NewInt(500))
+	s.MintTokensToAddress(delegator2, cosmosMath.NewInt(500))
+
+	_, err := s.msgServer.AddStake(ctx, &types.MsgAddStake{
+		Sender:  reputer.String(),
+		TopicId: topicId1,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.AddReputerStakePool(ctx, &types.MsgAddReputerStakePool{
+		Sender: poolReputer,
+		Amount: cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.SetReputerStakeAllocation(ctx, &types.MsgSetReputerStakeAllocation{
+		Sender:   poolReputer,
+		TopicId:  topicId2,
+		Fraction: alloraMath.MustNewDecFromString("0.5"),
+	})
+	require.NoError(err)
+	for _, delegator := range []sdk.AccAddress{delegator1, delegator2} {
+		_, err = s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+			Sender:  delegator.String(),
+			TopicId: topicId1,
+			Reputer: reputer.String(),
+			Amount:  cosmosMath.NewInt(500),
+		})
+		require.NoError(err)
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegator2.String(),
+		SrcTopicId: topicId1,
+		SrcReputer: reputer.String(),
+		DstTopicId: topicId2,
+		DstReputer: poolReputer,
+		Amount:     cosmosMath.NewInt(200),
+	})
+	require.NoError(err)
+
+	// Rewards are added to the stake of the reputer and compounded into the stake of its delegators
+	reputerRewards := s.insertValueBundlesAndGetRewards(reputer, topicId1, 1003, alloraMath.MustNewDecFromString("17.53436"))
+	err = keeper.AddStake(ctx, topicId1, reputer.String(), reputerRewards[0].Reward.SdkIntTrim())
+	require.NoError(err)
+	err = keeper.CompoundDelegateStakeReward(ctx, topicId1, delegator1.String(), reputer.String())
+	require.NoError(err)
+
+	_, err = s.msgServer.StartRemoveStake(ctx, &types.MsgStartRemoveStake{
+		Sender:  reputer.String(),
+		TopicId: topicId1,
+		Amount:  cosmosMath.NewInt(300),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.StartRemoveDelegateStake(ctx, &types.MsgStartRemoveDelegateStake{
+		Sender:  delegator2.String(),
+		TopicId: topicId1,
+		Reputer: reputer.String(),
+		Amount:  cosmosMath.NewInt(300),
+	})
+	require.NoError(err)
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow + 1)
+	_, err = s.msgServer.ConfirmRemoveStake(ctx, &types.MsgConfirmRemoveStake{
+		Sender:  reputer.String(),
+		TopicId: topicId1,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.ConfirmRemoveDelegateStake(ctx, &types.MsgConfirmDelegateRemoveStake{
+		Sender:  delegator2.String(),
+		TopicId: topicId1,
+		Reputer: reputer.String(),
+	})
+	require.NoError(err)
+
+	indexer := newStakeIndexer()
+	indexer.apply(ctx.EventManager().Events())
+
+	totalStake, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(totalStake, indexer.totalStake)
+	// Every topic, reputer and delegator involved is checked, a stake the indexer has no entry for must be zero
+	reputers := []string{reputer.String(), poolReputer}
+	delegators := []string{delegator1.String(), delegator2.String()}
+	for _, topicId := range []uint64{topicId1, topicId2} {
+		topicStake, err := keeper.GetTopicStake(ctx, topicId)
+		require.NoError(err)
+		require.Equal(topicStake, indexedStake(indexer.topicStakes, topicId), "stake of topic %d", topicId)
+		for _, reputer := range reputers {
+			stake, err := keeper.GetStakeOnReputerInTopic(ctx, topicId, reputer)
+			require.NoError(err)
+			require.Equal(stake, indexedStake(indexer.reputerStakes, reputerStakeKey{topicId, reputer}),
+				"stake of reputer %s in topic %d", reputer, topicId)
+			stake, err = keeper.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+			require.NoError(err)
+			require.Equal(stake, indexedStake(indexer.delegateStakesUpon, reputerStakeKey{topicId, reputer}),
+				"delegate stake upon reputer %s in topic %d", reputer, topicId)
+		}
+		for _, delegator := range delegators {
+			stake, err := keeper.GetStakeFromDelegatorInTopic(ctx, topicId, delegator)
+			require.NoError(err)
+			require.Equal(stake, indexedStake(indexer.delegatorStakes, delegatorStakeKey{topicId, delegator}),
+				"stake from delegator %s in topic %d", delegator, topicId)
+			for _, reputer := range reputers {
+				placement, err := keeper.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+				require.NoError(err)
+				require.Equal(placement.Amount.SdkIntTrim(), indexedStake(indexer.delegateStakePlacements, delegateStakePlacementKey{topicId, delegator, reputer}),
+					"stake placed by delegator %s upon reputer %s in topic %d", delegator, reputer, topicId)
+			}
+		}
+	}
+}
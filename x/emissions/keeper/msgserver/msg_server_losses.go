@@ -68,7 +68,37 @@ func (ms msgServer) InsertBulkReputerPayload(
 		)
 	}
 
-	params, err := ms.k.GetParams(ctx)
+	statuses, err := ms.InsertReputerPayloadsAndFulfillNonce(ctx, topic, *msg.ReputerRequestNonce, msg.ReputerValueBundles)
+	if err != nil {
+		if errors.Is(err, types.ErrNoValidBundles) {
+			return nil, noValidBundlesError(statuses)
+		}
+		return nil, err
+	}
+
+	return &types.MsgInsertBulkReputerPayloadResponse{Statuses: statuses}, nil
+}
+
+// Insert the given reputer payloads together with those submitted directly by reputers for the nonce,
+// compute the network losses and regrets, then fulfill the nonce and mark the topic as rewardable.
+// Bundles given by the caller take precedence over pending ones from the same reputer.
+// Returns the status of every bundle, which are also emitted in an event.
+// If no bundle is accepted, the statuses are returned with ErrNoValidBundles for the caller to report.
+func (ms msgServer) InsertReputerPayloadsAndFulfillNonce(
+	ctx context.Context,
+	topic types.Topic,
+	reputerRequestNonce types.ReputerRequestNonce,
+	reputerValueBundles []*types.ReputerValueBundle,
+) ([]*types.PayloadStatus, error) {
+	pendingBundles, err := ms.k.GetPendingReputerPayloads(ctx, topic.Id, *reputerRequestNonce.ReputerNonce)
+	if err != nil {
+		return nil, err
+	}
+	allBundles := make([]*types.ReputerValueBundle, 0, len(reputerValueBundles)+len(pendingBundles))
+	allBundles = append(allBundles, reputerValueBundles...)
+	allBundles = append(allBundles, pendingBundles...)
+
+	params, err := ms.k.GetParamsForTopic(ctx, topic.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -78,22 +108,27 @@ func (ms msgServer) InsertBulkReputerPayload(
 	// and get get score for each reputer => later we can skim only the top few by score descending
 	lossBundlesByReputer := make(map[string]*types.ReputerValueBundle)
 	latestReputerScores := make(map[string]types.Score)
-	for _, bundle := range msg.ReputerValueBundles {
+	statuses := make([]*types.PayloadStatus, 0)
+	for _, bundle := range allBundles {
 		if err := bundle.Validate(); err != nil {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, bundle.GetValueBundle().GetReputer(), types.PayloadRejectionReason_INVALID_BUNDLE))
 			continue
 		}
 
 		reputer := bundle.ValueBundle.Reputer
 
-		// Check that the reputer's value bundle is for a topic matching the leader's given topic
-		if bundle.ValueBundle.TopicId != msg.TopicId {
+		// Check that the reputer's value bundle is for the topic being fulfilled
+		if bundle.ValueBundle.TopicId != topic.Id {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_WRONG_TOPIC_OR_NONCE))
 			continue
 		}
-		// Check that the reputer's value bundle is for a nonce matching the leader's given nonce
-		if bundle.ValueBundle.ReputerRequestNonce.WorkerNonce.BlockHeight != msg.ReputerRequestNonce.WorkerNonce.BlockHeight {
+		// Check that the reputer's value bundle is for the nonce being fulfilled
+		if bundle.ValueBundle.ReputerRequestNonce.WorkerNonce.BlockHeight != reputerRequestNonce.WorkerNonce.BlockHeight {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_WRONG_TOPIC_OR_NONCE))
 			continue
 		}
-		if bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != msg.ReputerRequestNonce.ReputerNonce.BlockHeight {
+		if bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != reputerRequestNonce.ReputerNonce.BlockHeight {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_WRONG_TOPIC_OR_NONCE))
 			continue
 		}
 
@@ -102,19 +137,24 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// Check that the reputer is registered in the topic
 			isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, bundle.ValueBundle.TopicId, reputer)
 			if err != nil {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_STORE_ERROR))
 				continue
 			}
 			// We'll keep what we can get from the payload, but we'll ignore the rest
 			if !isReputerRegistered {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_NOT_REGISTERED))
 				continue
 			}
 
-			// Check that the reputer enough stake in the topic
-			stake, err := ms.k.GetStakeOnReputerInTopic(ctx, msg.TopicId, reputer)
+			// Check that the reputer enough stake in the topic,
+			// which includes the stake it allocates to the topic from its stake pool
+			stake, err := ms.k.GetStakeOnReputerInTopic(ctx, topic.Id, reputer)
 			if err != nil {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_STORE_ERROR))
 				continue
 			}
 			if stake.LT(params.RequiredMinimumStake) {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_INSUFFICIENT_STAKE))
 				continue
 			}
 
@@ -122,8 +162,9 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// A check of their registration and other filters have already been applied when their inferences were inserted.
 			// We keep what we can, ignoring the reputer and their contribution (losses) entirely
 			// if they're left with no valid losses.
-			filteredBundle, err := ms.FilterUnacceptedWorkersFromReputerValueBundle(ctx, msg.TopicId, *msg.ReputerRequestNonce, bundle)
+			filteredBundle, err := ms.FilterUnacceptedWorkersFromReputerValueBundle(ctx, topic.Id, reputerRequestNonce, bundle)
 			if err != nil {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_NO_ACCEPTED_VALUES))
 				continue
 			}
 
@@ -134,41 +175,48 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// Get the latest score for each reputer
 			latestScore, err := ms.k.GetLatestReputerScore(ctx, bundle.ValueBundle.TopicId, reputer)
 			if err != nil {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_SCORE_NOT_FOUND))
 				continue
 			}
 			latestReputerScores[bundle.ValueBundle.Reputer] = latestScore
 			lossBundlesByReputer[bundle.ValueBundle.Reputer] = filteredBundle
+		} else {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_DUPLICATE))
 		}
 	}
 
 	// If we pseudo-random sample from the non-sybil set of reputers, we would do it here
-	topReputers := FindTopNByScoreDesc(params.MaxTopReputersToReward, latestReputerScores, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	topReputers := FindTopNByScoreDesc(params.MaxTopReputersToReward, latestReputerScores, reputerRequestNonce.ReputerNonce.BlockHeight)
 
 	// Check that the reputer in the payload is a top reputer among those who have submitted losses
 	stakesByReputer := make(map[string]cosmosMath.Int)
 	lossBundlesFromTopReputers := make([]*types.ReputerValueBundle, 0)
 	for _, reputer := range topReputers {
-		stake, err := ms.k.GetStakeOnReputerInTopic(ctx, msg.TopicId, reputer)
+		stake, err := ms.k.GetStakeOnReputerInTopic(ctx, topic.Id, reputer)
 		if err != nil {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_REPUTER, reputer, types.PayloadRejectionReason_STORE_ERROR))
+			// Already reported, leave it out of the statuses of the top reputers
+			delete(latestReputerScores, reputer)
 			continue
 		}
 
 		lossBundlesFromTopReputers = append(lossBundlesFromTopReputers, lossBundlesByReputer[reputer])
 		stakesByReputer[reputer] = stake
 	}
+	statuses = append(statuses, topActorStatuses(types.ActorType_REPUTER, latestReputerScores, topReputers)...)
 	// sort by reputer score descending
 	sort.Slice(lossBundlesFromTopReputers, func(i, j int) bool {
 		return lossBundlesFromTopReputers[i].ValueBundle.Reputer < lossBundlesFromTopReputers[j].ValueBundle.Reputer
 	})
 
 	if len(lossBundlesFromTopReputers) == 0 {
-		return nil, types.ErrNoValidBundles
+		return statuses, types.ErrNoValidBundles
 	}
 
 	bundles := types.ReputerValueBundles{
 		ReputerValueBundles: lossBundlesFromTopReputers,
 	}
-	err = ms.k.InsertReputerLossBundlesAtBlock(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, bundles)
+	err = ms.k.InsertReputerLossBundlesAtBlock(ctx, topic.Id, reputerRequestNonce.ReputerNonce.BlockHeight, bundles)
 	if err != nil {
 		return nil, err
 	}
@@ -178,36 +226,53 @@ func (ms msgServer) InsertBulkReputerPayload(
 		return nil, err
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.Logger().Debug(fmt.Sprintf("Reputer Nonce %d Network Loss Bundle %v", msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle))
+	sdkCtx.Logger().Debug(fmt.Sprintf("Reputer Nonce %d Network Loss Bundle %v", reputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle))
 
-	err = ms.k.InsertNetworkLossBundleAtBlock(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
+	err = ms.k.InsertNetworkLossBundleAtBlock(ctx, topic.Id, reputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
 	if err != nil {
 		return nil, err
 	}
 
-	err = synth.GetCalcSetNetworkRegrets(sdkCtx, ms.k, msg.TopicId, networkLossBundle, *msg.ReputerRequestNonce.ReputerNonce, topic.AlphaRegret)
+	err = synth.GetCalcSetNetworkRegrets(sdkCtx, ms.k, topic.Id, networkLossBundle, *reputerRequestNonce.ReputerNonce, topic.AlphaRegret)
 	if err != nil {
 		return nil, err
 	}
 
 	// Update the unfulfilled nonces
-	_, err = ms.k.FulfillReputerNonce(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce)
+	_, err = ms.k.FulfillReputerNonce(ctx, topic.Id, reputerRequestNonce.ReputerNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reputers that submitted a valid bundle count as live for the nonce, whether or not they were a top reputer
+	submittedReputers := make(map[string]bool, len(lossBundlesByReputer))
+	for reputer := range lossBundlesByReputer {
+		submittedReputers[reputer] = true
+	}
+	err = ms.k.HandleReputerLiveness(ctx, topic.Id, submittedReputers)
 	if err != nil {
 		return nil, err
 	}
 
 	// Update topic reward nonce
-	err = ms.k.SetTopicRewardNonce(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	err = ms.k.SetTopicRewardNonce(ctx, topic.Id, reputerRequestNonce.ReputerNonce.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.DeletePendingReputerPayloads(ctx, topic.Id, *reputerRequestNonce.ReputerNonce)
 	if err != nil {
 		return nil, err
 	}
 
-	err = ms.k.AddRewardableTopic(ctx, msg.TopicId)
+	err = ms.k.AddRewardableTopic(ctx, topic.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgInsertBulkReputerPayloadResponse{}, nil
+	types.EmitNewPayloadStatusesEvent(sdkCtx, topic.Id, reputerRequestNonce.ReputerNonce.BlockHeight, statuses)
+	types.IncrPayloadStatusMetrics(ctx, topic.Id, statuses)
+	return statuses, nil
 }
 
 // Filter out values of unaccepted workers.
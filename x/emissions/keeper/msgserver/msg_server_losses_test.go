@@ -185,6 +185,58 @@ func (s *KeeperTestSuite) TestMsgInsertBulkReputerPayload() {
 	require.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestMsgInsertBulkReputerPayloadReturnsStatuses() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	block := types.BlockHeight(1)
+
+	reputerPrivateKey := secp256k1.GenPrivKey()
+	reputerAddr := sdk.AccAddress(reputerPrivateKey.PubKey().Address())
+	unregisteredPrivateKey := secp256k1.GenPrivKey()
+	unregisteredAddr := sdk.AccAddress(unregisteredPrivateKey.PubKey().Address())
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId, reputerNonce, workerNonce := s.getBasicReputerPayload(reputerAddr, workerAddr, block)
+	unregisteredValueBundle := reputerValueBundle
+	unregisteredValueBundle.Reputer = unregisteredAddr.String()
+
+	err := keeper.InsertForecasts(ctx, topicId, types.Nonce{BlockHeight: block}, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
+	require.NoError(err)
+
+	lossesMsg := &types.MsgInsertBulkReputerPayload{
+		Sender:  reputerAddr.String(),
+		TopicId: topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &reputerNonce,
+			WorkerNonce:  &workerNonce,
+		},
+		ReputerValueBundles: []*types.ReputerValueBundle{
+			{
+				ValueBundle: &unregisteredValueBundle,
+				Signature:   s.signValueBundle(&unregisteredValueBundle, unregisteredPrivateKey),
+				Pubkey:      hex.EncodeToString(unregisteredPrivateKey.PubKey().Bytes()),
+			},
+			{
+				ValueBundle: &reputerValueBundle,
+				Signature:   s.signValueBundle(&reputerValueBundle, reputerPrivateKey),
+				Pubkey:      hex.EncodeToString(reputerPrivateKey.PubKey().Bytes()),
+			},
+		},
+	}
+	response, err := msgServer.InsertBulkReputerPayload(ctx, lossesMsg)
+	require.NoError(err)
+	require.Equal([]*types.PayloadStatus{
+		types.NewRejectedPayloadStatus(types.ActorType_REPUTER, unregisteredAddr.String(), types.PayloadRejectionReason_NOT_REGISTERED),
+		types.NewAcceptedPayloadStatus(types.ActorType_REPUTER, reputerAddr.String()),
+	}, response.Statuses)
+}
+
 func (s *KeeperTestSuite) TestInsertingReputerPayloadWithMismatchedTopicIdsIsIgnored() {
 	ctx := s.ctx
 	require := s.Require()
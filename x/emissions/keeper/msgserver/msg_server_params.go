@@ -2,27 +2,117 @@ package msgserver
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := ms.k.ValidateStringIsBech32(msg.Sender); err != nil {
 		return nil, err
 	}
-	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+	if err := checkParamsUpdater(ctx, ms.k, msg.Sender); err != nil {
+		return nil, err
+	}
+	existingParams, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if !isAdmin {
-		return nil, types.ErrNotWhitelistAdmin
+	// Check the update against the current params even if it is scheduled, to reject it early
+	updatedParams := ApplyOptionalParams(existingParams, msg.Params)
+	err = updatedParams.Validate()
+	if err != nil {
+		return nil, err
 	}
-	existingParams, err := ms.k.GetParams(ctx)
+
+	// Updates of whitelist admins are announced ahead, the authority already goes through governance
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	minEffectiveBlockHeight := blockHeight + existingParams.MinAdminParamsUpdateDelay
+	if msg.Sender != ms.k.GetAuthority() && msg.EffectiveBlockHeight < minEffectiveBlockHeight {
+		return nil, errorsmod.Wrapf(types.ErrParamsUpdateTooSoon,
+			"effective block height %d is before %d", msg.EffectiveBlockHeight, minEffectiveBlockHeight)
+	}
+
+	if msg.EffectiveBlockHeight > blockHeight {
+		err = ms.k.ScheduleParamsUpdate(ctx, types.ScheduledParamsUpdate{
+			Sender:               msg.Sender,
+			Params:               msg.Params,
+			EffectiveBlockHeight: msg.EffectiveBlockHeight,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgUpdateParamsResponse{}, nil
+	}
+
+	err = ms.k.SetParams(ctx, updatedParams)
 	if err != nil {
 		return nil, err
 	}
+	types.EmitNewParamsUpdatedEvent(sdk.UnwrapSDKContext(ctx), msg.Sender, updatedParams)
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// The authority may always update params, whitelist admins alongside it
+func checkParamsUpdater(ctx context.Context, k keeper.Keeper, sender string) error {
+	if sender == k.GetAuthority() {
+		return nil
+	}
+	isAdmin, err := k.IsWhitelistAdmin(ctx, sender)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return types.ErrNotWhitelistAdmin
+	}
+	return nil
+}
+
+// Apply the params updates whose effective block height has been reached, in the order they were scheduled.
+// Updates whose sender may no longer update params, or that are no longer valid against the params they are
+// applied on, are dropped.
+func ApplyScheduledParamsUpdates(ctx sdk.Context, k keeper.Keeper) error {
+	updates, err := k.PopScheduledParamsUpdates(ctx, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+	for _, update := range updates {
+		err = checkParamsUpdater(ctx, k, update.Sender)
+		if errors.Is(err, types.ErrNotWhitelistAdmin) {
+			ctx.Logger().Warn(fmt.Sprintf("Dropping params update scheduled by %s at block %d: %s", update.Sender, update.EffectiveBlockHeight, err.Error()))
+			continue
+		} else if err != nil {
+			return err
+		}
+		existingParams, err := k.GetParams(ctx)
+		if err != nil {
+			return err
+		}
+		updatedParams := ApplyOptionalParams(existingParams, update.Params)
+		err = updatedParams.Validate()
+		if err != nil {
+			ctx.Logger().Warn(fmt.Sprintf("Dropping params update scheduled by %s at block %d: %s", update.Sender, update.EffectiveBlockHeight, err.Error()))
+			continue
+		}
+		err = k.SetParams(ctx, updatedParams)
+		if err != nil {
+			return err
+		}
+		types.EmitNewParamsUpdatedEvent(ctx, update.Sender, updatedParams)
+	}
+	return nil
+}
+
+// Set the params that are present in the update, leaving the others as they are
+func ApplyOptionalParams(existingParams types.Params, newParams *types.OptionalParams) types.Params {
+	if newParams == nil {
+		return existingParams
+	}
 	// every option is a repeated field, so we interpret an empty array as "make no change"
-	newParams := msg.Params
 	if len(newParams.Version) == 1 {
 		existingParams.Version = newParams.Version[0]
 	}
@@ -143,13 +233,32 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 	if len(newParams.TopicFeeRevenueDecayRate) == 1 {
 		existingParams.TopicFeeRevenueDecayRate = newParams.TopicFeeRevenueDecayRate[0]
 	}
-	err = existingParams.Validate()
-	if err != nil {
-		return nil, err
+	if len(newParams.LivenessWindow) == 1 {
+		existingParams.LivenessWindow = newParams.LivenessWindow[0]
 	}
-	err = ms.k.SetParams(ctx, existingParams)
-	if err != nil {
-		return nil, err
+	if len(newParams.MaxMissedEpochs) == 1 {
+		existingParams.MaxMissedEpochs = newParams.MaxMissedEpochs[0]
 	}
-	return &types.MsgUpdateParamsResponse{}, nil
+	if len(newParams.JailDuration) == 1 {
+		existingParams.JailDuration = newParams.JailDuration[0]
+	}
+	if len(newParams.MinTopicParams) == 1 {
+		existingParams.MinTopicParams = newParams.MinTopicParams[0]
+	}
+	if len(newParams.MaxTopicParams) == 1 {
+		existingParams.MaxTopicParams = newParams.MaxTopicParams[0]
+	}
+	if len(newParams.TopicCreatorAllowlistEnabled) == 1 {
+		existingParams.TopicCreatorAllowlistEnabled = newParams.TopicCreatorAllowlistEnabled[0]
+	}
+	if len(newParams.WorkerRewardsClaimable) == 1 {
+		existingParams.WorkerRewardsClaimable = newParams.WorkerRewardsClaimable[0]
+	}
+	if len(newParams.Pruning) == 1 {
+		existingParams.Pruning = newParams.Pruning[0]
+	}
+	if len(newParams.MinAdminParamsUpdateDelay) == 1 {
+		existingParams.MinAdminParamsUpdateDelay = newParams.MinAdminParamsUpdateDelay[0]
+	}
+	return existingParams
 }
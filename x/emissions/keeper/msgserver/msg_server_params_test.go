@@ -3,6 +3,7 @@ package msgserver_test
 import (
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,15 +28,24 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		MaxTopicsPerBlock: []uint64{20},
 	}
 
+	// Whitelist admins may not update params at once
 	updateMsg := &types.MsgUpdateParams{
 		Sender: adminAddr.String(),
 		Params: newParams,
 	}
+	_, err = msgServer.UpdateParams(ctx, updateMsg)
+	require.ErrorIs(err, types.ErrParamsUpdateTooSoon)
+	updateMsg.EffectiveBlockHeight = ctx.BlockHeight() + existingParams.MinAdminParamsUpdateDelay - 1
+	_, err = msgServer.UpdateParams(ctx, updateMsg)
+	require.ErrorIs(err, types.ErrParamsUpdateTooSoon)
 
+	updateMsg.EffectiveBlockHeight = ctx.BlockHeight() + existingParams.MinAdminParamsUpdateDelay
 	response, err := msgServer.UpdateParams(ctx, updateMsg)
 	require.NoError(err)
 	require.NotNil(response)
 
+	err = msgserver.ApplyScheduledParamsUpdates(ctx.WithBlockHeight(updateMsg.EffectiveBlockHeight), keeper)
+	require.NoError(err)
 	updatedParams, err := keeper.GetParams(ctx)
 	require.NoError(err)
 
@@ -44,15 +54,39 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 	require.Equal(existingParams.Version, updatedParams.Version)
 }
 
-func (s *KeeperTestSuite) TestUpdateAllParams() {
+func (s *KeeperTestSuite) TestScheduledParamsUpdateDroppedOnceAdminRemoved() {
 	ctx, msgServer := s.ctx, s.msgServer
 	keeper := s.emissionsKeeper
 	require := s.Require()
 
-	adminPrivateKey := secp256k1.GenPrivKey()
-	adminAddr := sdk.AccAddress(adminPrivateKey.PubKey().Address())
+	adminAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(keeper.AddWhitelistAdmin(ctx, adminAddr))
+	existingParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
 
-	keeper.AddWhitelistAdmin(ctx, adminAddr.String())
+	effectiveBlockHeight := ctx.BlockHeight() + existingParams.MinAdminParamsUpdateDelay
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender:               adminAddr,
+		Params:               &types.OptionalParams{MaxTopicsPerBlock: []uint64{42}},
+		EffectiveBlockHeight: effectiveBlockHeight,
+	})
+	require.NoError(err)
+	require.NoError(keeper.RemoveWhitelistAdmin(ctx, adminAddr))
+
+	err = msgserver.ApplyScheduledParamsUpdates(ctx.WithBlockHeight(effectiveBlockHeight), keeper)
+	require.NoError(err)
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(existingParams.MaxTopicsPerBlock, params.MaxTopicsPerBlock, "The update of a removed admin should not apply")
+	scheduled, err := keeper.GetScheduledParamsUpdates(ctx)
+	require.NoError(err)
+	require.Empty(scheduled)
+}
+
+func (s *KeeperTestSuite) TestUpdateAllParams() {
+	ctx, msgServer := s.ctx, s.msgServer
+	keeper := s.emissionsKeeper
+	require := s.Require()
 
 	newParams := &types.OptionalParams{
 		Version:                         []string{"1234"},
@@ -93,10 +127,19 @@ func (s *KeeperTestSuite) TestUpdateAllParams() {
 		CRewardForecast:                 []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
 		FTolerance:                      []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
 		CNorm:                           []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		Pruning: []types.PruningParams{{
+			MaxRecordsPerBlock: 1234,
+			InfererScores:      types.Retention{Epochs: 1234},
+			ForecasterScores:   types.Retention{Epochs: 1234},
+			ReputerScores:      types.Retention{Blocks: 1234},
+			Regrets:            types.Retention{Blocks: 1234},
+			LatestScores:       types.Retention{Epochs: 1234},
+		}},
+		MinAdminParamsUpdateDelay: []int64{1234},
 	}
 
 	updateMsg := &types.MsgUpdateParams{
-		Sender: adminAddr.String(),
+		Sender: keeper.GetAuthority(),
 		Params: newParams,
 	}
 
@@ -137,6 +180,8 @@ func (s *KeeperTestSuite) TestUpdateAllParams() {
 	require.Equal(newParams.DefaultPageLimit[0], updatedParams.DefaultPageLimit)
 	require.Equal(newParams.MaxPageLimit[0], updatedParams.MaxPageLimit)
 	require.Equal(newParams.MinEpochLengthRecordLimit[0], updatedParams.MinEpochLengthRecordLimit)
+	require.Equal(newParams.Pruning[0], updatedParams.Pruning)
+	require.Equal(newParams.MinAdminParamsUpdateDelay[0], updatedParams.MinAdminParamsUpdateDelay)
 	require.Equal(newParams.MaxSerializedMsgLength[0], updatedParams.MaxSerializedMsgLength)
 	require.Equal(newParams.PRewardInference[0], updatedParams.PRewardInference)
 	require.Equal(newParams.PRewardForecast[0], updatedParams.PRewardForecast)
@@ -173,3 +218,64 @@ func (s *KeeperTestSuite) TestUpdateParamsNonWhitelistedUser() {
 	require.Nil(response, "Response should be nil when access is denied")
 	require.Error(err, types.ErrNotWhitelistAdmin, "Expected an error for non-whitelisted sender")
 }
+
+func (s *KeeperTestSuite) TestUpdateParamsByAuthority() {
+	ctx, msgServer := s.ctx, s.msgServer
+	keeper := s.emissionsKeeper
+	require := s.Require()
+
+	authority := keeper.GetAuthority()
+	isAdmin, err := keeper.IsWhitelistAdmin(ctx, authority)
+	require.NoError(err)
+	require.False(isAdmin, "The authority should not need to be a whitelist admin")
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender: authority,
+		Params: &types.OptionalParams{MaxTopicsPerBlock: []uint64{42}},
+	})
+	require.NoError(err)
+
+	updatedParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(uint64(42), updatedParams.MaxTopicsPerBlock)
+}
+
+func (s *KeeperTestSuite) TestUpdateParamsScheduledForFutureBlock() {
+	ctx, msgServer := s.ctx, s.msgServer
+	keeper := s.emissionsKeeper
+	require := s.Require()
+
+	blockHeight := ctx.BlockHeight()
+	existingParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender:               keeper.GetAuthority(),
+		Params:               &types.OptionalParams{MaxTopicsPerBlock: []uint64{42}},
+		EffectiveBlockHeight: blockHeight + 10,
+	})
+	require.NoError(err)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(existingParams.MaxTopicsPerBlock, params.MaxTopicsPerBlock, "Scheduled update should not apply yet")
+	scheduled, err := keeper.GetScheduledParamsUpdates(ctx)
+	require.NoError(err)
+	require.Len(scheduled, 1)
+	require.Equal(blockHeight+10, scheduled[0].EffectiveBlockHeight)
+
+	err = msgserver.ApplyScheduledParamsUpdates(ctx.WithBlockHeight(blockHeight+9), keeper)
+	require.NoError(err)
+	params, err = keeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(existingParams.MaxTopicsPerBlock, params.MaxTopicsPerBlock)
+
+	err = msgserver.ApplyScheduledParamsUpdates(ctx.WithBlockHeight(blockHeight+10), keeper)
+	require.NoError(err)
+	params, err = keeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(uint64(42), params.MaxTopicsPerBlock)
+	scheduled, err = keeper.GetScheduledParamsUpdates(ctx)
+	require.NoError(err)
+	require.Empty(scheduled)
+}
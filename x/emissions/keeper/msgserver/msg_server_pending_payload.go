@@ -0,0 +1,229 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// A tx function by which a registered worker submits its own signed bundle for an unfulfilled nonce,
+// without relying on a leader. The bundle is kept pending until the nonce is fulfilled,
+// either by a bulk payload or by the EndBlocker once the nonce closes.
+func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.MsgInsertWorkerPayload) (*types.MsgInsertWorkerPayloadResponse, error) {
+	err := ms.CheckInputLength(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, msg.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !nonceUnfulfilled {
+		return nil, types.ErrNonceAlreadyFulfilled
+	}
+
+	// On commit-reveal topics the payload is a reveal: only accept it once the commit phase is over
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if topic.IsCommitReveal() && sdkCtx.BlockHeight() <= topic.CommitDeadline(*msg.Nonce) {
+		return nil, types.ErrRevealPhaseNotStarted
+	}
+
+	isRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isRegistered {
+		return nil, types.ErrAddressNotRegistered
+	}
+
+	err = ms.k.AddPendingWorkerPayload(ctx, msg.TopicId, *msg.Nonce, *msg.WorkerDataBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInsertWorkerPayloadResponse{}, nil
+}
+
+// A tx function by which a registered reputer submits its own signed bundle for an unfulfilled nonce,
+// without relying on a leader. The bundle is kept pending until the nonce is fulfilled,
+// either by a bulk payload or by the EndBlocker once the nonce closes.
+func (ms msgServer) InsertReputerPayload(ctx context.Context, msg *types.MsgInsertReputerPayload) (*types.MsgInsertReputerPayloadResponse, error) {
+	err := ms.CheckInputLength(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	// Can't report losses on work that is not yet committed
+	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, msg.ReputerRequestNonce.WorkerNonce)
+	if err != nil {
+		return nil, err
+	}
+	if workerNonceUnfulfilled {
+		return nil, errorsmod.Wrapf(
+			types.ErrNonceStillUnfulfilled,
+			"Reputer's worker nonce not yet fulfilled: %v  for reputer block: %v",
+			msg.ReputerRequestNonce.WorkerNonce.BlockHeight,
+			msg.ReputerRequestNonce.ReputerNonce.BlockHeight,
+		)
+	}
+
+	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce)
+	if err != nil {
+		return nil, err
+	}
+	if !reputerNonceUnfulfilled {
+		return nil, errorsmod.Wrapf(
+			types.ErrNonceAlreadyFulfilled,
+			"Reputer nonce already fulfilled: %v",
+			msg.ReputerRequestNonce.ReputerNonce.BlockHeight,
+		)
+	}
+
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isRegistered {
+		return nil, types.ErrAddressNotRegistered
+	}
+
+	err = ms.k.AddPendingReputerPayload(ctx, msg.TopicId, *msg.ReputerRequestNonce.ReputerNonce, *msg.ReputerValueBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInsertReputerPayloadResponse{}, nil
+}
+
+// Runs fulfill on a branch of ctx that is written only if it succeeds. A panic is recovered as an error, so that
+// payloads tripping over a bug fail their nonce rather than halting the chain at the end of the block.
+func fulfillOnBranch(ctx sdk.Context, fulfill func(sdk.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while fulfilling nonce: %v", r)
+		}
+	}()
+	cacheCtx, write := ctx.CacheContext()
+	if err := fulfill(cacheCtx); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// Fulfill the closed nonces of a topic that have payloads pending from direct submissions.
+// A worker nonce closes at its block height, i.e. one epoch after it was opened.
+// A reputer nonce closes one epoch after its ground truth becomes available.
+// Each nonce is fulfilled on a branch of the context, so a nonce that fails, or panics, leaves no partial writes;
+// its pending payloads are then dropped.
+func FulfillClosedNoncesFromPendingPayloads(ctx sdk.Context, k keeper.Keeper, topic types.Topic) error {
+	ms := msgServer{k: k}
+	blockHeight := ctx.BlockHeight()
+
+	workerNonces, err := k.GetUnfulfilledWorkerNonces(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	for _, nonce := range workerNonces.Nonces {
+		if nonce == nil || nonce.BlockHeight > blockHeight {
+			continue
+		}
+		pending, err := k.GetPendingWorkerPayloads(ctx, topic.Id, *nonce)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			continue
+		}
+		var statuses []*types.PayloadStatus
+		err = fulfillOnBranch(ctx, func(cacheCtx sdk.Context) error {
+			var err error
+			statuses, err = ms.InsertWorkerPayloadsAndFulfillNonce(cacheCtx, topic, *nonce, nil)
+			return err
+		})
+		if err != nil {
+			// The statuses emitted on the branch are dropped with it
+			types.EmitNewPayloadStatusesEvent(ctx, topic.Id, nonce.BlockHeight, statuses)
+			types.IncrPayloadStatusMetrics(ctx, topic.Id, statuses)
+			ctx.Logger().Warn(fmt.Sprintf("Error fulfilling worker nonce %d of topic %d from pending payloads: %s", nonce.BlockHeight, topic.Id, err.Error()))
+			err = k.DeletePendingWorkerPayloads(ctx, topic.Id, *nonce)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+	}
+
+	reputerNonces, err := k.GetUnfulfilledReputerNonces(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	for _, nonce := range reputerNonces.Nonces {
+		if nonce == nil || nonce.ReputerNonce == nil || nonce.WorkerNonce == nil {
+			continue
+		}
+		if nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag+topic.EpochLength > blockHeight {
+			continue
+		}
+		pending, err := k.GetPendingReputerPayloads(ctx, topic.Id, *nonce.ReputerNonce)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			continue
+		}
+		var statuses []*types.PayloadStatus
+		err = fulfillOnBranch(ctx, func(cacheCtx sdk.Context) error {
+			var err error
+			statuses, err = ms.InsertReputerPayloadsAndFulfillNonce(cacheCtx, topic, *nonce, nil)
+			return err
+		})
+		if err != nil {
+			// The statuses emitted on the branch are dropped with it
+			types.EmitNewPayloadStatusesEvent(ctx, topic.Id, nonce.ReputerNonce.BlockHeight, statuses)
+			types.IncrPayloadStatusMetrics(ctx, topic.Id, statuses)
+			ctx.Logger().Warn(fmt.Sprintf("Error fulfilling reputer nonce %d of topic %d from pending payloads: %s", nonce.ReputerNonce.BlockHeight, topic.Id, err.Error()))
+			err = k.DeletePendingReputerPayloads(ctx, topic.Id, *nonce.ReputerNonce)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+	}
+
+	return nil
+}
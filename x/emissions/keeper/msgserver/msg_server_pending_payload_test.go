@@ -0,0 +1,255 @@
+package msgserver_test
+
+import (
+	"encoding/hex"
+
+	"github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+func (s *KeeperTestSuite) TestMsgInsertWorkerPayloadIsPendingUntilNonceCloses() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	bundle := workerMsg.WorkerDataBundles[0]
+
+	_, err := s.msgServer.InsertWorkerPayload(ctx, &types.MsgInsertWorkerPayload{
+		Sender:           bundle.Worker,
+		Nonce:            workerMsg.Nonce,
+		TopicId:          topicId,
+		WorkerDataBundle: bundle,
+	})
+	require.NoError(err)
+
+	pending, err := keeper.GetPendingWorkerPayloads(ctx, topicId, *workerMsg.Nonce)
+	require.NoError(err)
+	require.Len(pending, 1)
+	_, err = keeper.GetInferencesAtBlock(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.Error(err, "Pending payloads should not be inserted before the nonce closes")
+
+	topic, err := keeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+
+	// The nonce is still open one block before its block height
+	err = msgserver.FulfillClosedNoncesFromPendingPayloads(ctx.WithBlockHeight(workerMsg.Nonce.BlockHeight-1), keeper, topic)
+	require.NoError(err)
+	unfulfilled, err := keeper.IsWorkerNonceUnfulfilled(ctx, topicId, workerMsg.Nonce)
+	require.NoError(err)
+	require.True(unfulfilled)
+
+	err = msgserver.FulfillClosedNoncesFromPendingPayloads(ctx.WithBlockHeight(workerMsg.Nonce.BlockHeight), keeper, topic)
+	require.NoError(err)
+
+	unfulfilled, err = keeper.IsWorkerNonceUnfulfilled(ctx, topicId, workerMsg.Nonce)
+	require.NoError(err)
+	require.False(unfulfilled)
+	inferences, err := keeper.GetInferencesAtBlock(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 1)
+	require.Equal(bundle.InferenceForecastsBundle.Inference.Inferer, inferences.Inferences[0].Inferer)
+	unfulfilledReputer, err := keeper.IsReputerNonceUnfulfilled(ctx, topicId, workerMsg.Nonce)
+	require.NoError(err)
+	require.True(unfulfilledReputer, "Fulfilling the worker nonce should open the reputer nonce")
+
+	pending, err = keeper.GetPendingWorkerPayloads(ctx, topicId, *workerMsg.Nonce)
+	require.NoError(err)
+	require.Empty(pending)
+}
+
+func (s *KeeperTestSuite) TestPendingWorkerPayloadStatusesAreEmittedWhenNoneIsValid() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	bundle := workerMsg.WorkerDataBundles[0]
+
+	_, err := s.msgServer.InsertWorkerPayload(ctx, &types.MsgInsertWorkerPayload{
+		Sender:           bundle.Worker,
+		Nonce:            workerMsg.Nonce,
+		TopicId:          topicId,
+		WorkerDataBundle: bundle,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RemoveRegistration(ctx, &types.MsgRemoveRegistration{
+		Sender:  bundle.Worker,
+		TopicId: topicId,
+	})
+	require.NoError(err)
+
+	topic, err := keeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	err = msgserver.FulfillClosedNoncesFromPendingPayloads(ctx.WithBlockHeight(workerMsg.Nonce.BlockHeight), keeper, topic)
+	require.NoError(err)
+	unfulfilled, err := keeper.IsWorkerNonceUnfulfilled(ctx, topicId, workerMsg.Nonce)
+	require.NoError(err)
+	require.True(unfulfilled)
+
+	var event *types.EventPayloadStatuses
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(&types.EventPayloadStatuses{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(err)
+		event = msg.(*types.EventPayloadStatuses)
+	}
+	require.NotNil(event, "The statuses of a nonce that failed to be fulfilled should still be emitted")
+	require.Equal([]*types.PayloadStatus{
+		types.NewRejectedPayloadStatus(types.ActorType_INFERER, bundle.Worker, types.PayloadRejectionReason_NOT_REGISTERED),
+	}, event.Statuses)
+}
+
+func (s *KeeperTestSuite) TestPendingForecastOnlyWorkerPayloadIsFulfilledWhenNonceCloses() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference = nil
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	bundle := workerMsg.WorkerDataBundles[0]
+
+	_, err := s.msgServer.InsertWorkerPayload(ctx, &types.MsgInsertWorkerPayload{
+		Sender:           bundle.Worker,
+		Nonce:            workerMsg.Nonce,
+		TopicId:          topicId,
+		WorkerDataBundle: bundle,
+	})
+	require.NoError(err)
+	pending, err := keeper.GetPendingWorkerPayloads(ctx, topicId, *workerMsg.Nonce)
+	require.NoError(err)
+	require.Len(pending, 1)
+
+	topic, err := keeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.NotPanics(func() {
+		err = msgserver.FulfillClosedNoncesFromPendingPayloads(ctx.WithBlockHeight(workerMsg.Nonce.BlockHeight), keeper, topic)
+	})
+	require.NoError(err)
+
+	_, err = keeper.GetInferencesAtBlock(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.Error(err, "No inference should be inserted from a forecast only payload")
+	pending, err = keeper.GetPendingWorkerPayloads(ctx, topicId, *workerMsg.Nonce)
+	require.NoError(err)
+	require.Empty(pending)
+}
+
+func (s *KeeperTestSuite) TestMsgInsertWorkerPayloadFailsForBundleOfAnotherWorker() {
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, err := s.msgServer.InsertWorkerPayload(s.ctx, &types.MsgInsertWorkerPayload{
+		Sender:           getNewAddress(),
+		Nonce:            workerMsg.Nonce,
+		TopicId:          topicId,
+		WorkerDataBundle: workerMsg.WorkerDataBundles[0],
+	})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestMsgInsertBulkWorkerPayloadIncludesPendingPayloads() {
+	ctx := s.ctx
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	bundle := workerMsg.WorkerDataBundles[0]
+
+	_, err := s.msgServer.InsertWorkerPayload(ctx, &types.MsgInsertWorkerPayload{
+		Sender:           bundle.Worker,
+		Nonce:            workerMsg.Nonce,
+		TopicId:          topicId,
+		WorkerDataBundle: bundle,
+	})
+	require.NoError(err)
+
+	// The leader only relays a bundle with a broken signature, as if it censored the worker
+	censored := *bundle
+	censored.InferencesForecastsBundleSignature = []byte("not a signature")
+	workerMsg.WorkerDataBundles = []*types.WorkerDataBundle{&censored}
+
+	_, err = s.msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(ctx, topicId, workerMsg.Nonce.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 1)
+}
+
+func (s *KeeperTestSuite) TestMsgInsertReputerPayloadIsPendingUntilNonceCloses() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	block := types.BlockHeight(1)
+
+	reputerPrivateKey := secp256k1.GenPrivKey()
+	reputerAddr := sdk.AccAddress(reputerPrivateKey.PubKey().Address())
+	workerAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId, reputerNonce, workerNonce := s.getBasicReputerPayload(reputerAddr, workerAddr, block)
+
+	err := keeper.InsertForecasts(ctx, topicId, workerNonce, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertInferences(ctx, topicId, workerNonce, expectedInferences)
+	require.NoError(err)
+
+	_, err = s.msgServer.InsertReputerPayload(ctx, &types.MsgInsertReputerPayload{
+		Sender:  reputerAddr.String(),
+		TopicId: topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &reputerNonce,
+			WorkerNonce:  &workerNonce,
+		},
+		ReputerValueBundle: &types.ReputerValueBundle{
+			ValueBundle: &reputerValueBundle,
+			Signature:   s.signValueBundle(&reputerValueBundle, reputerPrivateKey),
+			Pubkey:      hex.EncodeToString(reputerPrivateKey.PubKey().Bytes()),
+		},
+	})
+	require.NoError(err)
+
+	pending, err := keeper.GetPendingReputerPayloads(ctx, topicId, reputerNonce)
+	require.NoError(err)
+	require.Len(pending, 1)
+
+	// Reputers have one epoch after the ground truth is available to submit
+	topic, err := keeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	closingBlock := reputerNonce.BlockHeight + topic.GroundTruthLag + topic.EpochLength
+
+	err = msgserver.FulfillClosedNoncesFromPendingPayloads(ctx.WithBlockHeight(closingBlock-1), keeper, topic)
+	require.NoError(err)
+	unfulfilled, err := keeper.IsReputerNonceUnfulfilled(ctx, topicId, &reputerNonce)
+	require.NoError(err)
+	require.True(unfulfilled)
+
+	err = msgserver.FulfillClosedNoncesFromPendingPayloads(ctx.WithBlockHeight(closingBlock), keeper, topic)
+	require.NoError(err)
+	unfulfilled, err = keeper.IsReputerNonceUnfulfilled(ctx, topicId, &reputerNonce)
+	require.NoError(err)
+	require.False(unfulfilled)
+
+	lossBundles, err := keeper.GetReputerLossBundlesAtBlock(ctx, topicId, reputerNonce.BlockHeight)
+	require.NoError(err)
+	require.Len(lossBundles.ReputerValueBundles, 1)
+	pending, err = keeper.GetPendingReputerPayloads(ctx, topicId, reputerNonce)
+	require.NoError(err)
+	require.Empty(pending)
+}
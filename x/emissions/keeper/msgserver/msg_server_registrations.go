@@ -24,7 +24,25 @@ func (ms msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*type
 		return nil, types.ErrTopicDoesNotExist
 	}
 
-	hasEnoughBal, fee, _ := ms.CheckBalanceForRegistration(ctx, msg.Sender)
+	if err := ms.checkTopicAllowlist(ctx, msg.TopicId, msg.Sender, msg.IsReputer); err != nil {
+		return nil, err
+	}
+
+	// Jailed actors can't register again until their jail duration has elapsed
+	var liveness types.ActorLiveness
+	if msg.IsReputer {
+		liveness, err = ms.k.GetReputerLiveness(ctx, msg.TopicId, msg.Sender)
+	} else {
+		liveness, err = ms.k.GetWorkerLiveness(ctx, msg.TopicId, msg.Sender)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if liveness.IsJailed(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return nil, types.ErrActorJailed
+	}
+
+	hasEnoughBal, fee, _ := ms.CheckBalanceForRegistration(ctx, msg.TopicId, msg.Sender)
 	if !hasEnoughBal {
 		return nil, types.ErrTopicRegistrantNotEnoughDenom
 	}
@@ -43,16 +61,26 @@ func (ms msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*type
 		NodeId:       msg.Owner + "|" + msg.LibP2PKey,
 	}
 
+	// A registration starts the liveness of the actor afresh and ends any jail it has served,
+	// so that jail can't later be used to come back through Unjail without paying the fee
 	if msg.IsReputer {
 		err = ms.k.InsertReputer(ctx, msg.TopicId, msg.Sender, nodeInfo)
 		if err != nil {
 			return nil, err
 		}
+		err = ms.k.RemoveReputerLiveness(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		err = ms.k.InsertWorker(ctx, msg.TopicId, msg.Sender, nodeInfo)
 		if err != nil {
 			return nil, err
 		}
+		err = ms.k.RemoveWorkerLiveness(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.MsgRegisterResponse{
@@ -88,6 +116,10 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.MsgRemove
 		if err != nil {
 			return nil, err
 		}
+		err = ms.k.RemoveReputerLiveness(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
 
 	} else {
 		isRegisteredInTopic, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
@@ -104,6 +136,10 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.MsgRemove
 		if err != nil {
 			return nil, err
 		}
+		err = ms.k.RemoveWorkerLiveness(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Return a successful response
@@ -113,8 +149,84 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.MsgRemove
 	}, nil
 }
 
-func (ms msgServer) CheckBalanceForRegistration(ctx context.Context, address string) (bool, sdk.Coin, error) {
-	moduleParams, err := ms.k.GetParams(ctx)
+// Registers a worker or reputer that was jailed for missing too many nonces back in the topic,
+// once its jail duration has elapsed. No registration fee is charged.
+func (ms msgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	if err := ms.k.ValidateStringIsBech32(msg.Sender); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	var liveness types.ActorLiveness
+	var isRegisteredInTopic bool
+	if msg.IsReputer {
+		liveness, err = ms.k.GetReputerLiveness(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
+		isRegisteredInTopic, err = ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	} else {
+		liveness, err = ms.k.GetWorkerLiveness(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
+		isRegisteredInTopic, err = ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Only an actor whose jail is still pending may unjail. Registering or leaving the topic clears the jail.
+	if liveness.JailedUntil == 0 || isRegisteredInTopic {
+		return nil, types.ErrActorNotJailed
+	}
+	if liveness.IsJailed(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return nil, types.ErrActorJailed
+	}
+	// Unjailing registers the actor again, so it is held to the allowlist of the topic as a registration is
+	if err := ms.checkTopicAllowlist(ctx, msg.TopicId, msg.Sender, msg.IsReputer); err != nil {
+		return nil, err
+	}
+
+	if msg.IsReputer {
+		err = ms.k.UnjailReputer(ctx, msg.TopicId, msg.Sender)
+	} else {
+		err = ms.k.UnjailWorker(ctx, msg.TopicId, msg.Sender)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnjailResponse{}, nil
+}
+
+// Only the workers and reputers allowed by the creator of a permissioned topic may register to it
+func (ms msgServer) checkTopicAllowlist(ctx context.Context, topicId uint64, address string, isReputer bool) error {
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if !topic.IsPermissioned {
+		return nil
+	}
+	isAllowed, err := ms.k.IsInTopicAllowlist(ctx, topicId, address, isReputer)
+	if err != nil {
+		return err
+	}
+	if !isAllowed {
+		return types.ErrNotInTopicAllowlist
+	}
+	return nil
+}
+
+func (ms msgServer) CheckBalanceForRegistration(ctx context.Context, topicId uint64, address string) (bool, sdk.Coin, error) {
+	moduleParams, err := ms.k.GetParamsForTopic(ctx, topicId)
 	if err != nil {
 		return false, sdk.Coin{}, err
 	}
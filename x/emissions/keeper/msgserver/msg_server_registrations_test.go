@@ -314,6 +314,7 @@ func (s *KeeperTestSuite) TestBlocklistedAddressUnableToRegister() {
 		s.accountKeeper,
 		s.bankKeeper,
 		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	blockHeight := int64(600)
@@ -384,3 +385,162 @@ func (s *KeeperTestSuite) TestMsgRegisterReputerInvalidTopicNotExist() {
 	_, err := msgServer.Register(ctx, registerMsg)
 	require.ErrorIs(err, types.ErrTopicDoesNotExist, "Register should return an error")
 }
+
+func (s *KeeperTestSuite) TestMsgUnjailWorker() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	creatorAddress := sdk.AccAddress(PKS[1].Address())
+	topicId := uint64(1)
+	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, Creator: creatorAddress.String()})
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.LivenessWindow = 1
+	moduleParams.MaxMissedEpochs = 0
+	moduleParams.JailDuration = 10
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	mintAmount := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 100))
+	s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, mintAmount)
+	err = s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, workerAddr, mintAmount)
+	require.NoError(err)
+
+	registerMsg := &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		Owner:        workerAddr.String(),
+	}
+	unjailMsg := &types.MsgUnjail{
+		Sender:  workerAddr.String(),
+		TopicId: topicId,
+	}
+
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.NoError(err)
+	_, err = msgServer.Unjail(ctx, unjailMsg)
+	require.ErrorIs(err, types.ErrActorNotJailed, "A worker that was never jailed can't unjail")
+
+	// Missing a single nonce gets the worker jailed
+	err = s.emissionsKeeper.HandleWorkerLiveness(ctx, topicId, map[string]bool{})
+	require.NoError(err)
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.False(isRegistered)
+
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.ErrorIs(err, types.ErrActorJailed, "A jailed worker can't register again")
+	_, err = msgServer.Unjail(ctx.WithBlockHeight(9), unjailMsg)
+	require.ErrorIs(err, types.ErrActorJailed, "A worker can't unjail before its jail duration has elapsed")
+
+	_, err = msgServer.Unjail(ctx.WithBlockHeight(10), unjailMsg)
+	require.NoError(err)
+	isRegistered, err = s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.True(isRegistered, "Unjailed worker should be registered in the topic again")
+
+	_, err = msgServer.Unjail(ctx.WithBlockHeight(10), unjailMsg)
+	require.ErrorIs(err, types.ErrActorNotJailed)
+}
+
+func (s *KeeperTestSuite) TestMsgUnjailRequiresPendingJail() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	creatorAddress := sdk.AccAddress(PKS[1].Address())
+	topicId := uint64(1)
+	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, Creator: creatorAddress.String()})
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.LivenessWindow = 1
+	moduleParams.MaxMissedEpochs = 0
+	moduleParams.JailDuration = 10
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	mintAmount := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 100))
+	s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, mintAmount)
+	err = s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, workerAddr, mintAmount)
+	require.NoError(err)
+
+	registerMsg := &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		Owner:        workerAddr.String(),
+	}
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.NoError(err)
+	err = s.emissionsKeeper.HandleWorkerLiveness(ctx, topicId, map[string]bool{})
+	require.NoError(err)
+
+	// Registering again once the jail has been served clears it
+	_, err = msgServer.Register(ctx.WithBlockHeight(10), registerMsg)
+	require.NoError(err)
+	liveness, err := s.emissionsKeeper.GetWorkerLiveness(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.Equal(types.ActorLiveness{}, liveness)
+
+	_, err = msgServer.RemoveRegistration(ctx.WithBlockHeight(10), &types.MsgRemoveRegistration{
+		Sender:  workerAddr.String(),
+		TopicId: topicId,
+	})
+	require.NoError(err)
+	_, err = msgServer.Unjail(ctx.WithBlockHeight(10), &types.MsgUnjail{
+		Sender:  workerAddr.String(),
+		TopicId: topicId,
+	})
+	require.ErrorIs(err, types.ErrActorNotJailed, "A worker that left the topic can't come back through an old jail")
+}
+
+func (s *KeeperTestSuite) TestMsgUnjailRequiresTopicAllowlist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	creatorAddress := sdk.AccAddress(PKS[1].Address()).String()
+	topicId := uint64(1)
+	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, Creator: creatorAddress, IsPermissioned: true})
+	require.NoError(s.emissionsKeeper.AddToTopicAllowlist(ctx, topicId, workerAddr, false))
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.LivenessWindow = 1
+	moduleParams.MaxMissedEpochs = 0
+	moduleParams.JailDuration = 10
+	require.NoError(s.emissionsKeeper.SetParams(ctx, moduleParams))
+
+	mintAmount := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 100))
+	s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, mintAmount)
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.MustAccAddressFromBech32(workerAddr), mintAmount))
+	_, err = msgServer.Register(ctx, &types.MsgRegister{
+		Sender:       workerAddr,
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		Owner:        workerAddr,
+	})
+	require.NoError(err)
+	require.NoError(s.emissionsKeeper.HandleWorkerLiveness(ctx, topicId, map[string]bool{}))
+
+	// The worker is removed from the allowlist while jailed
+	_, err = msgServer.RemoveFromTopicAllowlist(ctx, &types.MsgRemoveFromTopicAllowlist{
+		Sender:  creatorAddress,
+		TopicId: topicId,
+		Address: workerAddr,
+	})
+	require.NoError(err)
+
+	_, err = msgServer.Unjail(ctx.WithBlockHeight(10), &types.MsgUnjail{Sender: workerAddr, TopicId: topicId})
+	require.ErrorIs(err, types.ErrNotInTopicAllowlist)
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr)
+	require.NoError(err)
+	require.False(isRegistered)
+}
@@ -0,0 +1,185 @@
+package msgserver
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Adds stake to the topic-agnostic stake pool of a reputer.
+// The stake in every topic the reputer allocates to rises along with the pool.
+func (ms msgServer) AddReputerStakePool(ctx context.Context, msg *types.MsgAddReputerStakePool) (*types.MsgAddReputerStakePoolResponse, error) {
+	if msg.Amount.IsZero() {
+		return nil, types.ErrReceivedZeroAmount
+	}
+
+	// Send the funds
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, msg.Amount))
+	err := ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraStakingAccountName, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.AddReputerStakePool(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAddReputerStakePoolResponse{}, nil
+}
+
+// Kicks off the removal of stake from the stake pool of a reputer. The stake keeps backing the topics
+// it is allocated to until the removal is confirmed after the stake removal delay.
+func (ms msgServer) StartRemoveReputerStakePool(ctx context.Context, msg *types.MsgStartRemoveReputerStakePool) (*types.MsgStartRemoveReputerStakePoolResponse, error) {
+	if msg.Amount.IsZero() {
+		return nil, types.ErrReceivedZeroAmount
+	}
+
+	pool, err := ms.k.GetReputerStakePool(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount.GT(pool) {
+		return nil, types.ErrInsufficientReputerStakePool
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = ms.k.SetReputerStakePoolRemoval(ctx, types.ReputerStakePoolRemoval{
+		BlockRemovalStarted: sdkCtx.BlockHeight(),
+		Reputer:             msg.Sender,
+		Amount:              msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgStartRemoveReputerStakePoolResponse{}, nil
+}
+
+// Removes the stake queued for removal from the stake pool of a reputer once the stake removal delay has passed
+func (ms msgServer) ConfirmRemoveReputerStakePool(ctx context.Context, msg *types.MsgConfirmRemoveReputerStakePool) (*types.MsgConfirmRemoveReputerStakePoolResponse, error) {
+	removal, err := ms.k.GetReputerStakePoolRemoval(ctx, msg.Sender)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrConfirmRemoveStakeNoRemovalStarted
+		}
+		return nil, err
+	}
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if removal.BlockRemovalStarted+moduleParams.RemoveStakeDelayWindow >= sdkCtx.BlockHeight() {
+		return nil, types.ErrConfirmRemoveStakeTooEarly
+	}
+
+	// Update the stake data structures before sending the funds, as the pool may have shrunk since
+	err = ms.k.RemoveReputerStakePool(ctx, msg.Sender, removal.Amount)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.DeleteReputerStakePoolRemoval(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, removal.Amount))
+	err = ms.k.SendCoinsFromModuleToAccount(ctx, types.AlloraStakingAccountName, msg.Sender, coins)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgConfirmRemoveReputerStakePoolResponse{}, nil
+}
+
+// Sets the fraction of its stake pool a reputer allocates to a topic.
+// A higher fraction applies at once. A lower fraction is queued, as it removes stake from the topic,
+// and applies once confirmed after the stake removal delay.
+func (ms msgServer) SetReputerStakeAllocation(ctx context.Context, msg *types.MsgSetReputerStakeAllocation) (*types.MsgSetReputerStakeAllocationResponse, error) {
+	if msg.Fraction.IsNegative() || msg.Fraction.Gt(alloraMath.OneDec()) {
+		return nil, types.ErrInvalidReputerStakeAllocation
+	}
+
+	currentFraction, err := ms.k.GetReputerStakeAllocation(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Fraction.Lt(currentFraction) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		err = ms.k.SetReputerStakeAllocationDecrease(ctx, types.ReputerStakeAllocationDecrease{
+			BlockRemovalStarted: sdkCtx.BlockHeight(),
+			Reputer:             msg.Sender,
+			Allocation: types.ReputerStakeAllocation{
+				TopicId:  msg.TopicId,
+				Fraction: msg.Fraction,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgSetReputerStakeAllocationResponse{}, nil
+	}
+
+	// Only reputers registered in the topic may back it with more stake
+	isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isReputerRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	allocatedBefore, err := ms.k.GetAllocatedStakeOfReputerInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.SetReputerStakeAllocation(ctx, msg.Sender, msg.TopicId, msg.Fraction)
+	if err != nil {
+		return nil, err
+	}
+	// A higher allocation supersedes any lower one still waiting for the delay
+	err = ms.k.DeleteReputerStakeAllocationDecrease(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	allocatedAfter, err := ms.k.GetAllocatedStakeOfReputerInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.ActivateTopicIfWeightAtLeastGlobalMin(ctx, msg.TopicId, allocatedAfter.Sub(allocatedBefore))
+	return &types.MsgSetReputerStakeAllocationResponse{}, err
+}
+
+// Applies a lower allocation of a reputer to a topic once the stake removal delay has passed
+func (ms msgServer) ConfirmReputerStakeAllocationDecrease(ctx context.Context, msg *types.MsgConfirmReputerStakeAllocationDecrease) (*types.MsgConfirmReputerStakeAllocationDecreaseResponse, error) {
+	decrease, err := ms.k.GetReputerStakeAllocationDecrease(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrConfirmRemoveStakeNoRemovalStarted
+		}
+		return nil, err
+	}
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if decrease.BlockRemovalStarted+moduleParams.RemoveStakeDelayWindow >= sdkCtx.BlockHeight() {
+		return nil, types.ErrConfirmRemoveStakeTooEarly
+	}
+
+	err = ms.k.SetReputerStakeAllocation(ctx, msg.Sender, msg.TopicId, decrease.Allocation.Fraction)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.DeleteReputerStakeAllocationDecrease(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgConfirmReputerStakeAllocationDecreaseResponse{}, nil
+}
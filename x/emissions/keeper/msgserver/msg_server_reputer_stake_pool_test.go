@@ -0,0 +1,197 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Creates two topics the reputer is registered in and puts 1000 in its stake pool
+func (s *KeeperTestSuite) commonReputerStakePoolSetup(reputerAddr string, workerAddr string) (uint64, uint64) {
+	require := s.Require()
+	topicId1 := s.commonStakingSetup(s.ctx, reputerAddr, workerAddr, cosmosMath.NewInt(1000))
+	topicId2 := s.commonStakingSetup(s.ctx, reputerAddr, workerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(reputerAddr), cosmosMath.NewInt(1000))
+
+	_, err := s.msgServer.AddReputerStakePool(s.ctx, &types.MsgAddReputerStakePool{
+		Sender: reputerAddr,
+		Amount: cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	return topicId1, topicId2
+}
+
+func (s *KeeperTestSuite) TestReputerStakeAllocationsDeriveTopicStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	topicId1, topicId2 := s.commonReputerStakePoolSetup(reputerAddr, workerAddr)
+	totalStakeBefore, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+
+	_, err = s.msgServer.SetReputerStakeAllocation(ctx, &types.MsgSetReputerStakeAllocation{
+		Sender:   reputerAddr,
+		TopicId:  topicId1,
+		Fraction: alloraMath.MustNewDecFromString("0.6"),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.SetReputerStakeAllocation(ctx, &types.MsgSetReputerStakeAllocation{
+		Sender:   reputerAddr,
+		TopicId:  topicId2,
+		Fraction: alloraMath.MustNewDecFromString("0.5"),
+	})
+	require.ErrorIs(err, types.ErrInvalidReputerStakeAllocation)
+	_, err = s.msgServer.SetReputerStakeAllocation(ctx, &types.MsgSetReputerStakeAllocation{
+		Sender:   reputerAddr,
+		TopicId:  topicId2,
+		Fraction: alloraMath.MustNewDecFromString("0.4"),
+	})
+	require.NoError(err)
+
+	stake1, err := keeper.GetStakeOnReputerInTopic(ctx, topicId1, reputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(600), stake1)
+	stake2, err := keeper.GetStakeOnReputerInTopic(ctx, topicId2, reputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(400), stake2)
+	topicStake1, err := keeper.GetTopicStake(ctx, topicId1)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(600), topicStake1)
+
+	// Growing the pool grows the stake in every topic it is allocated to
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(reputerAddr), cosmosMath.NewInt(500))
+	_, err = s.msgServer.AddReputerStakePool(ctx, &types.MsgAddReputerStakePool{
+		Sender: reputerAddr,
+		Amount: cosmosMath.NewInt(500),
+	})
+	require.NoError(err)
+
+	stake1, err = keeper.GetStakeOnReputerInTopic(ctx, topicId1, reputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(900), stake1)
+	stake2, err = keeper.GetStakeOnReputerInTopic(ctx, topicId2, reputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(600), stake2)
+	totalStakeAfter, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1500), totalStakeAfter.Sub(totalStakeBefore))
+
+	// Allocated stake cannot be taken out as stake placed directly in the topic
+	_, err = s.msgServer.StartRemoveStake(ctx, &types.MsgStartRemoveStake{
+		Sender:  reputerAddr,
+		TopicId: topicId1,
+		Amount:  cosmosMath.NewInt(1),
+	})
+	require.ErrorIs(err, types.ErrInsufficientStakeToRemove)
+}
+
+func (s *KeeperTestSuite) TestReputerStakeAllocationDecreaseWaitsForRemovalDelay() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	topicId1, topicId2 := s.commonReputerStakePoolSetup(reputerAddr, workerAddr)
+
+	_, err := s.msgServer.SetReputerStakeAllocation(ctx, &types.MsgSetReputerStakeAllocation{
+		Sender:   reputerAddr,
+		TopicId:  topicId1,
+		Fraction: alloraMath.MustNewDecFromString("0.8"),
+	})
+	require.NoError(err)
+
+	startBlock := ctx.BlockHeight()
+	_, err = s.msgServer.SetReputerStakeAllocation(ctx, &types.MsgSetReputerStakeAllocation{
+		Sender:   reputerAddr,
+		TopicId:  topicId1,
+		Fraction: alloraMath.MustNewDecFromString("0.2"),
+	})
+	require.NoError(err)
+
+	// The stake stays in the topic, and still counts against the allocations of other topics
+	stake1, err := keeper.GetStakeOnReputerInTopic(ctx, topicId1, reputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(800), stake1)
+	_, err = s.msgServer.SetReputerStakeAllocation(ctx, &types.MsgSetReputerStakeAllocation{
+		Sender:   reputerAddr,
+		TopicId:  topicId2,
+		Fraction: alloraMath.MustNewDecFromString("0.5"),
+	})
+	require.ErrorIs(err, types.ErrInvalidReputerStakeAllocation)
+
+	_, err = s.msgServer.ConfirmReputerStakeAllocationDecrease(ctx, &types.MsgConfirmReputerStakeAllocationDecrease{
+		Sender:  reputerAddr,
+		TopicId: topicId1,
+	})
+	require.ErrorIs(err, types.ErrConfirmRemoveStakeTooEarly)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(startBlock + moduleParams.RemoveStakeDelayWindow + 1)
+	_, err = s.msgServer.ConfirmReputerStakeAllocationDecrease(ctx, &types.MsgConfirmReputerStakeAllocationDecrease{
+		Sender:  reputerAddr,
+		TopicId: topicId1,
+	})
+	require.NoError(err)
+
+	stake1, err = keeper.GetStakeOnReputerInTopic(ctx, topicId1, reputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(200), stake1)
+	_, err = keeper.GetReputerStakeAllocationDecrease(ctx, reputerAddr, topicId1)
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestReputerStakePoolRemoval() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	topicId1, _ := s.commonReputerStakePoolSetup(reputerAddr, workerAddr)
+
+	_, err := s.msgServer.SetReputerStakeAllocation(ctx, &types.MsgSetReputerStakeAllocation{
+		Sender:   reputerAddr,
+		TopicId:  topicId1,
+		Fraction: alloraMath.MustNewDecFromString("0.5"),
+	})
+	require.NoError(err)
+
+	_, err = s.msgServer.StartRemoveReputerStakePool(ctx, &types.MsgStartRemoveReputerStakePool{
+		Sender: reputerAddr,
+		Amount: cosmosMath.NewInt(1001),
+	})
+	require.ErrorIs(err, types.ErrInsufficientReputerStakePool)
+
+	startBlock := ctx.BlockHeight()
+	_, err = s.msgServer.StartRemoveReputerStakePool(ctx, &types.MsgStartRemoveReputerStakePool{
+		Sender: reputerAddr,
+		Amount: cosmosMath.NewInt(400),
+	})
+	require.NoError(err)
+
+	_, err = s.msgServer.ConfirmRemoveReputerStakePool(ctx, &types.MsgConfirmRemoveReputerStakePool{Sender: reputerAddr})
+	require.ErrorIs(err, types.ErrConfirmRemoveStakeTooEarly)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(startBlock + moduleParams.RemoveStakeDelayWindow + 1)
+	balanceBefore := s.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(reputerAddr), params.DefaultBondDenom)
+	_, err = s.msgServer.ConfirmRemoveReputerStakePool(ctx, &types.MsgConfirmRemoveReputerStakePool{Sender: reputerAddr})
+	require.NoError(err)
+
+	balanceAfter := s.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(reputerAddr), params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(400), balanceAfter.Amount.Sub(balanceBefore.Amount))
+	pool, err := keeper.GetReputerStakePool(ctx, reputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(600), pool)
+	stake1, err := keeper.GetStakeOnReputerInTopic(ctx, topicId1, reputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(300), stake1)
+}
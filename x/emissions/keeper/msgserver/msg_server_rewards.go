@@ -0,0 +1,27 @@
+package msgserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Withdraws the rewards credited to the sender while worker rewards are claimable
+func (ms msgServer) ClaimRewards(ctx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	claimed, err := ms.k.ClaimRewards(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgClaimRewardsResponse{Claimed: claimed}, nil
+}
+
+func (ms msgServer) SetRewardWithdrawAddress(ctx context.Context, msg *types.MsgSetRewardWithdrawAddress) (*types.MsgSetRewardWithdrawAddressResponse, error) {
+	if err := ms.k.ValidateStringIsBech32(msg.WithdrawAddress); err != nil {
+		return nil, err
+	}
+	err := ms.k.SetRewardWithdrawAddress(ctx, msg.Sender, msg.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetRewardWithdrawAddressResponse{}, nil
+}
@@ -0,0 +1,44 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestClaimRewardsToWithdrawAddress() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	workerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	withdrawAddr := sdk.AccAddress(PKS[1].Address())
+
+	_, err := s.msgServer.ClaimRewards(ctx, &types.MsgClaimRewards{Sender: workerAddr})
+	require.ErrorIs(err, types.ErrNoClaimableRewards)
+
+	rewards := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(100)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraClaimableRewardsAccountName, rewards.Add(rewards...)))
+	require.NoError(keeper.CreditClaimableRewards(ctx, workerAddr, rewards))
+	require.NoError(keeper.CreditClaimableRewards(ctx, workerAddr, rewards))
+
+	_, err = s.msgServer.SetRewardWithdrawAddress(ctx, &types.MsgSetRewardWithdrawAddress{
+		Sender:          workerAddr,
+		WithdrawAddress: withdrawAddr.String(),
+	})
+	require.NoError(err)
+
+	balanceBefore := s.bankKeeper.GetBalance(ctx, withdrawAddr, params.DefaultBondDenom)
+	res, err := s.msgServer.ClaimRewards(ctx, &types.MsgClaimRewards{Sender: workerAddr})
+	require.NoError(err)
+	require.Equal(rewards.Add(rewards...), res.Claimed)
+	balanceAfter := s.bankKeeper.GetBalance(ctx, withdrawAddr, params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(200), balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	claimable, err := keeper.GetClaimableRewards(ctx, workerAddr)
+	require.NoError(err)
+	require.True(claimable.IsZero())
+	_, err = s.msgServer.ClaimRewards(ctx, &types.MsgClaimRewards{Sender: workerAddr})
+	require.ErrorIs(err, types.ErrNoClaimableRewards)
+}
@@ -66,17 +66,11 @@ func (ms msgServer) StartRemoveStake(ctx context.Context, msg *types.MsgStartRem
 	}
 
 	// Check the sender has enough stake already placed on the topic to remove the stake
-	stakePlaced, err := ms.k.GetStakeOnReputerInTopic(ctx, msg.TopicId, msg.Sender)
+	removableStake, err := ms.getRemovableStakeOfReputerInTopic(ctx, msg.TopicId, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
-
-	delegateStakeUponReputerInTopic, err := ms.k.GetDelegateStakeUponReputer(ctx, msg.TopicId, msg.Sender)
-	if err != nil {
-		return nil, err
-	}
-	reputerStakeInTopicWithoutDelegateStake := stakePlaced.Sub(delegateStakeUponReputerInTopic)
-	if msg.Amount.GT(reputerStakeInTopicWithoutDelegateStake) {
+	if msg.Amount.GT(removableStake) {
 		return nil, types.ErrInsufficientStakeToRemove
 	}
 
@@ -95,6 +89,7 @@ func (ms msgServer) StartRemoveStake(ctx context.Context, msg *types.MsgStartRem
 	if err != nil {
 		return nil, err
 	}
+	types.EmitNewStakeRemovalStartedEvent(sdkCtx, stakeToRemove)
 	return &types.MsgStartRemoveStakeResponse{}, nil
 }
 
@@ -118,6 +113,14 @@ func (ms msgServer) ConfirmRemoveStake(ctx context.Context, msg *types.MsgConfir
 	if stakeRemoval.BlockRemovalStarted+moduleParams.RemoveStakeDelayWindow >= currentBlock {
 		return nil, types.ErrConfirmRemoveStakeTooEarly
 	}
+	// The stake allocated from the stake pool may have grown since the removal started
+	removableStake, err := ms.getRemovableStakeOfReputerInTopic(ctx, stakeRemoval.Placement.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if stakeRemoval.Placement.Amount.GT(removableStake) {
+		return nil, types.ErrInsufficientStakeToRemove
+	}
 	// Check the module has enough funds to send back to the sender
 	// Bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
 	// Send the funds
@@ -136,6 +139,24 @@ func (ms msgServer) ConfirmRemoveStake(ctx context.Context, msg *types.MsgConfir
 	return &types.MsgConfirmRemoveStakeResponse{}, nil
 }
 
+// Returns the stake a reputer placed directly in a topic,
+// leaving out the stake delegated to it and the stake allocated to the topic from its stake pool
+func (ms msgServer) getRemovableStakeOfReputerInTopic(ctx context.Context, topicId TopicId, reputer Actor) (cosmosMath.Int, error) {
+	stakePlaced, err := ms.k.GetStakeOnReputerInTopic(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	delegateStakeUponReputerInTopic, err := ms.k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	allocatedStake, err := ms.k.GetAllocatedStakeOfReputerInTopic(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return stakePlaced.Sub(delegateStakeUponReputerInTopic).Sub(allocatedStake), nil
+}
+
 // Delegates a stake to a reputer. Sender need not be registered to delegate stake.
 func (ms msgServer) DelegateStake(ctx context.Context, msg *types.MsgDelegateStake) (*types.MsgDelegateStakeResponse, error) {
 	if msg.Amount.IsZero() {
@@ -209,6 +230,25 @@ func (ms msgServer) StartRemoveDelegateStake(ctx context.Context, msg *types.Msg
 	if delegateStakePlaced.Amount.Lt(amountDec) {
 		return nil, types.ErrInsufficientDelegateStakeToRemove
 	}
+	// Stake redelegated to the position is still bound to the reputer it came from until its redelegation is over
+	redelegations, err := ms.k.GetInFlightRedelegationsTo(ctx, msg.TopicId, msg.Reputer, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	removable := delegateStakePlaced.Amount
+	for _, redelegation := range redelegations {
+		inFlightAmount, err := alloraMath.NewDecFromSdkInt(redelegation.Amount)
+		if err != nil {
+			return nil, err
+		}
+		removable, err = removable.Sub(inFlightAmount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if removable.Lt(amountDec) {
+		return nil, types.ErrInsufficientDelegateStakeToRemove
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	stakeToRemove := types.DelegateStakeRemoval{
@@ -226,6 +266,7 @@ func (ms msgServer) StartRemoveDelegateStake(ctx context.Context, msg *types.Msg
 	if err != nil {
 		return nil, err
 	}
+	types.EmitNewDelegateStakeRemovalStartedEvent(sdkCtx, stakeToRemove)
 	return &types.MsgStartRemoveDelegateStakeResponse{}, nil
 }
 
@@ -269,6 +310,72 @@ func (ms msgServer) ConfirmRemoveDelegateStake(ctx context.Context, msg *types.M
 	return &types.MsgConfirmRemoveDelegateStakeResponse{}, nil
 }
 
+// Moves delegate stake to another reputer or topic at once, instead of removing it and delegating it again.
+// Stake already queued for removal from the source can't be redelegated.
+func (ms msgServer) RedelegateStake(ctx context.Context, msg *types.MsgRedelegateStake) (*types.MsgRedelegateStakeResponse, error) {
+	if msg.Amount.IsZero() {
+		return nil, types.ErrReceivedZeroAmount
+	}
+	if msg.SrcTopicId == msg.DstTopicId && msg.SrcReputer == msg.DstReputer {
+		return nil, types.ErrRedelegationToSamePosition
+	}
+	if msg.DstReputer == msg.Sender {
+		return nil, types.ErrCantSelfDelegate
+	}
+
+	// Check the target reputer exists and is registered
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.DstTopicId, msg.DstReputer)
+	if err != nil {
+		return nil, err
+	}
+	if !isRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	// Stake moved to the source can not move on until its own redelegation is over, so it can not be chained
+	inFlight, err := ms.k.HasInFlightRedelegationTo(ctx, msg.SrcTopicId, msg.SrcReputer, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if inFlight {
+		return nil, types.ErrTransitiveRedelegation
+	}
+
+	// Check the delegator has enough stake placed on the source that is not already being removed
+	delegateStakePlaced, err := ms.k.GetDelegateStakePlacement(ctx, msg.SrcTopicId, msg.Sender, msg.SrcReputer)
+	if err != nil {
+		return nil, err
+	}
+	redelegatable := delegateStakePlaced.Amount
+	stakeRemoval, err := ms.k.GetDelegateStakeRemovalByTopicAndAddress(ctx, msg.SrcTopicId, msg.SrcReputer, msg.Sender)
+	if err == nil {
+		removalAmount, err := alloraMath.NewDecFromSdkInt(stakeRemoval.Placement.Amount)
+		if err != nil {
+			return nil, err
+		}
+		redelegatable, err = redelegatable.Sub(removalAmount)
+		if err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	amountDec, err := alloraMath.NewDecFromSdkInt(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if redelegatable.Lt(amountDec) {
+		return nil, types.ErrInsufficientDelegateStakeToRedelegate
+	}
+
+	// The stake stays in the staking account, only the stake data structures change
+	err = ms.k.RedelegateStake(ctx, msg.Sender, msg.SrcTopicId, msg.SrcReputer, msg.DstTopicId, msg.DstReputer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRedelegateStakeResponse{}, nil
+}
+
 func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.MsgRewardDelegateStake) (*types.MsgRewardDelegateStakeResponse, error) {
 	// Check the target reputer exists and is registered
 	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
@@ -287,11 +394,7 @@ func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.MsgRewar
 	if err != nil {
 		return nil, err
 	}
-	pendingReward, err := delegateInfo.Amount.Mul(share)
-	if err != nil {
-		return nil, err
-	}
-	pendingReward, err = pendingReward.Sub(delegateInfo.RewardDebt)
+	pendingReward, err := ms.k.GetDelegateStakePendingReward(ctx, msg.TopicId, msg.Sender, msg.Reputer)
 	if err != nil {
 		return nil, err
 	}
@@ -309,3 +412,55 @@ func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.MsgRewar
 	}
 	return &types.MsgRewardDelegateStakeResponse{}, nil
 }
+
+// Turns the auto-compounding of the rewards of a delegator on or off
+func (ms msgServer) SetDelegatorAutoCompound(ctx context.Context, msg *types.MsgSetDelegatorAutoCompound) (*types.MsgSetDelegatorAutoCompoundResponse, error) {
+	if err := ms.k.ValidateStringIsBech32(msg.Sender); err != nil {
+		return nil, err
+	}
+	err := ms.k.SetDelegatorAutoCompound(ctx, msg.Sender, msg.AutoCompound)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetDelegatorAutoCompoundResponse{}, nil
+}
+
+// Sets the commission a reputer takes from the rewards of the stake delegated to it. The first commission
+// of a reputer fixes its max rate and max change rate, after that only the rate may change, once per day.
+func (ms msgServer) SetReputerCommission(ctx context.Context, msg *types.MsgSetReputerCommission) (*types.MsgSetReputerCommissionResponse, error) {
+	if err := ms.k.ValidateStringIsBech32(msg.Sender); err != nil {
+		return nil, err
+	}
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	commission, err := ms.k.GetReputerCommission(ctx, msg.Sender)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		commission = types.ReputerCommission{
+			Rate:          msg.Rate,
+			MaxRate:       msg.MaxRate,
+			MaxChangeRate: msg.MaxChangeRate,
+			UpdateTime:    blockTime,
+		}
+		if err := commission.Validate(); err != nil {
+			return nil, err
+		}
+	} else {
+		if !msg.MaxRate.Equal(commission.MaxRate) || !msg.MaxChangeRate.Equal(commission.MaxChangeRate) {
+			return nil, types.ErrReputerCommissionMaxRatesImmutable
+		}
+		if err := commission.ValidateNewRate(msg.Rate, blockTime); err != nil {
+			return nil, err
+		}
+		commission.Rate = msg.Rate
+		commission.UpdateTime = blockTime
+	}
+
+	err = ms.k.SetReputerCommission(ctx, msg.Sender, commission)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetReputerCommissionResponse{}, nil
+}
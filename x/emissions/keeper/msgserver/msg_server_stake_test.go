@@ -2,6 +2,7 @@ package msgserver_test
 
 import (
 	"errors"
+	"time"
 
 	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
@@ -1145,3 +1146,553 @@ func (s *KeeperTestSuite) TestMultiRoundReputerStakeVs1000xDelegatorStake() {
 
 	testutil.InEpsilon3(s.T(), alloraMath.MustNewDecFromString(totalRewardsSecondRound.String()), totalRewardsThirdRound.String())
 }
+
+func (s *KeeperTestSuite) TestDelegatorAutoCompoundAddsPendingRewardToStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+	block := int64(1003)
+	score := alloraMath.MustNewDecFromString("17.53436")
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address())
+	reputerAddr := sdk.AccAddress(PKS[1].Address())
+	workerAddr := sdk.AccAddress(PKS[2].Address())
+
+	registrationInitialBalance := cosmosMath.NewInt(1000)
+	stakeAmount := cosmosMath.NewInt(500000)
+
+	topicId := s.commonStakingSetup(ctx, reputerAddr.String(), workerAddr.String(), registrationInitialBalance)
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000000))
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000000))
+
+	_, err := s.msgServer.AddStake(ctx, &types.MsgAddStake{
+		Sender:  reputerAddr.String(),
+		TopicId: topicId,
+		Amount:  stakeAmount,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+		Sender:  delegatorAddr.String(),
+		TopicId: topicId,
+		Reputer: reputerAddr.String(),
+		Amount:  stakeAmount,
+	})
+	require.NoError(err)
+
+	reputerRewards := s.insertValueBundlesAndGetRewards(reputerAddr, topicId, block, score)
+	expectedReward := reputerRewards[0].Reward.SdkIntTrim()
+
+	pendingRewards, err := keeper.GetDelegatorPendingRewards(ctx, delegatorAddr.String())
+	require.NoError(err)
+	require.Len(pendingRewards, 1)
+	require.Equal(topicId, pendingRewards[0].TopicId)
+	require.Equal(reputerAddr.String(), pendingRewards[0].Reputer)
+	require.Equal(expectedReward, pendingRewards[0].PendingReward.SdkIntTrim())
+
+	// Auto-compound can only be turned on by a delegator that has placed stake
+	_, err = s.msgServer.SetDelegatorAutoCompound(ctx, &types.MsgSetDelegatorAutoCompound{
+		Sender:       workerAddr.String(),
+		AutoCompound: true,
+	})
+	require.ErrorIs(err, types.ErrNoDelegateStakePlacement)
+	_, err = s.msgServer.SetDelegatorAutoCompound(ctx, &types.MsgSetDelegatorAutoCompound{
+		Sender:       delegatorAddr.String(),
+		AutoCompound: true,
+	})
+	require.NoError(err)
+	autoCompound, err := keeper.IsDelegatorAutoCompound(ctx, delegatorAddr.String())
+	require.NoError(err)
+	require.True(autoCompound)
+	delegators, err := keeper.GetAutoCompoundDelegators(ctx, topicId, reputerAddr.String())
+	require.NoError(err)
+	require.Equal([]string{delegatorAddr.String()}, delegators)
+
+	balanceBefore := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	err = keeper.CompoundDelegateStakeReward(ctx, topicId, delegatorAddr.String(), reputerAddr.String())
+	require.NoError(err)
+	balanceAfter := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	require.Equal(balanceBefore, balanceAfter, "Compounded rewards must not be paid out")
+
+	stakePlacement, err := keeper.GetDelegateStakePlacement(ctx, topicId, delegatorAddr.String(), reputerAddr.String())
+	require.NoError(err)
+	require.Equal(stakeAmount.Add(expectedReward), stakePlacement.Amount.SdkIntTrim())
+	stakeOnReputer, err := keeper.GetStakeOnReputerInTopic(ctx, topicId, reputerAddr.String())
+	require.NoError(err)
+	require.Equal(stakeAmount.Add(stakeAmount).Add(expectedReward), stakeOnReputer)
+
+	// The fraction of a token that was not compounded stays pending
+	compoundedReward, err := alloraMath.NewDecFromSdkInt(expectedReward)
+	require.NoError(err)
+	expectedRemainder, err := pendingRewards[0].PendingReward.Sub(compoundedReward)
+	require.NoError(err)
+	require.False(expectedRemainder.IsZero())
+	pendingReward, err := keeper.GetDelegateStakePendingReward(ctx, topicId, delegatorAddr.String(), reputerAddr.String())
+	require.NoError(err)
+	testutil.InEpsilon5(s.T(), pendingReward, expectedRemainder.String())
+
+	// Stake placed after auto-compound is turned on compounds too
+	newPlacement := types.DelegatorInfo{Amount: alloraMath.NewDecFromInt64(100), RewardDebt: alloraMath.ZeroDec()}
+	err = keeper.SetDelegateStakePlacement(ctx, topicId, delegatorAddr.String(), workerAddr.String(), newPlacement)
+	require.NoError(err)
+	delegators, err = keeper.GetAutoCompoundDelegators(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.Equal([]string{delegatorAddr.String()}, delegators)
+
+	// Removing all of the stake on a reputer stops the compounding on it, but not the setting of the delegator
+	err = keeper.RemoveDelegateStake(ctx, topicId, delegatorAddr.String(), reputerAddr.String(), stakePlacement.Amount.SdkIntTrim())
+	require.NoError(err)
+	delegators, err = keeper.GetAutoCompoundDelegators(ctx, topicId, reputerAddr.String())
+	require.NoError(err)
+	require.Empty(delegators)
+	autoCompound, err = keeper.IsDelegatorAutoCompound(ctx, delegatorAddr.String())
+	require.NoError(err)
+	require.True(autoCompound)
+
+	// Turning auto-compound off stops the compounding on all of the stake of the delegator
+	_, err = s.msgServer.SetDelegatorAutoCompound(ctx, &types.MsgSetDelegatorAutoCompound{
+		Sender:       delegatorAddr.String(),
+		AutoCompound: false,
+	})
+	require.NoError(err)
+	delegators, err = keeper.GetAutoCompoundDelegators(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.Empty(delegators)
+}
+
+func (s *KeeperTestSuite) TestSetReputerCommission() {
+	ctx := s.ctx
+	require := s.Require()
+	reputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+
+	msg := &types.MsgSetReputerCommission{
+		Sender:        reputerAddr,
+		Rate:          alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.01"),
+	}
+	_, err := s.msgServer.SetReputerCommission(ctx, msg)
+	require.NoError(err)
+
+	commissionRate, err := s.emissionsKeeper.GetReputerCommissionRate(ctx, reputerAddr)
+	require.NoError(err)
+	require.True(alloraMath.MustNewDecFromString("0.1").Equal(commissionRate))
+
+	// The rate may change at most once per day
+	msg.Rate = alloraMath.MustNewDecFromString("0.105")
+	_, err = s.msgServer.SetReputerCommission(ctx, msg)
+	require.ErrorIs(err, types.ErrReputerCommissionUpdateTooSoon)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	// and by at most the max change rate
+	msg.Rate = alloraMath.MustNewDecFromString("0.12")
+	_, err = s.msgServer.SetReputerCommission(ctx, msg)
+	require.ErrorIs(err, types.ErrReputerCommissionChangeTooLarge)
+
+	// while the max rates stay fixed
+	msg.Rate = alloraMath.MustNewDecFromString("0.105")
+	msg.MaxRate = alloraMath.MustNewDecFromString("0.3")
+	_, err = s.msgServer.SetReputerCommission(ctx, msg)
+	require.ErrorIs(err, types.ErrReputerCommissionMaxRatesImmutable)
+
+	msg.MaxRate = alloraMath.MustNewDecFromString("0.2")
+	_, err = s.msgServer.SetReputerCommission(ctx, msg)
+	require.NoError(err)
+
+	commissionRate, err = s.emissionsKeeper.GetReputerCommissionRate(ctx, reputerAddr)
+	require.NoError(err)
+	require.True(alloraMath.MustNewDecFromString("0.105").Equal(commissionRate))
+}
+
+func (s *KeeperTestSuite) TestSetReputerCommissionInvalid() {
+	ctx := s.ctx
+	require := s.Require()
+	reputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+
+	_, err := s.msgServer.SetReputerCommission(ctx, &types.MsgSetReputerCommission{
+		Sender:        reputerAddr,
+		Rate:          alloraMath.MustNewDecFromString("0.3"),
+		MaxRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.01"),
+	})
+	require.ErrorIs(err, types.ErrInvalidReputerCommission)
+
+	_, err = s.msgServer.SetReputerCommission(ctx, &types.MsgSetReputerCommission{
+		Sender:        reputerAddr,
+		Rate:          alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:       alloraMath.MustNewDecFromString("1.5"),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.01"),
+	})
+	require.ErrorIs(err, types.ErrInvalidReputerCommission)
+}
+
+func (s *KeeperTestSuite) TestReputerCommissionIsTakenFromDelegatorRewards() {
+	ctx := s.ctx
+	require := s.Require()
+	block := int64(1003)
+	score := alloraMath.MustNewDecFromString("17.53436")
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address())
+	reputerAddr := sdk.AccAddress(PKS[1].Address())
+	workerAddr := sdk.AccAddress(PKS[2].Address())
+
+	registrationInitialBalance := cosmosMath.NewInt(1000)
+	stakeAmount := cosmosMath.NewInt(500000)
+
+	topicId := s.commonStakingSetup(ctx, reputerAddr.String(), workerAddr.String(), registrationInitialBalance)
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000000))
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000000))
+
+	_, err := s.msgServer.AddStake(ctx, &types.MsgAddStake{
+		Sender:  reputerAddr.String(),
+		TopicId: topicId,
+		Amount:  stakeAmount,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+		Sender:  delegatorAddr.String(),
+		TopicId: topicId,
+		Reputer: reputerAddr.String(),
+		Amount:  stakeAmount,
+	})
+	require.NoError(err)
+	commissionRate := alloraMath.MustNewDecFromString("0.1")
+	_, err = s.msgServer.SetReputerCommission(ctx, &types.MsgSetReputerCommission{
+		Sender:        reputerAddr.String(),
+		Rate:          commissionRate,
+		MaxRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.01"),
+	})
+	require.NoError(err)
+
+	reputerRewards := s.insertValueBundlesAndGetRewards(reputerAddr, topicId, block, score)
+	reputerReward := reputerRewards[0]
+	require.True(commissionRate.Equal(reputerReward.CommissionRate))
+	require.True(reputerReward.Commission.Gt(alloraMath.ZeroDec()))
+
+	// With equal stakes, the reputer gets the delegators' reward plus the commission twice:
+	// once as the commission itself and once as the part of the delegator reward it no longer gets
+	pendingReward, err := s.emissionsKeeper.GetDelegateStakePendingReward(ctx, topicId, delegatorAddr.String(), reputerAddr.String())
+	require.NoError(err)
+	doubleCommission, err := reputerReward.Commission.Mul(alloraMath.NewDecFromInt64(2))
+	require.NoError(err)
+	expectedReputerReward, err := pendingReward.Add(doubleCommission)
+	require.NoError(err)
+	require.InDelta(
+		expectedReputerReward.SdkIntTrim().Int64(),
+		reputerReward.Reward.SdkIntTrim().Int64(),
+		1,
+	)
+}
+
+func (s *KeeperTestSuite) TestRedelegateStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	srcReputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	dstReputerAddr := sdk.AccAddress(PKS[3].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[2].Address()).String()
+	delegateAmount := cosmosMath.NewInt(1000)
+	redelegateAmount := cosmosMath.NewInt(600)
+
+	srcTopicId := s.commonStakingSetup(ctx, srcReputerAddr, workerAddr, cosmosMath.NewInt(1000))
+	dstTopicId := s.commonStakingSetup(ctx, dstReputerAddr, workerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(delegatorAddr), delegateAmount)
+
+	_, err := s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+		Sender:  delegatorAddr,
+		TopicId: srcTopicId,
+		Reputer: srcReputerAddr,
+		Amount:  delegateAmount,
+	})
+	require.NoError(err)
+
+	// Rewards accrued on the source must be paid out before the stake moves
+	err = keeper.SetDelegateRewardPerShare(ctx, srcTopicId, srcReputerAddr, alloraMath.MustNewDecFromString("0.5"))
+	require.NoError(err)
+	pendingRewardCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(500)))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, pendingRewardCoins))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToModule(ctx, types.AlloraStakingAccountName, types.AlloraPendingRewardForDelegatorAccountName, pendingRewardCoins))
+	balanceBefore := s.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(delegatorAddr), params.DefaultBondDenom)
+
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr,
+		SrcTopicId: srcTopicId,
+		SrcReputer: srcReputerAddr,
+		DstTopicId: dstTopicId,
+		DstReputer: dstReputerAddr,
+		Amount:     redelegateAmount,
+	})
+	require.NoError(err)
+
+	balanceAfter := s.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(delegatorAddr), params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(500), balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	srcPlacement, err := keeper.GetDelegateStakePlacement(ctx, srcTopicId, delegatorAddr, srcReputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(400), srcPlacement.Amount.SdkIntTrim())
+	dstPlacement, err := keeper.GetDelegateStakePlacement(ctx, dstTopicId, delegatorAddr, dstReputerAddr)
+	require.NoError(err)
+	require.Equal(redelegateAmount, dstPlacement.Amount.SdkIntTrim())
+
+	srcReputerStake, err := keeper.GetStakeOnReputerInTopic(ctx, srcTopicId, srcReputerAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(400), srcReputerStake)
+	dstReputerStake, err := keeper.GetStakeOnReputerInTopic(ctx, dstTopicId, dstReputerAddr)
+	require.NoError(err)
+	require.Equal(redelegateAmount, dstReputerStake)
+	dstTopicStake, err := keeper.GetTopicStake(ctx, dstTopicId)
+	require.NoError(err)
+	require.Equal(redelegateAmount, dstTopicStake)
+	totalStake, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(delegateAmount, totalStake)
+
+	// The source stays accountable for the moved stake during the stake removal delay window
+	redelegations, err := keeper.GetInFlightRedelegationsFromReputer(ctx, srcTopicId, srcReputerAddr)
+	require.NoError(err)
+	require.Len(redelegations, 1)
+	require.Equal(redelegateAmount, redelegations[0].Amount)
+	require.Equal(dstReputerAddr, redelegations[0].DstReputer)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	laterCtx := ctx.WithBlockHeight(ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow + 1)
+	redelegations, err = keeper.GetInFlightRedelegationsFromReputer(laterCtx, srcTopicId, srcReputerAddr)
+	require.NoError(err)
+	require.Empty(redelegations)
+}
+
+func (s *KeeperTestSuite) TestRedelegateStakeRejectsStakeRedelegatedInFlight() {
+	ctx := s.ctx
+	require := s.Require()
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	reputerAAddr := sdk.AccAddress(PKS[1].Address()).String()
+	reputerBAddr := sdk.AccAddress(PKS[3].Address()).String()
+	reputerCAddr := sdk.AccAddress(PKS[4].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[2].Address()).String()
+
+	topicA := s.commonStakingSetup(ctx, reputerAAddr, workerAddr, cosmosMath.NewInt(1000))
+	topicB := s.commonStakingSetup(ctx, reputerBAddr, workerAddr, cosmosMath.NewInt(1000))
+	topicC := s.commonStakingSetup(ctx, reputerCAddr, workerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(delegatorAddr), cosmosMath.NewInt(1000))
+
+	_, err := s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+		Sender:  delegatorAddr,
+		TopicId: topicA,
+		Reputer: reputerAAddr,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr,
+		SrcTopicId: topicA,
+		SrcReputer: reputerAAddr,
+		DstTopicId: topicB,
+		DstReputer: reputerBAddr,
+		Amount:     cosmosMath.NewInt(600),
+	})
+	require.NoError(err)
+
+	// The stake moved from A to B can not move on to C at once
+	bToC := &types.MsgRedelegateStake{
+		Sender:     delegatorAddr,
+		SrcTopicId: topicB,
+		SrcReputer: reputerBAddr,
+		DstTopicId: topicC,
+		DstReputer: reputerCAddr,
+		Amount:     cosmosMath.NewInt(600),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, bToC)
+	require.ErrorIs(err, types.ErrTransitiveRedelegation)
+
+	// while the stake left on A still can
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr,
+		SrcTopicId: topicA,
+		SrcReputer: reputerAAddr,
+		DstTopicId: topicC,
+		DstReputer: reputerCAddr,
+		Amount:     cosmosMath.NewInt(400),
+	})
+	require.NoError(err)
+
+	// Once the redelegation to B is over, its stake can move again
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	laterCtx := ctx.WithBlockHeight(ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow + 1)
+	_, err = s.msgServer.RedelegateStake(laterCtx, bToC)
+	require.NoError(err)
+	cPlacement, err := s.emissionsKeeper.GetDelegateStakePlacement(laterCtx, topicC, delegatorAddr, reputerCAddr)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1000), cPlacement.Amount.SdkIntTrim())
+}
+
+func (s *KeeperTestSuite) TestStartRemoveDelegateStakeExcludesStakeRedelegatedInFlight() {
+	ctx := s.ctx
+	require := s.Require()
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	reputerAAddr := sdk.AccAddress(PKS[1].Address()).String()
+	reputerBAddr := sdk.AccAddress(PKS[3].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[2].Address()).String()
+
+	topicA := s.commonStakingSetup(ctx, reputerAAddr, workerAddr, cosmosMath.NewInt(1000))
+	topicB := s.commonStakingSetup(ctx, reputerBAddr, workerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(delegatorAddr), cosmosMath.NewInt(1000))
+
+	for _, topicId := range []uint64{topicA, topicB} {
+		reputer := reputerAAddr
+		if topicId == topicB {
+			reputer = reputerBAddr
+		}
+		_, err := s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+			Sender:  delegatorAddr,
+			TopicId: topicId,
+			Reputer: reputer,
+			Amount:  cosmosMath.NewInt(500),
+		})
+		require.NoError(err)
+	}
+	_, err := s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr,
+		SrcTopicId: topicA,
+		SrcReputer: reputerAAddr,
+		DstTopicId: topicB,
+		DstReputer: reputerBAddr,
+		Amount:     cosmosMath.NewInt(300),
+	})
+	require.NoError(err)
+
+	// Only the stake delegated to B directly can be removed while the stake from A is in flight
+	removeFromB := &types.MsgStartRemoveDelegateStake{
+		Sender:  delegatorAddr,
+		TopicId: topicB,
+		Reputer: reputerBAddr,
+		Amount:  cosmosMath.NewInt(800),
+	}
+	_, err = s.msgServer.StartRemoveDelegateStake(ctx, removeFromB)
+	require.ErrorIs(err, types.ErrInsufficientDelegateStakeToRemove)
+	removeFromB.Amount = cosmosMath.NewInt(500)
+	_, err = s.msgServer.StartRemoveDelegateStake(ctx, removeFromB)
+	require.NoError(err)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	laterCtx := ctx.WithBlockHeight(ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow + 1)
+	removeFromB.Amount = cosmosMath.NewInt(800)
+	_, err = s.msgServer.StartRemoveDelegateStake(laterCtx, removeFromB)
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredRedelegations() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	reputerAAddr := sdk.AccAddress(PKS[1].Address()).String()
+	reputerBAddr := sdk.AccAddress(PKS[3].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[2].Address()).String()
+
+	topicA := s.commonStakingSetup(ctx, reputerAAddr, workerAddr, cosmosMath.NewInt(1000))
+	topicB := s.commonStakingSetup(ctx, reputerBAddr, workerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(delegatorAddr), cosmosMath.NewInt(1000))
+
+	_, err := s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+		Sender:  delegatorAddr,
+		TopicId: topicA,
+		Reputer: reputerAAddr,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	aToB := &types.MsgRedelegateStake{
+		Sender:     delegatorAddr,
+		SrcTopicId: topicA,
+		SrcReputer: reputerAAddr,
+		DstTopicId: topicB,
+		DstReputer: reputerBAddr,
+		Amount:     cosmosMath.NewInt(300),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, aToB)
+	require.NoError(err)
+	nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = s.msgServer.RedelegateStake(nextCtx, aToB)
+	require.NoError(err)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	// Only the first redelegation is over
+	pruneHeight := ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow + 1
+	err = keeper.PruneExpiredRedelegations(ctx.WithBlockHeight(pruneHeight), pruneHeight)
+	require.NoError(err)
+
+	// Reading at the block of the redelegations shows what is left in state regardless of the window
+	redelegations, err := keeper.GetInFlightRedelegations(nextCtx, topicA, reputerAAddr, delegatorAddr)
+	require.NoError(err)
+	require.Len(redelegations, 1)
+	require.Equal(nextCtx.BlockHeight(), redelegations[0].BlockStarted)
+	redelegations, err = keeper.GetInFlightRedelegationsTo(nextCtx, topicB, reputerBAddr, delegatorAddr)
+	require.NoError(err)
+	require.Len(redelegations, 1)
+
+	err = keeper.PruneExpiredRedelegations(ctx.WithBlockHeight(pruneHeight+1), pruneHeight+1)
+	require.NoError(err)
+	redelegations, err = keeper.GetInFlightRedelegations(nextCtx, topicA, reputerAAddr, delegatorAddr)
+	require.NoError(err)
+	require.Empty(redelegations)
+	redelegations, err = keeper.GetInFlightRedelegationsTo(nextCtx, topicB, reputerBAddr, delegatorAddr)
+	require.NoError(err)
+	require.Empty(redelegations)
+}
+
+func (s *KeeperTestSuite) TestRedelegateStakeExcludesStakeBeingRemoved() {
+	ctx := s.ctx
+	require := s.Require()
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	srcReputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	dstReputerAddr := sdk.AccAddress(PKS[3].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[2].Address()).String()
+
+	srcTopicId := s.commonStakingSetup(ctx, srcReputerAddr, workerAddr, cosmosMath.NewInt(1000))
+	dstTopicId := s.commonStakingSetup(ctx, dstReputerAddr, workerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(delegatorAddr), cosmosMath.NewInt(1000))
+
+	_, err := s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+		Sender:  delegatorAddr,
+		TopicId: srcTopicId,
+		Reputer: srcReputerAddr,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.StartRemoveDelegateStake(ctx, &types.MsgStartRemoveDelegateStake{
+		Sender:  delegatorAddr,
+		TopicId: srcTopicId,
+		Reputer: srcReputerAddr,
+		Amount:  cosmosMath.NewInt(700),
+	})
+	require.NoError(err)
+
+	msg := &types.MsgRedelegateStake{
+		Sender:     delegatorAddr,
+		SrcTopicId: srcTopicId,
+		SrcReputer: srcReputerAddr,
+		DstTopicId: dstTopicId,
+		DstReputer: dstReputerAddr,
+		Amount:     cosmosMath.NewInt(400),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrInsufficientDelegateStakeToRedelegate)
+
+	msg.Amount = cosmosMath.NewInt(300)
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.NoError(err)
+
+	msg.DstTopicId = srcTopicId
+	msg.DstReputer = srcReputerAddr
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrRedelegationToSamePosition)
+}
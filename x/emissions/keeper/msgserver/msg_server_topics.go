@@ -33,6 +33,15 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 	if msg.EpochLength < params.MinEpochLength {
 		return nil, types.ErrTopicCadenceBelowMinimum
 	}
+	if params.TopicCreatorAllowlistEnabled {
+		isAllowed, err := ms.k.IsInTopicCreatorAllowlist(ctx, msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+		if !isAllowed {
+			return nil, types.ErrNotInTopicCreatorAllowlist
+		}
+	}
 
 	// Before creating topic, transfer fee amount from creator to ecosystem bucket
 	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Creator, mintTypes.EcosystemModuleName, sdk.NewCoins(fee))
@@ -41,20 +50,22 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 	}
 
 	topic := types.Topic{
-		Id:              id,
-		Creator:         msg.Creator,
-		Metadata:        msg.Metadata,
-		LossLogic:       msg.LossLogic,
-		LossMethod:      msg.LossMethod,
-		InferenceLogic:  msg.InferenceLogic,
-		InferenceMethod: msg.InferenceMethod,
-		EpochLastEnded:  0,
-		EpochLength:     msg.EpochLength,
-		GroundTruthLag:  msg.GroundTruthLag,
-		DefaultArg:      msg.DefaultArg,
-		PNorm:           msg.PNorm,
-		AlphaRegret:     msg.AlphaRegret,
-		AllowNegative:   msg.AllowNegative,
+		Id:                 id,
+		Creator:            msg.Creator,
+		Metadata:           msg.Metadata,
+		LossLogic:          msg.LossLogic,
+		LossMethod:         msg.LossMethod,
+		InferenceLogic:     msg.InferenceLogic,
+		InferenceMethod:    msg.InferenceMethod,
+		EpochLastEnded:     0,
+		EpochLength:        msg.EpochLength,
+		GroundTruthLag:     msg.GroundTruthLag,
+		DefaultArg:         msg.DefaultArg,
+		PNorm:              msg.PNorm,
+		AlphaRegret:        msg.AlphaRegret,
+		AllowNegative:      msg.AllowNegative,
+		CommitRevealWindow: msg.CommitRevealWindow,
+		IsPermissioned:     msg.IsPermissioned,
 	}
 	_, err = ms.k.IncrementTopicId(ctx)
 	if err != nil {
@@ -63,12 +74,45 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 	if err := ms.k.SetTopic(ctx, id, topic); err != nil {
 		return nil, err
 	}
+	types.EmitNewTopicCreatedEvent(sdk.UnwrapSDKContext(ctx), topic)
 	// Rather than set latest weight-adjustment timestamp of a topic to 0
 	// we do nothing, since no value in the map means zero
 
 	return &types.MsgCreateNewTopicResponse{TopicId: id}, nil
 }
 
+// Replace the overrides of the global params for a topic. Only the topic creator may do so,
+// and only within the bounds that governance set for each overridable param.
+func (ms msgServer) UpdateTopicParams(ctx context.Context, msg *types.MsgUpdateTopicParams) (*types.MsgUpdateTopicParamsResponse, error) {
+	if err := ms.k.ValidateStringIsBech32(msg.Sender); err != nil {
+		return nil, err
+	}
+	if err := ms.checkSenderIsTopicCreator(ctx, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.Params.ValidateWithinBounds(moduleParams.MinTopicParams, moduleParams.MaxTopicParams)
+	if err != nil {
+		return nil, err
+	}
+	// The overrides must also be consistent with the global params they are combined with
+	err = msg.Params.ApplyTo(moduleParams).Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.SetTopicParams(ctx, msg.TopicId, msg.Params)
+	if err != nil {
+		return nil, err
+	}
+	types.EmitNewTopicParamsUpdatedEvent(sdk.UnwrapSDKContext(ctx), msg.TopicId, msg.Sender, msg.Params)
+	return &types.MsgUpdateTopicParamsResponse{}, nil
+}
+
 func (ms msgServer) CheckAddressHasBalanceForTopicCreationFee(ctx context.Context, address string) (bool, sdk.Coin, error) {
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
@@ -67,3 +67,89 @@ func (s *KeeperTestSuite) TestUpdateTopicLossUpdateLastRan() {
 	s.Require().NotNil(result)
 	s.Require().Equal(result, inferenceTs)
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateTopicParams() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	overrides := types.TopicParams{
+		MaxTopInferersToReward: []uint64{5},
+		BetaEntropy:            []alloraMath.Dec{alloraMath.MustNewDecFromString("0.3")},
+	}
+	_, err := msgServer.UpdateTopicParams(ctx, &types.MsgUpdateTopicParams{
+		Sender:  creator,
+		TopicId: topicId,
+		Params:  overrides,
+	})
+	require.NoError(err)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	topicParams, err := keeper.GetParamsForTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal(uint64(5), topicParams.MaxTopInferersToReward)
+	require.Equal(alloraMath.MustNewDecFromString("0.3"), topicParams.BetaEntropy)
+	require.Equal(moduleParams.MaxTopReputersToReward, topicParams.MaxTopReputersToReward, "Params that are not overridden keep their global value")
+
+	// Another topic is not affected
+	otherTopicParams, err := keeper.GetParamsForTopic(ctx, topicId+1)
+	require.NoError(err)
+	require.Equal(moduleParams, otherTopicParams)
+
+	// An empty record restores the global params
+	_, err = msgServer.UpdateTopicParams(ctx, &types.MsgUpdateTopicParams{
+		Sender:  creator,
+		TopicId: topicId,
+	})
+	require.NoError(err)
+	topicParams, err = keeper.GetParamsForTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal(moduleParams, topicParams)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateTopicParamsFailsForNonCreator() {
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+
+	_, err := s.msgServer.UpdateTopicParams(s.ctx, &types.MsgUpdateTopicParams{
+		Sender:  sdk.AccAddress(PKS[1].Address()).String(),
+		TopicId: topicId,
+		Params:  types.TopicParams{MaxTopInferersToReward: []uint64{5}},
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateTopicParamsFailsOutOfBounds() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	aboveMax := moduleParams.MaxTopicParams.MaxTopInferersToReward[0] + 1
+	_, err = msgServer.UpdateTopicParams(ctx, &types.MsgUpdateTopicParams{
+		Sender:  creator,
+		TopicId: topicId,
+		Params:  types.TopicParams{MaxTopInferersToReward: []uint64{aboveMax}},
+	})
+	require.ErrorIs(err, types.ErrTopicParamOutOfBounds)
+
+	// Governance can make a param not overridable by removing its bounds
+	moduleParams.MinTopicParams.BetaEntropy = nil
+	moduleParams.MaxTopicParams.BetaEntropy = nil
+	require.NoError(keeper.SetParams(ctx, moduleParams))
+	_, err = msgServer.UpdateTopicParams(ctx, &types.MsgUpdateTopicParams{
+		Sender:  creator,
+		TopicId: topicId,
+		Params:  types.TopicParams{BetaEntropy: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.3")}},
+	})
+	require.ErrorIs(err, types.ErrTopicParamNotOverridable)
+}
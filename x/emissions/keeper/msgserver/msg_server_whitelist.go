@@ -7,20 +7,16 @@ import (
 )
 
 func (ms msgServer) AddToWhitelistAdmin(ctx context.Context, msg *types.MsgAddToWhitelistAdmin) (*types.MsgAddToWhitelistAdminResponse, error) {
-	// Check that sender is also a whitelist admin
-	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
-	if err != nil {
-		return nil, err
-	}
-	if !isAdmin {
-		return nil, types.ErrNotWhitelistAdmin
+	// Only the authority manages the whitelist admins
+	if msg.Sender != ms.k.GetAuthority() {
+		return nil, types.ErrNotAuthority
 	}
 	// Validate the address
 	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
 		return nil, err
 	}
 	// Add the address to the whitelist
-	err = ms.k.AddWhitelistAdmin(ctx, msg.Address)
+	err := ms.k.AddWhitelistAdmin(ctx, msg.Address)
 	if err != nil {
 		return nil, err
 	}
@@ -28,22 +24,105 @@ func (ms msgServer) AddToWhitelistAdmin(ctx context.Context, msg *types.MsgAddTo
 }
 
 func (ms msgServer) RemoveFromWhitelistAdmin(ctx context.Context, msg *types.MsgRemoveFromWhitelistAdmin) (*types.MsgRemoveFromWhitelistAdminResponse, error) {
-	// Check that sender is also a whitelist admin
-	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
-	if err != nil {
-		return nil, err
-	}
-	if !isAdmin {
-		return nil, types.ErrNotWhitelistAdmin
+	// Only the authority manages the whitelist admins
+	if msg.Sender != ms.k.GetAuthority() {
+		return nil, types.ErrNotAuthority
 	}
 	// Validate the address
 	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
 		return nil, err
 	}
 	// Remove the address from the whitelist
-	err = ms.k.RemoveWhitelistAdmin(ctx, msg.Address)
+	err := ms.k.RemoveWhitelistAdmin(ctx, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 	return &types.MsgRemoveFromWhitelistAdminResponse{}, nil
 }
+
+// Allows a worker or reputer to register in a permissioned topic. Only the topic creator manages its allowlist.
+func (ms msgServer) AddToTopicAllowlist(ctx context.Context, msg *types.MsgAddToTopicAllowlist) (*types.MsgAddToTopicAllowlistResponse, error) {
+	if err := ms.checkSenderIsTopicCreator(ctx, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
+		return nil, err
+	}
+	err := ms.k.AddToTopicAllowlist(ctx, msg.TopicId, msg.Address, msg.IsReputer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAddToTopicAllowlistResponse{}, nil
+}
+
+// Revokes the permission of a worker or reputer to take part in a permissioned topic,
+// removing its registration to the topic if it has one
+func (ms msgServer) RemoveFromTopicAllowlist(ctx context.Context, msg *types.MsgRemoveFromTopicAllowlist) (*types.MsgRemoveFromTopicAllowlistResponse, error) {
+	if err := ms.checkSenderIsTopicCreator(ctx, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
+		return nil, err
+	}
+	err := ms.k.RemoveFromTopicAllowlist(ctx, msg.TopicId, msg.Address, msg.IsReputer)
+	if err != nil {
+		return nil, err
+	}
+	if msg.IsReputer {
+		err = ms.k.RemoveReputer(ctx, msg.TopicId, msg.Address)
+	} else {
+		err = ms.k.RemoveWorker(ctx, msg.TopicId, msg.Address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveFromTopicAllowlistResponse{}, nil
+}
+
+func (ms msgServer) AddToTopicCreatorAllowlist(ctx context.Context, msg *types.MsgAddToTopicCreatorAllowlist) (*types.MsgAddToTopicCreatorAllowlistResponse, error) {
+	// Only the authority manages the topic creator allowlist
+	if msg.Sender != ms.k.GetAuthority() {
+		return nil, types.ErrNotAuthority
+	}
+	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
+		return nil, err
+	}
+	err := ms.k.AddToTopicCreatorAllowlist(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAddToTopicCreatorAllowlistResponse{}, nil
+}
+
+func (ms msgServer) RemoveFromTopicCreatorAllowlist(ctx context.Context, msg *types.MsgRemoveFromTopicCreatorAllowlist) (*types.MsgRemoveFromTopicCreatorAllowlistResponse, error) {
+	// Only the authority manages the topic creator allowlist
+	if msg.Sender != ms.k.GetAuthority() {
+		return nil, types.ErrNotAuthority
+	}
+	if err := ms.k.ValidateStringIsBech32(msg.Address); err != nil {
+		return nil, err
+	}
+	err := ms.k.RemoveFromTopicCreatorAllowlist(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveFromTopicCreatorAllowlistResponse{}, nil
+}
+
+func (ms msgServer) checkSenderIsTopicCreator(ctx context.Context, sender string, topicId uint64) error {
+	topicExists, err := ms.k.TopicExists(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if !topicExists {
+		return types.ErrTopicDoesNotExist
+	}
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if sender != topic.Creator {
+		return types.ErrNotTopicCreator
+	}
+	return nil
+}
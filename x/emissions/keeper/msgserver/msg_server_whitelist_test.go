@@ -1,6 +1,7 @@
 package msgserver_test
 
 import (
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -10,7 +11,7 @@ func (s *KeeperTestSuite) TestAddWhitelistAdmin() {
 	require := s.Require()
 	msgServer := s.msgServer
 
-	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	authority := s.emissionsKeeper.GetAuthority()
 	newAdminAddr := sdk.AccAddress(nonAdminAccounts[0]).String()
 
 	// Verify that newAdminAddr is not a whitelist admin
@@ -18,9 +19,9 @@ func (s *KeeperTestSuite) TestAddWhitelistAdmin() {
 	require.NoError(err, "IsWhitelistAdmin should not return an error")
 	require.False(isWhitelistAdmin, "newAdminAddr should not be a whitelist admin")
 
-	// Attempt to add newAdminAddr to whitelist by adminAddr
+	// Attempt to add newAdminAddr to whitelist by the authority
 	msg := &types.MsgAddToWhitelistAdmin{
-		Sender:  adminAddr,
+		Sender:  authority,
 		Address: newAdminAddr,
 	}
 
@@ -47,7 +48,28 @@ func (s *KeeperTestSuite) TestAddWhitelistAdminInvalidUnauthorized() {
 	}
 
 	_, err := s.msgServer.AddToWhitelistAdmin(ctx, msg)
-	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
+	require.ErrorIs(err, types.ErrNotAuthority, "Should fail due to unauthorized access")
+}
+
+func (s *KeeperTestSuite) TestAddWhitelistAdminInvalidByWhitelistAdmin() {
+	ctx := s.ctx
+	require := s.Require()
+
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	targetAddr := sdk.AccAddress(nonAdminAccounts[0]).String()
+
+	// Whitelist admins can no longer add each other
+	msg := &types.MsgAddToWhitelistAdmin{
+		Sender:  adminAddr,
+		Address: targetAddr,
+	}
+
+	_, err := s.msgServer.AddToWhitelistAdmin(ctx, msg)
+	require.ErrorIs(err, types.ErrNotAuthority, "Should fail due to unauthorized access")
+
+	isWhitelistAdmin, err := s.emissionsKeeper.IsWhitelistAdmin(ctx, targetAddr)
+	require.NoError(err)
+	require.False(isWhitelistAdmin)
 }
 
 func (s *KeeperTestSuite) TestRemoveWhitelistAdmin() {
@@ -55,12 +77,12 @@ func (s *KeeperTestSuite) TestRemoveWhitelistAdmin() {
 	require := s.Require()
 	msgServer := s.msgServer
 
-	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	authority := s.emissionsKeeper.GetAuthority()
 	adminToRemove := sdk.AccAddress(PKS[1].Address()).String()
 
-	// Attempt to remove adminToRemove from the whitelist by adminAddr
+	// Attempt to remove adminToRemove from the whitelist by the authority
 	removeMsg := &types.MsgRemoveFromWhitelistAdmin{
-		Sender:  adminAddr,
+		Sender:  authority,
 		Address: adminToRemove,
 	}
 	_, err := msgServer.RemoveFromWhitelistAdmin(ctx, removeMsg)
@@ -86,5 +108,133 @@ func (s *KeeperTestSuite) TestRemoveWhitelistAdminInvalidUnauthorized() {
 	}
 
 	_, err := s.msgServer.RemoveFromWhitelistAdmin(ctx, msg)
-	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
+	require.ErrorIs(err, types.ErrNotAuthority, "Should fail due to unauthorized access")
+}
+
+func (s *KeeperTestSuite) TestRegisterInPermissionedTopicRequiresAllowlist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	creatorAddr := sdk.AccAddress(PKS[1].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := uint64(1)
+	topic := types.Topic{Id: topicId, Creator: creatorAddr, IsPermissioned: true}
+	require.NoError(s.emissionsKeeper.SetTopic(ctx, topicId, topic))
+	s.MintTokensToAddress(workerAddr, types.DefaultParams().RegistrationFee)
+
+	registerMsg := &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		IsReputer:    false,
+		Owner:        workerAddr.String(),
+	}
+	_, err := msgServer.Register(ctx, registerMsg)
+	require.ErrorIs(err, types.ErrNotInTopicAllowlist)
+
+	// Being allowed as a reputer does not allow registering as a worker
+	_, err = msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:    creatorAddr,
+		TopicId:   topicId,
+		Address:   workerAddr.String(),
+		IsReputer: true,
+	})
+	require.NoError(err)
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.ErrorIs(err, types.ErrNotInTopicAllowlist)
+
+	_, err = msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:  creatorAddr,
+		TopicId: topicId,
+		Address: workerAddr.String(),
+	})
+	require.NoError(err)
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.NoError(err)
+
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.True(isRegistered)
+
+	// Removing the worker from the allowlist also removes its registration
+	_, err = msgServer.RemoveFromTopicAllowlist(ctx, &types.MsgRemoveFromTopicAllowlist{
+		Sender:  creatorAddr,
+		TopicId: topicId,
+		Address: workerAddr.String(),
+	})
+	require.NoError(err)
+
+	isRegistered, err = s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.False(isRegistered)
+	isAllowed, err := s.emissionsKeeper.IsInTopicAllowlist(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.False(isAllowed)
+}
+
+func (s *KeeperTestSuite) TestAddToTopicAllowlistFailsForNonCreator() {
+	ctx := s.ctx
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	nonCreatorAddr := sdk.AccAddress(PKS[1].Address()).String()
+
+	_, err := s.msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:  nonCreatorAddr,
+		TopicId: topicId,
+		Address: nonCreatorAddr,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+
+	allowlist, err := s.emissionsKeeper.GetTopicAllowlist(ctx, topicId, false)
+	require.NoError(err)
+	require.Empty(allowlist)
+}
+
+func (s *KeeperTestSuite) TestCreateNewTopicWithTopicCreatorAllowlistEnabled() {
+	ctx := s.ctx
+	require := s.Require()
+
+	params := types.DefaultParams()
+	params.TopicCreatorAllowlistEnabled = true
+	require.NoError(s.emissionsKeeper.SetParams(ctx, params))
+
+	creatorAddr := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         creatorAddr.String(),
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		IsPermissioned:  true,
+	}
+	s.MintTokensToAddress(creatorAddr, types.DefaultParams().CreateTopicFee)
+
+	_, err := s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrNotInTopicCreatorAllowlist)
+
+	// Only the authority manages the topic creator allowlist
+	_, err = s.msgServer.AddToTopicCreatorAllowlist(ctx, &types.MsgAddToTopicCreatorAllowlist{
+		Sender:  creatorAddr.String(),
+		Address: creatorAddr.String(),
+	})
+	require.ErrorIs(err, types.ErrNotAuthority)
+
+	_, err = s.msgServer.AddToTopicCreatorAllowlist(ctx, &types.MsgAddToTopicCreatorAllowlist{
+		Sender:  s.emissionsKeeper.GetAuthority(),
+		Address: creatorAddr.String(),
+	})
+	require.NoError(err)
+
+	result, err := s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.True(topic.IsPermissioned)
 }
@@ -0,0 +1,92 @@
+package msgserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// A tx function that records a worker's commitment to its payload for a nonce of a commit-reveal topic.
+// The payload itself is revealed later by the leader via InsertBulkWorkerPayload, once the commit phase is over.
+func (ms msgServer) CommitWorkerPayload(ctx context.Context, msg *types.MsgCommitWorkerPayload) (*types.MsgCommitWorkerPayloadResponse, error) {
+	err := ms.CheckInputLength(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topic.IsCommitReveal() {
+		return nil, types.ErrCommitRevealNotEnabled
+	}
+
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, msg.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !nonceUnfulfilled {
+		return nil, types.ErrNonceAlreadyFulfilled
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() > topic.CommitDeadline(*msg.Nonce) {
+		return nil, types.ErrCommitPhaseEnded
+	}
+
+	isRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isRegistered {
+		return nil, types.ErrAddressNotRegistered
+	}
+
+	err = ms.k.SetWorkerCommitment(ctx, msg.TopicId, *msg.Nonce, msg.Sender, msg.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitWorkerPayloadResponse{}, nil
+}
+
+// Keep only the bundles whose revealed payload matches the commitment made by their worker for this nonce.
+// Bundles from workers that did not commit are dropped, and reported as rejected.
+func (ms msgServer) FilterRevealedWorkerDataBundles(
+	ctx context.Context,
+	topicId uint64,
+	nonce types.Nonce,
+	workerDataBundles []*types.WorkerDataBundle,
+) ([]*types.WorkerDataBundle, []*types.PayloadStatus, error) {
+	revealed := make([]*types.WorkerDataBundle, 0, len(workerDataBundles))
+	statuses := make([]*types.PayloadStatus, 0)
+	for _, workerDataBundle := range workerDataBundles {
+		if workerDataBundle == nil {
+			continue
+		}
+		commitment, err := ms.k.GetWorkerCommitment(ctx, topicId, nonce, workerDataBundle.Worker)
+		if err != nil {
+			return nil, nil, err
+		}
+		if commitment == nil || !workerDataBundle.MatchesCommitment(commitment) {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_INFERER, workerDataBundle.Worker, types.PayloadRejectionReason_COMMITMENT_MISMATCH))
+			continue
+		}
+		revealed = append(revealed, workerDataBundle)
+	}
+	return revealed, statuses, nil
+}
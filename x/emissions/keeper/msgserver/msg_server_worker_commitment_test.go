@@ -0,0 +1,118 @@
+package msgserver_test
+
+import (
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+)
+
+// Turns topic 0 of the worker payload set-up into a commit-reveal topic whose
+// commit phase for the payload's nonce ends at block 0
+func (s *KeeperTestSuite) setUpCommitRevealWorkerPayload(
+	workerPrivateKey secp256k1.PrivKey,
+) (types.MsgInsertBulkWorkerPayload, types.MsgCommitWorkerPayload) {
+	require := s.Require()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	err := s.emissionsKeeper.SetTopic(s.ctx, topicId, types.Topic{Id: topicId, EpochLength: 2, CommitRevealWindow: 1})
+	require.NoError(err)
+
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	bundle := workerMsg.WorkerDataBundles[0]
+	bundle.CommitSalt = []byte("salt")
+	commitment, err := types.ComputeWorkerCommitment(bundle.InferenceForecastsBundle, bundle.CommitSalt)
+	require.NoError(err)
+
+	commitMsg := types.MsgCommitWorkerPayload{
+		Sender:     bundle.Worker,
+		Nonce:      workerMsg.Nonce,
+		TopicId:    topicId,
+		Commitment: commitment,
+	}
+	return workerMsg, commitMsg
+}
+
+func (s *KeeperTestSuite) TestMsgCommitWorkerPayloadThenReveal() {
+	require := s.Require()
+
+	workerMsg, commitMsg := s.setUpCommitRevealWorkerPayload(secp256k1.GenPrivKey())
+
+	_, err := s.msgServer.CommitWorkerPayload(s.ctx.WithBlockHeight(0), &commitMsg)
+	require.NoError(err)
+
+	commitment, err := s.emissionsKeeper.GetWorkerCommitment(s.ctx, commitMsg.TopicId, *commitMsg.Nonce, commitMsg.Sender)
+	require.NoError(err)
+	require.Equal(commitMsg.Commitment, commitment)
+
+	_, err = s.msgServer.InsertBulkWorkerPayload(s.ctx.WithBlockHeight(1), &workerMsg)
+	require.NoError(err)
+
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(s.ctx, workerMsg.TopicId, workerMsg.Nonce.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 1)
+	require.Equal(1, s.getCountForecastsAtBlock(workerMsg.TopicId, workerMsg.Nonce.BlockHeight))
+
+	// Commitments of the fulfilled nonce are cleaned up
+	commitment, err = s.emissionsKeeper.GetWorkerCommitment(s.ctx, commitMsg.TopicId, *commitMsg.Nonce, commitMsg.Sender)
+	require.NoError(err)
+	require.Nil(commitment)
+}
+
+func (s *KeeperTestSuite) TestMsgCommitWorkerPayloadFailsWithoutCommitReveal() {
+	require := s.Require()
+
+	workerMsg, commitMsg := s.setUpCommitRevealWorkerPayload(secp256k1.GenPrivKey())
+	err := s.emissionsKeeper.SetTopic(s.ctx, workerMsg.TopicId, types.Topic{Id: workerMsg.TopicId, EpochLength: 2})
+	require.NoError(err)
+
+	_, err = s.msgServer.CommitWorkerPayload(s.ctx, &commitMsg)
+	require.ErrorIs(err, types.ErrCommitRevealNotEnabled)
+}
+
+func (s *KeeperTestSuite) TestMsgCommitWorkerPayloadFailsAfterDeadline() {
+	require := s.Require()
+
+	_, commitMsg := s.setUpCommitRevealWorkerPayload(secp256k1.GenPrivKey())
+
+	_, err := s.msgServer.CommitWorkerPayload(s.ctx.WithBlockHeight(1), &commitMsg)
+	require.ErrorIs(err, types.ErrCommitPhaseEnded)
+}
+
+func (s *KeeperTestSuite) TestMsgCommitWorkerPayloadFailsWithUnregisteredWorker() {
+	require := s.Require()
+
+	_, commitMsg := s.setUpCommitRevealWorkerPayload(secp256k1.GenPrivKey())
+	commitMsg.Sender = getNewAddress()
+
+	_, err := s.msgServer.CommitWorkerPayload(s.ctx, &commitMsg)
+	require.ErrorIs(err, types.ErrAddressNotRegistered)
+}
+
+func (s *KeeperTestSuite) TestMsgInsertBulkWorkerPayloadFailsBeforeRevealPhase() {
+	require := s.Require()
+
+	workerMsg, commitMsg := s.setUpCommitRevealWorkerPayload(secp256k1.GenPrivKey())
+
+	_, err := s.msgServer.CommitWorkerPayload(s.ctx, &commitMsg)
+	require.NoError(err)
+
+	_, err = s.msgServer.InsertBulkWorkerPayload(s.ctx.WithBlockHeight(0), &workerMsg)
+	require.ErrorIs(err, types.ErrRevealPhaseNotStarted)
+}
+
+func (s *KeeperTestSuite) TestMsgInsertBulkWorkerPayloadDropsUncommittedOrMismatchedReveals() {
+	require := s.Require()
+
+	workerMsg, commitMsg := s.setUpCommitRevealWorkerPayload(secp256k1.GenPrivKey())
+
+	// No commitment at all
+	_, err := s.msgServer.InsertBulkWorkerPayload(s.ctx.WithBlockHeight(1), &workerMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+
+	// Revealed salt does not match the commitment
+	_, err = s.msgServer.CommitWorkerPayload(s.ctx, &commitMsg)
+	require.NoError(err)
+	workerMsg.WorkerDataBundles[0].CommitSalt = []byte("another salt")
+
+	_, err = s.msgServer.InsertBulkWorkerPayload(s.ctx.WithBlockHeight(1), &workerMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+}
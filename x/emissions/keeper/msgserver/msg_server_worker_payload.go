@@ -2,9 +2,12 @@ package msgserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,6 +17,8 @@ import (
 // and none from any unregistered inferer.
 // Signatures, anti-synil procedures, and "skimming of only the top few workers by score
 // descending" should be done here.
+// Also returns whether the inference of each bundle was accepted and, if not, why,
+// including when none was and ErrNoValidBundles is returned.
 func (ms msgServer) VerifyAndInsertInferencesFromTopInferers(
 	ctx context.Context,
 	topicId uint64,
@@ -21,12 +26,12 @@ func (ms msgServer) VerifyAndInsertInferencesFromTopInferers(
 	// inferences []*types.Inference,
 	workerDataBundles []*types.WorkerDataBundle,
 	maxTopWorkersToReward uint64,
-) (map[string]bool, error) {
+) (map[string]bool, []*types.PayloadStatus, error) {
 	inferencesByInferer := make(map[string]*types.Inference)
 	latestInfererScores := make(map[string]types.Score)
-	errors := make(map[string]string)
+	statuses := make([]*types.PayloadStatus, 0)
 	if len(workerDataBundles) == 0 {
-		return nil, types.ErrNoValidBundles
+		return nil, statuses, types.ErrNoValidBundles
 	}
 	for _, workerDataBundle := range workerDataBundles {
 		/// Do filters first, then consider the inferenes for inclusion
@@ -34,17 +39,21 @@ func (ms msgServer) VerifyAndInsertInferencesFromTopInferers(
 		/// All filters should be done in order of increasing computational complexity
 
 		if err := workerDataBundle.Validate(); err != nil {
-			errors[workerDataBundle.Worker] = "Validate: Invalid worker data bundle"
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_INFERER, workerDataBundle.GetWorker(), types.PayloadRejectionReason_INVALID_BUNDLE))
 			continue // Ignore only invalid worker data bundles
 		}
 		/// If we do PoX-like anti-sybil procedure, would go here
 
 		inference := workerDataBundle.InferenceForecastsBundle.Inference
+		// Bundles that only carry a forecast have no inference to reject
+		if inference == nil {
+			continue
+		}
 
 		// Check if the topic and nonce are correct
 		if inference.TopicId != topicId ||
 			inference.BlockHeight != nonce.BlockHeight {
-			errors[workerDataBundle.Worker] = "Worker data bundle does not match topic or nonce"
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_INFERER, inference.Inferer, types.PayloadRejectionReason_WRONG_TOPIC_OR_NONCE))
 			continue
 		}
 
@@ -54,23 +63,25 @@ func (ms msgServer) VerifyAndInsertInferencesFromTopInferers(
 			// Check if the inferer is registered
 			isInfererRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, inference.Inferer)
 			if err != nil {
-				errors[workerDataBundle.Worker] = "Err to check if worker is registered in topic"
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_INFERER, inference.Inferer, types.PayloadRejectionReason_STORE_ERROR))
 				continue
 			}
 			if !isInfererRegistered {
-				errors[workerDataBundle.Worker] = "Inferer is not registered"
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_INFERER, inference.Inferer, types.PayloadRejectionReason_NOT_REGISTERED))
 				continue
 			}
 
 			// Get the latest score for each inferer => only take top few by score descending
 			latestScore, err := ms.k.GetLatestInfererScore(ctx, topicId, inference.Inferer)
 			if err != nil {
-				errors[workerDataBundle.Worker] = "Latest score not found"
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_INFERER, inference.Inferer, types.PayloadRejectionReason_SCORE_NOT_FOUND))
 				continue
 			}
 			/// Filtering done now, now write what we must for inclusion
 			latestInfererScores[inference.Inferer] = latestScore
 			inferencesByInferer[inference.Inferer] = inference
+		} else {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_INFERER, inference.Inferer, types.PayloadRejectionReason_DUPLICATE))
 		}
 	}
 
@@ -85,9 +96,10 @@ func (ms msgServer) VerifyAndInsertInferencesFromTopInferers(
 		acceptedInferers[worker] = true
 		inferencesFromTopInferers = append(inferencesFromTopInferers, inferencesByInferer[worker])
 	}
+	statuses = append(statuses, topActorStatuses(types.ActorType_INFERER, latestInfererScores, topInferers)...)
 
 	if len(inferencesFromTopInferers) == 0 {
-		return nil, types.ErrNoValidBundles
+		return nil, statuses, types.ErrNoValidBundles
 	}
 
 	// Ensure deterministic ordering of inferences
@@ -101,10 +113,10 @@ func (ms msgServer) VerifyAndInsertInferencesFromTopInferers(
 	}
 	err := ms.k.InsertInferences(ctx, topicId, nonce, inferencesToInsert)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return acceptedInferers, nil
+	return acceptedInferers, statuses, nil
 }
 
 // Output a new set of forecasts where only 1 forecast per registerd forecaster is kept,
@@ -112,6 +124,7 @@ func (ms msgServer) VerifyAndInsertInferencesFromTopInferers(
 // and none from any unregistered forecaster.
 // Signatures, anti-synil procedures, and "skimming of only the top few workers by score
 // descending" should be done here.
+// Also returns whether the forecast of each valid bundle that has one was accepted and, if not, why.
 func (ms msgServer) VerifyAndInsertForecastsFromTopForecasters(
 	ctx context.Context,
 	topicId uint64,
@@ -120,25 +133,34 @@ func (ms msgServer) VerifyAndInsertForecastsFromTopForecasters(
 	// Inferers in the current batch, assumed to have passed VerifyAndInsertInferencesFromTopInferers() filters
 	acceptedInferersOfBatch map[string]bool,
 	maxTopWorkersToReward uint64,
-) error {
+) ([]*types.PayloadStatus, error) {
 	forecastsByForecaster := make(map[string]*types.Forecast)
 	latestForecasterScores := make(map[string]types.Score)
+	statuses := make([]*types.PayloadStatus, 0)
 	for _, workerDataBundle := range workerDataBundle {
 		/// Do filters first, then consider the inferenes for inclusion
 		/// Do filters on the per payload first, then on each forecaster
 		/// All filters should be done in order of increasing computational complexity
 
 		if err := workerDataBundle.Validate(); err != nil {
+			// Bundles that carry no forecast were already reported with their inference
+			if workerDataBundle.GetInferenceForecastsBundle().GetForecast() != nil {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_FORECASTER, workerDataBundle.GetWorker(), types.PayloadRejectionReason_INVALID_BUNDLE))
+			}
 			continue // Ignore only invalid worker data bundles
 		}
 
 		/// If we do PoX-like anti-sybil procedure, would go here
 
 		forecast := workerDataBundle.InferenceForecastsBundle.Forecast
-		// Check that the forecast exist, is for the correct topic, and is for the correct nonce
-		if forecast == nil ||
-			forecast.TopicId != topicId ||
+		// Bundles that only carry an inference have no forecast to reject
+		if forecast == nil {
+			continue
+		}
+		// Check that the forecast is for the correct topic, and is for the correct nonce
+		if forecast.TopicId != topicId ||
 			forecast.BlockHeight != nonce.BlockHeight {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_FORECASTER, forecast.Forecaster, types.PayloadRejectionReason_WRONG_TOPIC_OR_NONCE))
 			continue
 		}
 
@@ -148,9 +170,11 @@ func (ms msgServer) VerifyAndInsertForecastsFromTopForecasters(
 			// Check if the forecaster is registered
 			isForecasterRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, forecast.Forecaster)
 			if err != nil {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_FORECASTER, forecast.Forecaster, types.PayloadRejectionReason_STORE_ERROR))
 				continue
 			}
 			if !isForecasterRegistered {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_FORECASTER, forecast.Forecaster, types.PayloadRejectionReason_NOT_REGISTERED))
 				continue
 			}
 
@@ -167,6 +191,7 @@ func (ms msgServer) VerifyAndInsertForecastsFromTopForecasters(
 
 			// Discard if empty
 			if len(acceptedForecastElements) == 0 {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_FORECASTER, forecast.Forecaster, types.PayloadRejectionReason_NO_ACCEPTED_VALUES))
 				continue
 			}
 
@@ -175,10 +200,13 @@ func (ms msgServer) VerifyAndInsertForecastsFromTopForecasters(
 			// Get the latest score for each forecaster => only take top few by score descending
 			latestScore, err := ms.k.GetLatestForecasterScore(ctx, topicId, forecast.Forecaster)
 			if err != nil {
+				statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_FORECASTER, forecast.Forecaster, types.PayloadRejectionReason_SCORE_NOT_FOUND))
 				continue
 			}
 			latestForecasterScores[forecast.Forecaster] = latestScore
 			forecastsByForecaster[forecast.Forecaster] = forecast
+		} else {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(types.ActorType_FORECASTER, forecast.Forecaster, types.PayloadRejectionReason_DUPLICATE))
 		}
 	}
 
@@ -191,6 +219,7 @@ func (ms msgServer) VerifyAndInsertForecastsFromTopForecasters(
 	for _, worker := range topForecasters {
 		forecastsFromTopForecasters = append(forecastsFromTopForecasters, forecastsByForecaster[worker])
 	}
+	statuses = append(statuses, topActorStatuses(types.ActorType_FORECASTER, latestForecasterScores, topForecasters)...)
 
 	// Though less than ideal because it produces less-acurate network inferences,
 	// it is fine if no forecasts are accepted
@@ -206,10 +235,10 @@ func (ms msgServer) VerifyAndInsertForecastsFromTopForecasters(
 	}
 	err := ms.k.InsertForecasts(ctx, topicId, nonce, forecastsToInsert)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return statuses, nil
 }
 
 // A tx function that accepts a list of forecasts and possibly returns an error
@@ -243,55 +272,168 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 		return nil, types.ErrNonceAlreadyFulfilled
 	}
 
-	moduleParams, err := ms.k.GetParams(ctx)
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	// On commit-reveal topics the payload is a reveal: only accept it once the commit phase is over
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if topic.IsCommitReveal() && sdkCtx.BlockHeight() <= topic.CommitDeadline(*msg.Nonce) {
+		return nil, types.ErrRevealPhaseNotStarted
+	}
+
+	statuses, err := ms.InsertWorkerPayloadsAndFulfillNonce(ctx, topic, *msg.Nonce, msg.WorkerDataBundles)
+	if err != nil {
+		if errors.Is(err, types.ErrNoValidBundles) {
+			return nil, noValidBundlesError(statuses)
+		}
+		return nil, err
+	}
+
+	return &types.MsgInsertBulkWorkerPayloadResponse{Statuses: statuses}, nil
+}
+
+// Workers that submitted a valid bundle count as live for the nonce, whether or not their work was accepted
+func submittedWorkers(workerDataBundles []*types.WorkerDataBundle) map[string]bool {
+	submitted := make(map[string]bool)
+	for _, workerDataBundle := range workerDataBundles {
+		if err := workerDataBundle.Validate(); err != nil {
+			continue
+		}
+		submitted[workerDataBundle.Worker] = true
+	}
+	return submitted
+}
+
+// Insert the given worker payloads together with those submitted directly by workers for the nonce,
+// then fulfill the nonce and open the matching reputer nonce.
+// Bundles given by the caller take precedence over pending ones from the same worker.
+// Returns the status of the inference and forecast of every bundle, which are also emitted in an event.
+// If no inference is accepted, the statuses are returned with ErrNoValidBundles for the caller to report,
+// since the state and events of the failed fulfillment are reverted.
+func (ms msgServer) InsertWorkerPayloadsAndFulfillNonce(
+	ctx context.Context,
+	topic types.Topic,
+	nonce types.Nonce,
+	workerDataBundles []*types.WorkerDataBundle,
+) ([]*types.PayloadStatus, error) {
+	moduleParams, err := ms.k.GetParamsForTopic(ctx, topic.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	acceptedInferers, err := ms.VerifyAndInsertInferencesFromTopInferers(
+	pendingBundles, err := ms.k.GetPendingWorkerPayloads(ctx, topic.Id, nonce)
+	if err != nil {
+		return nil, err
+	}
+	bundles := make([]*types.WorkerDataBundle, 0, len(workerDataBundles)+len(pendingBundles))
+	bundles = append(bundles, workerDataBundles...)
+	bundles = append(bundles, pendingBundles...)
+
+	// On commit-reveal topics only keep bundles that match the commitment of their worker
+	statuses := make([]*types.PayloadStatus, 0)
+	if topic.IsCommitReveal() {
+		var unrevealed []*types.PayloadStatus
+		bundles, unrevealed, err = ms.FilterRevealedWorkerDataBundles(ctx, topic.Id, nonce, bundles)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, unrevealed...)
+	}
+
+	acceptedInferers, inferenceStatuses, err := ms.VerifyAndInsertInferencesFromTopInferers(
 		ctx,
-		msg.TopicId,
-		*msg.Nonce,
-		msg.WorkerDataBundles,
+		topic.Id,
+		nonce,
+		bundles,
 		moduleParams.MaxTopInferersToReward,
 	)
+	statuses = append(statuses, inferenceStatuses...)
 	if err != nil {
+		if errors.Is(err, types.ErrNoValidBundles) {
+			return statuses, err
+		}
 		return nil, err
 	}
 
-	err = ms.VerifyAndInsertForecastsFromTopForecasters(
+	forecastStatuses, err := ms.VerifyAndInsertForecastsFromTopForecasters(
 		ctx,
-		msg.TopicId,
-		*msg.Nonce,
-		msg.WorkerDataBundles,
+		topic.Id,
+		nonce,
+		bundles,
 		acceptedInferers,
 		moduleParams.MaxTopForecastersToReward,
 	)
 	if err != nil {
 		return nil, err
 	}
+	statuses = append(statuses, forecastStatuses...)
+
 	// Update the unfulfilled worker nonce
-	_, err = ms.k.FulfillWorkerNonce(ctx, msg.TopicId, msg.Nonce)
+	_, err = ms.k.FulfillWorkerNonce(ctx, topic.Id, &nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	err = ms.k.HandleWorkerLiveness(ctx, topic.Id, submittedWorkers(bundles))
 	if err != nil {
-		return nil, types.ErrInvalidTopicId
+		return nil, err
+	}
+
+	err = ms.k.DeletePendingWorkerPayloads(ctx, topic.Id, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if topic.IsCommitReveal() {
+		err = ms.k.DeleteWorkerCommitments(ctx, topic.Id, nonce)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	workerNonce := &types.Nonce{
-		BlockHeight: msg.Nonce.BlockHeight - topic.EpochLength,
+		BlockHeight: nonce.BlockHeight - topic.EpochLength,
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.Logger().Debug(fmt.Sprintf("InsertBulkWorkerPayload workerNonce %d", workerNonce.BlockHeight))
 
-	err = ms.k.AddReputerNonce(ctx, topic.Id, msg.Nonce, workerNonce)
+	err = ms.k.AddReputerNonce(ctx, topic.Id, &nonce, workerNonce)
 	if err != nil {
 		return nil, err
 	}
 
-	// Return an empty response as the operation was successful
-	return &types.MsgInsertBulkWorkerPayloadResponse{}, nil
+	types.EmitNewPayloadStatusesEvent(sdkCtx, topic.Id, nonce.BlockHeight, statuses)
+	types.IncrPayloadStatusMetrics(ctx, topic.Id, statuses)
+	return statuses, nil
+}
+
+// Fails a fulfillment in which no payload was valid, with the statuses in the error,
+// as the event of a failed transaction is dropped along with the rest of it
+func noValidBundlesError(statuses []*types.PayloadStatus) error {
+	return errorsmod.Wrapf(types.ErrNoValidBundles, "payload statuses: %v", statuses)
+}
+
+// Statuses of the actors whose payloads passed every filter, in address order.
+// Only the top actors by score among them are accepted.
+func topActorStatuses(actorType types.ActorType, scores map[string]types.Score, topActors []string) []*types.PayloadStatus {
+	isTop := make(map[string]bool, len(topActors))
+	for _, actor := range topActors {
+		isTop[actor] = true
+	}
+	actors := make([]string, 0, len(scores))
+	for actor := range scores {
+		actors = append(actors, actor)
+	}
+	sort.Strings(actors)
+
+	statuses := make([]*types.PayloadStatus, 0, len(actors))
+	for _, actor := range actors {
+		if isTop[actor] {
+			statuses = append(statuses, types.NewAcceptedPayloadStatus(actorType, actor))
+		} else {
+			statuses = append(statuses, types.NewRejectedPayloadStatus(actorType, actor, types.PayloadRejectionReason_NOT_TOP_BY_SCORE))
+		}
+	}
+	return statuses
 }
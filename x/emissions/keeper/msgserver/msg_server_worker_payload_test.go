@@ -2,12 +2,17 @@ package msgserver_test
 
 import (
 	"encoding/hex"
+	"fmt"
+	"time"
 
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
 )
 
 func getNewAddress() string {
@@ -521,3 +526,138 @@ func (s *KeeperTestSuite) TestMsgInsertBulkWorkerAlreadyFullfilledNonce() {
 	_, err = msgServer.InsertBulkWorkerPayload(ctx, workerMsg)
 	require.ErrorIs(err, types.ErrNonceAlreadyFulfilled)
 }
+
+func (s *KeeperTestSuite) TestMsgInsertBulkWorkerPayloadCountsAcceptedPayloads() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	config := metrics.DefaultConfig("")
+	config.EnableHostname = false
+	config.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(config, sink)
+	require.NoError(err)
+	defer metrics.NewGlobal(config, &metrics.BlackholeSink{}) //nolint:errcheck
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+
+	counters := sink.Data()[0].Counters
+	for _, payload := range []string{types.MetricPayloadInference, types.MetricPayloadForecast} {
+		key := fmt.Sprintf("%s.%s;%s=%d;%s=%s",
+			types.ModuleName, types.MetricKeyPayloadsAccepted,
+			types.MetricLabelTopicId, topicId,
+			types.MetricLabelPayload, payload,
+		)
+		require.Contains(counters, key)
+		require.Equal(1, counters[key].Count, payload)
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgInsertBulkWorkerPayloadReturnsStatuses() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	inferer := workerMsg.WorkerDataBundles[0].Worker
+	forecaster := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Forecast.Forecaster
+	unsignedWorker := getNewAddress()
+	workerMsg.WorkerDataBundles = append(workerMsg.WorkerDataBundles, &types.WorkerDataBundle{
+		Worker: unsignedWorker,
+		InferenceForecastsBundle: &types.InferenceForecastBundle{
+			Inference: &types.Inference{
+				TopicId:     topicId,
+				BlockHeight: workerMsg.Nonce.BlockHeight,
+				Inferer:     unsignedWorker,
+				Value:       alloraMath.NewDecFromInt64(100),
+			},
+		},
+	})
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	expected := []*types.PayloadStatus{
+		types.NewRejectedPayloadStatus(types.ActorType_INFERER, unsignedWorker, types.PayloadRejectionReason_INVALID_BUNDLE),
+		types.NewAcceptedPayloadStatus(types.ActorType_INFERER, inferer),
+		types.NewAcceptedPayloadStatus(types.ActorType_FORECASTER, forecaster),
+	}
+	require.Equal(expected, response.Statuses)
+
+	// The statuses are also emitted for workers to follow
+	var event *types.EventPayloadStatuses
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(&types.EventPayloadStatuses{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(err)
+		event = msg.(*types.EventPayloadStatuses)
+	}
+	require.NotNil(event)
+	require.Equal(topicId, event.TopicId)
+	require.Equal(workerMsg.Nonce.BlockHeight, event.BlockHeight)
+	require.Equal(expected, event.Statuses)
+}
+
+func (s *KeeperTestSuite) TestMsgInsertBulkWorkerPayloadReportsInvalidForecastBundles() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	inferer := workerMsg.WorkerDataBundles[0].Worker
+	forecaster := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Forecast.Forecaster
+	unsignedWorker := getNewAddress()
+	workerMsg.WorkerDataBundles = append(workerMsg.WorkerDataBundles, &types.WorkerDataBundle{
+		Worker: unsignedWorker,
+		InferenceForecastsBundle: &types.InferenceForecastBundle{
+			Inference: &types.Inference{
+				TopicId:     topicId,
+				BlockHeight: workerMsg.Nonce.BlockHeight,
+				Inferer:     unsignedWorker,
+				Value:       alloraMath.NewDecFromInt64(100),
+			},
+			Forecast: &types.Forecast{
+				TopicId:     topicId,
+				BlockHeight: workerMsg.Nonce.BlockHeight,
+				Forecaster:  unsignedWorker,
+				ForecastElements: []*types.ForecastElement{
+					{Inferer: inferer, Value: alloraMath.NewDecFromInt64(100)},
+				},
+			},
+		},
+	})
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	require.Equal([]*types.PayloadStatus{
+		types.NewRejectedPayloadStatus(types.ActorType_INFERER, unsignedWorker, types.PayloadRejectionReason_INVALID_BUNDLE),
+		types.NewAcceptedPayloadStatus(types.ActorType_INFERER, inferer),
+		types.NewRejectedPayloadStatus(types.ActorType_FORECASTER, unsignedWorker, types.PayloadRejectionReason_INVALID_BUNDLE),
+		types.NewAcceptedPayloadStatus(types.ActorType_FORECASTER, forecaster),
+	}, response.Statuses)
+}
+
+func (s *KeeperTestSuite) TestMsgInsertBulkWorkerPayloadReportsStatusesWhenNoBundleIsValid() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, _ := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	inferer := workerMsg.WorkerDataBundles[0].Worker
+	workerMsg.WorkerDataBundles[0].Pubkey = ""
+
+	_, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+	// The leader learns why each payload was left out from the error, as the event of the failed transaction is dropped
+	require.ErrorContains(err, types.NewRejectedPayloadStatus(types.ActorType_INFERER, inferer, types.PayloadRejectionReason_INVALID_BUNDLE).String())
+}
@@ -0,0 +1,340 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Prunes the records of a topic older than cutoff, visiting at most budget of them starting after lastKey,
+// the key the previous step stopped at if any
+type pruneFunc func(ctx context.Context, topicId TopicId, cutoff BlockHeight, lastKey []byte, budget uint64) (pruneStep, error)
+
+type pruneStep struct {
+	visited uint64
+	pruned  uint64
+	// key of the last record visited, set if the records of the topic were not all visited within the budget
+	nextKey []byte
+}
+
+// Counts the entries of a collection and their size in bytes in the store
+type measureFunc func(ctx context.Context) (entries uint64, size uint64, err error)
+
+type prunedCollection struct {
+	name      string
+	retention func(types.PruningParams) types.Retention
+	prune     pruneFunc
+	measure   measureFunc
+}
+
+// Collections pruned by PruneHistoricalRecords, in the order they are pruned in.
+// The pruning cursor refers to them by index, so new collections must be appended.
+func (k *Keeper) prunedCollections() []prunedCollection {
+	return []prunedCollection{
+		{
+			name:      types.PrunedCollectionInfererScores,
+			retention: func(p types.PruningParams) types.Retention { return p.InfererScores },
+			prune:     pruneByBlock(k.infererScoresByBlock),
+			measure:   measureCollection(k.infererScoresByBlock),
+		},
+		{
+			name:      types.PrunedCollectionForecasterScores,
+			retention: func(p types.PruningParams) types.Retention { return p.ForecasterScores },
+			prune:     pruneByBlock(k.forecasterScoresByBlock),
+			measure:   measureCollection(k.forecasterScoresByBlock),
+		},
+		{
+			name:      types.PrunedCollectionReputerScores,
+			retention: func(p types.PruningParams) types.Retention { return p.ReputerScores },
+			prune:     pruneByBlock(k.reputerScoresByBlock),
+			measure:   measureCollection(k.reputerScoresByBlock),
+		},
+		{
+			name:      types.PrunedCollectionInfererRegrets,
+			retention: func(p types.PruningParams) types.Retention { return p.Regrets },
+			prune:     pruneByHeight(k.latestInfererNetworkRegrets, topicActorStart, regretHeight),
+			measure:   measureCollection(k.latestInfererNetworkRegrets),
+		},
+		{
+			name:      types.PrunedCollectionForecasterRegrets,
+			retention: func(p types.PruningParams) types.Retention { return p.Regrets },
+			prune:     pruneByHeight(k.latestForecasterNetworkRegrets, topicActorStart, regretHeight),
+			measure:   measureCollection(k.latestForecasterNetworkRegrets),
+		},
+		{
+			name:      types.PrunedCollectionOneInForecasterRegrets,
+			retention: func(p types.PruningParams) types.Retention { return p.Regrets },
+			prune:     pruneByHeight(k.latestOneInForecasterNetworkRegrets, topicActorActorStart, regretHeight),
+			measure:   measureCollection(k.latestOneInForecasterNetworkRegrets),
+		},
+		{
+			name:      types.PrunedCollectionOneInForecasterSelfRegrets,
+			retention: func(p types.PruningParams) types.Retention { return p.Regrets },
+			prune:     pruneByHeight(k.latestOneInForecasterSelfNetworkRegrets, topicActorStart, regretHeight),
+			measure:   measureCollection(k.latestOneInForecasterSelfNetworkRegrets),
+		},
+		{
+			name:      types.PrunedCollectionLatestInfererScores,
+			retention: func(p types.PruningParams) types.Retention { return p.LatestScores },
+			prune:     pruneByHeight(k.latestInfererScoresByWorker, topicActorStart, scoreHeight),
+			measure:   measureCollection(k.latestInfererScoresByWorker),
+		},
+		{
+			name:      types.PrunedCollectionLatestForecasterScores,
+			retention: func(p types.PruningParams) types.Retention { return p.LatestScores },
+			prune:     pruneByHeight(k.latestForecasterScoresByWorker, topicActorStart, scoreHeight),
+			measure:   measureCollection(k.latestForecasterScoresByWorker),
+		},
+		{
+			name:      types.PrunedCollectionLatestReputerScores,
+			retention: func(p types.PruningParams) types.Retention { return p.LatestScores },
+			prune:     pruneByHeight(k.latestReputerScoresByReputer, topicActorStart, scoreHeight),
+			measure:   measureCollection(k.latestReputerScoresByReputer),
+		},
+	}
+}
+
+// Collections pruned by PruneRecordsAfterRewards once a topic is rewarded, reported by the state retention query
+func (k *Keeper) prunedAfterRewardsCollections() []prunedCollection {
+	return []prunedCollection{
+		{name: types.PrunedCollectionInferences, measure: measureCollection(k.allInferences)},
+		{name: types.PrunedCollectionForecasts, measure: measureCollection(k.allForecasts)},
+		{name: types.PrunedCollectionLossBundles, measure: measureCollection(k.allLossBundles)},
+		{name: types.PrunedCollectionNetworkLossBundles, measure: measureCollection(k.networkLossBundles)},
+		{name: types.PrunedCollectionTopicRewardReports, measure: measureCollection(k.topicRewardReports)},
+		{name: types.PrunedCollectionWorkerCommitments, measure: measureCollection(k.workerCommitments)},
+		{name: types.PrunedCollectionPendingWorkerPayloads, measure: measureCollection(k.pendingWorkerPayloads)},
+		{name: types.PrunedCollectionPendingReputerPayloads, measure: measureCollection(k.pendingReputerPayloads)},
+	}
+}
+
+// Prunes the records older than the retention of their collection in the params. At most MaxRecordsPerBlock
+// records are visited per block, each topic visited counting as at least one, so the work of a block does not
+// grow with the state. Collections are pruned one after the other, topic by topic, and the pruning resumes
+// where it stopped in the next block. A block does not start a new pass once one is over.
+func (k *Keeper) PruneHistoricalRecords(ctx context.Context, blockHeight BlockHeight) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	budget := moduleParams.Pruning.MaxRecordsPerBlock
+	if budget == 0 {
+		return nil
+	}
+	cursor, err := k.GetPruningCursor(ctx)
+	if err != nil {
+		return err
+	}
+	nextTopicId, err := k.GetNextTopicId(ctx)
+	if err != nil {
+		return err
+	}
+
+	pruned := k.prunedCollections()
+	prunedRecords := make([]uint64, len(pruned))
+	for budget > 0 {
+		if cursor.Collection >= uint64(len(pruned)) {
+			cursor = types.PruningCursor{}
+			break
+		}
+		if cursor.TopicId == 0 {
+			cursor.TopicId = 1
+		}
+		c := pruned[cursor.Collection]
+		retention := c.retention(moduleParams.Pruning)
+		if !retention.IsSet() || cursor.TopicId >= nextTopicId {
+			cursor = types.PruningCursor{Collection: cursor.Collection + 1}
+			continue
+		}
+
+		topic, err := k.GetTopic(ctx, cursor.TopicId)
+		if err != nil {
+			return err
+		}
+		var step pruneStep
+		if cutoff, ok := retention.Cutoff(blockHeight, topic.EpochLength); ok {
+			step, err = c.prune(ctx, cursor.TopicId, cutoff, cursor.LastKey, budget)
+			if err != nil {
+				return err
+			}
+		}
+		prunedRecords[cursor.Collection] += step.pruned
+		budget -= max(step.visited, 1)
+		if step.nextKey != nil {
+			cursor.LastKey = step.nextKey
+		} else {
+			cursor.TopicId++
+			cursor.LastKey = nil
+		}
+	}
+
+	for i, c := range pruned {
+		types.IncrPrunedRecordsMetric(c.name, int(prunedRecords[i]))
+	}
+	return k.pruningCursor.Set(ctx, cursor)
+}
+
+func (k *Keeper) GetPruningCursor(ctx context.Context) (types.PruningCursor, error) {
+	cursor, err := k.pruningCursor.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PruningCursor{}, nil
+		}
+		return types.PruningCursor{}, err
+	}
+	return cursor, nil
+}
+
+// Returns the retention of every pruned collection with the number of its entries and their size in the store.
+// Every record of these collections is read, so this is meant for operators rather than for transactions.
+func (k *Keeper) GetCollectionRetentions(ctx context.Context) ([]*types.CollectionRetention, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retentions := make([]*types.CollectionRetention, 0)
+	for _, c := range k.prunedCollections() {
+		entries, size, err := c.measure(ctx)
+		if err != nil {
+			return nil, err
+		}
+		retentions = append(retentions, &types.CollectionRetention{
+			Collection: c.name,
+			Retention:  c.retention(moduleParams.Pruning),
+			Entries:    entries,
+			SizeBytes:  size,
+		})
+	}
+	// These are kept for MinEpochLengthRecordLimit epochs before the last rewarded nonce of their topic
+	afterRewards := types.Retention{Epochs: uint64(moduleParams.MinEpochLengthRecordLimit)}
+	for _, c := range k.prunedAfterRewardsCollections() {
+		entries, size, err := c.measure(ctx)
+		if err != nil {
+			return nil, err
+		}
+		retentions = append(retentions, &types.CollectionRetention{
+			Collection:         c.name,
+			Retention:          afterRewards,
+			PrunedAfterRewards: true,
+			Entries:            entries,
+			SizeBytes:          size,
+		})
+	}
+	return retentions, nil
+}
+
+// Prunes the records of a (topic, block_height) map, which are ordered by block height within a topic
+func pruneByBlock[V any](m collections.Map[collections.Pair[TopicId, BlockHeight], V]) pruneFunc {
+	return func(ctx context.Context, topicId TopicId, cutoff BlockHeight, _ []byte, budget uint64) (pruneStep, error) {
+		// Every record visited is pruned, so the next step starts over from the first record of the topic
+		rng := collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId).EndInclusive(cutoff)
+		return pruneRange(ctx, m, rng, budget, func(V) bool { return true })
+	}
+}
+
+// Prunes the records of a map keyed by topic first, whose values record the height they were set at
+func pruneByHeight[K, V any](
+	m collections.Map[K, V],
+	topicStart func(TopicId) K,
+	height func(V) BlockHeight,
+) pruneFunc {
+	return func(ctx context.Context, topicId TopicId, cutoff BlockHeight, lastKey []byte, budget uint64) (pruneStep, error) {
+		rng := new(collections.Range[K]).EndExclusive(topicStart(topicId + 1))
+		if len(lastKey) == 0 {
+			rng = rng.StartInclusive(topicStart(topicId))
+		} else {
+			_, key, err := m.KeyCodec().Decode(lastKey)
+			if err != nil {
+				return pruneStep{}, err
+			}
+			rng = rng.StartExclusive(key)
+		}
+		return pruneRange(ctx, m, rng, budget, func(v V) bool { return height(v) <= cutoff })
+	}
+}
+
+func pruneRange[K, V any](
+	ctx context.Context,
+	m collections.Map[K, V],
+	rng collections.Ranger[K],
+	budget uint64,
+	expired func(V) bool,
+) (pruneStep, error) {
+	var step pruneStep
+	var expiredKeys []K
+	err := func() error {
+		iter, err := m.Iterate(ctx, rng)
+		if err != nil {
+			return err
+		}
+		defer iter.Close()
+
+		var lastKey K
+		for ; iter.Valid(); iter.Next() {
+			if step.visited == budget {
+				keyCodec := m.KeyCodec()
+				step.nextKey = make([]byte, keyCodec.Size(lastKey))
+				_, err = keyCodec.Encode(step.nextKey, lastKey)
+				return err
+			}
+			kv, err := iter.KeyValue()
+			if err != nil {
+				return err
+			}
+			step.visited++
+			lastKey = kv.Key
+			if expired(kv.Value) {
+				expiredKeys = append(expiredKeys, kv.Key)
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		return pruneStep{}, err
+	}
+
+	// Records are removed once the iterator is closed
+	for _, key := range expiredKeys {
+		if err := m.Remove(ctx, key); err != nil {
+			return pruneStep{}, err
+		}
+	}
+	step.pruned = uint64(len(expiredKeys))
+	return step, nil
+}
+
+func measureCollection[K, V any](m collections.Map[K, V]) measureFunc {
+	return func(ctx context.Context) (uint64, uint64, error) {
+		var entries, size uint64
+		err := m.Walk(ctx, nil, func(key K, value V) (bool, error) {
+			encodedValue, err := m.ValueCodec().Encode(value)
+			if err != nil {
+				return true, err
+			}
+			entries++
+			size += uint64(len(m.GetPrefix()) + m.KeyCodec().Size(key) + len(encodedValue))
+			return false, nil
+		})
+		return entries, size, err
+	}
+}
+
+// First keys of a topic in maps keyed by topic and actors
+func topicActorStart(topicId TopicId) collections.Pair[TopicId, ActorId] {
+	return collections.Join(topicId, "")
+}
+
+func topicActorActorStart(topicId TopicId) collections.Triple[TopicId, ActorId, ActorId] {
+	return collections.Join3(topicId, "", "")
+}
+
+func regretHeight(regret types.TimestampedValue) BlockHeight {
+	return regret.BlockHeight
+}
+
+func scoreHeight(score types.Score) BlockHeight {
+	return score.BlockHeight
+}
@@ -61,3 +61,16 @@ func (qs queryServer) GetNetworkInferencesAtBlock(ctx context.Context, req *type
 
 	return &types.QueryNetworkInferencesAtBlockResponse{NetworkInferences: networkInferences}, nil
 }
+
+// Returns the commitment made by a worker to its payload for a nonce of a commit-reveal topic
+func (qs queryServer) GetWorkerCommitment(ctx context.Context, req *types.QueryWorkerCommitmentRequest) (*types.QueryWorkerCommitmentResponse, error) {
+	commitment, err := qs.k.GetWorkerCommitment(ctx, req.TopicId, types.Nonce{BlockHeight: req.BlockHeight}, req.WorkerAddress)
+	if err != nil {
+		return nil, err
+	}
+	if commitment == nil {
+		return nil, status.Errorf(codes.NotFound, "no commitment from worker %s for topic %v at block %v", req.WorkerAddress, req.TopicId, req.BlockHeight)
+	}
+
+	return &types.QueryWorkerCommitmentResponse{Commitment: commitment}, nil
+}
@@ -254,3 +254,29 @@ func (s *KeeperTestSuite) TestGetNetworkInferencesAtBlock() {
 	response, err := queryServer.GetNetworkInferencesAtBlock(s.ctx, req)
 	require.NotNil(response, "Response should not be nil")
 }
+
+func (s *KeeperTestSuite) TestGetWorkerCommitment() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+	topicId := uint64(1)
+	nonce := types.Nonce{BlockHeight: 100}
+	worker := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	commitment := make([]byte, types.WorkerCommitmentLength)
+	commitment[0] = 1
+
+	req := &types.QueryWorkerCommitmentRequest{
+		TopicId:       topicId,
+		BlockHeight:   nonce.BlockHeight,
+		WorkerAddress: worker,
+	}
+	_, err := queryServer.GetWorkerCommitment(ctx, req)
+	s.Require().Error(err, "Should fail when the worker has not committed")
+
+	err = keeper.SetWorkerCommitment(ctx, topicId, nonce, worker, commitment)
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetWorkerCommitment(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(commitment, response.Commitment)
+}
@@ -18,3 +18,35 @@ func (qs queryServer) Params(ctx context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// GetScheduledParamsUpdates defines the handler for the Query/GetScheduledParamsUpdates RPC method.
+func (qs queryServer) GetScheduledParamsUpdates(ctx context.Context, req *types.QueryScheduledParamsUpdatesRequest) (*types.QueryScheduledParamsUpdatesResponse, error) {
+	updates, err := qs.k.GetScheduledParamsUpdates(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledParamsUpdatesResponse{Updates: updates}, nil
+}
+
+// GetStateRetention defines the handler for the Query/GetStateRetention RPC method.
+func (qs queryServer) GetStateRetention(ctx context.Context, req *types.QueryStateRetentionRequest) (*types.QueryStateRetentionResponse, error) {
+	params, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	cursor, err := qs.k.GetPruningCursor(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	retentions, err := qs.k.GetCollectionRetentions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStateRetentionResponse{
+		MaxRecordsPerBlock: params.Pruning.MaxRecordsPerBlock,
+		Cursor:             &cursor,
+		Collections:        retentions,
+	}, nil
+}
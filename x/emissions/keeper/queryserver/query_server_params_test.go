@@ -1,6 +1,9 @@
 package queryserver_test
 
-import "github.com/allora-network/allora-chain/x/emissions/types"
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
 
 func (s *KeeperTestSuite) TestParams() {
 	ctx := s.ctx
@@ -20,3 +23,65 @@ func (s *KeeperTestSuite) TestParams() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().Equal(expectedParams.Version, response.Params.Version)
 }
+
+func (s *KeeperTestSuite) TestGetScheduledParamsUpdates() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+
+	later := types.ScheduledParamsUpdate{
+		Sender:               keeper.GetAuthority(),
+		Params:               &types.OptionalParams{Version: []string{"2.0"}},
+		EffectiveBlockHeight: 200,
+	}
+	sooner := types.ScheduledParamsUpdate{
+		Sender:               keeper.GetAuthority(),
+		Params:               &types.OptionalParams{Version: []string{"1.5"}},
+		EffectiveBlockHeight: 100,
+	}
+	s.Require().NoError(keeper.ScheduleParamsUpdate(ctx, later))
+	s.Require().NoError(keeper.ScheduleParamsUpdate(ctx, sooner))
+
+	response, err := queryServer.GetScheduledParamsUpdates(ctx, &types.QueryScheduledParamsUpdatesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Updates, 2)
+	s.Require().Equal(sooner.EffectiveBlockHeight, response.Updates[0].EffectiveBlockHeight)
+	s.Require().Equal(later.EffectiveBlockHeight, response.Updates[1].EffectiveBlockHeight)
+}
+
+func (s *KeeperTestSuite) TestGetStateRetention() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+
+	topicId := uint64(1)
+	worker := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	for _, height := range []int64{10, 20} {
+		score := types.Score{TopicId: topicId, BlockHeight: height, Address: worker, Score: alloraMath.OneDec()}
+		s.Require().NoError(keeper.InsertWorkerInferenceScore(ctx, topicId, height, score))
+	}
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetStateRetention(ctx, &types.QueryStateRetentionRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params.Pruning.MaxRecordsPerBlock, response.MaxRecordsPerBlock)
+	s.Require().Equal(types.PruningCursor{}, *response.Cursor)
+
+	retentions := make(map[string]*types.CollectionRetention)
+	for _, c := range response.Collections {
+		retentions[c.Collection] = c
+	}
+	inferer := retentions[types.PrunedCollectionInfererScores]
+	s.Require().NotNil(inferer)
+	s.Require().Equal(params.Pruning.InfererScores, inferer.Retention)
+	s.Require().False(inferer.PrunedAfterRewards)
+	s.Require().Equal(uint64(2), inferer.Entries)
+	s.Require().NotZero(inferer.SizeBytes)
+	s.Require().Zero(retentions[types.PrunedCollectionForecasterScores].Entries)
+
+	inferences := retentions[types.PrunedCollectionInferences]
+	s.Require().NotNil(inferences)
+	s.Require().True(inferences.PrunedAfterRewards)
+	s.Require().Equal(uint64(params.MinEpochLengthRecordLimit), inferences.Retention.Epochs)
+}
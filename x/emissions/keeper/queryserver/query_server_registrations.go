@@ -66,3 +66,53 @@ func (qs queryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *typ
 
 	return &types.QueryIsReputerRegisteredInTopicIdResponse{IsRegistered: isRegistered}, nil
 }
+
+func (qs queryServer) GetWorkerLiveness(ctx context.Context, req *types.QueryWorkerLivenessRequest) (*types.QueryWorkerLivenessResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	liveness, err := qs.k.GetWorkerLiveness(sdkCtx, req.TopicId, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	params, err := qs.k.GetParams(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	uptime, err := liveness.Uptime(params.LivenessWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWorkerLivenessResponse{
+		Liveness: &liveness,
+		Uptime:   uptime,
+		IsJailed: liveness.IsJailed(sdkCtx.BlockHeight()),
+	}, nil
+}
+
+func (qs queryServer) GetReputerLiveness(ctx context.Context, req *types.QueryReputerLivenessRequest) (*types.QueryReputerLivenessResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	liveness, err := qs.k.GetReputerLiveness(sdkCtx, req.TopicId, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	params, err := qs.k.GetParams(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	uptime, err := liveness.Uptime(params.LivenessWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReputerLivenessResponse{
+		Liveness: &liveness,
+		Uptime:   uptime,
+		IsJailed: liveness.IsJailed(sdkCtx.BlockHeight()),
+	}, nil
+}
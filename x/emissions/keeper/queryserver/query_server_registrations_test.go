@@ -2,6 +2,7 @@ package queryserver_test
 
 import (
 	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	minttypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -274,3 +275,36 @@ func (s *KeeperTestSuite) TestRegisteredReputerIsRegisteredInTopicId() {
 	require.NoError(err, "QueryIsReputerRegisteredInTopicId should not return an error")
 	require.True(queryResp.IsRegistered, "Query response should confirm reputer is registered")
 }
+
+func (s *KeeperTestSuite) TestGetWorkerLiveness() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+
+	moduleParams, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	moduleParams.LivenessWindow = 4
+	moduleParams.MaxMissedEpochs = 4
+	err = keeper.SetParams(ctx, moduleParams)
+	s.Require().NoError(err)
+
+	req := &types.QueryWorkerLivenessRequest{TopicId: topicId, Address: workerAddr.String()}
+	response, err := s.queryServer.GetWorkerLiveness(ctx, req)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.OneDec().Equal(response.Uptime), "Worker without tracked nonces should have full uptime")
+	s.Require().False(response.IsJailed)
+
+	err = keeper.InsertWorker(ctx, topicId, workerAddr.String(), types.OffchainNode{LibP2PKey: "test"})
+	s.Require().NoError(err)
+	for _, submitted := range []bool{true, false, true, true} {
+		err = keeper.HandleWorkerLiveness(ctx, topicId, map[string]bool{workerAddr.String(): submitted})
+		s.Require().NoError(err)
+	}
+
+	response, err = s.queryServer.GetWorkerLiveness(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), response.Liveness.MissedEpochsCounter)
+	s.Require().True(alloraMath.MustNewDecFromString("0.75").Equal(response.Uptime))
+	s.Require().False(response.IsJailed)
+}
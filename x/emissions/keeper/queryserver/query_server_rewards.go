@@ -0,0 +1,72 @@
+package queryserver
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Returns how the reward of a topic was distributed for a reward nonce
+func (qs queryServer) GetTopicRewardReport(ctx context.Context, req *types.QueryTopicRewardReportRequest) (*types.QueryTopicRewardReportResponse, error) {
+	report, err := qs.k.GetTopicRewardReport(ctx, req.TopicId, req.BlockHeight)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no reward report for topic %d at block %d", req.TopicId, req.BlockHeight)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicRewardReportResponse{Report: &report}, nil
+}
+
+// Runs the reward pipeline of a topic for a reward nonce as a dry run and returns the report it would produce
+func (qs queryServer) SimulateTopicRewards(ctx context.Context, req *types.QuerySimulateTopicRewardsRequest) (*types.QuerySimulateTopicRewardsResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %d not found", req.TopicId)
+	}
+
+	rewardNonce := req.BlockHeight
+	if rewardNonce == 0 {
+		rewardNonce, err = qs.k.GetTopicRewardNonce(ctx, req.TopicId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if rewardNonce == 0 {
+			return nil, status.Errorf(codes.NotFound, "topic %d has no reward nonce pending", req.TopicId)
+		}
+	}
+
+	report, err := rewards.SimulateTopicRewards(sdk.UnwrapSDKContext(ctx), qs.k, req.TopicId, rewardNonce)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QuerySimulateTopicRewardsResponse{Report: &report}, nil
+}
+
+// Returns the rewards credited to an address and not claimed yet, along with where they would be sent
+func (qs queryServer) GetClaimableRewards(ctx context.Context, req *types.QueryClaimableRewardsRequest) (*types.QueryClaimableRewardsResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, err
+	}
+	rewards, err := qs.k.GetClaimableRewards(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	withdrawAddress, err := qs.k.GetRewardWithdrawAddress(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimableRewardsResponse{Rewards: rewards, WithdrawAddress: withdrawAddress}, nil
+}
@@ -0,0 +1,84 @@
+package queryserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestGetTopicRewardReport() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+	topicId := uint64(1)
+	blockHeight := int64(100)
+	req := &types.QueryTopicRewardReportRequest{TopicId: topicId, BlockHeight: blockHeight}
+
+	_, err := queryServer.GetTopicRewardReport(ctx, req)
+	s.Require().Error(err, "GetTopicRewardReport should fail without a report")
+
+	report := types.TopicRewardReport{
+		TopicId:               topicId,
+		BlockHeight:           blockHeight,
+		TopicReward:           alloraMath.NewDecFromInt64(100),
+		InferenceEntropy:      alloraMath.MustNewDecFromString("0.5"),
+		ForecastingEntropy:    alloraMath.MustNewDecFromString("0.3"),
+		ReputerEntropy:        alloraMath.MustNewDecFromString("0.2"),
+		InferenceTaskReward:   alloraMath.NewDecFromInt64(50),
+		ForecastingTaskReward: alloraMath.NewDecFromInt64(30),
+		ReputerTaskReward:     alloraMath.NewDecFromInt64(20),
+		Chi:                   alloraMath.MustNewDecFromString("0.1"),
+		Gamma:                 alloraMath.OneDec(),
+		Actors: []types.ActorRewardReport{
+			{
+				ActorType:      types.ActorType_INFERER,
+				Address:        "worker",
+				Score:          alloraMath.MustNewDecFromString("0.01"),
+				RewardFraction: alloraMath.OneDec(),
+				Reward:         alloraMath.NewDecFromInt64(50),
+			},
+		},
+	}
+	s.Require().NoError(keeper.SetTopicRewardReport(ctx, report))
+
+	response, err := queryServer.GetTopicRewardReport(ctx, req)
+	s.Require().NoError(err, "GetTopicRewardReport should not produce an error")
+	s.Require().Equal(report.String(), response.Report.String())
+}
+
+func (s *KeeperTestSuite) TestSimulateTopicRewardsRequiresRewardNonce() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+
+	_, err := queryServer.SimulateTopicRewards(ctx, &types.QuerySimulateTopicRewardsRequest{TopicId: 1})
+	s.Require().Error(err, "SimulateTopicRewards should fail for a missing topic")
+
+	topicId := s.CreateOneTopic()
+	_, err = queryServer.SimulateTopicRewards(ctx, &types.QuerySimulateTopicRewardsRequest{TopicId: topicId})
+	s.Require().Error(err, "SimulateTopicRewards should fail without a pending reward nonce")
+}
+
+func (s *KeeperTestSuite) TestGetClaimableRewards() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+	workerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	withdrawAddr := sdk.AccAddress(PKS[1].Address()).String()
+	req := &types.QueryClaimableRewardsRequest{Address: workerAddr}
+
+	response, err := queryServer.GetClaimableRewards(ctx, req)
+	s.Require().NoError(err, "GetClaimableRewards should not produce an error")
+	s.Require().True(response.Rewards.IsZero())
+	s.Require().Equal(workerAddr, response.WithdrawAddress)
+
+	rewards := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(100)))
+	s.Require().NoError(keeper.CreditClaimableRewards(ctx, workerAddr, rewards))
+	s.Require().NoError(keeper.SetRewardWithdrawAddress(ctx, workerAddr, withdrawAddr))
+
+	response, err = queryServer.GetClaimableRewards(ctx, req)
+	s.Require().NoError(err, "GetClaimableRewards should not produce an error")
+	s.Require().Equal(rewards, response.Rewards)
+	s.Require().Equal(withdrawAddr, response.WithdrawAddress)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 )
 
@@ -105,6 +107,50 @@ func (qs queryServer) GetStakeFromDelegatorInTopic(ctx context.Context, req *typ
 	return &types.QueryStakeFromDelegatorInTopicResponse{Amount: stake}, nil
 }
 
+// Retrieves the reward accrued by the stake a delegator placed on a reputer in a topic that has not been paid out yet
+func (qs queryServer) GetDelegateStakePendingReward(ctx context.Context, req *types.QueryDelegateStakePendingRewardRequest) (*types.QueryDelegateStakePendingRewardResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.DelegatorAddress); err != nil {
+		return nil, err
+	}
+	if err := qs.k.ValidateStringIsBech32(req.ReputerAddress); err != nil {
+		return nil, err
+	}
+	pendingReward, err := qs.k.GetDelegateStakePendingReward(ctx, req.TopicId, req.DelegatorAddress, req.ReputerAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegateStakePendingRewardResponse{PendingReward: pendingReward}, nil
+}
+
+// Retrieves the pending rewards of every stake placement of a delegator and their sum
+func (qs queryServer) GetDelegatorPendingRewards(ctx context.Context, req *types.QueryDelegatorPendingRewardsRequest) (*types.QueryDelegatorPendingRewardsResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.DelegatorAddress); err != nil {
+		return nil, err
+	}
+	positions, err := qs.k.GetDelegatorPendingRewards(ctx, req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	totalPendingReward := alloraMath.ZeroDec()
+	for _, position := range positions {
+		totalPendingReward, err = totalPendingReward.Add(position.PendingReward)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	autoCompound, err := qs.k.IsDelegatorAutoCompound(ctx, req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorPendingRewardsResponse{
+		Positions:          positions,
+		TotalPendingReward: totalPendingReward,
+		AutoCompound:       autoCompound,
+	}, nil
+}
+
 // Retrieves total stake in a given topic
 func (qs queryServer) GetTopicStake(ctx context.Context, req *types.QueryTopicStakeRequest) (*types.QueryTopicStakeResponse, error) {
 	stake, err := qs.k.GetTopicStake(ctx, req.TopicId)
@@ -114,3 +160,69 @@ func (qs queryServer) GetTopicStake(ctx context.Context, req *types.QueryTopicSt
 
 	return &types.QueryTopicStakeResponse{Amount: stake}, nil
 }
+
+// Retrieves the commission of a reputer, which is zero if the reputer never set one
+func (qs queryServer) GetReputerCommission(ctx context.Context, req *types.QueryReputerCommissionRequest) (*types.QueryReputerCommissionResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.ReputerAddress); err != nil {
+		return nil, err
+	}
+	commission, err := qs.k.GetReputerCommission(ctx, req.ReputerAddress)
+	if err != nil {
+		if !errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		commission = types.ReputerCommission{
+			Rate:          alloraMath.ZeroDec(),
+			MaxRate:       alloraMath.ZeroDec(),
+			MaxChangeRate: alloraMath.ZeroDec(),
+		}
+	}
+
+	return &types.QueryReputerCommissionResponse{Commission: commission}, nil
+}
+
+// Retrieves the redelegations away from a reputer in a topic that are still within the stake removal delay window
+func (qs queryServer) GetRedelegationsFromReputer(ctx context.Context, req *types.QueryRedelegationsFromReputerRequest) (*types.QueryRedelegationsFromReputerResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.ReputerAddress); err != nil {
+		return nil, err
+	}
+	redelegations, err := qs.k.GetInFlightRedelegationsFromReputer(ctx, req.TopicId, req.ReputerAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationsFromReputerResponse{Redelegations: redelegations}, nil
+}
+
+// Returns the stake pool of a reputer along with how it is allocated across topics and its pending changes
+func (qs queryServer) GetReputerStakePool(ctx context.Context, req *types.QueryReputerStakePoolRequest) (*types.QueryReputerStakePoolResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.ReputerAddress); err != nil {
+		return nil, err
+	}
+	pool, err := qs.k.GetReputerStakePool(ctx, req.ReputerAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	allocations, err := qs.k.GetReputerStakeAllocations(ctx, req.ReputerAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pendingDecreases, err := qs.k.GetReputerStakeAllocationDecreases(ctx, req.ReputerAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var pendingRemoval *types.ReputerStakePoolRemoval
+	removal, err := qs.k.GetReputerStakePoolRemoval(ctx, req.ReputerAddress)
+	if err == nil {
+		pendingRemoval = &removal
+	} else if !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReputerStakePoolResponse{
+		Amount:           pool,
+		Allocations:      allocations,
+		PendingDecreases: pendingDecreases,
+		PendingRemoval:   pendingRemoval,
+	}, nil
+}
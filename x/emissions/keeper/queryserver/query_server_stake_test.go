@@ -2,6 +2,7 @@ package queryserver_test
 
 import (
 	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -184,3 +185,107 @@ func (s *KeeperTestSuite) TestGetTopicStake() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().Equal(stakeAmount, response.Amount, "The retrieved topic stake should match the stake amount added")
 }
+
+func (s *KeeperTestSuite) TestGetDelegatorPendingRewards() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	reputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	for nextTopicId, err := keeper.GetNextTopicId(ctx); nextTopicId <= 2; nextTopicId, err = keeper.IncrementTopicId(ctx) {
+		s.Require().NoError(err)
+	}
+
+	// The delegator placed 100 on the reputer in both topics, and each topic paid it a different reward
+	stakePlacement := types.DelegatorInfo{Amount: alloraMath.NewDecFromInt64(100), RewardDebt: alloraMath.NewDecFromInt64(0)}
+	s.Require().NoError(keeper.SetDelegateStakePlacement(ctx, 1, delegatorAddr, reputerAddr, stakePlacement))
+	s.Require().NoError(keeper.SetDelegateStakePlacement(ctx, 2, delegatorAddr, reputerAddr, stakePlacement))
+	s.Require().NoError(keeper.SetDelegateRewardPerShare(ctx, 1, reputerAddr, alloraMath.MustNewDecFromString("0.5")))
+	s.Require().NoError(keeper.SetDelegateRewardPerShare(ctx, 2, reputerAddr, alloraMath.MustNewDecFromString("0.25")))
+
+	positionResponse, err := queryServer.GetDelegateStakePendingReward(ctx, &types.QueryDelegateStakePendingRewardRequest{
+		DelegatorAddress: delegatorAddr,
+		ReputerAddress:   reputerAddr,
+		TopicId:          1,
+	})
+	s.Require().NoError(err, "GetDelegateStakePendingReward should not produce an error")
+	s.Require().True(alloraMath.NewDecFromInt64(50).Equal(positionResponse.PendingReward))
+
+	s.Require().NoError(keeper.SetDelegatorAutoCompound(ctx, delegatorAddr, true))
+
+	response, err := queryServer.GetDelegatorPendingRewards(ctx, &types.QueryDelegatorPendingRewardsRequest{
+		DelegatorAddress: delegatorAddr,
+	})
+	s.Require().NoError(err, "GetDelegatorPendingRewards should not produce an error")
+	s.Require().Len(response.Positions, 2)
+	s.Require().Equal(uint64(1), response.Positions[0].TopicId)
+	s.Require().Equal(uint64(2), response.Positions[1].TopicId)
+	s.Require().True(alloraMath.NewDecFromInt64(25).Equal(response.Positions[1].PendingReward))
+	s.Require().True(alloraMath.NewDecFromInt64(75).Equal(response.TotalPendingReward))
+	s.Require().True(response.AutoCompound)
+}
+
+func (s *KeeperTestSuite) TestGetReputerCommission() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	reputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	req := &types.QueryReputerCommissionRequest{ReputerAddress: reputerAddr}
+
+	response, err := queryServer.GetReputerCommission(ctx, req)
+	s.Require().NoError(err, "GetReputerCommission should not produce an error")
+	s.Require().True(response.Commission.Rate.IsZero(), "A reputer without commission should have a zero rate")
+
+	commission := types.ReputerCommission{
+		Rate:          alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.01"),
+		UpdateTime:    100,
+	}
+	s.Require().NoError(keeper.SetReputerCommission(ctx, reputerAddr, commission))
+
+	response, err = queryServer.GetReputerCommission(ctx, req)
+	s.Require().NoError(err, "GetReputerCommission should not produce an error")
+	s.Require().True(commission.Rate.Equal(response.Commission.Rate))
+	s.Require().True(commission.MaxRate.Equal(response.Commission.MaxRate))
+	s.Require().Equal(commission.UpdateTime, response.Commission.UpdateTime)
+}
+
+func (s *KeeperTestSuite) TestGetReputerStakePool() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	reputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	topicId := uint64(1)
+	req := &types.QueryReputerStakePoolRequest{ReputerAddress: reputerAddr}
+
+	response, err := queryServer.GetReputerStakePool(ctx, req)
+	s.Require().NoError(err, "GetReputerStakePool should not produce an error")
+	s.Require().True(response.Amount.IsZero())
+	s.Require().Empty(response.Allocations)
+	s.Require().Nil(response.PendingRemoval)
+
+	s.Require().NoError(keeper.AddReputerStakePool(ctx, reputerAddr, cosmosMath.NewInt(1000)))
+	s.Require().NoError(keeper.SetReputerStakeAllocation(ctx, reputerAddr, topicId, alloraMath.MustNewDecFromString("0.25")))
+	s.Require().NoError(keeper.SetReputerStakePoolRemoval(ctx, types.ReputerStakePoolRemoval{
+		BlockRemovalStarted: 10,
+		Reputer:             reputerAddr,
+		Amount:              cosmosMath.NewInt(100),
+	}))
+
+	response, err = queryServer.GetReputerStakePool(ctx, req)
+	s.Require().NoError(err, "GetReputerStakePool should not produce an error")
+	s.Require().Equal(cosmosMath.NewInt(1000), response.Amount)
+	s.Require().Len(response.Allocations, 1)
+	s.Require().Equal(topicId, response.Allocations[0].TopicId)
+	s.Require().True(alloraMath.MustNewDecFromString("0.25").Equal(response.Allocations[0].Fraction))
+	s.Require().NotNil(response.PendingRemoval)
+	s.Require().Equal(cosmosMath.NewInt(100), response.PendingRemoval.Amount)
+
+	stake, err := keeper.GetStakeOnReputerInTopic(ctx, topicId, reputerAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(250), stake)
+}
@@ -52,6 +52,28 @@ func (qs queryServer) GetTopic(ctx context.Context, req *types.QueryTopicRequest
 	}, nil
 }
 
+// GetTopicParams returns the overrides of a topic along with the params in effect for it.
+func (qs queryServer) GetTopicParams(ctx context.Context, req *types.QueryTopicParamsRequest) (*types.QueryTopicParamsResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !topicExists {
+		return nil, status.Error(codes.NotFound, types.ErrTopicDoesNotExist.Error())
+	}
+
+	topicParams, err := qs.k.GetTopicParams(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	effectiveParams, err := qs.k.GetParamsForTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicParamsResponse{TopicParams: topicParams, EffectiveParams: effectiveParams}, nil
+}
+
 // Retrieves a list of active topics. Paginated.
 func (qs queryServer) GetActiveTopics(ctx context.Context, req *types.QueryActiveTopicsRequest) (*types.QueryActiveTopicsResponse, error) {
 	activeTopics, pageRes, err := qs.k.GetIdsOfActiveTopics(ctx, req.Pagination)
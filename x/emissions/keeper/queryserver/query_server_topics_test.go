@@ -89,3 +89,22 @@ func (s *KeeperTestSuite) TestGetActiveTopics() {
 		s.Require().True(isActive, "Only active topics should be returned")
 	}
 }
+
+func (s *KeeperTestSuite) TestGetTopicParams() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	topicId := uint64(1)
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId}))
+	overrides := types.TopicParams{MaxTopReputersToReward: []uint64{3}}
+	s.Require().NoError(keeper.SetTopicParams(ctx, topicId, overrides))
+
+	response, err := queryServer.GetTopicParams(ctx, &types.QueryTopicParamsRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Equal(overrides, response.TopicParams)
+	s.Require().Equal(uint64(3), response.EffectiveParams.MaxTopReputersToReward)
+
+	_, err = queryServer.GetTopicParams(ctx, &types.QueryTopicParamsRequest{TopicId: topicId + 1})
+	s.Require().Error(err, "Querying params of a topic that does not exist should fail")
+}
@@ -21,3 +21,28 @@ func (qs queryServer) IsWhitelistAdmin(ctx context.Context, req *types.QueryIsWh
 
 	return &types.QueryIsWhitelistAdminResponse{IsAdmin: isAdmin}, nil
 }
+
+func (qs queryServer) GetTopicAllowlist(ctx context.Context, req *types.QueryTopicAllowlistRequest) (*types.QueryTopicAllowlistResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+	addresses, err := qs.k.GetTopicAllowlist(ctx, req.TopicId, req.IsReputer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicAllowlistResponse{Addresses: addresses}, nil
+}
+
+func (qs queryServer) GetTopicCreatorAllowlist(ctx context.Context, req *types.QueryTopicCreatorAllowlistRequest) (*types.QueryTopicCreatorAllowlistResponse, error) {
+	addresses, err := qs.k.GetTopicCreatorAllowlist(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicCreatorAllowlistResponse{Addresses: addresses}, nil
+}
@@ -33,3 +33,46 @@ func (s *KeeperTestSuite) TestIsWhitelistAdmin() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().False(response.IsAdmin, "The IsAdmin field should be false for the anti test address")
 }
+
+func (s *KeeperTestSuite) TestGetTopicAllowlist() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	topicId := uint64(1)
+	workerAddress := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+	reputerAddress := "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, IsPermissioned: true}))
+	s.Require().NoError(keeper.AddToTopicAllowlist(ctx, topicId, workerAddress, false))
+	s.Require().NoError(keeper.AddToTopicAllowlist(ctx, topicId, reputerAddress, true))
+
+	response, err := queryServer.GetTopicAllowlist(ctx, &types.QueryTopicAllowlistRequest{TopicId: topicId})
+	s.Require().NoError(err, "GetTopicAllowlist should not produce an error")
+	s.Require().Equal([]string{workerAddress}, response.Addresses)
+
+	response, err = queryServer.GetTopicAllowlist(ctx, &types.QueryTopicAllowlistRequest{TopicId: topicId, IsReputer: true})
+	s.Require().NoError(err, "GetTopicAllowlist should not produce an error")
+	s.Require().Equal([]string{reputerAddress}, response.Addresses)
+
+	_, err = queryServer.GetTopicAllowlist(ctx, &types.QueryTopicAllowlistRequest{TopicId: topicId + 1})
+	s.Require().Error(err, "GetTopicAllowlist should fail for a topic that does not exist")
+}
+
+func (s *KeeperTestSuite) TestGetTopicCreatorAllowlist() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	testAddress := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+
+	response, err := queryServer.GetTopicCreatorAllowlist(ctx, &types.QueryTopicCreatorAllowlistRequest{})
+	s.Require().NoError(err, "GetTopicCreatorAllowlist should not produce an error")
+	s.Require().Empty(response.Addresses)
+
+	s.Require().NoError(keeper.AddToTopicCreatorAllowlist(ctx, testAddress))
+
+	response, err = queryServer.GetTopicCreatorAllowlist(ctx, &types.QueryTopicCreatorAllowlistRequest{})
+	s.Require().NoError(err, "GetTopicCreatorAllowlist should not produce an error")
+	s.Require().Equal([]string{testAddress}, response.Addresses)
+}
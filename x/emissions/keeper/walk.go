@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	cosmosMath "cosmossdk.io/math"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Walkers visit every entry of a collection in key order, for tooling that reads the whole state, such as
+// `allorad emissions inspect`. Walking stops early when fn returns true or an error.
+
+func (k *Keeper) WalkTopics(ctx context.Context, fn func(topic types.Topic) (stop bool, err error)) error {
+	return k.topics.Walk(ctx, nil, func(_ TopicId, topic types.Topic) (bool, error) {
+		return fn(topic)
+	})
+}
+
+func (k *Keeper) WalkTopicStakes(ctx context.Context, fn func(topicId TopicId, stake cosmosMath.Int) (stop bool, err error)) error {
+	return k.topicStake.Walk(ctx, nil, fn)
+}
+
+func (k *Keeper) WalkReputerStakes(
+	ctx context.Context,
+	fn func(topicId TopicId, reputer ActorId, stake cosmosMath.Int) (stop bool, err error),
+) error {
+	return k.stakeByReputerAndTopicId.Walk(ctx, nil, func(key collections.Pair[TopicId, ActorId], stake cosmosMath.Int) (bool, error) {
+		return fn(key.K1(), key.K2(), stake)
+	})
+}
+
+func (k *Keeper) WalkDelegateStakePlacements(
+	ctx context.Context,
+	fn func(topicId TopicId, delegator ActorId, reputer ActorId, info types.DelegatorInfo) (stop bool, err error),
+) error {
+	return k.delegateStakePlacement.Walk(
+		ctx,
+		nil,
+		func(key collections.Triple[TopicId, ActorId, ActorId], info types.DelegatorInfo) (bool, error) {
+			return fn(key.K1(), key.K2(), key.K3(), info)
+		},
+	)
+}
+
+// Visits the latest inferer scores, then the latest forecaster scores, then the latest reputer scores
+func (k *Keeper) WalkLatestScores(
+	ctx context.Context,
+	fn func(actorType types.ActorType, score types.Score) (stop bool, err error),
+) error {
+	scores := []struct {
+		actorType types.ActorType
+		m         collections.Map[collections.Pair[TopicId, ActorId], types.Score]
+	}{
+		{types.ActorType_INFERER, k.latestInfererScoresByWorker},
+		{types.ActorType_FORECASTER, k.latestForecasterScoresByWorker},
+		{types.ActorType_REPUTER, k.latestReputerScoresByReputer},
+	}
+	for _, s := range scores {
+		stopped := false
+		err := s.m.Walk(ctx, nil, func(_ collections.Pair[TopicId, ActorId], score types.Score) (bool, error) {
+			stop, err := fn(s.actorType, score)
+			stopped = stop
+			return stop, err
+		})
+		if err != nil || stopped {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) WalkUnfulfilledWorkerNonces(
+	ctx context.Context,
+	fn func(topicId TopicId, nonces types.Nonces) (stop bool, err error),
+) error {
+	return k.unfulfilledWorkerNonces.Walk(ctx, nil, fn)
+}
+
+func (k *Keeper) WalkUnfulfilledReputerNonces(
+	ctx context.Context,
+	fn func(topicId TopicId, nonces types.ReputerRequestNonces) (stop bool, err error),
+) error {
+	return k.unfulfilledReputerNonces.Walk(ctx, nil, fn)
+}
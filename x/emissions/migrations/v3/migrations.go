@@ -0,0 +1,27 @@
+package v3
+
+import (
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// The params added in version 3 are not in the state of version 2, so they take their default values.
+// The other params are kept as they are.
+func MigrateParams(params types.Params) types.Params {
+	defaults := types.DefaultParams()
+	params.LivenessWindow = defaults.LivenessWindow
+	params.MaxMissedEpochs = defaults.MaxMissedEpochs
+	params.JailDuration = defaults.JailDuration
+	params.MinTopicParams = defaults.MinTopicParams
+	params.MaxTopicParams = defaults.MaxTopicParams
+	params.TopicCreatorAllowlistEnabled = defaults.TopicCreatorAllowlistEnabled
+	params.WorkerRewardsClaimable = defaults.WorkerRewardsClaimable
+	params.Pruning = defaults.Pruning
+	// Chains that scale scores over more samples than the default retention keep all of them
+	for _, retention := range []*types.Retention{
+		&params.Pruning.InfererScores, &params.Pruning.ForecasterScores, &params.Pruning.ReputerScores,
+	} {
+		retention.Epochs = max(retention.Epochs, params.MaxSamplesToScaleScores)
+	}
+	params.MinAdminParamsUpdateDelay = defaults.MinAdminParamsUpdateDelay
+	return params
+}
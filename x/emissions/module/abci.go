@@ -3,9 +3,15 @@ package module
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
 
 	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
 	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,19 +24,31 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		fmt.Sprintf("\n ---------------- Emissions EndBlock %d ------------------- \n",
 			blockHeight))
 
+	// Params updates scheduled for this block take effect before anything else uses the params
+	start := time.Now()
+	err := msgserver.ApplyScheduledParamsUpdates(sdkCtx, am.keeper)
+	if err != nil {
+		return errors.Wrapf(err, "Scheduled params updates error")
+	}
+	types.MeasureEndBlockerPhase(types.MetricPhaseScheduledParamsUpdates, start)
+
 	// Get unnormalized weights of active topics and the sum weight and revenue they have generated
+	start = time.Now()
 	weights, sumWeight, totalRevenue, err := rewards.GetAndUpdateActiveTopicWeights(sdkCtx, am.keeper, blockHeight)
 	if err != nil {
 		return errors.Wrapf(err, "Weights error")
 	}
+	types.MeasureEndBlockerPhase(types.MetricPhaseTopicWeights, start)
 	sdkCtx.Logger().Debug(fmt.Sprintf("EndBlocker %d: Total Revenue: %v, Sum Weight: %v", blockHeight, totalRevenue, sumWeight))
 
 	// REWARDS (will internally filter any non-RewardReady topics)
+	start = time.Now()
 	err = rewards.EmitRewards(sdkCtx, am.keeper, blockHeight, weights, sumWeight, totalRevenue)
 	if err != nil {
 		sdkCtx.Logger().Error("Error calculating global emission per topic: ", err)
 		return errors.Wrapf(err, "Rewards error")
 	}
+	types.MeasureEndBlockerPhase(types.MetricPhaseRewards, start)
 
 	// Reset the churn ready topics
 	err = am.keeper.ResetChurnableTopics(ctx)
@@ -40,63 +58,11 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 	}
 
 	// NONCE MGMT with Churnable weights
-	var wg sync.WaitGroup
-	// Loop over and run epochs on topics whose inferences are demanded enough to be served
+	// Collect the topics whose inferences are demanded enough to be served, then run their epochs
+	start = time.Now()
+	churnCandidates := make([]types.Topic, 0)
 	fn := func(sdkCtx sdk.Context, topic *types.Topic) error {
-		// Parallelize nonce management and update of topic to be in a churn ready state
-		wg.Add(1)
-		go func(topic types.Topic) {
-			defer wg.Done()
-			// Check the cadence of inferences, and just in case also check multiples of epoch lengths
-			// to avoid potential situations where the block is missed
-			if am.keeper.CheckCadence(blockHeight, topic) {
-				sdkCtx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker: Inference cadence met for topic: %v metadata: %s default arg: %s. \n",
-					topic.Id,
-					topic.Metadata,
-					topic.DefaultArg))
-
-				// Update the last inference ran
-				err = am.keeper.UpdateTopicEpochLastEnded(sdkCtx, topic.Id, blockHeight)
-				if err != nil {
-					sdkCtx.Logger().Warn(fmt.Sprintf("Error updating last inference ran: %s", err.Error()))
-				}
-				// Add Worker Nonces
-				nextNonce := types.Nonce{BlockHeight: blockHeight + topic.EpochLength}
-				err = am.keeper.AddWorkerNonce(sdkCtx, topic.Id, &nextNonce)
-				if err != nil {
-					sdkCtx.Logger().Warn(fmt.Sprintf("Error adding worker nonce: %s", err.Error()))
-					return
-				}
-				sdkCtx.Logger().Debug(fmt.Sprintf("Added worker nonce for topic %d: %v \n", topic.Id, nextNonce.BlockHeight))
-				// To notify topic handler that the topic is ready for churn i.e. requests to be sent to workers and reputers
-				err = am.keeper.AddChurnableTopic(ctx, topic.Id)
-				if err != nil {
-					sdkCtx.Logger().Warn(fmt.Sprintf("Error setting churn ready topic: %s", err.Error()))
-					return
-				}
-
-				MaxUnfulfilledReputerRequests := types.DefaultParams().MaxUnfulfilledReputerRequests
-				moduleParams, err := am.keeper.GetParams(ctx)
-				if err != nil {
-					sdkCtx.Logger().Warn(fmt.Sprintf("Error getting max retries to fulfil nonces for worker requests (using default), err: %s", err.Error()))
-				} else {
-					MaxUnfulfilledReputerRequests = moduleParams.MaxUnfulfilledReputerRequests
-				}
-				reputerPruningBlock := blockHeight - (int64(MaxUnfulfilledReputerRequests)*topic.EpochLength + topic.GroundTruthLag)
-				if reputerPruningBlock > 0 {
-					sdkCtx.Logger().Warn(fmt.Sprintf("Pruning reputer nonces before block: %v for topic: %d on block: %v", reputerPruningBlock, topic.Id, blockHeight))
-					am.keeper.PruneReputerNonces(sdkCtx, topic.Id, reputerPruningBlock)
-
-					workerPruningBlock := reputerPruningBlock - topic.EpochLength
-					if workerPruningBlock > 0 {
-						sdkCtx.Logger().Debug("Pruning worker nonces before block: ", workerPruningBlock, " for topic: ", topic.Id)
-						// Prune old worker nonces previous to current blockHeight to avoid inserting inferences after its time has passed
-						// Reputer nonces need to check worker nonces one epoch before the reputer nonces
-						am.keeper.PruneWorkerNonces(sdkCtx, topic.Id, workerPruningBlock)
-					}
-				}
-			}
-		}(*topic)
+		churnCandidates = append(churnCandidates, *topic)
 		return nil
 	}
 	err = rewards.IdentifyChurnableAmongActiveTopicsAndApplyFn(
@@ -110,7 +76,181 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		sdkCtx.Logger().Error("Error applying function on all rewardable topics: ", err)
 		return err
 	}
+	churnTopics(sdkCtx, am.keeper, blockHeight, churnCandidates)
+	types.MeasureEndBlockerPhase(types.MetricPhaseChurn, start)
+
+	// PENDING PAYLOADS AND REQUESTS
+	// Fulfill closed nonces of the churned topics with payloads submitted directly by workers and reputers,
+	// then emit the requests for the nonces that remain unfulfilled
+	start = time.Now()
+	churnableTopics, err := am.keeper.GetChurnableTopics(ctx)
+	if err != nil {
+		sdkCtx.Logger().Error("Error getting churnable topics: ", err)
+		return err
+	}
+	for _, topicId := range churnableTopics {
+		topic, err := am.keeper.GetTopic(ctx, topicId)
+		if err != nil {
+			sdkCtx.Logger().Warn(fmt.Sprintf("Error getting topic %d: %s", topicId, err.Error()))
+			continue
+		}
+		err = msgserver.FulfillClosedNoncesFromPendingPayloads(sdkCtx, am.keeper, topic)
+		if err != nil {
+			sdkCtx.Logger().Warn(fmt.Sprintf("Error fulfilling nonces from pending payloads for topic %d: %s", topicId, err.Error()))
+		}
+		err = EmitTopicRequests(sdkCtx, am.keeper, topic)
+		if err != nil {
+			sdkCtx.Logger().Warn(fmt.Sprintf("Error emitting requests for topic %d: %s", topicId, err.Error()))
+		}
+		setTopicStateMetrics(sdkCtx, am.keeper, topicId)
+	}
+	types.MeasureEndBlockerPhase(types.MetricPhasePendingPayloadsRequests, start)
+
+	// PRUNING
+	// Prune a bounded number of the records older than their retention, all or nothing so that an error
+	// leaves the state and the pruning cursor as they were
+	start = time.Now()
+	cacheCtx, write := sdkCtx.CacheContext()
+	err = am.keeper.PruneHistoricalRecords(cacheCtx, blockHeight)
+	if err != nil {
+		sdkCtx.Logger().Warn(fmt.Sprintf("Error pruning historical records: %s", err.Error()))
+	} else {
+		write()
+	}
+	cacheCtx, write = sdkCtx.CacheContext()
+	err = am.keeper.PruneExpiredRedelegations(cacheCtx, blockHeight)
+	if err != nil {
+		sdkCtx.Logger().Warn(fmt.Sprintf("Error pruning expired redelegations: %s", err.Error()))
+	} else {
+		write()
+	}
+	types.MeasureEndBlockerPhase(types.MetricPhasePruning, start)
+
+	activeTopicCount, err := am.keeper.GetActiveTopicCount(ctx)
+	if err != nil {
+		sdkCtx.Logger().Warn(fmt.Sprintf("Error counting active topics: %s", err.Error()))
+	} else {
+		types.SetTopicCountMetrics(activeTopicCount, len(churnableTopics))
+	}
+
+	return nil
+}
+
+// Exports the stake of a churned topic and how many of its nonces are left to fulfill
+func setTopicStateMetrics(ctx sdk.Context, k keeper.Keeper, topicId uint64) {
+	stake, err := k.GetTopicStake(ctx, topicId)
+	if err != nil {
+		ctx.Logger().Warn(fmt.Sprintf("Error getting stake of topic %d for metrics: %s", topicId, err.Error()))
+		return
+	}
+	workerNonces, err := k.GetUnfulfilledWorkerNonces(ctx, topicId)
+	if err != nil {
+		ctx.Logger().Warn(fmt.Sprintf("Error getting unfulfilled worker nonces of topic %d for metrics: %s", topicId, err.Error()))
+		return
+	}
+	reputerNonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
+	if err != nil {
+		ctx.Logger().Warn(fmt.Sprintf("Error getting unfulfilled reputer nonces of topic %d for metrics: %s", topicId, err.Error()))
+		return
+	}
+	types.SetTopicStateMetrics(topicId, stake, len(workerNonces.Nonces), len(reputerNonces.Nonces))
+}
+
+// Outcome of running the epoch of a topic on its own branch of the context
+type topicChurnResult struct {
+	topicId uint64
+	write   func()
+	err     error
+}
+
+// Runs the epochs of the given topics in two phases so that every validator ends up with the same state.
+// First, each topic is processed in parallel on its own cached branch of the context, which keeps its
+// writes buffered and away from the shared store. Then the writes of each branch are applied one after
+// the other in ascending topic id order. A topic whose processing fails leaves no writes behind.
+func churnTopics(ctx sdk.Context, k keeper.Keeper, blockHeight int64, topics []types.Topic) {
+	sort.Slice(topics, func(i, j int) bool {
+		return topics[i].Id < topics[j].Id
+	})
+
+	// Branches are created up front as the parent store must not be branched concurrently.
+	// Each branch gets its own gas meter, which is not safe for concurrent use.
+	results := make([]topicChurnResult, len(topics))
+	branches := make([]sdk.Context, len(topics))
+	for i, topic := range topics {
+		cacheCtx, write := ctx.CacheContext()
+		branches[i] = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		results[i] = topicChurnResult{topicId: topic.Id, write: write}
+	}
+
+	var wg sync.WaitGroup
+	for i, topic := range topics {
+		wg.Add(1)
+		go func(i int, topic types.Topic) {
+			defer wg.Done()
+			results[i].err = churnTopic(branches[i], k, blockHeight, topic)
+		}(i, topic)
+	}
 	wg.Wait()
 
+	for _, result := range results {
+		if result.err != nil {
+			ctx.Logger().Warn(fmt.Sprintf("Error churning topic %d: %s", result.topicId, result.err.Error()))
+			continue
+		}
+		result.write()
+	}
+}
+
+// Opens the next worker nonce of a topic whose cadence is met, marks it as churnable
+// and prunes the nonces that can no longer be fulfilled
+func churnTopic(ctx sdk.Context, k keeper.Keeper, blockHeight int64, topic types.Topic) error {
+	// Check the cadence of inferences, and just in case also check multiples of epoch lengths
+	// to avoid potential situations where the block is missed
+	if !k.CheckCadence(blockHeight, topic) {
+		return nil
+	}
+	ctx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker: Inference cadence met for topic: %v metadata: %s default arg: %s. \n",
+		topic.Id,
+		topic.Metadata,
+		topic.DefaultArg))
+
+	// Update the last inference ran
+	err := k.UpdateTopicEpochLastEnded(ctx, topic.Id, blockHeight)
+	if err != nil {
+		return errors.Wrapf(err, "error updating last inference ran")
+	}
+	// Add Worker Nonces
+	nextNonce := types.Nonce{BlockHeight: blockHeight + topic.EpochLength}
+	err = k.AddWorkerNonce(ctx, topic.Id, &nextNonce)
+	if err != nil {
+		return errors.Wrapf(err, "error adding worker nonce")
+	}
+	ctx.Logger().Debug(fmt.Sprintf("Added worker nonce for topic %d: %v \n", topic.Id, nextNonce.BlockHeight))
+	// To notify topic handler that the topic is ready for churn i.e. requests to be sent to workers and reputers
+	err = k.AddChurnableTopic(ctx, topic.Id)
+	if err != nil {
+		return errors.Wrapf(err, "error setting churn ready topic")
+	}
+
+	MaxUnfulfilledReputerRequests := types.DefaultParams().MaxUnfulfilledReputerRequests
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		ctx.Logger().Warn(fmt.Sprintf("Error getting max retries to fulfil nonces for worker requests (using default), err: %s", err.Error()))
+	} else {
+		MaxUnfulfilledReputerRequests = moduleParams.MaxUnfulfilledReputerRequests
+	}
+	reputerPruningBlock := blockHeight - (int64(MaxUnfulfilledReputerRequests)*topic.EpochLength + topic.GroundTruthLag)
+	if reputerPruningBlock > 0 {
+		ctx.Logger().Warn(fmt.Sprintf("Pruning reputer nonces before block: %v for topic: %d on block: %v", reputerPruningBlock, topic.Id, blockHeight))
+		k.PruneReputerNonces(ctx, topic.Id, reputerPruningBlock)
+
+		workerPruningBlock := reputerPruningBlock - topic.EpochLength
+		if workerPruningBlock > 0 {
+			ctx.Logger().Debug("Pruning worker nonces before block: ", workerPruningBlock, " for topic: ", topic.Id)
+			// Prune old worker nonces previous to current blockHeight to avoid inserting inferences after its time has passed
+			// Reputer nonces need to check worker nonces one epoch before the reputer nonces
+			k.PruneWorkerNonces(ctx, topic.Id, workerPruningBlock)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,114 @@
+package module
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func setUpChurnTopicsKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	key := storetypes.NewKVStoreKey("emissions")
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(AppModule{})
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		storeService,
+		nil,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	require.NoError(t, k.SetParams(testCtx.Ctx, types.DefaultParams()))
+	return testCtx.Ctx, k
+}
+
+// Run with -race: topics are processed concurrently on branches of the same context
+func TestChurnTopicsWithManyTopics(t *testing.T) {
+	ctx, k := setUpChurnTopicsKeeper(t)
+	blockHeight := int64(1000)
+	ctx = ctx.WithBlockHeight(blockHeight)
+
+	numTopics := 200
+	topics := make([]types.Topic, 0, numTopics)
+	for i := numTopics; i > 0; i-- {
+		topic := types.Topic{Id: uint64(i), EpochLength: int64(i%10 + 1)}
+		require.NoError(t, k.SetTopic(ctx, topic.Id, topic))
+		topics = append(topics, topic)
+	}
+
+	churnTopics(ctx, k, blockHeight, topics)
+
+	churnable, err := k.GetChurnableTopics(ctx)
+	require.NoError(t, err)
+	require.Len(t, churnable, numTopics)
+	for i := 1; i <= numTopics; i++ {
+		topicId := uint64(i)
+		topic, err := k.GetTopic(ctx, topicId)
+		require.NoError(t, err)
+		require.Equal(t, blockHeight, topic.EpochLastEnded)
+
+		nonces, err := k.GetUnfulfilledWorkerNonces(ctx, topicId)
+		require.NoError(t, err)
+		require.Len(t, nonces.Nonces, 1)
+		require.Equal(t, blockHeight+topic.EpochLength, nonces.Nonces[0].BlockHeight)
+	}
+}
+
+func TestChurnTopicsDiscardsWritesOfFailedTopic(t *testing.T) {
+	ctx, k := setUpChurnTopicsKeeper(t)
+	blockHeight := int64(1000)
+
+	storedTopic := types.Topic{Id: 1, EpochLength: 10}
+	require.NoError(t, k.SetTopic(ctx, storedTopic.Id, storedTopic))
+	// Updating the epoch of a topic that was never stored fails
+	missingTopic := types.Topic{Id: 2, EpochLength: 10}
+
+	churnTopics(ctx, k, blockHeight, []types.Topic{missingTopic, storedTopic})
+
+	churnable, err := k.GetChurnableTopics(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{storedTopic.Id}, churnable)
+	nonces, err := k.GetUnfulfilledWorkerNonces(ctx, missingTopic.Id)
+	require.NoError(t, err)
+	require.Empty(t, nonces.Nonces)
+}
+
+func TestEmitTopicRequestsEmitsWorkerRequestForUnfulfilledNonce(t *testing.T) {
+	ctx, k := setUpChurnTopicsKeeper(t)
+	blockHeight := int64(1000)
+	ctx = ctx.WithBlockHeight(blockHeight)
+
+	topic := types.Topic{Id: 1, EpochLength: 10, InferenceLogic: "bafybeig", InferenceMethod: "allora-inference-function.wasm"}
+	require.NoError(t, k.SetTopic(ctx, topic.Id, topic))
+	require.NoError(t, k.AddWorkerNonce(ctx, topic.Id, &types.Nonce{BlockHeight: blockHeight}))
+	// Only the events of the requests are expected, not those of setting up the nonce
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	require.NoError(t, EmitTopicRequests(ctx, k, topic))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, proto.MessageName(&types.EventWorkerRequest{}), events[0].Type)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	request, ok := msg.(*types.EventWorkerRequest)
+	require.True(t, ok)
+	require.Equal(t, topic.Id, request.TopicId)
+	require.Equal(t, blockHeight, request.Nonce.BlockHeight)
+	require.Equal(t, topic.InferenceLogic, request.InferenceLogic)
+	require.Equal(t, topic.InferenceMethod, request.InferenceMethod)
+}
@@ -29,6 +29,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetTopicParams",
+					Use:       "topic-params [topic_id]",
+					Short:     "Get the param overrides of a topic and the params in effect for it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "GetActiveTopics",
 					Use:       "active-topics [pagination]",
@@ -74,6 +82,75 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetDelegateStakePendingReward",
+					Use:       "delegate-pending-reward [delegator_address] [reputer_address] [topic_id]",
+					Short:     "Get the reward accrued by the stake of a delegator on a reputer in a topic and not paid out yet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+						{ProtoField: "reputer_address"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetDelegatorPendingRewards",
+					Use:       "delegator-pending-rewards [delegator_address]",
+					Short:     "Get the pending rewards of every stake placement of a delegator, their sum and whether they auto-compound",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerCommission",
+					Use:       "reputer-commission [reputer_address]",
+					Short:     "Get the commission a reputer takes from the rewards of the stake delegated to it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "reputer_address"},
+					},
+				},
+				{
+					RpcMethod: "GetRedelegationsFromReputer",
+					Use:       "redelegations-from-reputer [reputer_address] [topic_id]",
+					Short:     "Get the delegate stake moved away from a reputer in a topic that is still within the stake removal delay window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "reputer_address"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerStakePool",
+					Use:       "reputer-stake-pool [reputer_address]",
+					Short:     "Get the topic-agnostic stake pool of a reputer, its allocations across topics and their pending changes",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "reputer_address"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicRewardReport",
+					Use:       "topic-reward-report [topic_id] [block_height]",
+					Short:     "Get how the reward of a topic was distributed for a reward nonce, with the scores, entropies and fractions behind it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+					},
+				},
+				{
+					RpcMethod: "SimulateTopicRewards",
+					Use:       "simulate-topic-rewards [topic_id] [block_height]",
+					Short:     "Run the reward pipeline of a topic for a reward nonce as a dry run. A block height of 0 uses the pending reward nonce",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+					},
+				},
+				{
+					RpcMethod: "GetClaimableRewards",
+					Use:       "claimable-rewards [address]",
+					Short:     "Get the rewards credited to an address and not claimed yet, along with where they would be sent",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
 				{
 					RpcMethod: "GetWorkerLatestInferenceByTopicId",
 					Use:       "worker-latest-inference [topic_id] [worker_address]",
@@ -142,6 +219,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "GetWorkerLiveness",
+					Use:       "worker-liveness [topic_id] [address]",
+					Short:     "Get the missed nonces, uptime and jail status of a worker in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerLiveness",
+					Use:       "reputer-liveness [topic_id] [address]",
+					Short:     "Get the missed nonces, uptime and jail status of a reputer in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
 				{
 					RpcMethod: "GetTotalStake",
 					Use:       "total-stake",
@@ -166,6 +261,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "block_height_last_reward"},
 					},
 				},
+				{
+					RpcMethod: "GetWorkerCommitment",
+					Use:       "worker-commitment [topic_id] [block_height] [worker_address]",
+					Short:     "Get the commitment of a worker to its payload for a nonce of a commit-reveal topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+						{ProtoField: "worker_address"},
+					},
+				},
 				{
 					RpcMethod: "GetNetworkLossBundleAtBlock",
 					Use:       "network-loss-bundle-at-block [topic_id] [block]",
@@ -183,6 +288,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "GetScheduledParamsUpdates",
+					Use:       "scheduled-params-updates",
+					Short:     "Get the params updates that are scheduled to take effect at a future block height",
+				},
+				{
+					RpcMethod: "GetStateRetention",
+					Use:       "state-retention",
+					Short:     "Get the retention of the pruned collections with their number of entries and size, reading every record of them",
+				},
+				{
+					RpcMethod: "GetTopicAllowlist",
+					Use:       "topic-allowlist [topic_id] [is_reputer]",
+					Short:     "Get the workers, or reputers if is_reputer is true, allowed to register in a permissioned topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicCreatorAllowlist",
+					Use:       "topic-creator-allowlist",
+					Short:     "Get the accounts allowed to create topics while the topic creator allowlist is enabled",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -191,7 +320,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "UpdateParams",
 					Use:       "update-params [sender] [params]",
-					Short:     "Update params of the network",
+					Short:     "Update params of the network, or schedule the update with --effective-block-height, which whitelist admins must do at least the min admin params update delay ahead",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "sender"},
 						{ProtoField: "params"},
@@ -216,6 +345,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "allow_negative"},
 					},
 				},
+				{
+					RpcMethod: "UpdateTopicParams",
+					Use:       "update-topic-params [sender] [topic_id] [params]",
+					Short:     "Override params for a topic within the governance bounds, as its creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "params"},
+					},
+				},
 				{
 					RpcMethod: "Register",
 					Use:       "register [creator] [lib_p2p_key] [multi_address] [topic_ids] [initial_stake] [owner] [is_reputer]",
@@ -239,6 +378,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "is_reputer"},
 					},
 				},
+				{
+					RpcMethod: "Unjail",
+					Use:       "unjail [sender] [topic_id] [is_reputer]",
+					Short:     "Register a jailed reputer or worker back in a topic once its jail duration has elapsed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+					},
+				},
 				{
 					RpcMethod: "AddStake",
 					Use:       "add-stake [sender] [topic_id] [amount]",
@@ -299,6 +448,81 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "RedelegateStake",
+					Use:       "redelegate-stake [sender] [src_topic_id] [src_reputer] [dst_topic_id] [dst_reputer] [amount]",
+					Short:     "Move delegate stake of the sender to another reputer or topic without waiting for the stake removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "src_topic_id"},
+						{ProtoField: "src_reputer"},
+						{ProtoField: "dst_topic_id"},
+						{ProtoField: "dst_reputer"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "AddReputerStakePool",
+					Use:       "add-reputer-stake-pool [sender] [amount]",
+					Short:     "Add stake to the topic-agnostic stake pool of a reputer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "StartRemoveReputerStakePool",
+					Use:       "start-remove-reputer-stake-pool [sender] [amount]",
+					Short:     "Start removing stake from the stake pool of a reputer, to be confirmed after the stake removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "ConfirmRemoveReputerStakePool",
+					Use:       "confirm-remove-reputer-stake-pool [sender]",
+					Short:     "Confirm the removal of stake from the stake pool of a reputer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+					},
+				},
+				{
+					RpcMethod: "SetReputerStakeAllocation",
+					Use:       "set-reputer-stake-allocation [sender] [topic_id] [fraction]",
+					Short:     "Set the fraction of the reputer stake pool allocated to a topic. Lower fractions apply after the stake removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "fraction"},
+					},
+				},
+				{
+					RpcMethod: "ConfirmReputerStakeAllocationDecrease",
+					Use:       "confirm-reputer-stake-allocation-decrease [sender] [topic_id]",
+					Short:     "Confirm a lower allocation of the reputer stake pool to a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "ClaimRewards",
+					Use:       "claim-rewards [sender]",
+					Short:     "Withdraw the rewards credited to the sender to its withdraw address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+					},
+				},
+				{
+					RpcMethod: "SetRewardWithdrawAddress",
+					Use:       "set-reward-withdraw-address [sender] [withdraw_address]",
+					Short:     "Set the address claimed rewards of the sender are sent to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "withdraw_address"},
+					},
+				},
 				{
 					RpcMethod: "RewardDelegateStake",
 					Use:       "reward-delegate-stake [sender] [topic_id] [reputer]",
@@ -309,6 +533,26 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "SetDelegatorAutoCompound",
+					Use:       "set-delegator-auto-compound [sender] [auto_compound]",
+					Short:     "Add the rewards of Delegator [sender] to its delegated stake as they settle, instead of holding them until claimed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "auto_compound"},
+					},
+				},
+				{
+					RpcMethod: "SetReputerCommission",
+					Use:       "set-reputer-commission [sender] [rate] [max_rate] [max_change_rate]",
+					Short:     "Set the commission Reputer [sender] takes from the rewards of the stake delegated to it. The max rate and max change rate can't change once set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "rate"},
+						{ProtoField: "max_rate"},
+						{ProtoField: "max_change_rate"},
+					},
+				},
 				{
 					RpcMethod: "FundTopic",
 					Use:       "fund-topic [sender] [topic_id] [amount] [extra_data]",
@@ -337,6 +581,46 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "AddToTopicAllowlist",
+					Use:       "add-to-topic-allowlist [sender] [topic_id] [address] [is_reputer]",
+					Short:     "allow a worker, or reputer if is_reputer is true, to register in a permissioned topic, as its creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "RemoveFromTopicAllowlist",
+					Use:       "remove-from-topic-allowlist [sender] [topic_id] [address] [is_reputer]",
+					Short:     "revoke the permission of a worker, or reputer if is_reputer is true, to take part in a permissioned topic, as its creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "AddToTopicCreatorAllowlist",
+					Use:       "add-to-topic-creator-allowlist [sender] [address]",
+					Short:     "allow an address to create topics while the topic creator allowlist is enabled",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "RemoveFromTopicCreatorAllowlist",
+					Use:       "remove-from-topic-creator-allowlist [sender] [address]",
+					Short:     "disallow an address from creating topics while the topic creator allowlist is enabled",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "address"},
+					},
+				},
 				{
 					RpcMethod: "InsertBulkWorkerPayload",
 					Use:       "insert-bulk-worker-payload [worker_data_bundles]",
@@ -346,6 +630,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "worker_data_bundles"},
 					},
 				},
+				{
+					RpcMethod: "CommitWorkerPayload",
+					Use:       "commit-worker-payload [sender] [nonce] [topic_id] [commitment]",
+					Short:     "Commit to a worker payload on a commit-reveal topic, revealed later in the bulk worker payload",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "nonce"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "commitment"},
+					},
+				},
+				{
+					RpcMethod: "InsertWorkerPayload",
+					Use:       "insert-worker-payload [sender] [nonce] [topic_id] [worker_data_bundle]",
+					Short:     "Insert a worker's own payload, kept pending until the nonce is fulfilled",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "nonce"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "worker_data_bundle"},
+					},
+				},
+				{
+					RpcMethod: "InsertReputerPayload",
+					Use:       "insert-reputer-payload [sender] [reputer_request_nonce] [topic_id] [reputer_value_bundle]",
+					Short:     "Insert a reputer's own payload, kept pending until the nonce is fulfilled",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "reputer_request_nonce"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer_value_bundle"},
+					},
+				},
 				{
 					RpcMethod: "InsertBulkReputerPayload",
 					Use:       "insert-bulk-reputer-payload [reputer_value_bundles]",
@@ -11,6 +11,7 @@ import (
 	modulev1 "github.com/allora-network/allora-chain/x/emissions/api/module/v1"
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ appmodule.AppModule = AppModule{}
@@ -53,6 +54,12 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		feeCollectorName = authtypes.FeeCollectorName
 	}
 
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.AddressCodec,
@@ -60,6 +67,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AccountKeeper,
 		in.BankKeeper,
 		feeCollectorName,
+		authority.String(),
 	)
 	m := NewAppModule(in.Cdc, k)
 
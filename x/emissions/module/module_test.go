@@ -0,0 +1,80 @@
+package module_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+// An upgrade runs every migration from the version of the state, here the first one
+func TestRunMigrationsFromVersion1(t *testing.T) {
+	key := storetypes.NewKVStoreKey("emissions")
+	storeService := runtime.NewKVStoreService(key)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, module.AppModule{})
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		storeService,
+		authtypes.ProtoBaseAccount,
+		map[string][]string{types.AlloraClaimableRewardsAccountName: {"minter"}},
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		params.Bech32PrefixAccAddr,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	emissionsKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		storeService,
+		accountKeeper,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	// The params of version 1 lack the fields added since
+	v1Params := types.DefaultParams()
+	v1Params.MaxTopicsPerBlock = 42
+	v1Params.LivenessWindow = 0
+	v1Params.MaxMissedEpochs = 0
+	v1Params.JailDuration = 0
+	v1Params.MinTopicParams = types.TopicParams{}
+	v1Params.MaxTopicParams = types.TopicParams{}
+	v1Params.Pruning = types.PruningParams{}
+	v1Params.MinAdminParamsUpdateDelay = 0
+	require.Error(t, v1Params.Validate())
+	require.NoError(t, emissionsKeeper.SetParams(ctx, v1Params))
+
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	configurator := sdkmodule.NewConfigurator(encCfg.Codec, msgRouter, queryRouter)
+	manager := sdkmodule.NewManager(module.NewAppModule(encCfg.Codec, emissionsKeeper))
+	require.NoError(t, manager.RegisterServices(configurator))
+
+	versions, err := manager.RunMigrations(ctx.WithLogger(log.NewNopLogger()), configurator, sdkmodule.VersionMap{types.ModuleName: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(module.ConsensusVersion), versions[types.ModuleName])
+
+	migratedParams, err := emissionsKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	expectedParams := types.DefaultParams()
+	expectedParams.MaxTopicsPerBlock = 42
+	require.Equal(t, expectedParams, migratedParams)
+	require.NotNil(t, accountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(types.AlloraClaimableRewardsAccountName)))
+}
@@ -0,0 +1,145 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const secondsInAMonth uint64 = 2592000
+
+// Calculate approximate time for the previous block as epoch timestamp
+func PreviousBlockApproxTime(ctx sdk.Context, k keeper.Keeper, inferenceBlockHeight int64, groundTruthLag int64) (uint64, error) {
+	emissionsParams, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	approximateTimePerBlockSeconds := secondsInAMonth / emissionsParams.BlocksPerMonth
+	timeDifferenceInBlocks := ctx.BlockHeight() - inferenceBlockHeight
+	// Ensure no time in the future is calculated because of ground truth lag
+	if groundTruthLag > timeDifferenceInBlocks {
+		timeDifferenceInBlocks = 0
+	} else {
+		timeDifferenceInBlocks -= groundTruthLag
+	}
+
+	timeDifferenceInSeconds := uint64(timeDifferenceInBlocks) * approximateTimePerBlockSeconds
+	previousBlockApproxTime := uint64(ctx.BlockTime().Unix()) - timeDifferenceInSeconds
+	return previousBlockApproxTime, nil
+}
+
+// Requests for inferences on the unfulfilled worker nonces of the topic that are still within their epoch,
+// limited to the MaxRetriesToFulfilNoncesWorker most recent ones
+func GetWorkerRequests(ctx sdk.Context, k keeper.Keeper, topic types.Topic) ([]types.EventWorkerRequest, error) {
+	workerNonces, err := k.GetUnfulfilledWorkerNonces(ctx, topic.Id)
+	if err != nil {
+		return nil, err
+	}
+	// Filter workerNonces to only include those that are within the epoch length
+	// This is to avoid requesting inferences for epochs that have already ended
+	workerNonces = synth.FilterNoncesWithinEpochLength(workerNonces, ctx.BlockHeight(), topic.EpochLength)
+
+	maxRetriesToFulfilNoncesWorker := types.DefaultParams().MaxRetriesToFulfilNoncesWorker
+	emissionsParams, err := k.GetParams(ctx)
+	if err != nil {
+		ctx.Logger().Warn(fmt.Sprintf("Error getting max retries to fulfil nonces for worker requests (using default), err: %s", err.Error()))
+	} else {
+		maxRetriesToFulfilNoncesWorker = emissionsParams.MaxRetriesToFulfilNoncesWorker
+	}
+	sortedWorkerNonces := synth.SelectTopNWorkerNonces(workerNonces, int(maxRetriesToFulfilNoncesWorker))
+
+	requests := make([]types.EventWorkerRequest, 0, len(sortedWorkerNonces))
+	for _, nonce := range sortedWorkerNonces {
+		requests = append(requests, types.EventWorkerRequest{
+			TopicId:         topic.Id,
+			Nonce:           nonce,
+			InferenceLogic:  topic.InferenceLogic,
+			InferenceMethod: topic.InferenceMethod,
+			DefaultArg:      topic.DefaultArg,
+			AllowNegative:   topic.AllowNegative,
+		})
+	}
+	return requests, nil
+}
+
+// Requests for losses on the unfulfilled reputer nonces of the topic whose ground truth is available,
+// limited to the MaxRetriesToFulfilNoncesReputer oldest ones.
+// Nonces for which no network inferences can be computed are skipped.
+func GetReputerRequests(ctx sdk.Context, k keeper.Keeper, topic types.Topic) ([]types.EventReputerRequest, error) {
+	reputerNonces, err := k.GetUnfulfilledReputerNonces(ctx, topic.Id)
+	if err != nil {
+		return nil, err
+	}
+	// No filtering - reputation of previous rounds can still be retried if work has been done.
+	maxRetriesToFulfilNoncesReputer := types.DefaultParams().MaxRetriesToFulfilNoncesReputer
+	emissionsParams, err := k.GetParams(ctx)
+	if err != nil {
+		ctx.Logger().Warn(fmt.Sprintf("Error getting max num of retries to fulfil nonces for worker requests (using default), err: %s", err.Error()))
+	} else {
+		maxRetriesToFulfilNoncesReputer = emissionsParams.MaxRetriesToFulfilNoncesReputer
+	}
+	topNReputerNonces := synth.SelectTopNReputerNonces(&reputerNonces, int(maxRetriesToFulfilNoncesReputer), ctx.BlockHeight(), topic.GroundTruthLag)
+
+	requests := make([]types.EventReputerRequest, 0, len(topNReputerNonces))
+	for _, nonce := range topNReputerNonces {
+		reputerValueBundle, err := synth.GetNetworkInferencesAtBlock(
+			ctx,
+			k,
+			topic.Id,
+			nonce.ReputerNonce.BlockHeight,
+			nonce.WorkerNonce.BlockHeight,
+		)
+		if err != nil {
+			ctx.Logger().Debug(fmt.Sprintf("Error getting latest inferences at block: %d  error: %s", nonce.ReputerNonce.BlockHeight, err.Error()))
+			continue
+		}
+		if reputerValueBundle == nil || len(reputerValueBundle.InfererValues) == 0 {
+			continue
+		}
+
+		previousBlockApproxTime, err := PreviousBlockApproxTime(ctx, k, nonce.ReputerNonce.BlockHeight, topic.GroundTruthLag)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, types.EventReputerRequest{
+			TopicId:             topic.Id,
+			ReputerRequestNonce: nonce,
+			ValueBundle:         reputerValueBundle,
+			LossLogic:           topic.LossLogic,
+			LossMethod:          topic.LossMethod,
+			AllowNegative:       topic.AllowNegative,
+			ApproxTime:          previousBlockApproxTime,
+		})
+	}
+	return requests, nil
+}
+
+// Emit the worker and reputer requests of a churnable topic so that any off-chain orchestrator
+// subscribed to the chain's events can trigger them
+func EmitTopicRequests(ctx sdk.Context, k keeper.Keeper, topic types.Topic) error {
+	workerRequests, err := GetWorkerRequests(ctx, k, topic)
+	if err != nil {
+		return err
+	}
+	for i := range workerRequests {
+		err = ctx.EventManager().EmitTypedEvent(&workerRequests[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	reputerRequests, err := GetReputerRequests(ctx, k, topic)
+	if err != nil {
+		return err
+	}
+	for i := range reputerRequests {
+		err = ctx.EventManager().EmitTypedEvent(&reputerRequests[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
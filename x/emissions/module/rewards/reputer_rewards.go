@@ -139,7 +139,7 @@ func GetRewardForReputerTaskInTopic(
 	return ret, nil
 }
 
-// Send total reward for delegator to PENDING_ACCOUNT
+// Send total reward for delegator, less the commission of the reputer, to PENDING_ACCOUNT
 // and return remain reward for reputer
 func GetRewardForReputerFromTotalReward(
 	ctx sdk.Context,
@@ -179,6 +179,19 @@ func GetRewardForReputerFromTotalReward(
 		if err != nil {
 			return nil, err
 		}
+		// The reputer keeps its commission out of the reward of the stake delegated to it
+		commissionRate, err := keeper.GetReputerCommissionRate(ctx, reputer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get reputer commission rate")
+		}
+		commission, err := delegatorReward.Mul(commissionRate)
+		if err != nil {
+			return nil, err
+		}
+		delegatorReward, err = delegatorReward.Sub(commission)
+		if err != nil {
+			return nil, err
+		}
 		if delegatorReward.Gt(alloraMath.NewDecFromInt64(0)) {
 			// update reward share
 			// new_share = current_share + (reward / total_stake)
@@ -218,10 +231,12 @@ func GetRewardForReputerFromTotalReward(
 			return nil, err
 		}
 		reputerRewards = append(reputerRewards, types.TaskReward{
-			Address: reputerReward.Address,
-			Reward:  reputerRw,
-			TopicId: reputerReward.TopicId,
-			Type:    types.ReputerAndDelegatorRewardType,
+			Address:        reputerReward.Address,
+			Reward:         reputerRw,
+			TopicId:        reputerReward.TopicId,
+			Type:           types.ReputerAndDelegatorRewardType,
+			CommissionRate: commissionRate,
+			Commission:     commission,
 		})
 	}
 
@@ -40,35 +40,15 @@ func EmitRewards(
 	if err != nil {
 		return errors.Wrapf(err, "failed to get rewardable topics")
 	}
-	// Sorted, active topics by weight descending. Still need skim top N to truly be the churnable topics
-	sortedChurnableTopics := alloraMath.GetSortedElementsByDecWeightDesc(rewardableTopics, weights)
-
+	sortedChurnableTopics, topicRewards, err := GetChurnableTopicsAndRewards(ctx, k, moduleParams, rewardableTopics, weights, totalReward)
+	if err != nil {
+		return err
+	}
 	if len(sortedChurnableTopics) == 0 {
 		Logger(ctx).Warn("No churnable topics found")
 		return nil
 	}
 
-	// Top `N=MaxTopicsPerBlock` active topics of this block => the *actually* churnable topics
-	if uint64(len(sortedChurnableTopics)) > moduleParams.MaxTopicsPerBlock {
-		sortedChurnableTopics = sortedChurnableTopics[:moduleParams.MaxTopicsPerBlock]
-	}
-
-	// Get total weight of churnable topics
-	sumWeightOfChurnableTopics := alloraMath.ZeroDec()
-	for _, topicId := range sortedChurnableTopics {
-		sumWeightOfChurnableTopics, err = sumWeightOfChurnableTopics.Add(*weights[topicId])
-		if err != nil {
-			return errors.Wrapf(err, "failed to add weight of top topics")
-		}
-	}
-
-	// Revenue (above) is what was earned by topics in this timestep. Rewards are what are actually paid to topics => participants
-	// The reward and revenue calculations are coupled here to minimize excessive compute
-	topicRewards, err := CalcTopicRewards(ctx, k, weights, sortedChurnableTopics, sumWeightOfChurnableTopics, totalReward)
-	if err != nil {
-		return errors.Wrapf(err, "failed to calculate topic rewards")
-	}
-
 	// Calculate then pay out topic rewards to topic participants
 	totalRewardToStakedReputers := alloraMath.ZeroDec() // This is used to communicate with the mint module
 	for _, topicId := range sortedChurnableTopics {
@@ -84,8 +64,13 @@ func EmitRewards(
 			continue
 		}
 
+		topicParams, err := k.GetParamsForTopic(ctx, topicId)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get params for topic %d", topicId)
+		}
+
 		// Distribute rewards between topic participants
-		totalRewardsDistribution, rewardInTopicToReputers, err := GenerateRewardsDistributionByTopicParticipant(ctx, k, topicId, topicReward, topicRewardNonce, moduleParams)
+		totalRewardsDistribution, rewardInTopicToReputers, report, err := GenerateRewardsDistributionAndReportByTopicParticipant(ctx, k, topicId, topicReward, topicRewardNonce, topicParams)
 		if err != nil {
 			topicRewardString := "nil"
 			Logger(ctx).Warn(
@@ -98,6 +83,10 @@ func EmitRewards(
 			)
 			continue
 		}
+		err = k.SetTopicRewardReport(ctx, report)
+		if err != nil {
+			Logger(ctx).Warn(fmt.Sprintf("Failed to save reward report of topic %d: %s", topicId, err.Error()))
+		}
 		totalRewardToStakedReputers, err = totalRewardToStakedReputers.Add(rewardInTopicToReputers)
 		if err != nil {
 			return errors.Wrapf(
@@ -170,6 +159,50 @@ func EmitRewards(
 	return nil
 }
 
+// Returns the topics rewarded in this block, by weight descending, along with the reward of each.
+// The top `N=MaxTopicsPerBlock` rewardable topics by weight share the total reward in proportion to their weights.
+func GetChurnableTopicsAndRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	moduleParams types.Params,
+	rewardableTopics []uint64,
+	weights map[uint64]*alloraMath.Dec,
+	totalReward alloraMath.Dec,
+) (
+	[]uint64,
+	map[uint64]*alloraMath.Dec,
+	error,
+) {
+	// Sorted, active topics by weight descending. Still need skim top N to truly be the churnable topics
+	sortedChurnableTopics := alloraMath.GetSortedElementsByDecWeightDesc(rewardableTopics, weights)
+	if len(sortedChurnableTopics) == 0 {
+		return sortedChurnableTopics, map[uint64]*alloraMath.Dec{}, nil
+	}
+
+	// Top `N=MaxTopicsPerBlock` active topics of this block => the *actually* churnable topics
+	if uint64(len(sortedChurnableTopics)) > moduleParams.MaxTopicsPerBlock {
+		sortedChurnableTopics = sortedChurnableTopics[:moduleParams.MaxTopicsPerBlock]
+	}
+
+	// Get total weight of churnable topics
+	sumWeightOfChurnableTopics := alloraMath.ZeroDec()
+	var err error
+	for _, topicId := range sortedChurnableTopics {
+		sumWeightOfChurnableTopics, err = sumWeightOfChurnableTopics.Add(*weights[topicId])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to add weight of top topics")
+		}
+	}
+
+	// Revenue is what was earned by topics in this timestep. Rewards are what are actually paid to topics => participants
+	// The reward and revenue calculations are coupled here to minimize excessive compute
+	topicRewards, err := CalcTopicRewards(ctx, k, weights, sortedChurnableTopics, sumWeightOfChurnableTopics, totalReward)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to calculate topic rewards")
+	}
+	return sortedChurnableTopics, topicRewards, nil
+}
+
 func CalcTopicRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
@@ -207,42 +240,68 @@ func GenerateRewardsDistributionByTopicParticipant(
 	totalRewardsDistribution []types.TaskReward,
 	taskReputerReward alloraMath.Dec,
 	err error,
+) {
+	totalRewardsDistribution, taskReputerReward, _, err = GenerateRewardsDistributionAndReportByTopicParticipant(
+		ctx,
+		k,
+		topicId,
+		topicReward,
+		blockHeight,
+		moduleParams,
+	)
+	return totalRewardsDistribution, taskReputerReward, err
+}
+
+// Distributes the reward of a topic between its participants, and reports the scores, entropies, task rewards
+// and reward fractions the distribution was derived from
+func GenerateRewardsDistributionAndReportByTopicParticipant(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	topicReward *alloraMath.Dec,
+	blockHeight int64,
+	moduleParams types.Params,
+) (
+	totalRewardsDistribution []types.TaskReward,
+	taskReputerReward alloraMath.Dec,
+	report types.TopicRewardReport,
+	err error,
 ) {
 	if topicReward == nil {
-		return nil, alloraMath.Dec{}, types.ErrInvalidReward
+		return nil, alloraMath.Dec{}, types.TopicRewardReport{}, types.ErrInvalidReward
 	}
 	bundles, err := k.GetReputerLossBundlesAtBlock(ctx, topicId, blockHeight)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", blockHeight)
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", blockHeight)
 	}
 
 	lossBundles, err := k.GetNetworkLossBundleAtBlock(ctx, topicId, blockHeight)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", blockHeight)
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", blockHeight)
 	}
 
 	// Calculate and Set the reputer scores
 	reputerScores, err := GenerateReputerScores(ctx, k, topicId, blockHeight, *bundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return nil, alloraMath.Dec{}, types.TopicRewardReport{}, err
 	}
 
 	// Calculate and Set the worker scores for their inference work
 	infererScores, err := GenerateInferenceScores(ctx, k, topicId, blockHeight, *lossBundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return nil, alloraMath.Dec{}, types.TopicRewardReport{}, err
 	}
 
 	// Calculate and Set the worker scores for their forecast work
 	forecasterScores, err := GenerateForecastScores(ctx, k, topicId, blockHeight, *lossBundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return nil, alloraMath.Dec{}, types.TopicRewardReport{}, err
 	}
 
 	// Get reputer participants' addresses and reward fractions to be used in the reward round for topic
 	reputers, reputersRewardFractions, err := GetReputersRewardFractions(ctx, k, topicId, moduleParams.PRewardReputer, reputerScores)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer reward round data")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get reputer reward round data")
 	}
 
 	// Get reputer task entropy
@@ -256,7 +315,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		reputersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer task entropy")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get reputer task entropy")
 	}
 
 	// Get inferer reward fractions
@@ -270,7 +329,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		infererScores,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inferer reward fractions")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get inferer reward fractions")
 	}
 
 	// Get inference entropy
@@ -284,7 +343,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		inferersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inference task entropy")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get inference task entropy")
 	}
 
 	// Get forecaster reward fractions
@@ -298,7 +357,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		forecasterScores,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get forecaster reward fractions")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get forecaster reward fractions")
 	}
 
 	var forecastingEntropy alloraMath.Dec
@@ -314,7 +373,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 			forecastersRewardFractions,
 		)
 		if err != nil {
-			return []types.TaskReward{}, alloraMath.Dec{}, err
+			return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, err
 		}
 	} else {
 		// If there are no forecasters, set forecasting entropy to zero
@@ -329,7 +388,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		topicReward,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for reputer task in topic")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get reward for reputer task in topic")
 	}
 
 	// Get Total Rewards for Inference task
@@ -342,7 +401,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		topicReward,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for inference task in topic")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get reward for inference task in topic")
 	}
 
 	// Get Total Rewards for Forecasting task
@@ -355,7 +414,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		topicReward,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for forecasting task in topic")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get reward for forecasting task in topic")
 	}
 
 	totalRewardsDistribution = make([]types.TaskReward, 0)
@@ -370,7 +429,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		reputersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer rewards")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get reputer rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, reputerRewards...)
 
@@ -383,7 +442,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		inferersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inference rewards")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get inference rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, inferenceRewards...)
 
@@ -396,16 +455,65 @@ func GenerateRewardsDistributionByTopicParticipant(
 		forecastersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get forecast rewards")
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get forecast rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, forecastRewards...)
 
-	return totalRewardsDistribution, taskReputerReward, nil
+	chi, gamma, err := getChiAndGamma(
+		lossBundles.NaiveValue,
+		lossBundles.CombinedValue,
+		inferenceEntropy,
+		forecastingEntropy,
+	)
+	if err != nil {
+		return []types.TaskReward{}, alloraMath.Dec{}, types.TopicRewardReport{}, errors.Wrapf(err, "failed to get chi and gamma")
+	}
+	actors := make([]types.ActorRewardReport, 0, len(totalRewardsDistribution))
+	actors = appendActorRewardReports(actors, types.ActorType_REPUTER, reputerScores, reputersRewardFractions, reputerRewards)
+	actors = appendActorRewardReports(actors, types.ActorType_INFERER, infererScores, inferersRewardFractions, inferenceRewards)
+	actors = appendActorRewardReports(actors, types.ActorType_FORECASTER, forecasterScores, forecastersRewardFractions, forecastRewards)
+	report = types.TopicRewardReport{
+		TopicId:               topicId,
+		BlockHeight:           blockHeight,
+		TopicReward:           *topicReward,
+		InferenceEntropy:      inferenceEntropy,
+		ForecastingEntropy:    forecastingEntropy,
+		ReputerEntropy:        reputerEntropy,
+		InferenceTaskReward:   taskInferenceReward,
+		ForecastingTaskReward: taskForecastingReward,
+		ReputerTaskReward:     taskReputerReward,
+		Chi:                   chi,
+		Gamma:                 gamma,
+		Actors:                actors,
+	}
+
+	return totalRewardsDistribution, taskReputerReward, report, nil
+}
+
+// The scores, reward fractions and rewards of the participants of a task are all in the same order
+func appendActorRewardReports(
+	reports []types.ActorRewardReport,
+	actorType types.ActorType,
+	scores []types.Score,
+	rewardFractions []alloraMath.Dec,
+	rewards []types.TaskReward,
+) []types.ActorRewardReport {
+	for i, reward := range rewards {
+		reports = append(reports, types.ActorRewardReport{
+			ActorType:      actorType,
+			Address:        reward.Address,
+			Score:          scores[i].Score,
+			RewardFraction: rewardFractions[i],
+			Reward:         reward.Reward,
+		})
+	}
+	return reports
 }
 
 // pay out the rewards to the participants
 // this function moves tokens from the rewards module to the participants
 // if it fails to pay a particular participant, it will continue to the next participant
+// while worker rewards are claimable, they are credited to the workers to be claimed instead of being sent to them
 func payoutRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
@@ -416,6 +524,13 @@ func payoutRewards(
 	infererRewards := make([]types.TaskReward, 0)
 	forecasterRewards := make([]types.TaskReward, 0)
 	blockHeight := ctx.BlockHeight()
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return append(ret, errors.Wrapf(err, "failed to get module params"))
+	}
+	// Worker rewards to credit to the claimable rewards ledger, moved out of the rewards account in a single transfer
+	claimableRewards := make([]types.TaskReward, 0)
+	claimableTotal := sdk.NewCoins()
 	for _, reward := range rewards {
 		if reward.Reward.IsZero() {
 			continue
@@ -441,6 +556,12 @@ func payoutRewards(
 			}
 
 			reputerAndDelegatorRewards = append(reputerAndDelegatorRewards, reward)
+
+			// Delegators of the reputer who opted in have their share of the reward added to their stake
+			ret = append(ret, compoundDelegateRewards(ctx, k, reward.TopicId, reward.Address)...)
+		} else if moduleParams.WorkerRewardsClaimable {
+			claimableRewards = append(claimableRewards, reward)
+			claimableTotal = claimableTotal.Add(coins...)
 		} else {
 			accAddress, err := sdk.AccAddressFromBech32(reward.Address)
 			if err != nil {
@@ -471,9 +592,79 @@ func payoutRewards(
 		}
 	}
 
+	if !claimableTotal.IsZero() {
+		err = k.SendCoinsFromModuleToModule(ctx, types.AlloraRewardsAccountName, types.AlloraClaimableRewardsAccountName, claimableTotal)
+		if err != nil {
+			ret = append(ret, errors.Wrapf(
+				err,
+				"failed to send coins from rewards module to claimable rewards module: %s",
+				claimableTotal.String(),
+			))
+			claimableRewards = nil
+		}
+	}
+	for _, reward := range claimableRewards {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, reward.Reward.Abs().SdkIntTrim()))
+		err = k.CreditClaimableRewards(ctx, reward.Address, coins)
+		if err != nil {
+			ret = append(ret, errors.Wrapf(err, "failed to credit claimable rewards to %s", reward.Address))
+			continue
+		}
+		if reward.Type == types.WorkerInferenceRewardType {
+			infererRewards = append(infererRewards, reward)
+		} else if reward.Type == types.WorkerForecastRewardType {
+			forecasterRewards = append(forecasterRewards, reward)
+		}
+	}
+
 	types.EmitNewInfererRewardsSettledEvent(ctx, blockHeight, infererRewards)
 	types.EmitNewForecasterRewardsSettledEvent(ctx, blockHeight, forecasterRewards)
 	types.EmitNewReputerAndDelegatorRewardsSettledEvent(ctx, blockHeight, reputerAndDelegatorRewards)
+	incrRewardsMetric(types.WorkerInferenceRewardType, infererRewards)
+	incrRewardsMetric(types.WorkerForecastRewardType, forecasterRewards)
+	incrRewardsMetric(types.ReputerAndDelegatorRewardType, reputerAndDelegatorRewards)
+	return ret
+}
+
+// Adds the rewards paid out for a task type to the total exported for it
+func incrRewardsMetric(taskType types.TaskRewardType, rewards []types.TaskReward) {
+	if len(rewards) == 0 {
+		return
+	}
+	total := alloraMath.ZeroDec()
+	for _, reward := range rewards {
+		sum, err := total.Add(reward.Reward.Abs())
+		if err != nil {
+			return
+		}
+		total = sum
+	}
+	types.IncrRewardsMetric(taskType, total)
+}
+
+// add the pending rewards of the auto-compounding delegators of a reputer to their stake on that reputer,
+// instead of leaving them in the pending rewards account until they are claimed
+func compoundDelegateRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	reputer string,
+) []error {
+	ret := make([]error, 0)
+	delegators, err := k.GetAutoCompoundDelegators(ctx, topicId, reputer)
+	if err != nil {
+		return append(ret, errors.Wrapf(err, "failed to get auto-compounding delegators"))
+	}
+	for _, delegator := range delegators {
+		// A compound that fails part way leaves the reward pending
+		cacheCtx, write := ctx.CacheContext()
+		err = k.CompoundDelegateStakeReward(cacheCtx, topicId, delegator, reputer)
+		if err != nil {
+			ret = append(ret, errors.Wrapf(err, "failed to compound reward of delegator %s on reputer %s", delegator, reputer))
+			continue
+		}
+		write()
+	}
 	return ret
 }
 
@@ -0,0 +1,66 @@
+package rewards
+
+import (
+	"slices"
+
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Runs the reward pipeline of a topic for a reward nonce as the end of the current block would, treating the topic
+// as rewardable in this block, and returns the report of the distribution.
+// Everything the pipeline writes goes to a branch of the context that is thrown away.
+func SimulateTopicRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	rewardNonce int64,
+) (types.TopicRewardReport, error) {
+	cacheCtx, _ := ctx.CacheContext()
+
+	weights, _, _, err := GetAndUpdateActiveTopicWeights(cacheCtx, k, cacheCtx.BlockHeight())
+	if err != nil {
+		return types.TopicRewardReport{}, errors.Wrapf(err, "failed to get active topic weights")
+	}
+	totalReward, err := k.GetTotalRewardToDistribute(cacheCtx)
+	if err != nil {
+		return types.TopicRewardReport{}, errors.Wrapf(err, "failed to get total reward to distribute")
+	}
+	moduleParams, err := k.GetParams(cacheCtx)
+	if err != nil {
+		return types.TopicRewardReport{}, errors.Wrapf(err, "failed to get module params")
+	}
+	rewardableTopics, err := k.GetRewardableTopics(cacheCtx)
+	if err != nil {
+		return types.TopicRewardReport{}, errors.Wrapf(err, "failed to get rewardable topics")
+	}
+	if !slices.Contains(rewardableTopics, topicId) {
+		rewardableTopics = append(rewardableTopics, topicId)
+	}
+	_, topicRewards, err := GetChurnableTopicsAndRewards(cacheCtx, k, moduleParams, rewardableTopics, weights, totalReward)
+	if err != nil {
+		return types.TopicRewardReport{}, err
+	}
+	// An inactive topic, or one outside the top topics by weight, is not rewarded in this block
+	topicReward := alloraMath.ZeroDec()
+	if reward, ok := topicRewards[topicId]; ok && reward != nil {
+		topicReward = *reward
+	}
+
+	topicParams, err := k.GetParamsForTopic(cacheCtx, topicId)
+	if err != nil {
+		return types.TopicRewardReport{}, errors.Wrapf(err, "failed to get params for topic %d", topicId)
+	}
+	_, _, report, err := GenerateRewardsDistributionAndReportByTopicParticipant(
+		cacheCtx,
+		k,
+		topicId,
+		&topicReward,
+		rewardNonce,
+		topicParams,
+	)
+	return report, err
+}
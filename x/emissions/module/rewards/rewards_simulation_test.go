@@ -0,0 +1,85 @@
+package rewards_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *RewardsTestSuite) TestSimulateTopicRewardsMatchesPersistedReport() {
+	require := s.Require()
+	block := int64(600)
+
+	reputerAddrs := []sdk.AccAddress{s.addrs[0], s.addrs[1], s.addrs[2]}
+	workerAddrs := []sdk.AccAddress{s.addrs[5], s.addrs[6], s.addrs[7], s.addrs[8], s.addrs[9]}
+	stake := cosmosMath.NewInt(1000000).Mul(inference_synthesis.CosmosIntOneE18())
+	topicId := s.setUpTopic(block, workerAddrs, reputerAddrs, stake, alloraMath.OneDec())
+
+	err := s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: block})
+	require.NoError(err)
+	err = s.emissionsKeeper.AddReputerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: block}, &types.Nonce{BlockHeight: block})
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkWorkerPayload(s.ctx, &types.MsgInsertBulkWorkerPayload{
+		Sender:            workerAddrs[0].String(),
+		Nonce:             &types.Nonce{BlockHeight: block},
+		TopicId:           topicId,
+		WorkerDataBundles: GenerateWorkerDataBundles(s, block, topicId),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkReputerPayload(s.ctx, &types.MsgInsertBulkReputerPayload{
+		Sender:  reputerAddrs[0].String(),
+		TopicId: topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &types.Nonce{BlockHeight: block},
+			WorkerNonce:  &types.Nonce{BlockHeight: block},
+		},
+		ReputerValueBundles: GenerateLossBundles(s, block, topicId, reputerAddrs).ReputerValueBundles,
+	})
+	require.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(block + 1)
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(1000))
+	simulated, err := rewards.SimulateTopicRewards(s.ctx, s.emissionsKeeper, topicId, block)
+	require.NoError(err)
+	require.True(simulated.TopicReward.Gt(alloraMath.ZeroDec()))
+	reputersReported := 0
+	for _, actor := range simulated.Actors {
+		if actor.ActorType == types.ActorType_REPUTER {
+			reputersReported++
+		}
+	}
+	require.Equal(len(reputerAddrs), reputersReported)
+
+	// The dry run leaves no trace behind
+	_, err = s.emissionsKeeper.GetTopicRewardReport(s.ctx, topicId, block)
+	require.Error(err)
+	_, noPriorFraction, err := s.emissionsKeeper.GetPreviousReputerRewardFraction(s.ctx, topicId, reputerAddrs[0].String())
+	require.NoError(err)
+	require.True(noPriorFraction)
+	rewardNonce, err := s.emissionsKeeper.GetTopicRewardNonce(s.ctx, topicId)
+	require.NoError(err)
+	require.Equal(block, rewardNonce)
+
+	err = s.emissionsAppModule.EndBlock(s.ctx)
+	require.NoError(err)
+
+	report, err := s.emissionsKeeper.GetTopicRewardReport(s.ctx, topicId, block)
+	require.NoError(err)
+	require.Equal(simulated.String(), report.String())
+
+	// Each reported payout is what the actor was paid, split by the task reward fractions
+	for _, actor := range report.Actors {
+		taskReward := report.InferenceTaskReward
+		if actor.ActorType == types.ActorType_FORECASTER {
+			taskReward = report.ForecastingTaskReward
+		} else if actor.ActorType == types.ActorType_REPUTER {
+			continue
+		}
+		expected, err := actor.RewardFraction.Mul(taskReward)
+		require.NoError(err)
+		require.True(expected.Equal(actor.Reward), "expected %s, got %s", expected, actor.Reward)
+	}
+}
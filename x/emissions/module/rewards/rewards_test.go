@@ -70,11 +70,12 @@ func (s *RewardsTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
-		"ecosystem":              {"minter"},
-		"bonded_tokens_pool":     {"burner", "staking"},
-		"not_bonded_tokens_pool": {"burner", "staking"},
-		multiPerm:                {"burner", "minter", "staking"},
-		randomPerm:               {"random"},
+		types.AlloraClaimableRewardsAccountName:          {"minter"},
+		"ecosystem":                                      {"minter"},
+		"bonded_tokens_pool":                             {"burner", "staking"},
+		"not_bonded_tokens_pool":                         {"burner", "staking"},
+		multiPerm:                                        {"burner", "minter", "staking"},
+		randomPerm:                                       {"random"},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -100,7 +101,8 @@ func (s *RewardsTestSuite) SetupTest() {
 		storeService,
 		accountKeeper,
 		bankKeeper,
-		authtypes.FeeCollectorName)
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	stakingKeeper := stakingkeeper.NewKeeper(
 		encCfg.Codec,
 		storeService,
@@ -2100,11 +2102,6 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionWithOneInfererAndOneReputer
 }
 
 func (s *RewardsTestSuite) SetParamsForTest(numInferers uint64) {
-	// Setup a sender address
-	adminPrivateKey := secp256k1.GenPrivKey()
-	adminAddr := sdk.AccAddress(adminPrivateKey.PubKey().Address())
-	s.emissionsKeeper.AddWhitelistAdmin(s.ctx, adminAddr.String())
-
 	newParams := &types.OptionalParams{
 		MaxTopInferersToReward: []uint64{24},
 		MinEpochLength:         []int64{1},
@@ -2112,7 +2109,7 @@ func (s *RewardsTestSuite) SetParamsForTest(numInferers uint64) {
 	}
 
 	updateMsg := &types.MsgUpdateParams{
-		Sender: adminAddr.String(),
+		Sender: s.emissionsKeeper.GetAuthority(),
 		Params: newParams,
 	}
 
@@ -3258,3 +3255,71 @@ func (s *RewardsTestSuite) TestRewardForRemainingParticipantsGoUpWhenParticipant
 	require.True(reputer2_Reward0.GT(cosmosMath.ZeroInt()))
 	require.True(reputer2_Reward1.Equal(cosmosMath.ZeroInt()))
 }
+
+func (s *RewardsTestSuite) TestClaimableWorkerRewardsAreCreditedInsteadOfSent() {
+	require := s.Require()
+	block := int64(600)
+
+	reputerAddrs := []sdk.AccAddress{s.addrs[0], s.addrs[1], s.addrs[2]}
+	workerAddrs := []sdk.AccAddress{s.addrs[5], s.addrs[6], s.addrs[7], s.addrs[8], s.addrs[9]}
+	stake := cosmosMath.NewInt(1000000).Mul(inference_synthesis.CosmosIntOneE18())
+	topicId := s.setUpTopic(block, workerAddrs, reputerAddrs, stake, alloraMath.OneDec())
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.WorkerRewardsClaimable = true
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
+	err = s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: block})
+	require.NoError(err)
+	err = s.emissionsKeeper.AddReputerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: block}, &types.Nonce{BlockHeight: block})
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkWorkerPayload(s.ctx, &types.MsgInsertBulkWorkerPayload{
+		Sender:            workerAddrs[0].String(),
+		Nonce:             &types.Nonce{BlockHeight: block},
+		TopicId:           topicId,
+		WorkerDataBundles: GenerateWorkerDataBundles(s, block, topicId),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkReputerPayload(s.ctx, &types.MsgInsertBulkReputerPayload{
+		Sender:  reputerAddrs[0].String(),
+		TopicId: topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &types.Nonce{BlockHeight: block},
+			WorkerNonce:  &types.Nonce{BlockHeight: block},
+		},
+		ReputerValueBundles: GenerateLossBundles(s, block, topicId, reputerAddrs).ReputerValueBundles,
+	})
+	require.NoError(err)
+
+	workerBalances := make([]cosmosMath.Int, len(workerAddrs))
+	for i, addr := range workerAddrs {
+		workerBalances[i] = s.bankKeeper.GetBalance(s.ctx, addr, params.DefaultBondDenom).Amount
+	}
+
+	s.ctx = s.ctx.WithBlockHeight(block + 1)
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(1000))
+	err = s.emissionsAppModule.EndBlock(s.ctx)
+	require.NoError(err)
+
+	claimableAccount := s.accountKeeper.GetModuleAddress(types.AlloraClaimableRewardsAccountName)
+	totalCredited := cosmosMath.ZeroInt()
+	for i, addr := range workerAddrs {
+		require.Equal(workerBalances[i], s.bankKeeper.GetBalance(s.ctx, addr, params.DefaultBondDenom).Amount)
+		claimable, err := s.emissionsKeeper.GetClaimableRewards(s.ctx, addr.String())
+		require.NoError(err)
+		totalCredited = totalCredited.Add(claimable.AmountOf(params.DefaultBondDenom))
+	}
+	require.True(totalCredited.IsPositive())
+	require.Equal(totalCredited, s.bankKeeper.GetBalance(s.ctx, claimableAccount, params.DefaultBondDenom).Amount)
+
+	claimable, err := s.emissionsKeeper.GetClaimableRewards(s.ctx, workerAddrs[0].String())
+	require.NoError(err)
+	res, err := s.msgServer.ClaimRewards(s.ctx, &types.MsgClaimRewards{Sender: workerAddrs[0].String()})
+	require.NoError(err)
+	require.Equal(claimable, res.Claimed)
+	require.Equal(
+		workerBalances[0].Add(claimable.AmountOf(params.DefaultBondDenom)),
+		s.bankKeeper.GetBalance(s.ctx, workerAddrs[0], params.DefaultBondDenom).Amount,
+	)
+}
@@ -175,6 +175,8 @@ func GetAndUpdateActiveTopicWeights(
 			return errors.Wrapf(err, "failed to get current topic weight")
 		}
 
+		types.SetTopicWeightMetrics(topic.Id, weight, topicFeeRevenue)
+
 		err = k.SetPreviousTopicWeight(ctx, topic.Id, weight)
 		if err != nil {
 			return errors.Wrapf(err, "failed to set previous topic weight")
@@ -0,0 +1,77 @@
+package simulator
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+var csvHeader = []string{
+	"epoch",
+	"block_height",
+	"active",
+	"topic_weight",
+	"topic_reward",
+	"combined_loss",
+	"naive_loss",
+	"name",
+	"address",
+	"type",
+	"score",
+	"reward_fraction",
+	"reward",
+	"regret",
+	"stake",
+	"error",
+}
+
+func WriteJSON(w io.Writer, results []EpochResult) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
+
+// Writes a row for each actor in each of its roles in each epoch, along with the outcome of the epoch for the topic
+func WriteCSV(w io.Writer, results []EpochResult) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, result := range results {
+		epoch := []string{
+			strconv.Itoa(result.Epoch),
+			strconv.FormatInt(result.BlockHeight, 10),
+			strconv.FormatBool(result.Active),
+			result.TopicWeight,
+			result.TopicReward,
+			result.CombinedLoss,
+			result.NaiveLoss,
+		}
+		// An epoch whose rewards were not distributed still gets a row, to report why
+		if len(result.Actors) == 0 {
+			if err := writer.Write(append(epoch, "", "", "", "", "", "", "", "", result.Error)); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, actor := range result.Actors {
+			err := writer.Write(append(epoch[:len(epoch):len(epoch)],
+				actor.Name,
+				actor.Address,
+				actor.Type,
+				actor.Score,
+				actor.RewardFraction,
+				actor.Reward,
+				actor.Regret,
+				actor.Stake,
+				result.Error,
+			))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,207 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// A scenario of a single topic played out epoch by epoch. Actors are referred to by name, and all
+// values are decimal strings. Example:
+//
+//	{
+//	  "params": {"p_reward_inference": "2", "beta_entropy": "0.3"},
+//	  "topic": {"epoch_length": 10, "p_norm": "3", "alpha_regret": "0.1"},
+//	  "topic_reward": "1000000",
+//	  "fee_revenue": "100000",
+//	  "workers": ["alice", "bob"],
+//	  "reputers": [{"name": "carol", "stake": "1000000"}],
+//	  "epochs": [
+//	    {
+//	      "ground_truth": "10",
+//	      "inferences": {"alice": "9.5", "bob": "12"},
+//	      "forecasts": {"alice": {"alice": "0.2", "bob": "4"}},
+//	      "losses": {"carol": {"inferers": {"bob": "4.2"}}}
+//	    }
+//	  ]
+//	}
+type Scenario struct {
+	// Overrides of the module params, in their JSON form. Params left out keep their default value.
+	Params json.RawMessage `json:"params,omitempty"`
+	Topic  ScenarioTopic   `json:"topic"`
+	// Reward the topic distributes between its participants every epoch, in uallo
+	TopicReward string `json:"topic_reward"`
+	// Fee revenue the topic collects every epoch unless the epoch sets its own, in uallo
+	FeeRevenue string            `json:"fee_revenue,omitempty"`
+	Workers    []string          `json:"workers"`
+	Reputers   []ScenarioReputer `json:"reputers"`
+	Epochs     []ScenarioEpoch   `json:"epochs"`
+}
+
+type ScenarioTopic struct {
+	EpochLength    int64  `json:"epoch_length"`
+	GroundTruthLag int64  `json:"ground_truth_lag,omitempty"`
+	PNorm          string `json:"p_norm,omitempty"`
+	AlphaRegret    string `json:"alpha_regret,omitempty"`
+	AllowNegative  bool   `json:"allow_negative,omitempty"`
+}
+
+type ScenarioReputer struct {
+	Name string `json:"name"`
+	// Stake of the reputer in the topic at the start of the scenario, in uallo.
+	// Reputer rewards are added to it as on chain.
+	Stake string `json:"stake"`
+}
+
+type ScenarioEpoch struct {
+	// Value the network inferences of the epoch are evaluated against
+	GroundTruth string `json:"ground_truth"`
+	// Fee revenue the topic collects in this epoch, overriding the fee revenue of the scenario
+	FeeRevenue string `json:"fee_revenue,omitempty"`
+	// Inference of each worker; workers left out do not take part in the epoch
+	Inferences map[string]string `json:"inferences"`
+	// Loss each forecaster forecasts for the inference of each inferer of the epoch, itself included,
+	// as the forecast-implied inferences are only synthesized from forecasts covering every inferer
+	Forecasts map[string]map[string]string `json:"forecasts,omitempty"`
+	// Losses reported by each reputer. Reputers left out report the squared error of every network value
+	// against the ground truth of the epoch; losses left out of a report are computed the same way.
+	Losses map[string]ScenarioLosses `json:"losses,omitempty"`
+	// Reputers that sit out this epoch and report nothing
+	AbsentReputers []string `json:"absent_reputers,omitempty"`
+}
+
+// Losses a reputer reports for the network values of an epoch, each keyed by worker name
+type ScenarioLosses struct {
+	// Ground truth the losses left out are computed against, instead of the ground truth of the epoch
+	GroundTruth       string            `json:"ground_truth,omitempty"`
+	Combined          string            `json:"combined,omitempty"`
+	Naive             string            `json:"naive,omitempty"`
+	Inferers          map[string]string `json:"inferers,omitempty"`
+	Forecasters       map[string]string `json:"forecasters,omitempty"`
+	OneOutInferers    map[string]string `json:"one_out_inferers,omitempty"`
+	OneOutForecasters map[string]string `json:"one_out_forecasters,omitempty"`
+	OneInForecasters  map[string]string `json:"one_in_forecasters,omitempty"`
+}
+
+func LoadScenario(path string) (Scenario, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Scenario{}, err
+	}
+	var scenario Scenario
+	if err := json.Unmarshal(bz, &scenario); err != nil {
+		return Scenario{}, fmt.Errorf("failed to parse scenario %s: %w", path, err)
+	}
+	if err := scenario.Validate(); err != nil {
+		return Scenario{}, fmt.Errorf("invalid scenario %s: %w", path, err)
+	}
+	return scenario, nil
+}
+
+func (s Scenario) Validate() error {
+	if s.Topic.EpochLength <= 0 {
+		return fmt.Errorf("topic epoch length must be positive")
+	}
+	if _, err := parseInt(s.TopicReward, "topic reward"); err != nil {
+		return err
+	}
+	if _, err := parseInt(s.FeeRevenue, "fee revenue"); err != nil {
+		return err
+	}
+	if len(s.Workers) == 0 || len(s.Reputers) == 0 {
+		return fmt.Errorf("at least one worker and one reputer are required")
+	}
+	workers := make(map[string]bool, len(s.Workers))
+	for _, worker := range s.Workers {
+		if worker == "" || workers[worker] {
+			return fmt.Errorf("worker names must be unique and not empty, got %q", worker)
+		}
+		workers[worker] = true
+	}
+	reputers := make(map[string]bool, len(s.Reputers))
+	for _, reputer := range s.Reputers {
+		if reputer.Name == "" || reputers[reputer.Name] || workers[reputer.Name] {
+			return fmt.Errorf("actor names must be unique and not empty, got %q", reputer.Name)
+		}
+		reputers[reputer.Name] = true
+		if _, err := parseInt(reputer.Stake, "stake of reputer "+reputer.Name); err != nil {
+			return err
+		}
+	}
+
+	for i, epoch := range s.Epochs {
+		if _, err := parseDec(epoch.GroundTruth, fmt.Sprintf("ground truth of epoch %d", i)); err != nil {
+			return err
+		}
+		if _, err := parseInt(epoch.FeeRevenue, fmt.Sprintf("fee revenue of epoch %d", i)); err != nil {
+			return err
+		}
+		if len(epoch.Inferences) == 0 {
+			return fmt.Errorf("epoch %d has no inferences", i)
+		}
+		for worker, inference := range epoch.Inferences {
+			if !workers[worker] {
+				return fmt.Errorf("epoch %d: unknown worker %q", i, worker)
+			}
+			if _, err := parseDec(inference, fmt.Sprintf("epoch %d: inference of %s", i, worker)); err != nil {
+				return err
+			}
+		}
+		for forecaster, forecast := range epoch.Forecasts {
+			if !workers[forecaster] {
+				return fmt.Errorf("epoch %d: unknown forecaster %q", i, forecaster)
+			}
+			for inferer, loss := range forecast {
+				if !workers[inferer] {
+					return fmt.Errorf("epoch %d: forecast of %s for unknown worker %q", i, forecaster, inferer)
+				}
+				if _, err := parseDec(loss, fmt.Sprintf("epoch %d: forecast of %s for %s", i, forecaster, inferer)); err != nil {
+					return err
+				}
+			}
+			for inferer := range epoch.Inferences {
+				if _, ok := forecast[inferer]; !ok {
+					return fmt.Errorf("epoch %d: forecast of %s misses inferer %q", i, forecaster, inferer)
+				}
+			}
+		}
+		for reputer := range epoch.Losses {
+			if !reputers[reputer] {
+				return fmt.Errorf("epoch %d: losses of unknown reputer %q", i, reputer)
+			}
+		}
+		for _, reputer := range epoch.AbsentReputers {
+			if !reputers[reputer] {
+				return fmt.Errorf("epoch %d: unknown absent reputer %q", i, reputer)
+			}
+		}
+	}
+	return nil
+}
+
+// Parses an optional decimal, returning zero when it is empty
+func parseDec(s string, what string) (alloraMath.Dec, error) {
+	if s == "" {
+		return alloraMath.ZeroDec(), nil
+	}
+	value, err := alloraMath.NewDecFromString(s)
+	if err != nil {
+		return alloraMath.Dec{}, fmt.Errorf("invalid %s %q: %w", what, s, err)
+	}
+	return value, nil
+}
+
+// Parses an optional non-negative integer, returning zero when it is empty
+func parseInt(s string, what string) (cosmosMath.Int, error) {
+	if s == "" {
+		return cosmosMath.ZeroInt(), nil
+	}
+	value, ok := cosmosMath.NewIntFromString(s)
+	if !ok || value.IsNegative() {
+		return cosmosMath.Int{}, fmt.Errorf("invalid %s %q", what, s)
+	}
+	return value, nil
+}
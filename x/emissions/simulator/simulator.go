@@ -0,0 +1,575 @@
+// Package simulator plays out a scenario of a topic against an in-memory emissions keeper, running the same
+// inference synthesis, loss, regret, topic weight and reward distribution code as the chain, so the reward
+// mechanism params can be tuned offline.
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	defaultPNorm       = "3"
+	defaultAlphaRegret = "0.1"
+)
+
+// Outcome of an epoch of the scenario
+type EpochResult struct {
+	Epoch       int    `json:"epoch"`
+	BlockHeight int64  `json:"block_height"`
+	Active      bool   `json:"active"` // whether the topic weight reached the minimum topic weight, without which it is not rewarded
+	TopicWeight string `json:"topic_weight"`
+	TopicReward string `json:"topic_reward"`
+
+	CombinedInference string `json:"combined_inference"`
+	NaiveInference    string `json:"naive_inference"`
+	CombinedLoss      string `json:"combined_loss"`
+	NaiveLoss         string `json:"naive_loss"`
+
+	InferenceEntropy      string `json:"inference_entropy"`
+	ForecastingEntropy    string `json:"forecasting_entropy"`
+	ReputerEntropy        string `json:"reputer_entropy"`
+	InferenceTaskReward   string `json:"inference_task_reward"`
+	ForecastingTaskReward string `json:"forecasting_task_reward"`
+	ReputerTaskReward     string `json:"reputer_task_reward"`
+	Chi                   string `json:"chi"`
+	Gamma                 string `json:"gamma"`
+
+	// Why the rewards of the epoch could not be distributed, in which case the chain skips the topic
+	// for the block, as in the first epoch, which lacks the losses network inferences are synthesized from
+	Error  string        `json:"error,omitempty"`
+	Actors []ActorResult `json:"actors"`
+}
+
+// Outcome of an epoch for an actor in one of its roles
+type ActorResult struct {
+	Name           string `json:"name"`
+	Address        string `json:"address"`
+	Type           string `json:"type"` // inferer, forecaster or reputer
+	Score          string `json:"score"`
+	RewardFraction string `json:"reward_fraction"`
+	Reward         string `json:"reward"`
+	// Network regret of the inferer or forecaster after the epoch, empty for reputers
+	Regret string `json:"regret,omitempty"`
+	// Stake of the reputer after its reward of the epoch is added to it, empty for workers
+	Stake string `json:"stake,omitempty"`
+}
+
+type Simulator struct {
+	ctx       sdk.Context
+	k         keeper.Keeper
+	scenario  Scenario
+	topic     types.Topic
+	addresses map[string]string // address of each actor name
+	names     map[string]string // name of each actor address
+}
+
+func NewSimulator(scenario Scenario) (*Simulator, error) {
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	// A fixed block time keeps the simulation deterministic
+	ctx := sdk.NewContext(cms, cmtproto.Header{Time: time.Unix(0, 0).UTC()}, false, log.NewNopLogger())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	// Nothing the simulation runs moves tokens, so the keeper does without the account and bank keepers
+	k := keeper.NewKeeper(
+		cdc,
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		runtime.NewKVStoreService(key),
+		nil,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	s := &Simulator{
+		ctx:       ctx,
+		k:         k,
+		scenario:  scenario,
+		addresses: make(map[string]string),
+		names:     make(map[string]string),
+	}
+	if err := s.setup(cdc); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Deterministic address of an actor of the scenario
+func actorAddress(name string) string {
+	return sdk.MustBech32ifyAddressBytes(params.Bech32PrefixAccAddr, crypto.AddressHash([]byte("simulator/"+name)))
+}
+
+// The module params with the overrides of the scenario applied
+func scenarioParams(cdc codec.JSONCodec, overrides json.RawMessage) (types.Params, error) {
+	moduleParams := types.DefaultParams()
+	if len(overrides) == 0 {
+		return moduleParams, nil
+	}
+	bz, err := cdc.MarshalJSON(&moduleParams)
+	if err != nil {
+		return types.Params{}, err
+	}
+	merged := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bz, &merged); err != nil {
+		return types.Params{}, err
+	}
+	overridden := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(overrides, &overridden); err != nil {
+		return types.Params{}, fmt.Errorf("invalid params: %w", err)
+	}
+	for name, value := range overridden {
+		merged[name] = value
+	}
+	bz, err = json.Marshal(merged)
+	if err != nil {
+		return types.Params{}, err
+	}
+	if err := cdc.UnmarshalJSON(bz, &moduleParams); err != nil {
+		return types.Params{}, fmt.Errorf("invalid params: %w", err)
+	}
+	if err := moduleParams.Validate(); err != nil {
+		return types.Params{}, fmt.Errorf("invalid params: %w", err)
+	}
+	return moduleParams, nil
+}
+
+// Initializes the module state, creates the topic and registers and stakes its actors
+func (s *Simulator) setup(cdc codec.JSONCodec) error {
+	moduleParams, err := scenarioParams(cdc, s.scenario.Params)
+	if err != nil {
+		return err
+	}
+	if err := s.k.SetParams(s.ctx, moduleParams); err != nil {
+		return err
+	}
+	if err := s.k.SetTotalStake(s.ctx, cosmosMath.ZeroInt()); err != nil {
+		return err
+	}
+	// Topic ID 0 is reserved, as in genesis
+	if _, err := s.k.IncrementTopicId(s.ctx); err != nil {
+		return err
+	}
+
+	pNorm, alphaRegret := s.scenario.Topic.PNorm, s.scenario.Topic.AlphaRegret
+	if pNorm == "" {
+		pNorm = defaultPNorm
+	}
+	if alphaRegret == "" {
+		alphaRegret = defaultAlphaRegret
+	}
+	topicId, err := s.k.IncrementTopicId(s.ctx)
+	if err != nil {
+		return err
+	}
+	s.topic = types.Topic{
+		Id:             topicId,
+		Creator:        actorAddress("creator"),
+		EpochLength:    s.scenario.Topic.EpochLength,
+		GroundTruthLag: s.scenario.Topic.GroundTruthLag,
+		AllowNegative:  s.scenario.Topic.AllowNegative,
+	}
+	if s.topic.PNorm, err = parseDec(pNorm, "topic p norm"); err != nil {
+		return err
+	}
+	if s.topic.AlphaRegret, err = parseDec(alphaRegret, "topic alpha regret"); err != nil {
+		return err
+	}
+	if err := s.k.SetTopic(s.ctx, topicId, s.topic); err != nil {
+		return err
+	}
+
+	for _, worker := range s.scenario.Workers {
+		address := s.addActor(worker)
+		if err := s.k.InsertWorker(s.ctx, topicId, address, types.OffchainNode{Owner: address, NodeAddress: address}); err != nil {
+			return err
+		}
+	}
+	for _, reputer := range s.scenario.Reputers {
+		address := s.addActor(reputer.Name)
+		if err := s.k.InsertReputer(s.ctx, topicId, address, types.OffchainNode{Owner: address, NodeAddress: address}); err != nil {
+			return err
+		}
+		stake, err := parseInt(reputer.Stake, "stake of reputer "+reputer.Name)
+		if err != nil {
+			return err
+		}
+		if err := s.k.AddStake(s.ctx, topicId, address, stake); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Simulator) addActor(name string) string {
+	address := actorAddress(name)
+	s.addresses[name] = address
+	s.names[address] = name
+	return address
+}
+
+// Plays out every epoch of the scenario in order
+func (s *Simulator) Run() ([]EpochResult, error) {
+	results := make([]EpochResult, 0, len(s.scenario.Epochs))
+	for i, epoch := range s.scenario.Epochs {
+		result, err := s.runEpoch(i, epoch)
+		if err != nil {
+			return nil, fmt.Errorf("epoch %d: %w", i, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Runs an epoch the way the chain would across its worker nonce, reputer nonce and reward block,
+// compressed into a single block: the inferences of the epoch are evaluated against its ground truth right away
+func (s *Simulator) runEpoch(i int, epoch ScenarioEpoch) (EpochResult, error) {
+	topicId := s.topic.Id
+	blockHeight := int64(i+1) * s.topic.EpochLength
+	previousBlockHeight := blockHeight - s.topic.EpochLength
+	ctx := s.ctx.WithBlockHeight(blockHeight)
+	nonce := types.Nonce{BlockHeight: blockHeight}
+
+	topicParams, err := s.k.GetParamsForTopic(ctx, topicId)
+	if err != nil {
+		return EpochResult{}, err
+	}
+
+	// Funding the topic reactivates it if its weight fell under the minimum in an earlier epoch
+	feeRevenue := epoch.FeeRevenue
+	if feeRevenue == "" {
+		feeRevenue = s.scenario.FeeRevenue
+	}
+	revenue, err := parseInt(feeRevenue, "fee revenue")
+	if err != nil {
+		return EpochResult{}, err
+	}
+	if !revenue.IsZero() {
+		if err := s.k.AddTopicFeeRevenue(ctx, topicId, revenue); err != nil {
+			return EpochResult{}, err
+		}
+	}
+	if err := s.k.ActivateTopic(ctx, topicId); err != nil {
+		return EpochResult{}, err
+	}
+
+	if err := s.insertWorkerPayloads(ctx, nonce, epoch); err != nil {
+		return EpochResult{}, err
+	}
+	networkInferences, err := synth.GetNetworkInferencesAtBlock(ctx, s.k, topicId, blockHeight, previousBlockHeight)
+	if err != nil {
+		return EpochResult{}, err
+	}
+	networkLosses, err := s.insertReputerPayloads(ctx, nonce, epoch, networkInferences, topicParams.Epsilon)
+	if err != nil {
+		return EpochResult{}, err
+	}
+
+	weights, _, _, err := rewards.GetAndUpdateActiveTopicWeights(ctx, s.k, blockHeight)
+	if err != nil {
+		return EpochResult{}, err
+	}
+	topicWeight := alloraMath.ZeroDec()
+	topicReward := alloraMath.ZeroDec()
+	weight, active := weights[topicId]
+	if active {
+		topicWeight = *weight
+		if topicReward, err = parseDec(s.scenario.TopicReward, "topic reward"); err != nil {
+			return EpochResult{}, err
+		}
+	}
+
+	distribution, _, report, err := rewards.GenerateRewardsDistributionAndReportByTopicParticipant(
+		ctx,
+		s.k,
+		topicId,
+		&topicReward,
+		blockHeight,
+		topicParams,
+	)
+	result := EpochResult{
+		Epoch:             i,
+		BlockHeight:       blockHeight,
+		Active:            active,
+		TopicWeight:       topicWeight.String(),
+		TopicReward:       topicReward.String(),
+		CombinedInference: networkInferences.CombinedValue.String(),
+		NaiveInference:    networkInferences.NaiveValue.String(),
+		CombinedLoss:      networkLosses.CombinedValue.String(),
+		NaiveLoss:         networkLosses.NaiveValue.String(),
+		Actors:            make([]ActorResult, 0, len(report.Actors)),
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+	// Reputer rewards are added to their stake, as on chain
+	for _, reward := range distribution {
+		if reward.Type != types.ReputerAndDelegatorRewardType || reward.Reward.IsZero() {
+			continue
+		}
+		if err := s.k.AddStake(ctx, topicId, reward.Address, reward.Reward.Abs().SdkIntTrim()); err != nil {
+			return EpochResult{}, err
+		}
+	}
+
+	result.InferenceEntropy = report.InferenceEntropy.String()
+	result.ForecastingEntropy = report.ForecastingEntropy.String()
+	result.ReputerEntropy = report.ReputerEntropy.String()
+	result.InferenceTaskReward = report.InferenceTaskReward.String()
+	result.ForecastingTaskReward = report.ForecastingTaskReward.String()
+	result.ReputerTaskReward = report.ReputerTaskReward.String()
+	result.Chi = report.Chi.String()
+	result.Gamma = report.Gamma.String()
+	for _, actor := range report.Actors {
+		actorResult := ActorResult{
+			Name:           s.names[actor.Address],
+			Address:        actor.Address,
+			Type:           strings.ToLower(actor.ActorType.String()),
+			Score:          actor.Score.String(),
+			RewardFraction: actor.RewardFraction.String(),
+			Reward:         actor.Reward.String(),
+		}
+		switch actor.ActorType {
+		case types.ActorType_INFERER:
+			regret, _, err := s.k.GetInfererNetworkRegret(ctx, topicId, actor.Address)
+			if err != nil {
+				return EpochResult{}, err
+			}
+			actorResult.Regret = regret.Value.String()
+		case types.ActorType_FORECASTER:
+			regret, _, err := s.k.GetForecasterNetworkRegret(ctx, topicId, actor.Address)
+			if err != nil {
+				return EpochResult{}, err
+			}
+			actorResult.Regret = regret.Value.String()
+		case types.ActorType_REPUTER:
+			stake, err := s.k.GetStakeOnReputerInTopic(ctx, topicId, actor.Address)
+			if err != nil {
+				return EpochResult{}, err
+			}
+			actorResult.Stake = stake.String()
+		}
+		result.Actors = append(result.Actors, actorResult)
+	}
+	return result, nil
+}
+
+func (s *Simulator) insertWorkerPayloads(ctx sdk.Context, nonce types.Nonce, epoch ScenarioEpoch) error {
+	inferences := make([]*types.Inference, 0, len(epoch.Inferences))
+	for _, worker := range sortedKeys(epoch.Inferences) {
+		value, err := parseDec(epoch.Inferences[worker], "inference of "+worker)
+		if err != nil {
+			return err
+		}
+		inferences = append(inferences, &types.Inference{
+			TopicId:     s.topic.Id,
+			BlockHeight: nonce.BlockHeight,
+			Inferer:     s.addresses[worker],
+			Value:       value,
+		})
+	}
+	if err := s.k.InsertInferences(ctx, s.topic.Id, nonce, types.Inferences{Inferences: inferences}); err != nil {
+		return err
+	}
+
+	forecasts := make([]*types.Forecast, 0, len(epoch.Forecasts))
+	for _, forecaster := range sortedKeys(epoch.Forecasts) {
+		elements := make([]*types.ForecastElement, 0, len(epoch.Forecasts[forecaster]))
+		for _, inferer := range sortedKeys(epoch.Forecasts[forecaster]) {
+			value, err := parseDec(epoch.Forecasts[forecaster][inferer], "forecast of "+forecaster)
+			if err != nil {
+				return err
+			}
+			elements = append(elements, &types.ForecastElement{Inferer: s.addresses[inferer], Value: value})
+		}
+		forecasts = append(forecasts, &types.Forecast{
+			TopicId:          s.topic.Id,
+			BlockHeight:      nonce.BlockHeight,
+			Forecaster:       s.addresses[forecaster],
+			ForecastElements: elements,
+		})
+	}
+	if len(forecasts) == 0 {
+		return nil
+	}
+	return s.k.InsertForecasts(ctx, s.topic.Id, nonce, types.Forecasts{Forecasts: forecasts})
+}
+
+// Inserts the losses of the reputers present in the epoch, then computes and inserts
+// the network losses they add up to and updates the network regrets with them
+func (s *Simulator) insertReputerPayloads(
+	ctx sdk.Context,
+	nonce types.Nonce,
+	epoch ScenarioEpoch,
+	networkInferences *types.ValueBundle,
+	epsilon alloraMath.Dec,
+) (types.ValueBundle, error) {
+	absent := make(map[string]bool, len(epoch.AbsentReputers))
+	for _, reputer := range epoch.AbsentReputers {
+		absent[reputer] = true
+	}
+
+	bundles := make([]*types.ReputerValueBundle, 0, len(s.scenario.Reputers))
+	stakesByReputer := make(map[string]cosmosMath.Int)
+	for _, reputer := range s.scenario.Reputers {
+		if absent[reputer.Name] {
+			continue
+		}
+		address := s.addresses[reputer.Name]
+		valueBundle, err := s.reportedLosses(networkInferences, epoch.GroundTruth, epoch.Losses[reputer.Name])
+		if err != nil {
+			return types.ValueBundle{}, fmt.Errorf("losses of %s: %w", reputer.Name, err)
+		}
+		valueBundle.TopicId = s.topic.Id
+		valueBundle.Reputer = address
+		valueBundle.ReputerRequestNonce = &types.ReputerRequestNonce{ReputerNonce: &nonce, WorkerNonce: &nonce}
+		bundles = append(bundles, &types.ReputerValueBundle{ValueBundle: valueBundle})
+
+		stake, err := s.k.GetStakeOnReputerInTopic(ctx, s.topic.Id, address)
+		if err != nil {
+			return types.ValueBundle{}, err
+		}
+		stakesByReputer[address] = stake
+	}
+	if len(bundles) == 0 {
+		return types.ValueBundle{}, fmt.Errorf("no reputer reported losses")
+	}
+	sort.Slice(bundles, func(i, j int) bool {
+		return bundles[i].ValueBundle.Reputer < bundles[j].ValueBundle.Reputer
+	})
+
+	reputerBundles := types.ReputerValueBundles{ReputerValueBundles: bundles}
+	if err := s.k.InsertReputerLossBundlesAtBlock(ctx, s.topic.Id, nonce.BlockHeight, reputerBundles); err != nil {
+		return types.ValueBundle{}, err
+	}
+	networkLosses, err := synth.CalcNetworkLosses(stakesByReputer, reputerBundles, epsilon)
+	if err != nil {
+		return types.ValueBundle{}, err
+	}
+	if err := s.k.InsertNetworkLossBundleAtBlock(ctx, s.topic.Id, nonce.BlockHeight, networkLosses); err != nil {
+		return types.ValueBundle{}, err
+	}
+	err = synth.GetCalcSetNetworkRegrets(ctx, s.k, s.topic.Id, networkLosses, nonce, s.topic.AlphaRegret)
+	if err != nil {
+		return types.ValueBundle{}, err
+	}
+	return networkLosses, nil
+}
+
+// Losses a reputer reports for the network inferences: the ones of the scenario,
+// and the squared error against the ground truth for the others
+func (s *Simulator) reportedLosses(
+	networkInferences *types.ValueBundle,
+	epochGroundTruth string,
+	reported ScenarioLosses,
+) (*types.ValueBundle, error) {
+	groundTruth := epochGroundTruth
+	if reported.GroundTruth != "" {
+		groundTruth = reported.GroundTruth
+	}
+	truth, err := parseDec(groundTruth, "ground truth")
+	if err != nil {
+		return nil, err
+	}
+
+	loss := func(reportedLoss string, value alloraMath.Dec) (alloraMath.Dec, error) {
+		if reportedLoss != "" {
+			return parseDec(reportedLoss, "loss")
+		}
+		diff, err := value.Sub(truth)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		return diff.Mul(diff)
+	}
+	workerLosses := func(reportedLosses map[string]string, values []*types.WorkerAttributedValue) ([]*types.WorkerAttributedValue, error) {
+		losses := make([]*types.WorkerAttributedValue, 0, len(values))
+		for _, value := range values {
+			l, err := loss(reportedLosses[s.names[value.Worker]], value.Value)
+			if err != nil {
+				return nil, err
+			}
+			losses = append(losses, &types.WorkerAttributedValue{Worker: value.Worker, Value: l})
+		}
+		return losses, nil
+	}
+	withheldLosses := func(reportedLosses map[string]string, values []*types.WithheldWorkerAttributedValue) ([]*types.WithheldWorkerAttributedValue, error) {
+		losses := make([]*types.WithheldWorkerAttributedValue, 0, len(values))
+		for _, value := range values {
+			l, err := loss(reportedLosses[s.names[value.Worker]], value.Value)
+			if err != nil {
+				return nil, err
+			}
+			losses = append(losses, &types.WithheldWorkerAttributedValue{Worker: value.Worker, Value: l})
+		}
+		return losses, nil
+	}
+
+	bundle := &types.ValueBundle{}
+	if bundle.CombinedValue, err = loss(reported.Combined, networkInferences.CombinedValue); err != nil {
+		return nil, err
+	}
+	if bundle.NaiveValue, err = loss(reported.Naive, networkInferences.NaiveValue); err != nil {
+		return nil, err
+	}
+	if bundle.InfererValues, err = workerLosses(reported.Inferers, networkInferences.InfererValues); err != nil {
+		return nil, err
+	}
+	if bundle.ForecasterValues, err = workerLosses(reported.Forecasters, networkInferences.ForecasterValues); err != nil {
+		return nil, err
+	}
+	if bundle.OneInForecasterValues, err = workerLosses(reported.OneInForecasters, networkInferences.OneInForecasterValues); err != nil {
+		return nil, err
+	}
+	if bundle.OneOutInfererValues, err = withheldLosses(reported.OneOutInferers, networkInferences.OneOutInfererValues); err != nil {
+		return nil, err
+	}
+	if bundle.OneOutForecasterValues, err = withheldLosses(reported.OneOutForecasters, networkInferences.OneOutForecasterValues); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,150 @@
+package simulator_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/simulator"
+	"github.com/stretchr/testify/require"
+)
+
+func runScenario(t *testing.T, scenario simulator.Scenario) []simulator.EpochResult {
+	sim, err := simulator.NewSimulator(scenario)
+	require.NoError(t, err)
+	results, err := sim.Run()
+	require.NoError(t, err)
+	return results
+}
+
+func findActor(t *testing.T, result simulator.EpochResult, name string, actorType string) simulator.ActorResult {
+	for _, actor := range result.Actors {
+		if actor.Name == name && actor.Type == actorType {
+			return actor
+		}
+	}
+	require.Failf(t, "actor not found", "%s %s in epoch %d", actorType, name, result.Epoch)
+	return simulator.ActorResult{}
+}
+
+func TestSimulateScenario(t *testing.T) {
+	scenario, err := simulator.LoadScenario("testdata/scenario.json")
+	require.NoError(t, err)
+
+	results := runScenario(t, scenario)
+	require.Len(t, results, len(scenario.Epochs))
+
+	// Without the losses of an earlier epoch there are no one-out inferences to score workers with
+	require.NotEmpty(t, results[0].Error)
+	require.Empty(t, results[0].Actors)
+
+	for _, result := range results[1:] {
+		require.Empty(t, result.Error, "epoch %d", result.Epoch)
+		require.True(t, result.Active, "epoch %d", result.Epoch)
+		// The whole topic reward goes to the actors, up to rounding
+		total := 0.
+		for _, actor := range result.Actors {
+			reward, err := strconv.ParseFloat(actor.Reward, 64)
+			require.NoError(t, err)
+			total += reward
+		}
+		topicReward, err := strconv.ParseFloat(result.TopicReward, 64)
+		require.NoError(t, err)
+		require.InEpsilon(t, topicReward, total, 1e-9, "epoch %d", result.Epoch)
+	}
+
+	// Reputer rewards are added to their stake
+	require.Equal(t, 1, alloraMath.MustNewDecFromString(findActor(t, results[2], "erin", "reputer").Stake).Cmp(
+		alloraMath.MustNewDecFromString(findActor(t, results[1], "erin", "reputer").Stake)))
+
+	// The reputer evaluating against a wrong ground truth in epoch 3 loses most of its share
+	frankBefore := alloraMath.MustNewDecFromString(findActor(t, results[2], "frank", "reputer").RewardFraction)
+	frankAfter := alloraMath.MustNewDecFromString(findActor(t, results[3], "frank", "reputer").RewardFraction)
+	require.True(t, frankAfter.Lt(frankBefore))
+
+	// An absent reputer is not rewarded
+	for _, actor := range results[5].Actors {
+		require.NotEqual(t, "frank", actor.Name)
+	}
+
+	// The same scenario always plays out the same way
+	require.Equal(t, results, runScenario(t, scenario))
+}
+
+func TestSimulateParamsOverrides(t *testing.T) {
+	scenario, err := simulator.LoadScenario("testdata/scenario.json")
+	require.NoError(t, err)
+
+	scenario.Params = json.RawMessage(`{"p_reward_inference": "1"}`)
+	base := runScenario(t, scenario)
+	scenario.Params = json.RawMessage(`{"p_reward_inference": "3"}`)
+	sharper := runScenario(t, scenario)
+	require.NotEqual(
+		t,
+		findActor(t, base[2], "alice", "inferer").RewardFraction,
+		findActor(t, sharper[2], "alice", "inferer").RewardFraction,
+	)
+
+	scenario.Params = json.RawMessage(`{"not_a_param": "1"}`)
+	_, err = simulator.NewSimulator(scenario)
+	require.Error(t, err)
+}
+
+func TestScenarioValidation(t *testing.T) {
+	valid := func() simulator.Scenario {
+		return simulator.Scenario{
+			Topic:       simulator.ScenarioTopic{EpochLength: 10},
+			TopicReward: "1000",
+			Workers:     []string{"alice", "bob"},
+			Reputers:    []simulator.ScenarioReputer{{Name: "carol", Stake: "1000"}},
+			Epochs: []simulator.ScenarioEpoch{{
+				GroundTruth: "10",
+				Inferences:  map[string]string{"alice": "9", "bob": "11"},
+				Forecasts:   map[string]map[string]string{"alice": {"alice": "1", "bob": "1"}},
+			}},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	scenario := valid()
+	scenario.Topic.EpochLength = 0
+	require.Error(t, scenario.Validate())
+
+	scenario = valid()
+	scenario.Reputers = append(scenario.Reputers, simulator.ScenarioReputer{Name: "alice"})
+	require.Error(t, scenario.Validate())
+
+	scenario = valid()
+	scenario.Epochs[0].Inferences["dave"] = "10"
+	require.Error(t, scenario.Validate())
+
+	scenario = valid()
+	scenario.Epochs[0].Forecasts["bob"] = map[string]string{"alice": "1"}
+	require.Error(t, scenario.Validate())
+
+	scenario = valid()
+	scenario.Epochs[0].Inferences["bob"] = "eleven"
+	require.Error(t, scenario.Validate())
+}
+
+func TestWriteCSV(t *testing.T) {
+	scenario, err := simulator.LoadScenario("testdata/scenario.json")
+	require.NoError(t, err)
+	results := runScenario(t, scenario)
+
+	var buf bytes.Buffer
+	require.NoError(t, simulator.WriteCSV(&buf, results))
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+
+	expectedRows := 1
+	for _, result := range results {
+		expectedRows += max(len(result.Actors), 1)
+	}
+	require.Len(t, rows, expectedRows)
+	require.Equal(t, "epoch", rows[0][0])
+	require.Equal(t, results[0].Error, rows[1][len(rows[1])-1])
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Minimum time in seconds between two changes of the commission rate of a reputer
+const ReputerCommissionUpdateInterval = int64(24 * 60 * 60)
+
+// Checks that the rates lie within [0, 1], and that neither the rate nor the max change rate exceed the max rate
+func (c ReputerCommission) Validate() error {
+	if c.Rate.IsNegative() || c.MaxRate.IsNegative() || c.MaxChangeRate.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidReputerCommission, "rates must not be negative")
+	}
+	if c.MaxRate.Gt(alloraMath.OneDec()) {
+		return errorsmod.Wrap(ErrInvalidReputerCommission, "max rate must not exceed 1")
+	}
+	if c.Rate.Gt(c.MaxRate) {
+		return errorsmod.Wrap(ErrInvalidReputerCommission, "rate must not exceed the max rate")
+	}
+	if c.MaxChangeRate.Gt(c.MaxRate) {
+		return errorsmod.Wrap(ErrInvalidReputerCommission, "max change rate must not exceed the max rate")
+	}
+	return nil
+}
+
+// Checks that the rate may be changed to the new rate at the given unix time in seconds
+func (c ReputerCommission) ValidateNewRate(newRate alloraMath.Dec, blockTime int64) error {
+	if blockTime-c.UpdateTime < ReputerCommissionUpdateInterval {
+		return ErrReputerCommissionUpdateTooSoon
+	}
+	if newRate.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidReputerCommission, "rate must not be negative")
+	}
+	if newRate.Gt(c.MaxRate) {
+		return errorsmod.Wrap(ErrInvalidReputerCommission, "rate must not exceed the max rate")
+	}
+	change, err := newRate.Sub(c.Rate)
+	if err != nil {
+		return err
+	}
+	if change.Abs().Gt(c.MaxChangeRate) {
+		return ErrReputerCommissionChangeTooLarge
+	}
+	return nil
+}
@@ -64,4 +64,30 @@ var (
 	ErrValidationMustBeBetweenZeroAndOne        = errors.Register(ModuleName, 61, "value must be between 0 and 1")
 	ErrValidationMustBeGreaterthanZero          = errors.Register(ModuleName, 62, "value must be greater than 0")
 	ErrEmptyArray                               = errors.Register(ModuleName, 63, "empty array")
+	ErrCommitRevealNotEnabled                   = errors.Register(ModuleName, 64, "commit-reveal is not enabled for this topic")
+	ErrCommitPhaseEnded                         = errors.Register(ModuleName, 65, "commit phase has ended for this nonce")
+	ErrRevealPhaseNotStarted                    = errors.Register(ModuleName, 66, "reveal phase has not started for this nonce")
+	ErrInvalidCommitment                        = errors.Register(ModuleName, 67, "invalid commitment")
+	ErrActorJailed                              = errors.Register(ModuleName, 68, "actor is jailed in this topic")
+	ErrActorNotJailed                           = errors.Register(ModuleName, 69, "actor is not jailed in this topic")
+	ErrNotAuthority                             = errors.Register(ModuleName, 70, "sender is not the module authority")
+	ErrValidationParamsInconsistent             = errors.Register(ModuleName, 71, "params are inconsistent with each other")
+	ErrTopicParamNotOverridable                 = errors.Register(ModuleName, 72, "param may not be overridden for topics")
+	ErrTopicParamOutOfBounds                    = errors.Register(ModuleName, 73, "topic param is out of the governance bounds")
+	ErrNotTopicCreator                          = errors.Register(ModuleName, 74, "sender is not the creator of the topic")
+	ErrNotInTopicAllowlist                      = errors.Register(ModuleName, 75, "address is not in the allowlist of this permissioned topic")
+	ErrNotInTopicCreatorAllowlist               = errors.Register(ModuleName, 76, "address is not in the topic creator allowlist")
+	ErrInvalidReputerCommission                 = errors.Register(ModuleName, 77, "invalid reputer commission")
+	ErrReputerCommissionUpdateTooSoon           = errors.Register(ModuleName, 78, "reputer commission rate may only be changed once per day")
+	ErrReputerCommissionChangeTooLarge          = errors.Register(ModuleName, 79, "reputer commission rate change exceeds the max change rate")
+	ErrReputerCommissionMaxRatesImmutable       = errors.Register(ModuleName, 80, "reputer commission max rate and max change rate may not be changed")
+	ErrRedelegationToSamePosition               = errors.Register(ModuleName, 81, "cannot redelegate stake to the position it comes from")
+	ErrInsufficientDelegateStakeToRedelegate    = errors.Register(ModuleName, 82, "insufficient delegate stake to redelegate")
+	ErrInvalidReputerStakeAllocation            = errors.Register(ModuleName, 83, "reputer stake allocations must be fractions between 0 and 1 summing to at most 1")
+	ErrInsufficientReputerStakePool             = errors.Register(ModuleName, 84, "insufficient stake in the reputer stake pool")
+	ErrNoClaimableRewards                       = errors.Register(ModuleName, 85, "no rewards to claim")
+	ErrValidationRetentionAmbiguous             = errors.Register(ModuleName, 86, "retention may be set in epochs or in blocks, not both")
+	ErrNoDelegateStakePlacement                 = errors.Register(ModuleName, 87, "delegator has no stake placed")
+	ErrTransitiveRedelegation                   = errors.Register(ModuleName, 88, "cannot redelegate stake that was redelegated within the stake removal delay window")
+	ErrParamsUpdateTooSoon                      = errors.Register(ModuleName, 89, "whitelist admins must schedule params updates at least the min admin params update delay ahead")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -50,8 +51,137 @@ func EmitNewReputerAndDelegatorRewardsSettledEvent(ctx sdk.Context, blockHeight
 	ctx.EventManager().EmitTypedEvent(NewRewardsSetEventBase(ActorType_REPUTER, blockHeight, rewards))
 }
 
+func EmitNewPayloadStatusesEvent(ctx sdk.Context, topicId TopicId, blockHeight BlockHeight, statuses []*PayloadStatus) {
+	if len(statuses) < 1 {
+		return
+	}
+	ctx.EventManager().EmitTypedEvent(&EventPayloadStatuses{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Statuses:    statuses,
+	})
+}
+
+func EmitNewTopicCreatedEvent(ctx sdk.Context, topic Topic) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicCreated{Topic: &topic})
+}
+
+func EmitNewTopicActivatedEvent(ctx sdk.Context, topicId TopicId) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicActivated{TopicId: topicId})
+}
+
+func EmitNewTopicInactivatedEvent(ctx sdk.Context, topicId TopicId) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicInactivated{TopicId: topicId})
+}
+
+func EmitNewActorRegisteredEvent(ctx sdk.Context, topicId TopicId, address string, isReputer bool, node OffchainNode) {
+	ctx.EventManager().EmitTypedEvent(&EventActorRegistered{
+		TopicId:      topicId,
+		Address:      address,
+		IsReputer:    isReputer,
+		Owner:        node.Owner,
+		LibP2PKey:    node.LibP2PKey,
+		MultiAddress: node.MultiAddress,
+	})
+}
+
+func EmitNewActorDeregisteredEvent(ctx sdk.Context, topicId TopicId, address string, isReputer bool) {
+	ctx.EventManager().EmitTypedEvent(&EventActorDeregistered{
+		TopicId:   topicId,
+		Address:   address,
+		IsReputer: isReputer,
+	})
+}
+
+func EmitNewStakeAddedEvent(ctx sdk.Context, topicId TopicId, reputer string, amount cosmosMath.Int) {
+	ctx.EventManager().EmitTypedEvent(&EventStakeAdded{TopicId: topicId, Reputer: reputer, Amount: amount})
+}
+
+func EmitNewStakeRemovedEvent(ctx sdk.Context, topicId TopicId, reputer string, amount cosmosMath.Int) {
+	ctx.EventManager().EmitTypedEvent(&EventStakeRemoved{TopicId: topicId, Reputer: reputer, Amount: amount})
+}
+
+func EmitNewDelegateStakeAddedEvent(ctx sdk.Context, topicId TopicId, delegator, reputer string, amount cosmosMath.Int) {
+	ctx.EventManager().EmitTypedEvent(&EventDelegateStakeAdded{
+		TopicId:   topicId,
+		Delegator: delegator,
+		Reputer:   reputer,
+		Amount:    amount,
+	})
+}
+
+func EmitNewDelegateStakeRemovedEvent(ctx sdk.Context, topicId TopicId, delegator, reputer string, amount cosmosMath.Int) {
+	ctx.EventManager().EmitTypedEvent(&EventDelegateStakeRemoved{
+		TopicId:   topicId,
+		Delegator: delegator,
+		Reputer:   reputer,
+		Amount:    amount,
+	})
+}
+
+func EmitNewStakeRemovalStartedEvent(ctx sdk.Context, removal StakeRemoval) {
+	ctx.EventManager().EmitTypedEvent(&EventStakeRemovalStarted{Removal: &removal})
+}
+
+func EmitNewDelegateStakeRemovalStartedEvent(ctx sdk.Context, removal DelegateStakeRemoval) {
+	ctx.EventManager().EmitTypedEvent(&EventDelegateStakeRemovalStarted{Removal: &removal})
+}
+
+func EmitNewWorkerNonceAddedEvent(ctx sdk.Context, topicId TopicId, nonce *Nonce, droppedNonces []*Nonce) {
+	ctx.EventManager().EmitTypedEvent(&EventWorkerNonceAdded{
+		TopicId:       topicId,
+		Nonce:         nonce,
+		DroppedNonces: droppedNonces,
+	})
+}
+
+func EmitNewReputerNonceAddedEvent(ctx sdk.Context, topicId TopicId, nonce *ReputerRequestNonce, droppedNonces []*ReputerRequestNonce) {
+	ctx.EventManager().EmitTypedEvent(&EventReputerNonceAdded{
+		TopicId:       topicId,
+		Nonce:         nonce,
+		DroppedNonces: droppedNonces,
+	})
+}
+
+func EmitNewWorkerNonceFulfilledEvent(ctx sdk.Context, topicId TopicId, nonce *Nonce) {
+	ctx.EventManager().EmitTypedEvent(&EventWorkerNonceFulfilled{TopicId: topicId, Nonce: nonce})
+}
+
+func EmitNewReputerNonceFulfilledEvent(ctx sdk.Context, topicId TopicId, nonce *Nonce) {
+	ctx.EventManager().EmitTypedEvent(&EventReputerNonceFulfilled{TopicId: topicId, Nonce: nonce})
+}
+
+func EmitNewTopicFeeRevenueAddedEvent(ctx sdk.Context, topicId TopicId, amount cosmosMath.Int) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicFeeRevenueAdded{TopicId: topicId, Amount: amount})
+}
+
+func EmitNewTopicFeeRevenueDrippedEvent(ctx sdk.Context, topicId TopicId, blockHeight BlockHeight, dripped, remaining cosmosMath.Int) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicFeeRevenueDripped{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Dripped:     dripped,
+		Remaining:   remaining,
+	})
+}
+
+func EmitNewParamsUpdatedEvent(ctx sdk.Context, sender string, params Params) {
+	ctx.EventManager().EmitTypedEvent(&EventParamsUpdated{Sender: sender, Params: &params})
+}
+
+func EmitNewTopicParamsUpdatedEvent(ctx sdk.Context, topicId TopicId, sender string, params TopicParams) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicParamsUpdated{TopicId: topicId, Sender: sender, Params: &params})
+}
+
 /// Utils
 
+func NewAcceptedPayloadStatus(actorType ActorType, address string) *PayloadStatus {
+	return &PayloadStatus{ActorType: actorType, Address: address, Accepted: true}
+}
+
+func NewRejectedPayloadStatus(actorType ActorType, address string, reason PayloadRejectionReason) *PayloadStatus {
+	return &PayloadStatus{ActorType: actorType, Address: address, Accepted: false, Reason: reason}
+}
+
 // Assumes length of `scores` is at least 1
 func NewScoresSetEventBase(actorType ActorType, scores []Score) proto.Message {
 	topicId := scores[0].TopicId
@@ -76,15 +206,21 @@ func NewRewardsSetEventBase(actorType ActorType, blockHeight BlockHeight, reward
 	topicId := rewards[0].TopicId
 	addresses := make([]string, len(rewards))
 	rewardValues := make([]alloraMath.Dec, len(rewards))
+	commissionRates := make([]alloraMath.Dec, len(rewards))
+	commissions := make([]alloraMath.Dec, len(rewards))
 	for i, reward := range rewards {
 		addresses[i] = reward.Address
 		rewardValues[i] = reward.Reward
+		commissionRates[i] = reward.CommissionRate
+		commissions[i] = reward.Commission
 	}
 	return &EventRewardsSettled{
-		ActorType:   actorType,
-		TopicId:     topicId,
-		BlockHeight: blockHeight,
-		Addresses:   addresses,
-		Rewards:     rewardValues,
+		ActorType:       actorType,
+		TopicId:         topicId,
+		BlockHeight:     blockHeight,
+		Addresses:       addresses,
+		Rewards:         rewardValues,
+		CommissionRates: commissionRates,
+		Commissions:     commissions,
 	}
 }
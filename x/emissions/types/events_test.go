@@ -17,6 +17,7 @@ const (
 	AttributeKeyAddresses   = "addresses"
 	AttributeKeyScores      = "scores"
 	AttributeKeyRewards     = "rewards"
+	AttributeKeyCommissions = "commissions"
 )
 
 func TestEmitNewInfererScoresSetEventWithScores(t *testing.T) {
@@ -220,7 +221,7 @@ func TestEmitNewInfererRewardsSettledEventWithRewards(t *testing.T) {
 	require.Equal(t, "emissions.v1.EventRewardsSettled", event.Type)
 
 	attributes := event.Attributes
-	require.Len(t, attributes, 5)
+	require.Len(t, attributes, 7)
 
 	val, exists := event.GetAttribute(AttributeKeyActorType)
 	require.True(t, exists)
@@ -277,7 +278,7 @@ func TestEmitNewForecasterRewardsSettledEventWithRewards(t *testing.T) {
 	require.Equal(t, "emissions.v1.EventRewardsSettled", event.Type)
 
 	attributes := event.Attributes
-	require.Len(t, attributes, 5)
+	require.Len(t, attributes, 7)
 
 	val, exists := event.GetAttribute(AttributeKeyActorType)
 	require.True(t, exists)
@@ -314,14 +315,18 @@ func TestEmitNewReputerAndDelegatorRewardsSettledEventWithRewards(t *testing.T)
 	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
 	rewards := []types.TaskReward{
 		{
-			TopicId: uint64(1),
-			Address: "address1",
-			Reward:  alloraMath.NewDecFromInt64(100),
+			TopicId:        uint64(1),
+			Address:        "address1",
+			Reward:         alloraMath.NewDecFromInt64(100),
+			CommissionRate: alloraMath.MustNewDecFromString("0.1"),
+			Commission:     alloraMath.NewDecFromInt64(5),
 		},
 		{
-			TopicId: uint64(1),
-			Address: "address2",
-			Reward:  alloraMath.NewDecFromInt64(200),
+			TopicId:        uint64(1),
+			Address:        "address2",
+			Reward:         alloraMath.NewDecFromInt64(200),
+			CommissionRate: alloraMath.ZeroDec(),
+			Commission:     alloraMath.ZeroDec(),
 		},
 	}
 
@@ -334,7 +339,7 @@ func TestEmitNewReputerAndDelegatorRewardsSettledEventWithRewards(t *testing.T)
 	require.Equal(t, "emissions.v1.EventRewardsSettled", event.Type)
 
 	attributes := event.Attributes
-	require.Len(t, attributes, 5)
+	require.Len(t, attributes, 7)
 
 	val, exists := event.GetAttribute(AttributeKeyActorType)
 	require.True(t, exists)
@@ -355,6 +360,10 @@ func TestEmitNewReputerAndDelegatorRewardsSettledEventWithRewards(t *testing.T)
 	val, exists = event.GetAttribute(AttributeKeyRewards)
 	require.True(t, exists)
 	require.Contains(t, val.GetValue(), `["100","200"]`)
+
+	val, exists = event.GetAttribute(AttributeKeyCommissions)
+	require.True(t, exists)
+	require.Contains(t, val.GetValue(), `["5","0"]`)
 }
 
 func TestEmitNewReputerAndDelegatorRewardsSettledEventWithNoRewards(t *testing.T) {
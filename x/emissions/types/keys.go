@@ -8,6 +8,7 @@ const (
 	AlloraStakingAccountName                   = "allorastaking"
 	AlloraRewardsAccountName                   = "allorarewards"
 	AlloraPendingRewardForDelegatorAccountName = "allorapendingrewards"
+	AlloraClaimableRewardsAccountName          = "alloraclaimablerewards"
 )
 
 var (
@@ -64,4 +65,28 @@ var (
 	TopicRewardNonceKey                         = collections.NewPrefix(49)
 	DelegateRewardPerShare                      = collections.NewPrefix(50)
 	PreviousPercentageRewardToStakedReputersKey = collections.NewPrefix(51)
+	WorkerCommitmentsKey                        = collections.NewPrefix(52)
+	PendingWorkerPayloadsKey                    = collections.NewPrefix(53)
+	PendingReputerPayloadsKey                   = collections.NewPrefix(54)
+	WorkerLivenessKey                           = collections.NewPrefix(55)
+	ReputerLivenessKey                          = collections.NewPrefix(56)
+	ScheduledParamsUpdatesKey                   = collections.NewPrefix(57)
+	TopicParamsKey                              = collections.NewPrefix(58)
+	TopicWorkerAllowlistKey                     = collections.NewPrefix(59)
+	TopicReputerAllowlistKey                    = collections.NewPrefix(60)
+	TopicCreatorAllowlistKey                    = collections.NewPrefix(61)
+	DelegatorAutoCompoundKey                    = collections.NewPrefix(62)
+	ReputerCommissionsKey                       = collections.NewPrefix(63)
+	RedelegationsKey                            = collections.NewPrefix(64)
+	ReputerStakePoolKey                         = collections.NewPrefix(65)
+	ReputerStakeAllocationsKey                  = collections.NewPrefix(66)
+	ReputerStakeAllocationDecreasesKey          = collections.NewPrefix(67)
+	ReputerStakePoolRemovalsKey                 = collections.NewPrefix(68)
+	TopicRewardReportsKey                       = collections.NewPrefix(69)
+	ClaimableRewardsKey                         = collections.NewPrefix(70)
+	RewardWithdrawAddressesKey                  = collections.NewPrefix(71)
+	PruningCursorKey                            = collections.NewPrefix(72)
+	RedelegationsToKey                          = collections.NewPrefix(73)
+	RedelegationsByBlockKey                     = collections.NewPrefix(74)
+	AutoCompoundPositionsKey                    = collections.NewPrefix(75)
 )
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"math/bits"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Records whether the actor missed the next fulfilled nonce within a window of the given size.
+// The record is reset if the window size changed to one that needs a bitmap of another length,
+// otherwise misses tracked beyond the new window are forgotten.
+func (l *ActorLiveness) RecordNonce(missed bool, window uint64) {
+	bitmapLength := int((window + 7) / 8)
+	if len(l.MissedEpochsBitmap) != bitmapLength {
+		l.IndexOffset = 0
+		l.MissedEpochsBitmap = make([]byte, bitmapLength)
+	}
+	if window%8 != 0 {
+		l.MissedEpochsBitmap[bitmapLength-1] &= byte(1)<<(window%8) - 1
+	}
+	l.MissedEpochsCounter = 0
+	for _, b := range l.MissedEpochsBitmap {
+		l.MissedEpochsCounter += uint64(bits.OnesCount8(b))
+	}
+
+	index := l.IndexOffset % window
+	mask := byte(1) << (index % 8)
+	previouslyMissed := l.MissedEpochsBitmap[index/8]&mask != 0
+	switch {
+	case missed && !previouslyMissed:
+		l.MissedEpochsBitmap[index/8] |= mask
+		l.MissedEpochsCounter++
+	case !missed && previouslyMissed:
+		l.MissedEpochsBitmap[index/8] &^= mask
+		l.MissedEpochsCounter--
+	}
+	l.IndexOffset++
+}
+
+// Jails the actor until the given block height and starts tracking its liveness afresh
+func (l *ActorLiveness) Jail(until BlockHeight) {
+	l.IndexOffset = 0
+	l.MissedEpochsCounter = 0
+	l.MissedEpochsBitmap = nil
+	l.JailedUntil = until
+}
+
+// True if the actor is still serving its jail duration at the given block height
+func (l *ActorLiveness) IsJailed(blockHeight BlockHeight) bool {
+	return blockHeight < l.JailedUntil
+}
+
+// Fraction of the nonces tracked within the window that the actor did not miss.
+// An actor with no tracked nonces has an uptime of 1.
+func (l *ActorLiveness) Uptime(window uint64) (alloraMath.Dec, error) {
+	tracked := l.IndexOffset
+	if tracked > window {
+		tracked = window
+	}
+	if tracked == 0 || l.MissedEpochsCounter > tracked {
+		return alloraMath.OneDec(), nil
+	}
+	live, err := alloraMath.NewDecFromUint64(tracked - l.MissedEpochsCounter)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	total, err := alloraMath.NewDecFromUint64(tracked)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return live.Quo(total)
+}
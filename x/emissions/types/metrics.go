@@ -0,0 +1,178 @@
+package types
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+)
+
+// Keys of the metrics the module exports through the telemetry of the node
+const (
+	MetricKeyActiveTopics             = "active_topics"
+	MetricKeyChurnableTopics          = "churnable_topics"
+	MetricKeyTopicWeight              = "topic_weight"
+	MetricKeyTopicFeeRevenue          = "topic_fee_revenue"
+	MetricKeyTopicStake               = "topic_stake"
+	MetricKeyUnfulfilledWorkerNonces  = "unfulfilled_worker_nonces"
+	MetricKeyUnfulfilledReputerNonces = "unfulfilled_reputer_nonces"
+	MetricKeyPayloadsAccepted         = "payloads_accepted"
+	MetricKeyPayloadsRejected         = "payloads_rejected"
+	MetricKeyRewards                  = "rewards"
+	MetricKeyEndBlocker               = "end_blocker"
+	MetricKeyBlocklessRequest         = "blockless_request"
+	MetricKeyPrunedRecords            = "pruned_records"
+)
+
+// Labels of the metrics and their values
+const (
+	MetricLabelTopicId    = "topic_id"
+	MetricLabelPayload    = "payload"
+	MetricLabelReason     = "reason"
+	MetricLabelTaskType   = "task_type"
+	MetricLabelRequest    = "request"
+	MetricLabelStatus     = "status"
+	MetricLabelCollection = "collection"
+
+	MetricPayloadInference          = "inference"
+	MetricPayloadForecast           = "forecast"
+	MetricPayloadReputerValueBundle = "reputer_value_bundle"
+
+	MetricRequestWorker  = "worker"
+	MetricRequestReputer = "reputer"
+
+	MetricStatusSuccess = "success"
+	MetricStatusFailure = "failure"
+)
+
+// Phases of the EndBlocker whose durations are measured
+const (
+	MetricPhaseScheduledParamsUpdates  = "scheduled_params_updates"
+	MetricPhaseTopicWeights            = "topic_weights"
+	MetricPhaseRewards                 = "rewards"
+	MetricPhaseChurn                   = "churn"
+	MetricPhasePendingPayloadsRequests = "pending_payloads_and_requests"
+	MetricPhasePruning                 = "pruning"
+)
+
+func topicLabel(topicId TopicId) metrics.Label {
+	return telemetry.NewLabel(MetricLabelTopicId, strconv.FormatUint(topicId, 10))
+}
+
+// Metrics only carry float32 values, precision is lost on large amounts
+func decMetricValue(x alloraMath.Dec) float32 {
+	val, err := strconv.ParseFloat(x.String(), 32)
+	if err != nil {
+		return 0
+	}
+	return float32(val)
+}
+
+func intMetricValue(x cosmosMath.Int) float32 {
+	if x.IsNil() {
+		return 0
+	}
+	val, _ := x.BigInt().Float64()
+	return float32(val)
+}
+
+// Sets the number of active topics and of the topics churned this block
+func SetTopicCountMetrics(activeTopics, churnableTopics int) {
+	telemetry.SetGauge(float32(activeTopics), ModuleName, MetricKeyActiveTopics)
+	telemetry.SetGauge(float32(churnableTopics), ModuleName, MetricKeyChurnableTopics)
+}
+
+// Sets the weight of a topic and the fee revenue it is weighted with
+func SetTopicWeightMetrics(topicId TopicId, weight alloraMath.Dec, feeRevenue cosmosMath.Int) {
+	labels := []metrics.Label{topicLabel(topicId)}
+	telemetry.SetGaugeWithLabels([]string{ModuleName, MetricKeyTopicWeight}, decMetricValue(weight), labels)
+	telemetry.SetGaugeWithLabels([]string{ModuleName, MetricKeyTopicFeeRevenue}, intMetricValue(feeRevenue), labels)
+}
+
+// Sets the stake of a topic and the number of its nonces workers and reputers have yet to fulfill
+func SetTopicStateMetrics(topicId TopicId, stake cosmosMath.Int, unfulfilledWorkerNonces, unfulfilledReputerNonces int) {
+	labels := []metrics.Label{topicLabel(topicId)}
+	telemetry.SetGaugeWithLabels([]string{ModuleName, MetricKeyTopicStake}, intMetricValue(stake), labels)
+	telemetry.SetGaugeWithLabels([]string{ModuleName, MetricKeyUnfulfilledWorkerNonces}, float32(unfulfilledWorkerNonces), labels)
+	telemetry.SetGaugeWithLabels([]string{ModuleName, MetricKeyUnfulfilledReputerNonces}, float32(unfulfilledReputerNonces), labels)
+}
+
+// Payloads verified while simulating a transaction are not counted, as they are verified again once it is delivered
+func countsPayloads(ctx context.Context) bool {
+	return sdk.UnwrapSDKContext(ctx).ExecMode() != sdk.ExecModeSimulate
+}
+
+func payloadMetricLabel(actorType ActorType) string {
+	switch actorType {
+	case ActorType_INFERER:
+		return MetricPayloadInference
+	case ActorType_FORECASTER:
+		return MetricPayloadForecast
+	default:
+		return MetricPayloadReputerValueBundle
+	}
+}
+
+// Counts the payloads accepted and rejected, by the reason they were rejected, when fulfilling a nonce of a topic
+func IncrPayloadStatusMetrics(ctx context.Context, topicId TopicId, statuses []*PayloadStatus) {
+	if !countsPayloads(ctx) {
+		return
+	}
+	for _, status := range statuses {
+		labels := []metrics.Label{topicLabel(topicId), telemetry.NewLabel(MetricLabelPayload, payloadMetricLabel(status.ActorType))}
+		if status.Accepted {
+			telemetry.IncrCounterWithLabels([]string{ModuleName, MetricKeyPayloadsAccepted}, 1, labels)
+			continue
+		}
+		labels = append(labels, telemetry.NewLabel(MetricLabelReason, strings.ToLower(status.Reason.String())))
+		telemetry.IncrCounterWithLabels([]string{ModuleName, MetricKeyPayloadsRejected}, 1, labels)
+	}
+}
+
+// Adds a reward paid out to the total paid out for its task type
+func IncrRewardsMetric(taskType TaskRewardType, reward alloraMath.Dec) {
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, MetricKeyRewards},
+		decMetricValue(reward),
+		[]metrics.Label{telemetry.NewLabel(MetricLabelTaskType, taskType.String())},
+	)
+}
+
+// Counts the records pruned from a collection
+func IncrPrunedRecordsMetric(collection string, pruned int) {
+	if pruned == 0 {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, MetricKeyPrunedRecords},
+		float32(pruned),
+		[]metrics.Label{telemetry.NewLabel(MetricLabelCollection, collection)},
+	)
+}
+
+// Measures the time a phase of the EndBlocker took since it started
+func MeasureEndBlockerPhase(phase string, start time.Time) {
+	telemetry.ModuleMeasureSince(ModuleName, start, MetricKeyEndBlocker, phase)
+}
+
+// Measures the latency of a call to the Blockless API making a request of workers or reputers
+func MeasureBlocklessRequest(request string, start time.Time, err error) {
+	status := MetricStatusSuccess
+	if err != nil {
+		status = MetricStatusFailure
+	}
+	metrics.MeasureSinceWithLabels(
+		[]string{ModuleName, MetricKeyBlocklessRequest},
+		start.UTC(),
+		[]metrics.Label{
+			telemetry.NewLabel(MetricLabelRequest, request),
+			telemetry.NewLabel(MetricLabelStatus, status),
+		},
+	)
+}
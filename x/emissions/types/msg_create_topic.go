@@ -31,6 +31,9 @@ func (msg *MsgCreateNewTopic) Validate() error {
 	if msg.EpochLength <= 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "epoch length must be greater than zero")
 	}
+	if msg.CommitRevealWindow < 0 || msg.CommitRevealWindow >= msg.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "commit reveal window must be non-negative and less than epoch length")
+	}
 	if msg.GroundTruthLag < 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "ground truth lag cannot be negative")
 	}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgInsertReputerPayload) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.ReputerRequestNonce == nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "reputer request nonce cannot be nil")
+	}
+	if msg.ReputerRequestNonce.WorkerNonce == nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "worker nonce cannot be nil")
+	}
+	if msg.ReputerRequestNonce.ReputerNonce == nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "reputer nonce cannot be nil")
+	}
+	if msg.ReputerValueBundle == nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "reputer value bundle cannot be nil")
+	}
+
+	if err := msg.ReputerValueBundle.Validate(); err != nil {
+		return err
+	}
+	valueBundle := msg.ReputerValueBundle.ValueBundle
+	if valueBundle.Reputer != msg.Sender {
+		return errors.Wrap(sdkerrors.ErrUnauthorized, "reputer value bundle must be submitted by its reputer")
+	}
+
+	// The bundle must be for the topic and nonces of the message
+	if valueBundle.TopicId != msg.TopicId ||
+		valueBundle.ReputerRequestNonce.ReputerNonce == nil ||
+		valueBundle.ReputerRequestNonce.WorkerNonce == nil ||
+		valueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != msg.ReputerRequestNonce.ReputerNonce.BlockHeight ||
+		valueBundle.ReputerRequestNonce.WorkerNonce.BlockHeight != msg.ReputerRequestNonce.WorkerNonce.BlockHeight {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer value bundle does not match topic or nonce")
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgInsertWorkerPayload) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.Nonce == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "nonce cannot be nil")
+	}
+
+	if err := msg.WorkerDataBundle.Validate(); err != nil {
+		return err
+	}
+	if msg.WorkerDataBundle.Worker != msg.Sender {
+		return errors.Wrap(sdkerrors.ErrUnauthorized, "worker data bundle must be submitted by its worker")
+	}
+
+	// The bundle must be for the topic and nonce of the message
+	inference := msg.WorkerDataBundle.InferenceForecastsBundle.Inference
+	if inference != nil && (inference.TopicId != msg.TopicId || inference.BlockHeight != msg.Nonce.BlockHeight) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference does not match topic or nonce")
+	}
+	forecast := msg.WorkerDataBundle.InferenceForecastsBundle.Forecast
+	if forecast != nil && (forecast.TopicId != msg.TopicId || forecast.BlockHeight != msg.Nonce.BlockHeight) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "forecast does not match topic or nonce")
+	}
+
+	return nil
+}
@@ -98,30 +98,107 @@ func (m *OffchainNode) GetNodeId() string {
 	return ""
 }
 
+// Liveness of a worker or reputer in a topic over the most recent fulfilled nonces
+type ActorLiveness struct {
+	IndexOffset         uint64 `protobuf:"varint,1,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	MissedEpochsCounter uint64 `protobuf:"varint,2,opt,name=missed_epochs_counter,json=missedEpochsCounter,proto3" json:"missed_epochs_counter,omitempty"`
+	MissedEpochsBitmap  []byte `protobuf:"bytes,3,opt,name=missed_epochs_bitmap,json=missedEpochsBitmap,proto3" json:"missed_epochs_bitmap,omitempty"`
+	JailedUntil         int64  `protobuf:"varint,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *ActorLiveness) Reset()         { *m = ActorLiveness{} }
+func (m *ActorLiveness) String() string { return proto.CompactTextString(m) }
+func (*ActorLiveness) ProtoMessage()    {}
+func (*ActorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c46ef77a30f1ab81, []int{1}
+}
+func (m *ActorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorLiveness.Merge(m, src)
+}
+func (m *ActorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorLiveness proto.InternalMessageInfo
+
+func (m *ActorLiveness) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ActorLiveness) GetMissedEpochsCounter() uint64 {
+	if m != nil {
+		return m.MissedEpochsCounter
+	}
+	return 0
+}
+
+func (m *ActorLiveness) GetMissedEpochsBitmap() []byte {
+	if m != nil {
+		return m.MissedEpochsBitmap
+	}
+	return nil
+}
+
+func (m *ActorLiveness) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OffchainNode)(nil), "emissions.v1.OffchainNode")
+	proto.RegisterType((*ActorLiveness)(nil), "emissions.v1.ActorLiveness")
 }
 
 func init() { proto.RegisterFile("emissions/v1/node.proto", fileDescriptor_c46ef77a30f1ab81) }
 
 var fileDescriptor_c46ef77a30f1ab81 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xcd, 0xcd, 0x2c,
-	0x2e, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x33, 0xd4, 0xcf, 0xcb, 0x4f, 0x49, 0xd5, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x4b, 0xe8, 0x95, 0x19, 0x2a, 0x2d, 0x65, 0xe4, 0xe2, 0xf1,
-	0x4f, 0x4b, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xf3, 0xcb, 0x4f, 0x49, 0x15, 0x92, 0xe3, 0xe2, 0xce,
-	0xc9, 0x4c, 0x8a, 0x2f, 0x30, 0x2a, 0x88, 0xcf, 0x4e, 0xad, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x0c, 0xe2, 0xcc, 0xc9, 0x4c, 0x0a, 0x30, 0x2a, 0xf0, 0x4e, 0xad, 0x14, 0x52, 0xe6, 0xe2, 0xcd,
-	0x2d, 0xcd, 0x29, 0xc9, 0x8c, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x02, 0xab,
-	0xe0, 0x01, 0x0b, 0x3a, 0x42, 0xc4, 0x84, 0x44, 0xb8, 0x58, 0xf3, 0xcb, 0xf3, 0x52, 0x8b, 0x24,
-	0x98, 0xc1, 0x92, 0x10, 0x8e, 0x90, 0x22, 0x17, 0x0f, 0xc8, 0x1d, 0x70, 0x9d, 0x2c, 0x60, 0x49,
-	0x6e, 0x90, 0x18, 0x4c, 0xa3, 0x38, 0x17, 0x3b, 0x58, 0x49, 0x66, 0x8a, 0x04, 0x2b, 0x58, 0x96,
-	0x0d, 0xc4, 0xf5, 0x4c, 0x71, 0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xc4, 0x9c, 0x9c,
-	0xfc, 0xa2, 0x44, 0xdd, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x18, 0x17, 0xec, 0x39, 0xfd,
-	0x0a, 0x7d, 0x44, 0x88, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc4, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0xad, 0x46, 0xcd, 0x2b, 0x01, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbd, 0x4e, 0xe3, 0x40,
+	0x10, 0xc7, 0xe3, 0xcb, 0xc7, 0x29, 0x6b, 0xa7, 0xd9, 0xcb, 0x29, 0xae, 0xac, 0x4b, 0xae, 0xb9,
+	0xe6, 0x62, 0x12, 0x1a, 0xda, 0x04, 0x51, 0x20, 0x10, 0x41, 0x96, 0x68, 0x68, 0x2c, 0xdb, 0x3b,
+	0x26, 0x4b, 0xec, 0x5d, 0xcb, 0xbb, 0xce, 0xc7, 0x5b, 0xf0, 0x12, 0x3c, 0x05, 0x2f, 0x40, 0x99,
+	0x92, 0x12, 0x25, 0x2f, 0x82, 0x3c, 0x56, 0x02, 0x94, 0xf3, 0xfb, 0xcd, 0x5f, 0xfa, 0x8f, 0x86,
+	0xf4, 0x20, 0xe5, 0x4a, 0x71, 0x29, 0x94, 0xbb, 0x1c, 0xb9, 0x42, 0x32, 0x18, 0x66, 0xb9, 0xd4,
+	0x92, 0x5a, 0x47, 0x31, 0x5c, 0x8e, 0x06, 0xcf, 0x06, 0xb1, 0x66, 0x71, 0x1c, 0xcd, 0x03, 0x2e,
+	0x6e, 0x24, 0x03, 0xea, 0x10, 0x33, 0xe1, 0xa1, 0x9f, 0x8d, 0x33, 0x7f, 0x01, 0x1b, 0xdb, 0xf8,
+	0x63, 0xfc, 0x6b, 0x7b, 0xed, 0x84, 0x87, 0xb7, 0xe3, 0xec, 0x0a, 0x36, 0xf4, 0x2f, 0xe9, 0xa4,
+	0x45, 0xa2, 0xb9, 0x1f, 0x30, 0x96, 0x83, 0x52, 0xf6, 0x0f, 0xdc, 0xb0, 0x10, 0x4e, 0x2a, 0x46,
+	0xbb, 0xa4, 0x29, 0x57, 0x02, 0x72, 0xbb, 0x8e, 0xb2, 0x1a, 0x68, 0x9f, 0x58, 0x65, 0x8f, 0x63,
+	0xb2, 0x81, 0xd2, 0x2c, 0xd9, 0x21, 0xd8, 0x23, 0x3f, 0x71, 0x85, 0x33, 0xbb, 0x89, 0xb6, 0x55,
+	0x8e, 0x97, 0x6c, 0xf0, 0x62, 0x90, 0xce, 0x24, 0xd2, 0x32, 0xbf, 0xe6, 0x4b, 0x10, 0xe5, 0x6a,
+	0x9f, 0x58, 0x5c, 0x30, 0x58, 0xfb, 0x32, 0x8e, 0x15, 0x68, 0x6c, 0xda, 0xf0, 0x4c, 0x64, 0x33,
+	0x44, 0x74, 0x4c, 0x7e, 0x97, 0xb7, 0x02, 0xf3, 0x21, 0x93, 0xd1, 0x5c, 0xf9, 0x91, 0x2c, 0x84,
+	0x86, 0x1c, 0x3b, 0x37, 0xbc, 0x5f, 0x95, 0xbc, 0x40, 0x77, 0x5e, 0x29, 0x7a, 0x42, 0xba, 0xdf,
+	0x33, 0x21, 0xd7, 0x69, 0x90, 0xe1, 0x25, 0x96, 0x47, 0xbf, 0x46, 0xa6, 0x68, 0xca, 0x22, 0x8f,
+	0x01, 0x4f, 0x80, 0xf9, 0x85, 0xd0, 0x3c, 0xc1, 0xb3, 0xea, 0x9e, 0x59, 0xb1, 0xbb, 0x12, 0x4d,
+	0xbd, 0xd7, 0x9d, 0x63, 0x6c, 0x77, 0x8e, 0xf1, 0xbe, 0x73, 0x8c, 0xa7, 0xbd, 0x53, 0xdb, 0xee,
+	0x9d, 0xda, 0xdb, 0xde, 0xa9, 0xdd, 0x9f, 0x3d, 0x70, 0x3d, 0x2f, 0xc2, 0x61, 0x24, 0x53, 0x37,
+	0x48, 0x12, 0x99, 0x07, 0xff, 0x05, 0xe8, 0x95, 0xcc, 0x17, 0x87, 0x11, 0x5f, 0xe3, 0xae, 0xdd,
+	0xcf, 0x7f, 0xea, 0x4d, 0x06, 0x2a, 0x6c, 0xe1, 0x3b, 0x4f, 0x3f, 0x06, 0x00, 0x2a, 0x6a, 0x60,
+	0x0d, 0xe9, 0x01, 0x00, 0x00,
 }
 
 func (m *OffchainNode) Marshal() (dAtA []byte, err error) {
@@ -182,6 +259,51 @@ func (m *OffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ActorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MissedEpochsBitmap) > 0 {
+		i -= len(m.MissedEpochsBitmap)
+		copy(dAtA[i:], m.MissedEpochsBitmap)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.MissedEpochsBitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MissedEpochsCounter != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.MissedEpochsCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNode(v)
 	base := offset
@@ -222,6 +344,28 @@ func (m *OffchainNode) Size() (n int) {
 	return n
 }
 
+func (m *ActorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexOffset != 0 {
+		n += 1 + sovNode(uint64(m.IndexOffset))
+	}
+	if m.MissedEpochsCounter != 0 {
+		n += 1 + sovNode(uint64(m.MissedEpochsCounter))
+	}
+	l = len(m.MissedEpochsBitmap)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovNode(uint64(m.JailedUntil))
+	}
+	return n
+}
+
 func sovNode(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -438,6 +582,147 @@ func (m *OffchainNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ActorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochsCounter", wireType)
+			}
+			m.MissedEpochsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochsCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochsBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedEpochsBitmap = append(m.MissedEpochsBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedEpochsBitmap == nil {
+				m.MissedEpochsBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNode(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,7 @@
 package types
 
 import (
-	"fmt"
-
+	errorsmod "cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 )
@@ -50,132 +49,245 @@ func DefaultParams() Params {
 		FTolerance:                      alloraMath.MustNewDecFromString("0.01"),   // fiducial value for rewards calculation
 		CNorm:                           alloraMath.MustNewDecFromString("0.75"),   // fiducial value for inference synthesis
 		TopicFeeRevenueDecayRate:        alloraMath.MustNewDecFromString("0.025"),  // rate at which topic fee revenue decays over time
+		LivenessWindow:                  uint64(100),                               // number of most recent fulfilled nonces over which actor liveness is tracked
+		MaxMissedEpochs:                 uint64(50),                                // actors that miss more than this many nonces within the liveness window are jailed
+		JailDuration:                    int64(17280),                              // ~1 day at 5 second blocks before a jailed actor may unjail
+		MinTopicParams:                  DefaultMinTopicParams(),                   // lowest values topic creators may override params with
+		MaxTopicParams:                  DefaultMaxTopicParams(),                   // highest values topic creators may override params with
+		TopicCreatorAllowlistEnabled:    false,                                     // anyone able to pay the topic creation fee may create topics
+		WorkerRewardsClaimable:          false,                                     // worker rewards are sent to workers as they are paid out
+		Pruning:                         DefaultPruningParams(),                    // how long historical records are kept before they are pruned
+		MinAdminParamsUpdateDelay:       int64(17280),                              // ~1 day at 5 second blocks between a params update of a whitelist admin and its effect
+	}
+}
+
+// Scores at each block are kept for twice the samples used to scale scores. The latest regrets and scores of
+// actors are kept forever, as pruning them resets an actor that comes back after a while to a new actor.
+func DefaultPruningParams() PruningParams {
+	return PruningParams{
+		MaxRecordsPerBlock: uint64(100),
+		InfererScores:      Retention{Epochs: 20},
+		ForecasterScores:   Retention{Epochs: 20},
+		ReputerScores:      Retention{Epochs: 20},
+		Regrets:            Retention{},
+		LatestScores:       Retention{},
+	}
+}
+
+// Topics may lower these params down to these values
+func DefaultMinTopicParams() TopicParams {
+	return TopicParams{
+		MaxTopInferersToReward:       []uint64{1},
+		MaxTopReputersToReward:       []uint64{1},
+		RequiredMinimumStake:         []cosmosMath.Int{cosmosMath.NewInt(100)},
+		RegistrationFee:              []cosmosMath.Int{cosmosMath.NewInt(10)},
+		BetaEntropy:                  []alloraMath.Dec{alloraMath.MustNewDecFromString("0.1")},
+		PRewardInference:             []alloraMath.Dec{alloraMath.NewDecFromInt64(1)},
+		MaxUnfulfilledWorkerRequests: []uint64{1},
+	}
+}
+
+// Topics may raise these params up to these values
+func DefaultMaxTopicParams() TopicParams {
+	return TopicParams{
+		MaxTopInferersToReward:       []uint64{96},
+		MaxTopReputersToReward:       []uint64{24},
+		RequiredMinimumStake:         []cosmosMath.Int{cosmosMath.NewInt(1000000)},
+		RegistrationFee:              []cosmosMath.Int{cosmosMath.NewInt(1000000)},
+		BetaEntropy:                  []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")},
+		PRewardInference:             []alloraMath.Dec{alloraMath.NewDecFromInt64(10)},
+		MaxUnfulfilledWorkerRequests: []uint64{100},
 	}
 }
 
 // Validate does the sanity check on the params.
 func (p Params) Validate() error {
 	if err := validateVersion(p.Version); err != nil {
-		return err
+		return errorsmod.Wrap(err, "Version")
 	}
 	if err := validateMinTopicWeight(p.MinTopicWeight); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MinTopicWeight")
 	}
 	if err := validateMaxTopicsPerBlock(p.MaxTopicsPerBlock); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxTopicsPerBlock")
 	}
 	if err := validateRequiredMinimumStake(p.RequiredMinimumStake); err != nil {
-		return err
+		return errorsmod.Wrap(err, "RequiredMinimumStake")
 	}
 	if err := validateRemoveStakeDelayWindow(p.RemoveStakeDelayWindow); err != nil {
-		return err
+		return errorsmod.Wrap(err, "RemoveStakeDelayWindow")
 	}
 	if err := validateMinEpochLength(p.MinEpochLength); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MinEpochLength")
 	}
 	if err := validateBetaEntropy(p.BetaEntropy); err != nil {
-		return err
+		return errorsmod.Wrap(err, "BetaEntropy")
 	}
 	if err := validateLearningRate(p.LearningRate); err != nil {
-		return err
+		return errorsmod.Wrap(err, "LearningRate")
 	}
 	if err := validateGradientDescentMaxIters(p.GradientDescentMaxIters); err != nil {
-		return err
+		return errorsmod.Wrap(err, "GradientDescentMaxIters")
 	}
 	if err := validateMaxGradientThreshold(p.MaxGradientThreshold); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxGradientThreshold")
 	}
 	if err := validateMinStakeFraction(p.MinStakeFraction); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MinStakeFraction")
 	}
 	if err := validateEpsilon(p.Epsilon); err != nil {
-		return err
+		return errorsmod.Wrap(err, "Epsilon")
 	}
 	if err := validateMaxUnfulfilledWorkerRequests(p.MaxUnfulfilledWorkerRequests); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxUnfulfilledWorkerRequests")
 	}
 	if err := validateMaxUnfulfilledReputerRequests(p.MaxUnfulfilledReputerRequests); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxUnfulfilledReputerRequests")
 	}
 	if err := validateTopicRewardStakeImportance(p.TopicRewardStakeImportance); err != nil {
-		return err
+		return errorsmod.Wrap(err, "TopicRewardStakeImportance")
 	}
 	if err := validateTopicRewardFeeRevenueImportance(p.TopicRewardFeeRevenueImportance); err != nil {
-		return err
+		return errorsmod.Wrap(err, "TopicRewardFeeRevenueImportance")
 	}
 	if err := validateTopicRewardAlpha(p.TopicRewardAlpha); err != nil {
-		return err
+		return errorsmod.Wrap(err, "TopicRewardAlpha")
 	}
 	if err := validateTaskRewardAlpha(p.TaskRewardAlpha); err != nil {
-		return err
+		return errorsmod.Wrap(err, "TaskRewardAlpha")
 	}
 	if err := validateValidatorsVsAlloraPercentReward(p.ValidatorsVsAlloraPercentReward); err != nil {
-		return err
+		return errorsmod.Wrap(err, "ValidatorsVsAlloraPercentReward")
 	}
 	if err := validateMaxSamplesToScaleScores(p.MaxSamplesToScaleScores); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxSamplesToScaleScores")
 	}
 	if err := validateMaxTopInferersToReward(p.MaxTopInferersToReward); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxTopInferersToReward")
 	}
 	if err := validateMaxTopForecastersToReward(p.MaxTopForecastersToReward); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxTopForecastersToReward")
 	}
 	if err := validateMaxTopReputersToReward(p.MaxTopReputersToReward); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxTopReputersToReward")
 	}
 	if err := validateCreateTopicFee(p.CreateTopicFee); err != nil {
-		return err
+		return errorsmod.Wrap(err, "CreateTopicFee")
 	}
 	if err := validateMaxRetriesToFulfilNoncesWorker(p.MaxRetriesToFulfilNoncesWorker); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxRetriesToFulfilNoncesWorker")
 	}
 	if err := validateMaxRetriesToFulfilNoncesReputer(p.MaxRetriesToFulfilNoncesReputer); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxRetriesToFulfilNoncesReputer")
 	}
 	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
-		return err
+		return errorsmod.Wrap(err, "RegistrationFee")
 	}
 	if err := validateDefaultPageLimit(p.DefaultPageLimit); err != nil {
-		return err
+		return errorsmod.Wrap(err, "DefaultPageLimit")
 	}
 	if err := validateMaxPageLimit(p.MaxPageLimit); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxPageLimit")
 	}
 	if err := validateMinEpochLengthRecordLimit(p.MinEpochLengthRecordLimit); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MinEpochLengthRecordLimit")
 	}
 	if err := validateMaxSerializedMsgLength(p.MaxSerializedMsgLength); err != nil {
-		return err
+		return errorsmod.Wrap(err, "MaxSerializedMsgLength")
 	}
 	if err := validateBlocksPerMonth(p.BlocksPerMonth); err != nil {
-		return err
+		return errorsmod.Wrap(err, "BlocksPerMonth")
 	}
 	if err := validatePRewardInference(p.PRewardInference); err != nil {
-		return err
+		return errorsmod.Wrap(err, "PRewardInference")
 	}
 	if err := validatePRewardForecast(p.PRewardForecast); err != nil {
-		return err
+		return errorsmod.Wrap(err, "PRewardForecast")
 	}
 	if err := validatePRewardReputer(p.PRewardReputer); err != nil {
-		return err
+		return errorsmod.Wrap(err, "PRewardReputer")
 	}
 	if err := validateCRewardInference(p.CRewardInference); err != nil {
-		return err
+		return errorsmod.Wrap(err, "CRewardInference")
 	}
 	if err := validateCRewardForecast(p.CRewardForecast); err != nil {
-		return err
+		return errorsmod.Wrap(err, "CRewardForecast")
 	}
 	if err := validateFTolerance(p.FTolerance); err != nil {
-		return err
+		return errorsmod.Wrap(err, "FTolerance")
 	}
 	if err := validateCNorm(p.CNorm); err != nil {
-		return err
+		return errorsmod.Wrap(err, "CNorm")
 	}
 	if err := validateTopicFeeRevenueDecayRate(p.TopicFeeRevenueDecayRate); err != nil {
+		return errorsmod.Wrap(err, "TopicFeeRevenueDecayRate")
+	}
+	if err := validateLivenessWindow(p.LivenessWindow); err != nil {
+		return errorsmod.Wrap(err, "LivenessWindow")
+	}
+	if err := validateMaxMissedEpochs(p.MaxMissedEpochs); err != nil {
+		return errorsmod.Wrap(err, "MaxMissedEpochs")
+	}
+	if err := validateJailDuration(p.JailDuration); err != nil {
+		return errorsmod.Wrap(err, "JailDuration")
+	}
+	if err := validateMinAdminParamsUpdateDelay(p.MinAdminParamsUpdateDelay); err != nil {
+		return errorsmod.Wrap(err, "MinAdminParamsUpdateDelay")
+	}
+
+	if err := validateTopicParamsBounds(p.MinTopicParams, p.MaxTopicParams); err != nil {
 		return err
 	}
+	if err := p.Pruning.Validate(); err != nil {
+		return errorsmod.Wrap(err, "Pruning")
+	}
 
+	return p.validateConsistency()
+}
+
+// Constraints between params that are each valid on their own
+func (p Params) validateConsistency() error {
+	if p.DefaultPageLimit > p.MaxPageLimit {
+		return errorsmod.Wrapf(ErrValidationParamsInconsistent,
+			"DefaultPageLimit %d exceeds MaxPageLimit %d", p.DefaultPageLimit, p.MaxPageLimit)
+	}
+	// Only this many worker nonces are kept, so requesting more of them could never be fulfilled
+	if uint64(p.MaxRetriesToFulfilNoncesWorker) > p.MaxUnfulfilledWorkerRequests {
+		return errorsmod.Wrapf(ErrValidationParamsInconsistent,
+			"MaxRetriesToFulfilNoncesWorker %d exceeds MaxUnfulfilledWorkerRequests %d",
+			p.MaxRetriesToFulfilNoncesWorker, p.MaxUnfulfilledWorkerRequests)
+	}
+	if uint64(p.MaxRetriesToFulfilNoncesReputer) > p.MaxUnfulfilledReputerRequests {
+		return errorsmod.Wrapf(ErrValidationParamsInconsistent,
+			"MaxRetriesToFulfilNoncesReputer %d exceeds MaxUnfulfilledReputerRequests %d",
+			p.MaxRetriesToFulfilNoncesReputer, p.MaxUnfulfilledReputerRequests)
+	}
+	// Reputers retry the oldest unfulfilled nonces, whose inferences and forecasts must not have been pruned yet
+	if p.MinEpochLengthRecordLimit < p.MaxRetriesToFulfilNoncesReputer {
+		return errorsmod.Wrapf(ErrValidationParamsInconsistent,
+			"MinEpochLengthRecordLimit %d is below MaxRetriesToFulfilNoncesReputer %d",
+			p.MinEpochLengthRecordLimit, p.MaxRetriesToFulfilNoncesReputer)
+	}
+	// Scaling scores reads back this many past scores, at least one for each block that was scored.
+	// A retention in blocks must hold them for topics of the shortest epoch length.
+	minScoreBlocks := int64(p.MaxSamplesToScaleScores) * p.MinEpochLength
+	for _, retention := range []Retention{p.Pruning.InfererScores, p.Pruning.ForecasterScores} {
+		if retention.Epochs > 0 && retention.Epochs < p.MaxSamplesToScaleScores {
+			return errorsmod.Wrapf(ErrValidationParamsInconsistent,
+				"retention of %d epochs of scores is below MaxSamplesToScaleScores %d",
+				retention.Epochs, p.MaxSamplesToScaleScores)
+		}
+		if retention.Blocks > 0 && retention.Blocks < minScoreBlocks {
+			return errorsmod.Wrapf(ErrValidationParamsInconsistent,
+				"retention of %d blocks of scores is below MaxSamplesToScaleScores %d epochs of MinEpochLength %d",
+				retention.Blocks, p.MaxSamplesToScaleScores, p.MinEpochLength)
+		}
+	}
+	// The missed epochs counter can never exceed the window, so no actor would ever be jailed
+	if p.LivenessWindow > 0 && p.MaxMissedEpochs >= p.LivenessWindow {
+		return errorsmod.Wrapf(ErrValidationParamsInconsistent,
+			"MaxMissedEpochs %d must be below LivenessWindow %d", p.MaxMissedEpochs, p.LivenessWindow)
+	}
 	return nil
 }
 
@@ -202,8 +314,11 @@ func validateMinTopicWeight(i alloraMath.Dec) error {
 }
 
 // Max number of topics to run cadence for per block.
-// Should be >= 0, uint enforces this.
-func validateMaxTopicsPerBlock(_ uint64) error {
+// Should be > 0, otherwise no topic is ever churned.
+func validateMaxTopicsPerBlock(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
 	return nil
 }
 
@@ -352,14 +467,20 @@ func validateCNorm(i alloraMath.Dec) error {
 }
 
 // maximum number of outstanding nonces for worker requests per topic from the chain
-// Should be zero or positive. Enforced by uint type
-func validateMaxUnfulfilledWorkerRequests(_ uint64) error {
+// Should be > 0, otherwise no worker nonce is ever kept.
+func validateMaxUnfulfilledWorkerRequests(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
 	return nil
 }
 
 // maximum number of outstanding nonces for reputer requests per topic from the chain
-// Should be zero or positive. Enforced by uint type
-func validateMaxUnfulfilledReputerRequests(_ uint64) error {
+// Should be > 0, otherwise no reputer nonce is ever kept.
+func validateMaxUnfulfilledReputerRequests(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
 	return nil
 }
 
@@ -472,14 +593,20 @@ func validateRegistrationFee(i cosmosMath.Int) error {
 }
 
 // default limit for pagination
-// should be non-negative, enforced by uint type
-func validateDefaultPageLimit(_ uint64) error {
+// Should be > 0, otherwise pages without an explicit limit are empty.
+func validateDefaultPageLimit(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
 	return nil
 }
 
 // max limit for pagination
-// should be non-negative, enforced by uint type
-func validateMaxPageLimit(_ uint64) error {
+// Should be > 0.
+func validateMaxPageLimit(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
 	return nil
 }
 
@@ -493,19 +620,19 @@ func validateMinEpochLengthRecordLimit(i int64) error {
 }
 
 // maximum size of data to msg and query server in bytes
-// Should be non-negative.
+// Should be > 0, otherwise every message is rejected.
 func validateMaxSerializedMsgLength(i int64) error {
-	if i < 0 {
+	if i <= 0 {
 		return ErrValidationMustBeGreaterthanZero
 	}
 	return nil
 }
 
 // Number of blocks in a month.
-// should be a number on the order of 525,960
+// should be a number on the order of 525,960. Block times are derived from it, so it must be > 0.
 func validateBlocksPerMonth(i uint64) error {
 	if i == 0 {
-		return fmt.Errorf("blocks per month must be positive: %d", i)
+		return ErrValidationMustBeGreaterthanZero
 	}
 	return nil
 }
@@ -519,6 +646,36 @@ func validateTopicFeeRevenueDecayRate(i alloraMath.Dec) error {
 	return nil
 }
 
+// number of most recent fulfilled nonces over which actor liveness is tracked, 0 disables liveness tracking
+// Should be zero or positive. Enforced by uint type
+func validateLivenessWindow(_ uint64) error {
+	return nil
+}
+
+// actors that miss more than this many nonces within the liveness window are jailed
+// Should be zero or positive. Enforced by uint type
+func validateMaxMissedEpochs(_ uint64) error {
+	return nil
+}
+
+// number of blocks a jailed actor must wait before unjailing
+// Should be non-negative.
+func validateJailDuration(i int64) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// blocks ahead whitelist admins must schedule params updates
+// Should be positive, so that only the authority may update params at once.
+func validateMinAdminParamsUpdateDelay(i int64) error {
+	if i <= 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
 // Whether an alloraDec is between the value of [0, 1] inclusive
 func isAlloraDecBetweenZeroAndOneInclusive(a alloraMath.Dec) bool {
 	return a.Gte(alloraMath.ZeroDec()) && a.Lte(alloraMath.OneDec())
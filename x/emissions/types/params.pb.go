@@ -68,15 +68,24 @@ type Params struct {
 	// min number of epochs to keep network losses, reputer losses, inferences, forecasts
 	MinEpochLengthRecordLimit int64 `protobuf:"varint,31,opt,name=min_epoch_length_record_limit,json=minEpochLengthRecordLimit,proto3" json:"min_epoch_length_record_limit,omitempty"`
 	// block emission rate in number of blocks expected per month
-	BlocksPerMonth           uint64                                          `protobuf:"varint,32,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
-	PRewardInference         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,33,opt,name=p_reward_inference,json=pRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_inference"`
-	PRewardForecast          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,34,opt,name=p_reward_forecast,json=pRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_forecast"`
-	PRewardReputer           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,35,opt,name=p_reward_reputer,json=pRewardReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_reputer"`
-	CRewardInference         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,36,opt,name=c_reward_inference,json=cRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_inference"`
-	CRewardForecast          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,37,opt,name=c_reward_forecast,json=cRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_forecast"`
-	FTolerance               github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,38,opt,name=f_tolerance,json=fTolerance,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"f_tolerance"`
-	CNorm                    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,39,opt,name=c_norm,json=cNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_norm"`
-	TopicFeeRevenueDecayRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,40,opt,name=topic_fee_revenue_decay_rate,json=topicFeeRevenueDecayRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_fee_revenue_decay_rate"`
+	BlocksPerMonth               uint64                                          `protobuf:"varint,32,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
+	PRewardInference             github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,33,opt,name=p_reward_inference,json=pRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_inference"`
+	PRewardForecast              github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,34,opt,name=p_reward_forecast,json=pRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_forecast"`
+	PRewardReputer               github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,35,opt,name=p_reward_reputer,json=pRewardReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_reputer"`
+	CRewardInference             github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,36,opt,name=c_reward_inference,json=cRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_inference"`
+	CRewardForecast              github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,37,opt,name=c_reward_forecast,json=cRewardForecast,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_reward_forecast"`
+	FTolerance                   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,38,opt,name=f_tolerance,json=fTolerance,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"f_tolerance"`
+	CNorm                        github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,39,opt,name=c_norm,json=cNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"c_norm"`
+	TopicFeeRevenueDecayRate     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,40,opt,name=topic_fee_revenue_decay_rate,json=topicFeeRevenueDecayRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_fee_revenue_decay_rate"`
+	LivenessWindow               uint64                                          `protobuf:"varint,41,opt,name=liveness_window,json=livenessWindow,proto3" json:"liveness_window,omitempty"`
+	MaxMissedEpochs              uint64                                          `protobuf:"varint,42,opt,name=max_missed_epochs,json=maxMissedEpochs,proto3" json:"max_missed_epochs,omitempty"`
+	JailDuration                 int64                                           `protobuf:"varint,43,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	MinTopicParams               TopicParams                                     `protobuf:"bytes,44,opt,name=min_topic_params,json=minTopicParams,proto3" json:"min_topic_params"`
+	MaxTopicParams               TopicParams                                     `protobuf:"bytes,45,opt,name=max_topic_params,json=maxTopicParams,proto3" json:"max_topic_params"`
+	TopicCreatorAllowlistEnabled bool                                            `protobuf:"varint,46,opt,name=topic_creator_allowlist_enabled,json=topicCreatorAllowlistEnabled,proto3" json:"topic_creator_allowlist_enabled,omitempty"`
+	WorkerRewardsClaimable       bool                                            `protobuf:"varint,47,opt,name=worker_rewards_claimable,json=workerRewardsClaimable,proto3" json:"worker_rewards_claimable,omitempty"`
+	Pruning                      PruningParams                                   `protobuf:"bytes,48,opt,name=pruning,proto3" json:"pruning"`
+	MinAdminParamsUpdateDelay    int64                                           `protobuf:"varint,49,opt,name=min_admin_params_update_delay,json=minAdminParamsUpdateDelay,proto3" json:"min_admin_params_update_delay,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -238,90 +247,395 @@ func (m *Params) GetBlocksPerMonth() uint64 {
 	return 0
 }
 
+func (m *Params) GetLivenessWindow() uint64 {
+	if m != nil {
+		return m.LivenessWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedEpochs() uint64 {
+	if m != nil {
+		return m.MaxMissedEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() int64 {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMinTopicParams() TopicParams {
+	if m != nil {
+		return m.MinTopicParams
+	}
+	return TopicParams{}
+}
+
+func (m *Params) GetMaxTopicParams() TopicParams {
+	if m != nil {
+		return m.MaxTopicParams
+	}
+	return TopicParams{}
+}
+
+func (m *Params) GetTopicCreatorAllowlistEnabled() bool {
+	if m != nil {
+		return m.TopicCreatorAllowlistEnabled
+	}
+	return false
+}
+
+func (m *Params) GetWorkerRewardsClaimable() bool {
+	if m != nil {
+		return m.WorkerRewardsClaimable
+	}
+	return false
+}
+
+func (m *Params) GetPruning() PruningParams {
+	if m != nil {
+		return m.Pruning
+	}
+	return PruningParams{}
+}
+
+func (m *Params) GetMinAdminParamsUpdateDelay() int64 {
+	if m != nil {
+		return m.MinAdminParamsUpdateDelay
+	}
+	return 0
+}
+
+// How long the records of a collection are kept, counted back from the current block.
+// At most one of the two fields may be set; with neither set the records are kept forever.
+type Retention struct {
+	Epochs uint64 `protobuf:"varint,1,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	Blocks int64  `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *Retention) Reset()         { *m = Retention{} }
+func (m *Retention) String() string { return proto.CompactTextString(m) }
+func (*Retention) ProtoMessage()    {}
+func (*Retention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f535e53c00d44458, []int{1}
+}
+func (m *Retention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Retention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Retention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Retention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retention.Merge(m, src)
+}
+func (m *Retention) XXX_Size() int {
+	return m.Size()
+}
+func (m *Retention) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retention proto.InternalMessageInfo
+
+func (m *Retention) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+func (m *Retention) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// Retention of the historical records that are pruned incrementally at the end of every block.
+// Inferences, forecasts and loss bundles are pruned when their topic is rewarded instead, see min_epoch_length_record_limit.
+type PruningParams struct {
+	// most records visited by the pruner in a block, 0 disables pruning
+	MaxRecordsPerBlock uint64 `protobuf:"varint,1,opt,name=max_records_per_block,json=maxRecordsPerBlock,proto3" json:"max_records_per_block,omitempty"`
+	// scores of the inferers, forecasters and reputers at each block
+	InfererScores    Retention `protobuf:"bytes,2,opt,name=inferer_scores,json=infererScores,proto3" json:"inferer_scores"`
+	ForecasterScores Retention `protobuf:"bytes,3,opt,name=forecaster_scores,json=forecasterScores,proto3" json:"forecaster_scores"`
+	ReputerScores    Retention `protobuf:"bytes,4,opt,name=reputer_scores,json=reputerScores,proto3" json:"reputer_scores"`
+	// latest regrets of workers, pruned once they were last updated longer ago than this
+	Regrets Retention `protobuf:"bytes,5,opt,name=regrets,proto3" json:"regrets"`
+	// latest score of each actor, along with its previous reward fraction and listening coefficient,
+	// pruned once the actor was last scored longer ago than this
+	LatestScores Retention `protobuf:"bytes,6,opt,name=latest_scores,json=latestScores,proto3" json:"latest_scores"`
+}
+
+func (m *PruningParams) Reset()         { *m = PruningParams{} }
+func (m *PruningParams) String() string { return proto.CompactTextString(m) }
+func (*PruningParams) ProtoMessage()    {}
+func (*PruningParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f535e53c00d44458, []int{2}
+}
+func (m *PruningParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruningParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruningParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruningParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruningParams.Merge(m, src)
+}
+func (m *PruningParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruningParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruningParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruningParams proto.InternalMessageInfo
+
+func (m *PruningParams) GetMaxRecordsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRecordsPerBlock
+	}
+	return 0
+}
+
+func (m *PruningParams) GetInfererScores() Retention {
+	if m != nil {
+		return m.InfererScores
+	}
+	return Retention{}
+}
+
+func (m *PruningParams) GetForecasterScores() Retention {
+	if m != nil {
+		return m.ForecasterScores
+	}
+	return Retention{}
+}
+
+func (m *PruningParams) GetReputerScores() Retention {
+	if m != nil {
+		return m.ReputerScores
+	}
+	return Retention{}
+}
+
+func (m *PruningParams) GetRegrets() Retention {
+	if m != nil {
+		return m.Regrets
+	}
+	return Retention{}
+}
+
+func (m *PruningParams) GetLatestScores() Retention {
+	if m != nil {
+		return m.LatestScores
+	}
+	return Retention{}
+}
+
+// Overrides of the global params for a single topic. Every field is a repeated field
+// with at most one element, an empty field keeps the global value (see OptionalParams).
+// As bounds, a field may only be overridden if both its lowest and highest values are set.
+type TopicParams struct {
+	MaxTopInferersToReward       []uint64                                          `protobuf:"varint,1,rep,packed,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopReputersToReward       []uint64                                          `protobuf:"varint,2,rep,packed,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	RequiredMinimumStake         []cosmossdk_io_math.Int                           `protobuf:"bytes,3,rep,name=required_minimum_stake,json=requiredMinimumStake,proto3,customtype=cosmossdk.io/math.Int" json:"required_minimum_stake"`
+	RegistrationFee              []cosmossdk_io_math.Int                           `protobuf:"bytes,4,rep,name=registration_fee,json=registrationFee,proto3,customtype=cosmossdk.io/math.Int" json:"registration_fee"`
+	BetaEntropy                  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,rep,name=beta_entropy,json=betaEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"beta_entropy"`
+	PRewardInference             []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,rep,name=p_reward_inference,json=pRewardInference,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_reward_inference"`
+	MaxUnfulfilledWorkerRequests []uint64                                          `protobuf:"varint,7,rep,packed,name=max_unfulfilled_worker_requests,json=maxUnfulfilledWorkerRequests,proto3" json:"max_unfulfilled_worker_requests,omitempty"`
+}
+
+func (m *TopicParams) Reset()         { *m = TopicParams{} }
+func (m *TopicParams) String() string { return proto.CompactTextString(m) }
+func (*TopicParams) ProtoMessage()    {}
+func (*TopicParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f535e53c00d44458, []int{3}
+}
+func (m *TopicParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicParams.Merge(m, src)
+}
+func (m *TopicParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicParams proto.InternalMessageInfo
+
+func (m *TopicParams) GetMaxTopInferersToReward() []uint64 {
+	if m != nil {
+		return m.MaxTopInferersToReward
+	}
+	return nil
+}
+
+func (m *TopicParams) GetMaxTopReputersToReward() []uint64 {
+	if m != nil {
+		return m.MaxTopReputersToReward
+	}
+	return nil
+}
+
+func (m *TopicParams) GetMaxUnfulfilledWorkerRequests() []uint64 {
+	if m != nil {
+		return m.MaxUnfulfilledWorkerRequests
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "emissions.v1.Params")
+	proto.RegisterType((*Retention)(nil), "emissions.v1.Retention")
+	proto.RegisterType((*PruningParams)(nil), "emissions.v1.PruningParams")
+	proto.RegisterType((*TopicParams)(nil), "emissions.v1.TopicParams")
 }
 
 func init() { proto.RegisterFile("emissions/v1/params.proto", fileDescriptor_f535e53c00d44458) }
 
 var fileDescriptor_f535e53c00d44458 = []byte{
-	// 1208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0x1c, 0x35,
-	0x14, 0xce, 0xd2, 0x36, 0xa5, 0x6e, 0x9a, 0x26, 0x26, 0x4d, 0xbd, 0x69, 0xb3, 0x09, 0x6d, 0x81,
-	0x08, 0xd1, 0x2c, 0x15, 0x07, 0x7e, 0x1e, 0x68, 0x95, 0xa6, 0x8a, 0x68, 0xaa, 0x30, 0x09, 0x14,
-	0x15, 0x24, 0xcb, 0xf1, 0xbc, 0xdd, 0xb5, 0x32, 0xb6, 0x07, 0xdb, 0xbb, 0xd9, 0xf4, 0xce, 0x9d,
-	0x3f, 0x83, 0x23, 0x07, 0xfe, 0x88, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x85, 0xda, 0x03, 0x67, 0xfe,
-	0x03, 0xe4, 0x1f, 0xb3, 0xd9, 0x4d, 0x01, 0xa1, 0xce, 0x25, 0xca, 0xf8, 0x7d, 0xfe, 0xbe, 0x37,
-	0xdf, 0x3c, 0xbf, 0xe7, 0x45, 0x4d, 0x90, 0xc2, 0x5a, 0xa1, 0x95, 0x6d, 0x0f, 0x6e, 0xb5, 0x4b,
-	0x66, 0x98, 0xb4, 0xeb, 0xa5, 0xd1, 0x4e, 0xe3, 0x99, 0x51, 0x68, 0x7d, 0x70, 0x6b, 0xa9, 0xc9,
-	0xb5, 0x95, 0xda, 0xd2, 0x10, 0x6b, 0xc7, 0x87, 0x08, 0x5c, 0x9a, 0x67, 0x52, 0x28, 0xdd, 0x0e,
-	0x7f, 0xd3, 0xd2, 0x42, 0x57, 0x77, 0x75, 0x84, 0xfa, 0xff, 0xe2, 0xea, 0xb5, 0xbf, 0x9a, 0x68,
-	0x7a, 0x27, 0x48, 0x60, 0x82, 0xce, 0x0e, 0xc0, 0x78, 0x76, 0xd2, 0x58, 0x6d, 0xac, 0x9d, 0xcb,
-	0xaa, 0x47, 0xfc, 0x31, 0x6a, 0x4a, 0x36, 0xa4, 0x16, 0x8c, 0x60, 0x85, 0x78, 0x0c, 0x39, 0x95,
-	0xb6, 0x4b, 0x0b, 0x50, 0x5d, 0xd7, 0x23, 0xaf, 0xad, 0x36, 0xd6, 0x4e, 0x65, 0x8b, 0x92, 0x0d,
-	0x77, 0x47, 0xf1, 0x6d, 0xdb, 0xbd, 0x1f, 0xa2, 0x98, 0xa1, 0x39, 0x29, 0x14, 0x75, 0xba, 0x14,
-	0x9c, 0x1e, 0x82, 0xe8, 0xf6, 0x1c, 0x39, 0xe5, 0xd9, 0xef, 0x7c, 0xf8, 0xe4, 0xd9, 0xca, 0xd4,
-	0xef, 0xcf, 0x56, 0xda, 0x5d, 0xe1, 0x7a, 0xfd, 0xfd, 0x75, 0xae, 0x65, 0x9b, 0x15, 0x85, 0x36,
-	0xec, 0xa6, 0x02, 0x77, 0xa8, 0xcd, 0x41, 0xf5, 0xc8, 0x7b, 0x4c, 0xa8, 0xb6, 0x64, 0xae, 0xb7,
-	0xbe, 0x01, 0x3c, 0x9b, 0x95, 0x42, 0xed, 0x79, 0xbe, 0x87, 0x81, 0x0e, 0xb7, 0xd1, 0x82, 0xcf,
-	0x2e, 0x48, 0x58, 0x5a, 0x82, 0xa1, 0xfb, 0x85, 0xe6, 0x07, 0xe4, 0xf4, 0x6a, 0x63, 0xed, 0x74,
-	0x36, 0x2f, 0xd9, 0x30, 0xa0, 0xed, 0x0e, 0x98, 0x3b, 0x3e, 0x80, 0x3b, 0x68, 0xd1, 0xc0, 0xf7,
-	0x7d, 0x61, 0xfc, 0x8b, 0x08, 0x25, 0x64, 0x5f, 0x52, 0xeb, 0xd8, 0x01, 0x90, 0x33, 0x21, 0xb3,
-	0xf7, 0x53, 0x66, 0x97, 0xa2, 0xa5, 0x36, 0x3f, 0x58, 0x17, 0x3a, 0xea, 0x6f, 0x29, 0xf7, 0xeb,
-	0x2f, 0x37, 0x51, 0xf2, 0x7a, 0x4b, 0xb9, 0x9f, 0xfe, 0xfc, 0xf9, 0xdd, 0x46, 0xb6, 0x50, 0xf1,
-	0x6d, 0x47, 0xba, 0x5d, 0xcf, 0xe6, 0x6d, 0x33, 0x20, 0xf5, 0x00, 0x22, 0x3b, 0xcd, 0xa1, 0x60,
-	0x47, 0xf4, 0x50, 0xa8, 0x5c, 0x1f, 0x92, 0xe9, 0x68, 0x5b, 0x04, 0x04, 0xfc, 0x86, 0x0f, 0x3f,
-	0x0c, 0x51, 0xbc, 0x16, 0x6d, 0x83, 0x52, 0xf3, 0x5e, 0x65, 0xf4, 0xd9, 0xb0, 0xc3, 0xbf, 0xfd,
-	0x5d, 0xbf, 0x9c, 0x0c, 0x7e, 0x84, 0x66, 0xf6, 0xc1, 0x31, 0x0a, 0xca, 0x19, 0x5d, 0x1e, 0x91,
-	0xd7, 0xeb, 0x99, 0x7b, 0xde, 0x93, 0xdd, 0x8d, 0x5c, 0xf8, 0x3b, 0x74, 0xa1, 0x00, 0x66, 0x94,
-	0x50, 0x5d, 0x6a, 0x98, 0x03, 0x72, 0xae, 0x1e, 0xf9, 0x4c, 0xc5, 0x96, 0x31, 0x07, 0x58, 0x22,
-	0x5f, 0x34, 0xb4, 0x6b, 0x58, 0x2e, 0x40, 0x39, 0xea, 0x7a, 0x06, 0x6c, 0x4f, 0x17, 0x39, 0x41,
-	0xf5, 0x64, 0x7c, 0x39, 0xdc, 0x4b, 0xac, 0x7b, 0x15, 0x29, 0x06, 0x84, 0xbd, 0xa5, 0xf1, 0x53,
-	0x74, 0x0c, 0xe3, 0xce, 0x57, 0xfa, 0xf9, 0x7a, 0x52, 0xfe, 0x2b, 0x85, 0x8f, 0xb7, 0x99, 0x08,
-	0xf1, 0x97, 0xe8, 0x2c, 0x94, 0x56, 0x14, 0x5a, 0x91, 0x99, 0x7a, 0xdc, 0x15, 0x0f, 0xbe, 0x8b,
-	0x56, 0xbc, 0x51, 0x7d, 0xd5, 0xe9, 0x17, 0x1d, 0x51, 0x14, 0x90, 0x53, 0xbf, 0x0d, 0x0c, 0xf5,
-	0x65, 0x07, 0xd6, 0x59, 0x72, 0x21, 0xd4, 0xfa, 0x55, 0xc9, 0x86, 0x5f, 0x1d, 0xa3, 0x1e, 0x06,
-	0x50, 0x96, 0x30, 0xf8, 0x1e, 0x5a, 0x3d, 0x49, 0x63, 0xa0, 0xec, 0xbb, 0x71, 0x9e, 0xd9, 0xc0,
-	0xb3, 0x3c, 0xc9, 0x93, 0x45, 0xd4, 0x88, 0xe8, 0x31, 0x5a, 0x8e, 0xe7, 0xd9, 0xc0, 0x21, 0x33,
-	0x79, 0xb2, 0x54, 0xc8, 0x52, 0x1b, 0xc7, 0x14, 0x07, 0x72, 0xb1, 0xde, 0x8b, 0x2f, 0x05, 0xf6,
-	0x2c, 0x90, 0x07, 0x73, 0xb7, 0x46, 0xd4, 0xf8, 0x87, 0x06, 0xba, 0x3e, 0x21, 0xde, 0x01, 0xa0,
-	0x06, 0x06, 0xa0, 0xfa, 0x13, 0x29, 0xcc, 0xd5, 0x4b, 0x61, 0x65, 0x2c, 0x85, 0x4d, 0x80, 0x2c,
-	0x0a, 0x8c, 0xe5, 0x01, 0x08, 0x4f, 0xa4, 0xc1, 0x8a, 0xb2, 0xc7, 0xc8, 0x7c, 0xcd, 0x6a, 0x1a,
-	0x53, 0xbd, 0xed, 0x09, 0x31, 0x47, 0xf3, 0x8e, 0xd9, 0x83, 0x49, 0x15, 0x5c, 0x4f, 0xe5, 0xa2,
-	0x67, 0x1c, 0x17, 0xf1, 0x9e, 0x0e, 0x58, 0x21, 0x72, 0xe6, 0xb4, 0xb1, 0x74, 0x60, 0x69, 0xdc,
-	0xe8, 0x7b, 0x29, 0xf7, 0x27, 0x33, 0xaa, 0x93, 0x37, 0x6a, 0x7a, 0x7a, 0xac, 0xf1, 0xb5, 0xbd,
-	0x1d, 0x20, 0x3b, 0x51, 0x20, 0x26, 0x83, 0x3f, 0x43, 0x57, 0xc2, 0x98, 0x61, 0xb2, 0x2c, 0xc0,
-	0x52, 0xa7, 0xa9, 0xe5, 0xac, 0x00, 0x6a, 0xb9, 0x36, 0x60, 0xc9, 0x42, 0xa8, 0xcd, 0xcb, 0x7e,
-	0xd0, 0x44, 0xc4, 0x9e, 0xde, 0xf5, 0xf1, 0xdd, 0x10, 0xc6, 0x9f, 0xa0, 0xa5, 0x34, 0x06, 0xa8,
-	0x50, 0x1d, 0x30, 0x60, 0x02, 0x45, 0xca, 0xfd, 0x52, 0xd8, 0xbc, 0x18, 0x87, 0xc1, 0x56, 0x8a,
-	0xef, 0xe9, 0xa4, 0xfc, 0x39, 0x5a, 0xae, 0xf6, 0x76, 0xb4, 0x01, 0xce, 0xac, 0x9b, 0xdc, 0xbe,
-	0x18, 0xb6, 0x37, 0xe3, 0xf6, 0xcd, 0x63, 0xc8, 0x88, 0x61, 0x4c, 0x3d, 0x1d, 0xaa, 0xf1, 0xed,
-	0x97, 0xc7, 0xd5, 0xd3, 0x71, 0x3a, 0xde, 0xfb, 0x08, 0xcd, 0x71, 0x03, 0xcc, 0x41, 0x1a, 0x93,
-	0x1d, 0x00, 0x42, 0x5e, 0x71, 0x12, 0xcd, 0x46, 0xa6, 0x30, 0xf1, 0x36, 0x01, 0xf0, 0xa7, 0x68,
-	0x69, 0xd4, 0x60, 0x73, 0xb0, 0xe1, 0x73, 0xfa, 0x44, 0x85, 0xcf, 0x80, 0x34, 0xa3, 0xa5, 0x15,
-	0x62, 0x23, 0x02, 0xb6, 0xd9, 0x70, 0xcb, 0x87, 0xf1, 0x17, 0xe8, 0xba, 0xc7, 0x1a, 0x70, 0x46,
-	0xc4, 0x0f, 0x12, 0x7b, 0x02, 0x55, 0x5a, 0x71, 0xb0, 0xa9, 0x0b, 0x91, 0xa5, 0x30, 0x98, 0x5a,
-	0x92, 0x0d, 0xb3, 0x88, 0xdc, 0xd3, 0x9b, 0x01, 0xf7, 0x20, 0xc0, 0x62, 0x1b, 0xc2, 0xdb, 0xe8,
-	0xc6, 0x7f, 0x92, 0x25, 0xdb, 0xc8, 0x95, 0xc0, 0xb6, 0xf2, 0x6f, 0x6c, 0xc9, 0x3d, 0xfc, 0x2d,
-	0x9a, 0x33, 0xd0, 0x15, 0xd6, 0x19, 0xe6, 0xfb, 0x6e, 0x30, 0xed, 0xea, 0x2b, 0x9a, 0x76, 0x71,
-	0x9c, 0xc9, 0xbb, 0xf6, 0x1e, 0xc2, 0x39, 0x74, 0x58, 0xbf, 0x70, 0xb4, 0x64, 0x5d, 0xa0, 0x85,
-	0x90, 0xc2, 0x91, 0xe5, 0xe0, 0xd6, 0x5c, 0x8a, 0xec, 0xb0, 0x2e, 0xdc, 0xf7, 0xeb, 0xf8, 0x06,
-	0x9a, 0xf5, 0x6f, 0x36, 0x86, 0x6c, 0x05, 0xe4, 0x8c, 0x64, 0xc3, 0x63, 0x94, 0xaf, 0xb1, 0x13,
-	0x23, 0x9d, 0x1a, 0xe0, 0xda, 0xe4, 0x69, 0xd3, 0x4a, 0x78, 0xf1, 0xe6, 0xe4, 0x7c, 0xcf, 0x02,
-	0x22, 0x32, 0xac, 0xa1, 0xb9, 0x70, 0xb3, 0x89, 0x97, 0x1c, 0xa9, 0x95, 0xeb, 0x91, 0xd5, 0xa0,
-	0x34, 0x1b, 0xd7, 0x77, 0xc0, 0x6c, 0xfb, 0x55, 0xdf, 0x9d, 0xca, 0xaa, 0x67, 0xc4, 0xc3, 0xe0,
-	0x7b, 0xe2, 0x9b, 0x35, 0xbb, 0x53, 0x19, 0xeb, 0x75, 0xab, 0x22, 0xf4, 0xdd, 0x69, 0x24, 0x53,
-	0x9d, 0x1b, 0x72, 0xad, 0x66, 0x77, 0x4a, 0x2a, 0xd5, 0x21, 0xf3, 0x37, 0xc8, 0x91, 0x48, 0x55,
-	0x23, 0xd7, 0x6b, 0xde, 0x20, 0x93, 0x46, 0x55, 0x4b, 0x80, 0x30, 0x7f, 0xd9, 0xae, 0x1b, 0x35,
-	0xed, 0xe2, 0xff, 0x60, 0x17, 0x7f, 0xc9, 0xae, 0xb7, 0x6a, 0xda, 0xc5, 0x4f, 0xd8, 0xf5, 0x0d,
-	0x3a, 0xdf, 0xa1, 0x4e, 0x17, 0x60, 0xc2, 0x1c, 0x7c, 0xbb, 0x1e, 0x3d, 0xea, 0xec, 0x55, 0x54,
-	0xf8, 0x01, 0x9a, 0xe6, 0x54, 0x69, 0x23, 0xc9, 0x3b, 0xf5, 0x48, 0xcf, 0xf0, 0x07, 0xda, 0x48,
-	0x7c, 0x88, 0xae, 0x8e, 0xfa, 0xdd, 0x68, 0x84, 0xe7, 0xc0, 0xd9, 0x51, 0xbc, 0x6c, 0xae, 0xd5,
-	0x53, 0x21, 0x2e, 0xf5, 0xc0, 0x34, 0xbc, 0x37, 0x3c, 0xb3, 0xbf, 0x78, 0xde, 0xc9, 0x9e, 0x3c,
-	0x6f, 0x35, 0x9e, 0x3e, 0x6f, 0x35, 0xfe, 0x78, 0xde, 0x6a, 0xfc, 0xf8, 0xa2, 0x35, 0xf5, 0xf4,
-	0x45, 0x6b, 0xea, 0xb7, 0x17, 0xad, 0xa9, 0x47, 0x1f, 0xfd, 0x4f, 0x91, 0x61, 0xfb, 0xf8, 0x37,
-	0x9a, 0x3b, 0x2a, 0xc1, 0xee, 0x4f, 0x87, 0x9f, 0x53, 0x1f, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0x0f, 0x3f, 0x40, 0x9c, 0xbd, 0x0d, 0x00, 0x00,
+	// 1646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x73, 0x1c, 0x49,
+	0x11, 0xd6, 0x78, 0xf4, 0x58, 0x97, 0xde, 0x85, 0x2c, 0x97, 0x64, 0x6b, 0x34, 0x48, 0x86, 0x1d,
+	0xcc, 0x7a, 0x66, 0x05, 0x87, 0x5d, 0x30, 0x07, 0xa4, 0x95, 0xb4, 0x21, 0x58, 0x39, 0xc4, 0x48,
+	0x8b, 0x09, 0x43, 0x44, 0x45, 0xa9, 0x3b, 0x67, 0xa6, 0x50, 0x77, 0x57, 0x53, 0x55, 0xf3, 0x90,
+	0x6f, 0x1c, 0xb8, 0x73, 0xe3, 0x2f, 0x70, 0xe4, 0xc0, 0x8f, 0xf0, 0xd1, 0x41, 0x70, 0x20, 0x38,
+	0x38, 0x08, 0xfb, 0xc0, 0xdf, 0x20, 0xea, 0xd1, 0x33, 0x3d, 0xb2, 0x2d, 0x14, 0x6e, 0x5f, 0x14,
+	0x9a, 0xca, 0xcc, 0x2f, 0xb3, 0xbe, 0xaa, 0xca, 0x47, 0xa3, 0x35, 0x88, 0xb9, 0x52, 0x5c, 0x24,
+	0xaa, 0xd1, 0xdb, 0x69, 0xa4, 0x4c, 0xb2, 0x58, 0xd5, 0x53, 0x29, 0xb4, 0xc0, 0x73, 0x43, 0x51,
+	0xbd, 0xb7, 0xb3, 0xbe, 0x16, 0x08, 0x15, 0x0b, 0x45, 0xad, 0xac, 0xe1, 0x7e, 0x38, 0xc5, 0xf5,
+	0x65, 0x16, 0xf3, 0x44, 0x34, 0xec, 0x5f, 0xbf, 0xb4, 0xd2, 0x16, 0x6d, 0xe1, 0x54, 0xcd, 0x7f,
+	0x6e, 0x75, 0xeb, 0x8f, 0x15, 0x34, 0x7d, 0x62, 0x5d, 0x60, 0x82, 0x66, 0x7a, 0x20, 0x0d, 0x3a,
+	0x29, 0x55, 0x4b, 0xb5, 0xdb, 0xcd, 0xec, 0x27, 0xfe, 0x09, 0x5a, 0x8b, 0xd9, 0x80, 0x2a, 0x90,
+	0x9c, 0x45, 0xfc, 0x39, 0x84, 0x34, 0x56, 0x6d, 0x1a, 0x41, 0xd2, 0xd6, 0x1d, 0x72, 0xab, 0x5a,
+	0xaa, 0x95, 0x9b, 0xab, 0x31, 0x1b, 0x9c, 0x0e, 0xe5, 0xc7, 0xaa, 0xfd, 0x8d, 0x95, 0x62, 0x86,
+	0x96, 0x62, 0x9e, 0x50, 0x2d, 0x52, 0x1e, 0xd0, 0x3e, 0xf0, 0x76, 0x47, 0x93, 0xb2, 0x41, 0xdf,
+	0xfb, 0xe2, 0xc5, 0xab, 0xcd, 0x89, 0x7f, 0xbf, 0xda, 0x6c, 0xb4, 0xb9, 0xee, 0x74, 0xcf, 0xeb,
+	0x81, 0x88, 0x1b, 0x2c, 0x8a, 0x84, 0x64, 0x8f, 0x12, 0xd0, 0x7d, 0x21, 0x2f, 0xb2, 0x9f, 0x41,
+	0x87, 0xf1, 0xa4, 0x11, 0x33, 0xdd, 0xa9, 0xef, 0x43, 0xd0, 0x5c, 0x88, 0x79, 0x72, 0x66, 0xf0,
+	0x9e, 0x5a, 0x38, 0xdc, 0x40, 0x2b, 0x26, 0x3a, 0xeb, 0x42, 0xd1, 0x14, 0x24, 0x3d, 0x8f, 0x44,
+	0x70, 0x41, 0x26, 0xab, 0xa5, 0xda, 0x64, 0x73, 0x39, 0x66, 0x03, 0xab, 0xad, 0x4e, 0x40, 0xee,
+	0x19, 0x01, 0x6e, 0xa1, 0x55, 0x09, 0x7f, 0xe8, 0x72, 0x69, 0x36, 0xc2, 0x13, 0x1e, 0x77, 0x63,
+	0xaa, 0x34, 0xbb, 0x00, 0x32, 0x65, 0x23, 0xfb, 0xdc, 0x47, 0x76, 0xc7, 0x51, 0xaa, 0xc2, 0x8b,
+	0x3a, 0x17, 0xce, 0xff, 0x51, 0xa2, 0xff, 0xf1, 0xf7, 0x47, 0xc8, 0x73, 0x7d, 0x94, 0xe8, 0xbf,
+	0xfe, 0xf7, 0x6f, 0x0f, 0x4b, 0xcd, 0x95, 0x0c, 0xef, 0xd8, 0xc1, 0x9d, 0x1a, 0x34, 0x43, 0x9b,
+	0x84, 0x58, 0xf4, 0xc0, 0xa1, 0xd3, 0x10, 0x22, 0x76, 0x49, 0xfb, 0x3c, 0x09, 0x45, 0x9f, 0x4c,
+	0x3b, 0xda, 0x9c, 0x82, 0xd5, 0xdf, 0x37, 0xe2, 0xa7, 0x56, 0x8a, 0x6b, 0x8e, 0x36, 0x48, 0x45,
+	0xd0, 0xc9, 0x88, 0x9e, 0xb1, 0x16, 0x66, 0xf7, 0x07, 0x66, 0xd9, 0x13, 0xfc, 0x0c, 0xcd, 0x9d,
+	0x83, 0x66, 0x14, 0x12, 0x2d, 0x45, 0x7a, 0x49, 0x3e, 0x29, 0x46, 0xee, 0xac, 0x01, 0x3b, 0x70,
+	0x58, 0xf8, 0x77, 0x68, 0x3e, 0x02, 0x26, 0x13, 0x9e, 0xb4, 0xa9, 0x64, 0x1a, 0xc8, 0xed, 0x62,
+	0xe0, 0x73, 0x19, 0x5a, 0x93, 0x69, 0xc0, 0x31, 0x32, 0x97, 0x86, 0xb6, 0x25, 0x0b, 0x39, 0x24,
+	0x9a, 0xea, 0x8e, 0x04, 0xd5, 0x11, 0x51, 0x48, 0x50, 0x31, 0x37, 0xe6, 0x3a, 0x7c, 0xed, 0x51,
+	0xcf, 0x32, 0x50, 0x0c, 0x08, 0x1b, 0x4a, 0xdd, 0x51, 0xb4, 0x24, 0x0b, 0xb4, 0xb9, 0xe9, 0xb3,
+	0xc5, 0x5c, 0x99, 0x53, 0xb2, 0x87, 0x77, 0xe8, 0x01, 0xf1, 0xaf, 0xd0, 0x0c, 0xa4, 0x8a, 0x47,
+	0x22, 0x21, 0x73, 0xc5, 0xb0, 0x33, 0x1c, 0x7c, 0x80, 0x36, 0x0d, 0x51, 0xdd, 0xa4, 0xd5, 0x8d,
+	0x5a, 0x3c, 0x8a, 0x20, 0xa4, 0xc6, 0x0c, 0x24, 0x35, 0xd7, 0x0e, 0x94, 0x56, 0x64, 0xde, 0xde,
+	0xf5, 0xfb, 0x31, 0x1b, 0x7c, 0x3b, 0xd2, 0x7a, 0x6a, 0x95, 0x9a, 0x5e, 0x07, 0x7f, 0x8d, 0xaa,
+	0x57, 0x61, 0x24, 0xa4, 0x5d, 0x9d, 0xc7, 0x59, 0xb0, 0x38, 0x1b, 0xe3, 0x38, 0x4d, 0xa7, 0x35,
+	0x04, 0x7a, 0x8e, 0x36, 0xdc, 0x7b, 0x96, 0xd0, 0x67, 0x32, 0xf4, 0x94, 0xf2, 0x38, 0x15, 0x52,
+	0xb3, 0x24, 0x00, 0xb2, 0x58, 0x6c, 0xe3, 0xeb, 0x16, 0xbd, 0x69, 0xc1, 0x2d, 0xb9, 0x47, 0x43,
+	0x68, 0xfc, 0xa7, 0x12, 0xda, 0x1e, 0x73, 0xde, 0x02, 0xa0, 0x12, 0x7a, 0x90, 0x74, 0xc7, 0x42,
+	0x58, 0x2a, 0x16, 0xc2, 0x66, 0x2e, 0x84, 0x43, 0x80, 0xa6, 0x73, 0x90, 0x8b, 0x03, 0x10, 0x1e,
+	0x0b, 0x83, 0x45, 0x69, 0x87, 0x91, 0xe5, 0x82, 0xb7, 0x29, 0xe7, 0x75, 0xd7, 0x00, 0xe2, 0x00,
+	0x2d, 0x6b, 0xa6, 0x2e, 0xc6, 0xbd, 0xe0, 0x62, 0x5e, 0x16, 0x0d, 0x62, 0xde, 0x89, 0xe1, 0xb4,
+	0xc7, 0x22, 0x1e, 0x32, 0x2d, 0xa4, 0xa2, 0x3d, 0x45, 0x9d, 0xa1, 0xc9, 0xa5, 0x81, 0x79, 0x99,
+	0xce, 0x3b, 0xf9, 0x4e, 0x41, 0x4e, 0x47, 0x3e, 0x7e, 0xad, 0x76, 0xad, 0xca, 0x89, 0x73, 0xe0,
+	0x82, 0xc1, 0x3f, 0x43, 0xf7, 0x6c, 0x99, 0x61, 0x71, 0x1a, 0x81, 0xa2, 0x5a, 0x50, 0x15, 0xb0,
+	0x08, 0xa8, 0x0a, 0x84, 0x04, 0x45, 0x56, 0xec, 0xdd, 0xbc, 0x6b, 0x0a, 0x8d, 0xd3, 0x38, 0x13,
+	0xa7, 0x46, 0x7e, 0x6a, 0xc5, 0xf8, 0xa7, 0x68, 0xdd, 0x97, 0x01, 0xca, 0x93, 0x16, 0x48, 0x90,
+	0x16, 0xc2, 0xc7, 0x7e, 0xc7, 0x1a, 0xaf, 0xba, 0x62, 0x70, 0xe4, 0xe5, 0x67, 0xc2, 0x7b, 0xfe,
+	0x39, 0xda, 0xc8, 0x6c, 0x5b, 0x42, 0x42, 0xc0, 0x94, 0x1e, 0x37, 0x5f, 0xb5, 0xe6, 0x6b, 0xce,
+	0xfc, 0x70, 0xa4, 0x32, 0x44, 0xc8, 0x79, 0xf7, 0x8f, 0x2a, 0x6f, 0x7e, 0x37, 0xef, 0xdd, 0x3f,
+	0xa7, 0x91, 0xed, 0x33, 0xb4, 0x14, 0x48, 0x60, 0x1a, 0x7c, 0x99, 0x6c, 0x01, 0x10, 0xf2, 0x81,
+	0x95, 0x68, 0xc1, 0x21, 0xd9, 0x8a, 0x77, 0x08, 0x80, 0x1f, 0xa3, 0xf5, 0x61, 0x82, 0x0d, 0x41,
+	0xd9, 0xe3, 0x34, 0x81, 0x72, 0x13, 0x01, 0x59, 0x73, 0x94, 0x66, 0x1a, 0xfb, 0x4e, 0xe1, 0x98,
+	0x0d, 0x8e, 0x8c, 0x18, 0xff, 0x12, 0x6d, 0x1b, 0x5d, 0x09, 0x5a, 0x72, 0x77, 0x20, 0x2e, 0x27,
+	0xd0, 0x44, 0x24, 0x01, 0x28, 0x9f, 0x85, 0xc8, 0xba, 0x2d, 0x4c, 0x95, 0x98, 0x0d, 0x9a, 0x4e,
+	0xf3, 0x4c, 0x1c, 0x5a, 0xbd, 0x27, 0x56, 0xcd, 0xa5, 0x21, 0x7c, 0x8c, 0x1e, 0x5c, 0x0b, 0xe6,
+	0x69, 0x23, 0xf7, 0x2c, 0xda, 0xe6, 0xfb, 0xd0, 0x3c, 0x7b, 0xf8, 0xb7, 0x68, 0x49, 0x42, 0x9b,
+	0x2b, 0x2d, 0x99, 0xc9, 0xbb, 0x96, 0xb4, 0xfb, 0x1f, 0x48, 0xda, 0x62, 0x1e, 0xc9, 0xb0, 0xf6,
+	0x19, 0xc2, 0x21, 0xb4, 0x58, 0x37, 0xd2, 0x34, 0x65, 0x6d, 0xa0, 0x11, 0x8f, 0xb9, 0x26, 0x1b,
+	0x96, 0xad, 0x25, 0x2f, 0x39, 0x61, 0x6d, 0xf8, 0xc6, 0xac, 0xe3, 0x07, 0x68, 0xc1, 0xec, 0x2c,
+	0xa7, 0x59, 0xb1, 0x9a, 0x73, 0x31, 0x1b, 0x8c, 0xb4, 0xcc, 0x1d, 0xbb, 0x52, 0xd2, 0xa9, 0x84,
+	0x40, 0xc8, 0xd0, 0x1b, 0x6d, 0xda, 0x8d, 0xaf, 0x8d, 0xd7, 0xf7, 0xa6, 0xd5, 0x70, 0x08, 0x35,
+	0xb4, 0x64, 0x3b, 0x1b, 0xd7, 0xe4, 0xc4, 0x22, 0xd1, 0x1d, 0x52, 0xb5, 0x9e, 0x16, 0xdc, 0xfa,
+	0x09, 0xc8, 0x63, 0xb3, 0x6a, 0xb2, 0x53, 0x9a, 0xe5, 0x0c, 0xf7, 0x18, 0x4c, 0x4e, 0xfc, 0x6e,
+	0xc1, 0xec, 0x94, 0xba, 0xfb, 0x7a, 0x94, 0x01, 0x9a, 0xec, 0x34, 0x74, 0x93, 0xbd, 0x1b, 0xb2,
+	0x55, 0x30, 0x3b, 0x79, 0x2f, 0xd9, 0x23, 0x33, 0x1d, 0xe4, 0xd0, 0x49, 0x76, 0x47, 0xb6, 0x0b,
+	0x76, 0x90, 0xde, 0x47, 0x76, 0x97, 0x00, 0xe1, 0xe0, 0x6d, 0xba, 0x1e, 0x14, 0xa4, 0x2b, 0x78,
+	0x07, 0x5d, 0xc1, 0x5b, 0x74, 0x7d, 0xaf, 0x20, 0x5d, 0xc1, 0x15, 0xba, 0x7e, 0x83, 0x66, 0x5b,
+	0x54, 0x8b, 0x08, 0xa4, 0xad, 0x83, 0xdf, 0x2f, 0x06, 0x8f, 0x5a, 0x67, 0x19, 0x14, 0x7e, 0x82,
+	0xa6, 0x03, 0x9a, 0x08, 0x19, 0x93, 0x4f, 0x8b, 0x81, 0x4e, 0x05, 0x4f, 0x84, 0x8c, 0x71, 0x1f,
+	0xdd, 0x1f, 0xe6, 0xbb, 0x61, 0x09, 0x0f, 0x21, 0x60, 0x97, 0xae, 0xd9, 0xac, 0x15, 0xf3, 0x42,
+	0xb4, 0xcf, 0x81, 0xbe, 0x78, 0xef, 0x1b, 0x64, 0xdb, 0x78, 0x7e, 0x8a, 0x16, 0x23, 0xde, 0x83,
+	0x04, 0x94, 0xca, 0xba, 0xf1, 0x1f, 0xb8, 0x67, 0x94, 0x2d, 0xfb, 0x2e, 0xfc, 0x21, 0x32, 0xd3,
+	0x03, 0x35, 0x33, 0x17, 0x84, 0xee, 0xe5, 0x2a, 0xf2, 0xd0, 0xaa, 0x2e, 0xc6, 0x6c, 0x70, 0x6c,
+	0xd7, 0xed, 0x63, 0x55, 0x78, 0x1b, 0xcd, 0xff, 0x9e, 0xf1, 0x88, 0x86, 0x5d, 0x97, 0x46, 0xc8,
+	0x0f, 0xed, 0x73, 0x9e, 0x33, 0x8b, 0xfb, 0x7e, 0x0d, 0x1f, 0xe5, 0xa7, 0x21, 0x37, 0xd9, 0x91,
+	0xcf, 0xaa, 0xa5, 0xda, 0xec, 0x8f, 0xd6, 0xea, 0xf9, 0xd1, 0xae, 0x6e, 0xf3, 0xb7, 0x9b, 0xcb,
+	0xf6, 0x26, 0x0d, 0x03, 0xa3, 0xa9, 0xc7, 0xad, 0x5a, 0x28, 0x36, 0x18, 0x87, 0x7a, 0x74, 0x53,
+	0x28, 0x36, 0xc8, 0xad, 0x9a, 0xfe, 0xd2, 0xc1, 0xd8, 0xda, 0x21, 0xa4, 0x2d, 0xff, 0xfd, 0x88,
+	0x2b, 0x4d, 0x21, 0x61, 0xe7, 0x11, 0x84, 0xa4, 0x5e, 0x2d, 0xd5, 0x3e, 0x69, 0xba, 0xf3, 0xfa,
+	0xca, 0x69, 0xed, 0x66, 0x4a, 0x07, 0x4e, 0x07, 0x7f, 0x89, 0xc8, 0xb0, 0x2d, 0x35, 0x57, 0x52,
+	0xd1, 0x20, 0x62, 0x3c, 0x36, 0x42, 0xd2, 0xb0, 0xf6, 0xab, 0x7d, 0xdf, 0x91, 0x5a, 0xf1, 0x57,
+	0x99, 0x14, 0x3f, 0x46, 0x33, 0xa9, 0xec, 0x9a, 0xc1, 0x80, 0x7c, 0x6e, 0xb7, 0x70, 0x6f, 0x7c,
+	0x0b, 0x27, 0x4e, 0x38, 0xb6, 0x89, 0xcc, 0x22, 0xcb, 0xab, 0x2c, 0x34, 0x7f, 0x1d, 0x11, 0xb4,
+	0x9b, 0x86, 0x4c, 0xfb, 0x81, 0x8b, 0xec, 0x0c, 0xf3, 0xea, 0xae, 0xd1, 0x71, 0x28, 0xdf, 0x5a,
+	0x0d, 0x3b, 0x72, 0x6d, 0x3d, 0x46, 0xb7, 0x9b, 0xa0, 0x21, 0xb1, 0x47, 0xb4, 0x8a, 0xa6, 0xfd,
+	0x41, 0x97, 0xec, 0x41, 0xfb, 0x5f, 0x66, 0xdd, 0x25, 0x59, 0x3f, 0xf0, 0xfa, 0x5f, 0x5b, 0x7f,
+	0x29, 0xa3, 0xf9, 0xb1, 0xf8, 0xf0, 0x0e, 0xba, 0xe3, 0x0a, 0x9d, 0xc9, 0xdc, 0xf9, 0x81, 0xd4,
+	0x01, 0x62, 0x5b, 0xd9, 0xac, 0x6c, 0x38, 0x91, 0xee, 0xa3, 0x05, 0xdf, 0xb3, 0x64, 0xcd, 0xce,
+	0x2d, 0xcb, 0xc3, 0xdd, 0x71, 0x1e, 0x86, 0x51, 0x7a, 0x0e, 0xe6, 0xbd, 0x91, 0xef, 0x80, 0x7e,
+	0x81, 0x96, 0x47, 0xdd, 0x4b, 0x06, 0x54, 0xbe, 0x09, 0xd0, 0xd2, 0xc8, 0xce, 0x63, 0xed, 0xa3,
+	0x85, 0x6c, 0x38, 0xf0, 0x40, 0x93, 0x37, 0x8a, 0xc8, 0x1b, 0x79, 0x94, 0x2f, 0xd0, 0x8c, 0x84,
+	0xb6, 0x04, 0xad, 0xc8, 0xd4, 0x4d, 0xcc, 0x33, 0x6d, 0xbc, 0x87, 0xe6, 0x23, 0xa6, 0x41, 0xe9,
+	0xcc, 0xfb, 0xf4, 0x4d, 0xcc, 0xe7, 0x9c, 0x8d, 0x73, 0xbe, 0xf5, 0xcf, 0x49, 0x34, 0x9b, 0xbf,
+	0xe6, 0xd7, 0x37, 0x88, 0xa5, 0x6a, 0xf9, 0x9a, 0x06, 0xf1, 0xfa, 0xf6, 0xee, 0x56, 0xde, 0xf6,
+	0xad, 0xf6, 0xee, 0xfd, 0x9f, 0x1b, 0xca, 0xd5, 0xf2, 0x47, 0xfc, 0xdc, 0xf0, 0xae, 0x8e, 0x68,
+	0xb2, 0x5a, 0xfe, 0x38, 0x1d, 0xd1, 0xd5, 0xcf, 0x0c, 0x53, 0xd5, 0x72, 0x91, 0xe4, 0x3c, 0xf6,
+	0x99, 0xe1, 0xdd, 0xdd, 0xca, 0x74, 0xb5, 0xfc, 0x71, 0xbb, 0x95, 0x1b, 0x8c, 0xd1, 0x33, 0xd5,
+	0xf2, 0xff, 0x1b, 0xa3, 0xf7, 0x9a, 0x2f, 0x5e, 0x57, 0x4a, 0x2f, 0x5f, 0x57, 0x4a, 0xff, 0x79,
+	0x5d, 0x29, 0xfd, 0xf9, 0x4d, 0x65, 0xe2, 0xe5, 0x9b, 0xca, 0xc4, 0xbf, 0xde, 0x54, 0x26, 0x9e,
+	0x7d, 0x79, 0xc3, 0x18, 0x07, 0x8d, 0xd1, 0x17, 0x3e, 0x7d, 0x99, 0x82, 0x3a, 0x9f, 0xb6, 0x1f,
+	0xe3, 0x7e, 0xfc, 0xbf, 0x01, 0x00, 0x2a, 0x9b, 0xb4, 0x15, 0xfb, 0x13, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -344,6 +658,94 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinAdminParamsUpdateDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinAdminParamsUpdateDelay))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size, err := m.Pruning.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x82
+	if m.WorkerRewardsClaimable {
+		i--
+		if m.WorkerRewardsClaimable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.TopicCreatorAllowlistEnabled {
+		i--
+		if m.TopicCreatorAllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size, err := m.MaxTopicParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xea
+	{
+		size, err := m.MinTopicParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xe2
+	if m.JailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailDuration))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxMissedEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedEpochs))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.LivenessWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LivenessWindow))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
 	{
 		size := m.TopicFeeRevenueDecayRate.Size()
 		i -= size
@@ -709,33 +1111,277 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Retention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Retention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Retention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.Blocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MaxSerializedMsgLength != 0 {
-		n += 1 + sovParams(uint64(m.MaxSerializedMsgLength))
+	if m.Epochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.MinTopicWeight.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.MaxTopicsPerBlock != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *PruningParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruningParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruningParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LatestScores.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Regrets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ReputerScores.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ForecasterScores.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.InfererScores.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxRecordsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecordsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		dAtA10 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
+		var j9 int
+		for _, num := range m.MaxUnfulfilledWorkerRequests {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintParams(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PRewardInference) > 0 {
+		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BetaEntropy) > 0 {
+		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BetaEntropy[iNdEx].Size()
+				i -= size
+				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RegistrationFee[iNdEx].Size()
+				i -= size
+				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RequiredMinimumStake[iNdEx].Size()
+				i -= size
+				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		dAtA12 := make([]byte, len(m.MaxTopReputersToReward)*10)
+		var j11 int
+		for _, num := range m.MaxTopReputersToReward {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintParams(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		dAtA14 := make([]byte, len(m.MaxTopInferersToReward)*10)
+		var j13 int
+		for _, num := range m.MaxTopInferersToReward {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintParams(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxSerializedMsgLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxSerializedMsgLength))
+	}
+	l = m.MinTopicWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxTopicsPerBlock != 0 {
 		n += 1 + sovParams(uint64(m.MaxTopicsPerBlock))
 	}
 	l = m.RequiredMinimumStake.Size()
@@ -825,6 +1471,121 @@ func (m *Params) Size() (n int) {
 	n += 2 + l + sovParams(uint64(l))
 	l = m.TopicFeeRevenueDecayRate.Size()
 	n += 2 + l + sovParams(uint64(l))
+	if m.LivenessWindow != 0 {
+		n += 2 + sovParams(uint64(m.LivenessWindow))
+	}
+	if m.MaxMissedEpochs != 0 {
+		n += 2 + sovParams(uint64(m.MaxMissedEpochs))
+	}
+	if m.JailDuration != 0 {
+		n += 2 + sovParams(uint64(m.JailDuration))
+	}
+	l = m.MinTopicParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxTopicParams.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.TopicCreatorAllowlistEnabled {
+		n += 3
+	}
+	if m.WorkerRewardsClaimable {
+		n += 3
+	}
+	l = m.Pruning.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MinAdminParamsUpdateDelay != 0 {
+		n += 2 + sovParams(uint64(m.MinAdminParamsUpdateDelay))
+	}
+	return n
+}
+
+func (m *Retention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		n += 1 + sovParams(uint64(m.Epochs))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovParams(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *PruningParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRecordsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecordsPerBlock))
+	}
+	l = m.InfererScores.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ForecasterScores.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ReputerScores.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Regrets.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LatestScores.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TopicParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxTopInferersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopInferersToReward {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopReputersToReward {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for _, e := range m.RequiredMinimumStake {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BetaEntropy) > 0 {
+		for _, e := range m.BetaEntropy {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.PRewardInference) > 0 {
+		for _, e := range m.PRewardInference {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledWorkerRequests {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1966,6 +2727,965 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessWindow", wireType)
+			}
+			m.LivenessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochs", wireType)
+			}
+			m.MaxMissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			m.JailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTopicParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTopicParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicCreatorAllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TopicCreatorAllowlistEnabled = bool(v != 0)
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRewardsClaimable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WorkerRewardsClaimable = bool(v != 0)
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruning", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pruning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAdminParamsUpdateDelay", wireType)
+			}
+			m.MinAdminParamsUpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAdminParamsUpdateDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Retention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Retention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Retention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruningParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruningParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruningParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPerBlock", wireType)
+			}
+			m.MaxRecordsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InfererScores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForecasterScores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerScores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Regrets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestScores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
+					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
+					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
+			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RegistrationFee = append(m.RegistrationFee, v)
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.BetaEntropy = append(m.BetaEntropy, v)
+			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardInference = append(m.PRewardInference, v)
+			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
+					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
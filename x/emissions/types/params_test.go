@@ -0,0 +1,255 @@
+package types_test
+
+import (
+	"testing"
+
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(p *types.Params)
+		expectedErr error
+	}{
+		{
+			name:   "default params",
+			modify: func(p *types.Params) {},
+		},
+		{
+			name:        "empty version",
+			modify:      func(p *types.Params) { p.Version = "" },
+			expectedErr: types.ErrValidationVersionEmpty,
+		},
+		{
+			name:        "negative min topic weight",
+			modify:      func(p *types.Params) { p.MinTopicWeight = alloraMath.NewDecFromInt64(-1) },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "zero max topics per block",
+			modify:      func(p *types.Params) { p.MaxTopicsPerBlock = 0 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "negative required minimum stake",
+			modify:      func(p *types.Params) { p.RequiredMinimumStake = cosmosMath.NewInt(-1) },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "zero min epoch length",
+			modify:      func(p *types.Params) { p.MinEpochLength = 0 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "learning rate of one",
+			modify:      func(p *types.Params) { p.LearningRate = alloraMath.OneDec() },
+			expectedErr: types.ErrValidationMustBeBetweenZeroAndOne,
+		},
+		{
+			name:        "zero epsilon",
+			modify:      func(p *types.Params) { p.Epsilon = alloraMath.ZeroDec() },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "zero max unfulfilled worker requests",
+			modify:      func(p *types.Params) { p.MaxUnfulfilledWorkerRequests = 0 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "zero max unfulfilled reputer requests",
+			modify:      func(p *types.Params) { p.MaxUnfulfilledReputerRequests = 0 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "topic reward alpha of zero",
+			modify:      func(p *types.Params) { p.TopicRewardAlpha = alloraMath.ZeroDec() },
+			expectedErr: types.ErrValidationMustBeBetweenZeroAndOne,
+		},
+		{
+			name:        "topic reward alpha of one",
+			modify:      func(p *types.Params) { p.TopicRewardAlpha = alloraMath.OneDec() },
+			expectedErr: types.ErrValidationMustBeBetweenZeroAndOne,
+		},
+		{
+			name:   "task reward alpha of one",
+			modify: func(p *types.Params) { p.TaskRewardAlpha = alloraMath.OneDec() },
+		},
+		{
+			name:        "validators percent reward above one",
+			modify:      func(p *types.Params) { p.ValidatorsVsAlloraPercentReward = alloraMath.NewDecFromInt64(2) },
+			expectedErr: types.ErrValidationMustBeBetweenZeroAndOne,
+		},
+		{
+			name:        "zero default page limit",
+			modify:      func(p *types.Params) { p.DefaultPageLimit = 0 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "zero max serialized msg length",
+			modify:      func(p *types.Params) { p.MaxSerializedMsgLength = 0 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "zero blocks per month",
+			modify:      func(p *types.Params) { p.BlocksPerMonth = 0 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "zero p reward inference",
+			modify:      func(p *types.Params) { p.PRewardInference = alloraMath.ZeroDec() },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "negative p reward reputer",
+			modify:      func(p *types.Params) { p.PRewardReputer = alloraMath.NewDecFromInt64(-3) },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "negative jail duration",
+			modify:      func(p *types.Params) { p.JailDuration = -1 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name:        "admin params updates applied at once",
+			modify:      func(p *types.Params) { p.MinAdminParamsUpdateDelay = 0 },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name: "max page limit below default page limit",
+			modify: func(p *types.Params) {
+				p.DefaultPageLimit = 100
+				p.MaxPageLimit = 99
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "more worker retries than unfulfilled worker requests",
+			modify: func(p *types.Params) {
+				p.MaxRetriesToFulfilNoncesWorker = 11
+				p.MaxUnfulfilledWorkerRequests = 10
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "more reputer retries than unfulfilled reputer requests",
+			modify: func(p *types.Params) {
+				p.MaxRetriesToFulfilNoncesReputer = 11
+				p.MaxUnfulfilledReputerRequests = 10
+				p.MinEpochLengthRecordLimit = 11
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "record limit below reputer retries",
+			modify: func(p *types.Params) {
+				p.MaxRetriesToFulfilNoncesReputer = 3
+				p.MinEpochLengthRecordLimit = 2
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "max missed epochs not below liveness window",
+			modify: func(p *types.Params) {
+				p.LivenessWindow = 10
+				p.MaxMissedEpochs = 10
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "topic params bound set on one side only",
+			modify: func(p *types.Params) {
+				p.MaxTopicParams.BetaEntropy = nil
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "lowest topic param above highest",
+			modify: func(p *types.Params) {
+				p.MinTopicParams.MaxTopReputersToReward = []uint64{10}
+				p.MaxTopicParams.MaxTopReputersToReward = []uint64{5}
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "topic params bound outside of the param range",
+			modify: func(p *types.Params) {
+				p.MaxTopicParams.BetaEntropy = []alloraMath.Dec{alloraMath.NewDecFromInt64(2)}
+			},
+			expectedErr: types.ErrValidationMustBeBetweenZeroAndOne,
+		},
+		{
+			name: "no topic params bounds",
+			modify: func(p *types.Params) {
+				p.MinTopicParams = types.TopicParams{}
+				p.MaxTopicParams = types.TopicParams{}
+			},
+		},
+		{
+			name:        "retention in both epochs and blocks",
+			modify:      func(p *types.Params) { p.Pruning.Regrets = types.Retention{Epochs: 5, Blocks: 100} },
+			expectedErr: types.ErrValidationRetentionAmbiguous,
+		},
+		{
+			name:        "negative retention in blocks",
+			modify:      func(p *types.Params) { p.Pruning.LatestScores = types.Retention{Blocks: -1} },
+			expectedErr: types.ErrValidationMustBeGreaterthanZero,
+		},
+		{
+			name: "score retention below the samples to scale scores",
+			modify: func(p *types.Params) {
+				p.MaxSamplesToScaleScores = 10
+				p.Pruning.InfererScores = types.Retention{Epochs: 9}
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "score retention in blocks below the samples to scale scores",
+			modify: func(p *types.Params) {
+				p.MaxSamplesToScaleScores = 10
+				p.MinEpochLength = 12
+				p.Pruning.ForecasterScores = types.Retention{Blocks: 119}
+			},
+			expectedErr: types.ErrValidationParamsInconsistent,
+		},
+		{
+			name: "score retention in blocks of the samples to scale scores",
+			modify: func(p *types.Params) {
+				p.MaxSamplesToScaleScores = 10
+				p.MinEpochLength = 12
+				p.Pruning.ForecasterScores = types.Retention{Blocks: 120}
+			},
+		},
+		{
+			name: "scores kept forever",
+			modify: func(p *types.Params) {
+				p.Pruning.InfererScores = types.Retention{}
+				p.Pruning.ForecasterScores = types.Retention{}
+				p.Pruning.ReputerScores = types.Retention{}
+			},
+		},
+		{
+			name: "max missed epochs ignored when liveness is disabled",
+			modify: func(p *types.Params) {
+				p.LivenessWindow = 0
+				p.MaxMissedEpochs = 10
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Names of the collections whose historical records are pruned, as reported by the state retention query
+const (
+	PrunedCollectionInfererScores              = "inferer_scores"
+	PrunedCollectionForecasterScores           = "forecaster_scores"
+	PrunedCollectionReputerScores              = "reputer_scores"
+	PrunedCollectionInfererRegrets             = "inferer_regrets"
+	PrunedCollectionForecasterRegrets          = "forecaster_regrets"
+	PrunedCollectionOneInForecasterRegrets     = "one_in_forecaster_regrets"
+	PrunedCollectionOneInForecasterSelfRegrets = "one_in_forecaster_self_regrets"
+	PrunedCollectionLatestInfererScores        = "latest_inferer_scores"
+	PrunedCollectionLatestForecasterScores     = "latest_forecaster_scores"
+	PrunedCollectionLatestReputerScores        = "latest_reputer_scores"
+	PrunedCollectionInferences                 = "inferences"
+	PrunedCollectionForecasts                  = "forecasts"
+	PrunedCollectionLossBundles                = "loss_bundles"
+	PrunedCollectionNetworkLossBundles         = "network_loss_bundles"
+	PrunedCollectionTopicRewardReports         = "topic_reward_reports"
+	PrunedCollectionWorkerCommitments          = "worker_commitments"
+	PrunedCollectionPendingWorkerPayloads      = "pending_worker_payloads"
+	PrunedCollectionPendingReputerPayloads     = "pending_reputer_payloads"
+)
+
+// True if records are kept for a limited time only
+func (r Retention) IsSet() bool {
+	return r.Epochs > 0 || r.Blocks > 0
+}
+
+// Returns the height up to which the records of a topic are older than the retention, and false if they
+// are kept forever or none of them is old enough yet
+func (r Retention) Cutoff(blockHeight BlockHeight, epochLength BlockHeight) (BlockHeight, bool) {
+	var cutoff BlockHeight
+	switch {
+	case r.Epochs > 0:
+		cutoff = blockHeight - int64(r.Epochs)*epochLength
+	case r.Blocks > 0:
+		cutoff = blockHeight - r.Blocks
+	default:
+		return 0, false
+	}
+	return cutoff, cutoff > 0
+}
+
+func (r Retention) Validate() error {
+	if r.Blocks < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	if r.Epochs > 0 && r.Blocks > 0 {
+		return ErrValidationRetentionAmbiguous
+	}
+	return nil
+}
+
+func (p PruningParams) Validate() error {
+	retentions := []struct {
+		name      string
+		retention Retention
+	}{
+		{"InfererScores", p.InfererScores},
+		{"ForecasterScores", p.ForecasterScores},
+		{"ReputerScores", p.ReputerScores},
+		{"Regrets", p.Regrets},
+		{"LatestScores", p.LatestScores},
+	}
+	for _, r := range retentions {
+		if err := r.retention.Validate(); err != nil {
+			return errorsmod.Wrap(err, r.name)
+		}
+	}
+	return nil
+}
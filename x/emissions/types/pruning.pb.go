@@ -0,0 +1,737 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: emissions/v1/pruning.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Where the pruner resumes at the next block
+type PruningCursor struct {
+	Collection uint64 `protobuf:"varint,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	TopicId    uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	LastKey    []byte `protobuf:"bytes,3,opt,name=last_key,json=lastKey,proto3" json:"last_key,omitempty"`
+}
+
+func (m *PruningCursor) Reset()         { *m = PruningCursor{} }
+func (m *PruningCursor) String() string { return proto.CompactTextString(m) }
+func (*PruningCursor) ProtoMessage()    {}
+func (*PruningCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb219bf6581d989, []int{0}
+}
+func (m *PruningCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruningCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruningCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruningCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruningCursor.Merge(m, src)
+}
+func (m *PruningCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruningCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruningCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruningCursor proto.InternalMessageInfo
+
+func (m *PruningCursor) GetCollection() uint64 {
+	if m != nil {
+		return m.Collection
+	}
+	return 0
+}
+
+func (m *PruningCursor) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *PruningCursor) GetLastKey() []byte {
+	if m != nil {
+		return m.LastKey
+	}
+	return nil
+}
+
+// How long the records of a collection are kept and how much space they take up
+type CollectionRetention struct {
+	Collection string    `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Retention  Retention `protobuf:"bytes,2,opt,name=retention,proto3" json:"retention"`
+	// records are pruned when their topic is rewarded, this many epochs behind its oldest unfulfilled reputer nonce,
+	// rather than incrementally at the end of every block
+	PrunedAfterRewards bool   `protobuf:"varint,3,opt,name=pruned_after_rewards,json=prunedAfterRewards,proto3" json:"pruned_after_rewards,omitempty"`
+	Entries            uint64 `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`
+	SizeBytes          uint64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (m *CollectionRetention) Reset()         { *m = CollectionRetention{} }
+func (m *CollectionRetention) String() string { return proto.CompactTextString(m) }
+func (*CollectionRetention) ProtoMessage()    {}
+func (*CollectionRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cb219bf6581d989, []int{1}
+}
+func (m *CollectionRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectionRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectionRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectionRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionRetention.Merge(m, src)
+}
+func (m *CollectionRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectionRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionRetention proto.InternalMessageInfo
+
+func (m *CollectionRetention) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *CollectionRetention) GetRetention() Retention {
+	if m != nil {
+		return m.Retention
+	}
+	return Retention{}
+}
+
+func (m *CollectionRetention) GetPrunedAfterRewards() bool {
+	if m != nil {
+		return m.PrunedAfterRewards
+	}
+	return false
+}
+
+func (m *CollectionRetention) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *CollectionRetention) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PruningCursor)(nil), "emissions.v1.PruningCursor")
+	proto.RegisterType((*CollectionRetention)(nil), "emissions.v1.CollectionRetention")
+}
+
+func init() { proto.RegisterFile("emissions/v1/pruning.proto", fileDescriptor_1cb219bf6581d989) }
+
+var fileDescriptor_1cb219bf6581d989 = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x4f, 0xc2, 0x30,
+	0x18, 0xc6, 0x37, 0x45, 0x81, 0x8a, 0x97, 0x49, 0xe2, 0x20, 0x71, 0x12, 0x4e, 0x5c, 0xdc, 0x44,
+	0x2f, 0x26, 0x9e, 0x84, 0x93, 0xf1, 0x62, 0x7a, 0xf4, 0xb2, 0x94, 0xed, 0x75, 0x34, 0x8c, 0x76,
+	0x69, 0x0b, 0x38, 0x3f, 0x85, 0x1f, 0x8b, 0x23, 0x47, 0x2f, 0x1a, 0x03, 0x5f, 0xc4, 0xb4, 0x93,
+	0x7f, 0xf1, 0xd6, 0xe7, 0xfd, 0xbd, 0x7d, 0x9e, 0xbe, 0x7d, 0x51, 0x13, 0xc6, 0x54, 0x4a, 0xca,
+	0x99, 0x0c, 0xa6, 0xdd, 0x20, 0x13, 0x13, 0x46, 0x59, 0xe2, 0x67, 0x82, 0x2b, 0xee, 0xd4, 0x36,
+	0xcc, 0x9f, 0x76, 0x9b, 0xf5, 0x84, 0x27, 0xdc, 0x80, 0x40, 0x9f, 0x8a, 0x9e, 0x66, 0x63, 0xff,
+	0x3e, 0x11, 0x64, 0x2c, 0x0b, 0xd4, 0x06, 0x74, 0xfa, 0x5c, 0xf8, 0xf5, 0x27, 0x42, 0x72, 0xe1,
+	0x78, 0x08, 0x45, 0x3c, 0x4d, 0x21, 0x52, 0x94, 0x33, 0xd7, 0x6e, 0xd9, 0x9d, 0x12, 0xde, 0xa9,
+	0x38, 0x0d, 0x54, 0x51, 0x3c, 0xa3, 0x51, 0x48, 0x63, 0xf7, 0xc0, 0xd0, 0xb2, 0xd1, 0x8f, 0xb1,
+	0x46, 0x29, 0x91, 0x2a, 0x1c, 0x41, 0xee, 0x1e, 0xb6, 0xec, 0x4e, 0x0d, 0x97, 0xb5, 0x7e, 0x82,
+	0xbc, 0xfd, 0x65, 0xa3, 0xb3, 0xfe, 0xc6, 0x04, 0x83, 0x02, 0x66, 0xdc, 0xfe, 0xa7, 0x55, 0xf7,
+	0xd2, 0xee, 0x51, 0x55, 0xac, 0x9b, 0x4d, 0xdc, 0xc9, 0xcd, 0xb9, 0xbf, 0x3b, 0xb1, 0xbf, 0xf1,
+	0xea, 0x95, 0xe6, 0xdf, 0x97, 0x16, 0xde, 0xf6, 0x3b, 0xd7, 0xa8, 0xae, 0xff, 0x0a, 0xe2, 0x90,
+	0xbc, 0x2a, 0x10, 0xa1, 0x80, 0x19, 0x11, 0xb1, 0x34, 0x6f, 0xab, 0x60, 0xa7, 0x60, 0x0f, 0x1a,
+	0xe1, 0x82, 0x38, 0x2e, 0x2a, 0x03, 0x53, 0x82, 0x82, 0x74, 0x4b, 0xc5, 0x6c, 0x7f, 0xd2, 0xb9,
+	0x40, 0x48, 0xd2, 0x77, 0x08, 0x07, 0xb9, 0x02, 0xe9, 0x1e, 0x19, 0x58, 0xd5, 0x95, 0x9e, 0x2e,
+	0xf4, 0xf0, 0x7c, 0xe9, 0xd9, 0x8b, 0xa5, 0x67, 0xff, 0x2c, 0x3d, 0xfb, 0x63, 0xe5, 0x59, 0x8b,
+	0x95, 0x67, 0x7d, 0xae, 0x3c, 0xeb, 0xe5, 0x2e, 0xa1, 0x6a, 0x38, 0x19, 0xf8, 0x11, 0x1f, 0x07,
+	0x24, 0x4d, 0xb9, 0x20, 0x57, 0x0c, 0xd4, 0x8c, 0x8b, 0xd1, 0x5a, 0x46, 0x43, 0x42, 0x59, 0xf0,
+	0x16, 0x6c, 0x97, 0xa4, 0xf2, 0x0c, 0xe4, 0xe0, 0xd8, 0x6c, 0xe8, 0xf6, 0x77, 0x00, 0x3c, 0xd1,
+	0x07, 0x98, 0xfe, 0x01, 0x00, 0x00,
+}
+
+func (m *PruningCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruningCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruningCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastKey) > 0 {
+		i -= len(m.LastKey)
+		copy(dAtA[i:], m.LastKey)
+		i = encodeVarintPruning(dAtA, i, uint64(len(m.LastKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Collection != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.Collection))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectionRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectionRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectionRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Entries != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PrunedAfterRewards {
+		i--
+		if m.PrunedAfterRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPruning(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintPruning(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPruning(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPruning(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PruningCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Collection != 0 {
+		n += 1 + sovPruning(uint64(m.Collection))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovPruning(uint64(m.TopicId))
+	}
+	l = len(m.LastKey)
+	if l > 0 {
+		n += 1 + l + sovPruning(uint64(l))
+	}
+	return n
+}
+
+func (m *CollectionRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovPruning(uint64(l))
+	}
+	l = m.Retention.Size()
+	n += 1 + l + sovPruning(uint64(l))
+	if m.PrunedAfterRewards {
+		n += 2
+	}
+	if m.Entries != 0 {
+		n += 1 + sovPruning(uint64(m.Entries))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPruning(uint64(m.SizeBytes))
+	}
+	return n
+}
+
+func sovPruning(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPruning(x uint64) (n int) {
+	return sovPruning(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PruningCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruningCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruningCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			m.Collection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Collection |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPruning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastKey = append(m.LastKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastKey == nil {
+				m.LastKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectionRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectionRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectionRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPruning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPruning
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedAfterRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrunedAfterRewards = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			m.Entries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPruning(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPruning
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPruning
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPruning
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPruning        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPruning          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPruning = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,7 +7,10 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -672,22 +675,26 @@ func (m *QueryStakeFromDelegatorInTopicResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryStakeFromDelegatorInTopicResponse proto.InternalMessageInfo
 
-type QueryTopicStakeRequest struct {
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+type QueryDelegateStakePendingRewardRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ReputerAddress   string `protobuf:"bytes,2,opt,name=reputer_address,json=reputerAddress,proto3" json:"reputer_address,omitempty"`
+	TopicId          uint64 `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *QueryTopicStakeRequest) Reset()         { *m = QueryTopicStakeRequest{} }
-func (m *QueryTopicStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTopicStakeRequest) ProtoMessage()    {}
-func (*QueryTopicStakeRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDelegateStakePendingRewardRequest) Reset() {
+	*m = QueryDelegateStakePendingRewardRequest{}
+}
+func (m *QueryDelegateStakePendingRewardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegateStakePendingRewardRequest) ProtoMessage()    {}
+func (*QueryDelegateStakePendingRewardRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{14}
 }
-func (m *QueryTopicStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegateStakePendingRewardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTopicStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegateStakePendingRewardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTopicStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegateStakePendingRewardRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -697,41 +704,57 @@ func (m *QueryTopicStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryTopicStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTopicStakeRequest.Merge(m, src)
+func (m *QueryDelegateStakePendingRewardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegateStakePendingRewardRequest.Merge(m, src)
 }
-func (m *QueryTopicStakeRequest) XXX_Size() int {
+func (m *QueryDelegateStakePendingRewardRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTopicStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTopicStakeRequest.DiscardUnknown(m)
+func (m *QueryDelegateStakePendingRewardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegateStakePendingRewardRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTopicStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegateStakePendingRewardRequest proto.InternalMessageInfo
 
-func (m *QueryTopicStakeRequest) GetTopicId() uint64 {
+func (m *QueryDelegateStakePendingRewardRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryDelegateStakePendingRewardRequest) GetReputerAddress() string {
+	if m != nil {
+		return m.ReputerAddress
+	}
+	return ""
+}
+
+func (m *QueryDelegateStakePendingRewardRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-type QueryTopicStakeResponse struct {
-	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+type QueryDelegateStakePendingRewardResponse struct {
+	PendingReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,opt,name=pending_reward,json=pendingReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"pending_reward"`
 }
 
-func (m *QueryTopicStakeResponse) Reset()         { *m = QueryTopicStakeResponse{} }
-func (m *QueryTopicStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTopicStakeResponse) ProtoMessage()    {}
-func (*QueryTopicStakeResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegateStakePendingRewardResponse) Reset() {
+	*m = QueryDelegateStakePendingRewardResponse{}
+}
+func (m *QueryDelegateStakePendingRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegateStakePendingRewardResponse) ProtoMessage()    {}
+func (*QueryDelegateStakePendingRewardResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{15}
 }
-func (m *QueryTopicStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegateStakePendingRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTopicStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegateStakePendingRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTopicStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegateStakePendingRewardResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -741,35 +764,34 @@ func (m *QueryTopicStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryTopicStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTopicStakeResponse.Merge(m, src)
+func (m *QueryDelegateStakePendingRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegateStakePendingRewardResponse.Merge(m, src)
 }
-func (m *QueryTopicStakeResponse) XXX_Size() int {
+func (m *QueryDelegateStakePendingRewardResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTopicStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTopicStakeResponse.DiscardUnknown(m)
+func (m *QueryDelegateStakePendingRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegateStakePendingRewardResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTopicStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegateStakePendingRewardResponse proto.InternalMessageInfo
 
-type QueryNetworkLossBundleAtBlockRequest struct {
-	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+type QueryDelegatorPendingRewardsRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryNetworkLossBundleAtBlockRequest) Reset()         { *m = QueryNetworkLossBundleAtBlockRequest{} }
-func (m *QueryNetworkLossBundleAtBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryNetworkLossBundleAtBlockRequest) ProtoMessage()    {}
-func (*QueryNetworkLossBundleAtBlockRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorPendingRewardsRequest) Reset()         { *m = QueryDelegatorPendingRewardsRequest{} }
+func (m *QueryDelegatorPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryDelegatorPendingRewardsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{16}
 }
-func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNetworkLossBundleAtBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorPendingRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -779,48 +801,43 @@ func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNetworkLossBundleAtBlockRequest.Merge(m, src)
+func (m *QueryDelegatorPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPendingRewardsRequest.Merge(m, src)
 }
-func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Size() int {
+func (m *QueryDelegatorPendingRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNetworkLossBundleAtBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNetworkLossBundleAtBlockRequest.DiscardUnknown(m)
+func (m *QueryDelegatorPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPendingRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNetworkLossBundleAtBlockRequest proto.InternalMessageInfo
-
-func (m *QueryNetworkLossBundleAtBlockRequest) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
-	}
-	return 0
-}
+var xxx_messageInfo_QueryDelegatorPendingRewardsRequest proto.InternalMessageInfo
 
-func (m *QueryNetworkLossBundleAtBlockRequest) GetBlockHeight() int64 {
+func (m *QueryDelegatorPendingRewardsRequest) GetDelegatorAddress() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.DelegatorAddress
 	}
-	return 0
+	return ""
 }
 
-type QueryNetworkLossBundleAtBlockResponse struct {
-	LossBundle *ValueBundle `protobuf:"bytes,1,opt,name=loss_bundle,json=lossBundle,proto3" json:"loss_bundle,omitempty"`
+type QueryDelegatorPendingRewardsResponse struct {
+	Positions          []DelegatorPendingReward                        `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	TotalPendingReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=total_pending_reward,json=totalPendingReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"total_pending_reward"`
+	AutoCompound       bool                                            `protobuf:"varint,3,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
-func (m *QueryNetworkLossBundleAtBlockResponse) Reset()         { *m = QueryNetworkLossBundleAtBlockResponse{} }
-func (m *QueryNetworkLossBundleAtBlockResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryNetworkLossBundleAtBlockResponse) ProtoMessage()    {}
-func (*QueryNetworkLossBundleAtBlockResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorPendingRewardsResponse) Reset()         { *m = QueryDelegatorPendingRewardsResponse{} }
+func (m *QueryDelegatorPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryDelegatorPendingRewardsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{17}
 }
-func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNetworkLossBundleAtBlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorPendingRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -830,40 +847,48 @@ func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNetworkLossBundleAtBlockResponse.Merge(m, src)
+func (m *QueryDelegatorPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPendingRewardsResponse.Merge(m, src)
 }
-func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Size() int {
+func (m *QueryDelegatorPendingRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNetworkLossBundleAtBlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNetworkLossBundleAtBlockResponse.DiscardUnknown(m)
+func (m *QueryDelegatorPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPendingRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNetworkLossBundleAtBlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorPendingRewardsResponse proto.InternalMessageInfo
 
-func (m *QueryNetworkLossBundleAtBlockResponse) GetLossBundle() *ValueBundle {
+func (m *QueryDelegatorPendingRewardsResponse) GetPositions() []DelegatorPendingReward {
 	if m != nil {
-		return m.LossBundle
+		return m.Positions
 	}
 	return nil
 }
 
-type QueryNextTopicIdRequest struct {
+func (m *QueryDelegatorPendingRewardsResponse) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
 }
 
-func (m *QueryNextTopicIdRequest) Reset()         { *m = QueryNextTopicIdRequest{} }
-func (m *QueryNextTopicIdRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryNextTopicIdRequest) ProtoMessage()    {}
-func (*QueryNextTopicIdRequest) Descriptor() ([]byte, []int) {
+type QueryReputerCommissionRequest struct {
+	ReputerAddress string `protobuf:"bytes,1,opt,name=reputer_address,json=reputerAddress,proto3" json:"reputer_address,omitempty"`
+}
+
+func (m *QueryReputerCommissionRequest) Reset()         { *m = QueryReputerCommissionRequest{} }
+func (m *QueryReputerCommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerCommissionRequest) ProtoMessage()    {}
+func (*QueryReputerCommissionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{18}
 }
-func (m *QueryNextTopicIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryReputerCommissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNextTopicIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReputerCommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNextTopicIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReputerCommissionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -873,34 +898,41 @@ func (m *QueryNextTopicIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryNextTopicIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNextTopicIdRequest.Merge(m, src)
+func (m *QueryReputerCommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerCommissionRequest.Merge(m, src)
 }
-func (m *QueryNextTopicIdRequest) XXX_Size() int {
+func (m *QueryReputerCommissionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNextTopicIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNextTopicIdRequest.DiscardUnknown(m)
+func (m *QueryReputerCommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerCommissionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNextTopicIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryReputerCommissionRequest proto.InternalMessageInfo
 
-type QueryNextTopicIdResponse struct {
-	NextTopicId uint64 `protobuf:"varint,1,opt,name=next_topic_id,json=nextTopicId,proto3" json:"next_topic_id,omitempty"`
+func (m *QueryReputerCommissionRequest) GetReputerAddress() string {
+	if m != nil {
+		return m.ReputerAddress
+	}
+	return ""
 }
 
-func (m *QueryNextTopicIdResponse) Reset()         { *m = QueryNextTopicIdResponse{} }
-func (m *QueryNextTopicIdResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryNextTopicIdResponse) ProtoMessage()    {}
-func (*QueryNextTopicIdResponse) Descriptor() ([]byte, []int) {
+type QueryReputerCommissionResponse struct {
+	Commission ReputerCommission `protobuf:"bytes,1,opt,name=commission,proto3" json:"commission"`
+}
+
+func (m *QueryReputerCommissionResponse) Reset()         { *m = QueryReputerCommissionResponse{} }
+func (m *QueryReputerCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerCommissionResponse) ProtoMessage()    {}
+func (*QueryReputerCommissionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{19}
 }
-func (m *QueryNextTopicIdResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryReputerCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNextTopicIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReputerCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNextTopicIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReputerCommissionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -910,41 +942,42 @@ func (m *QueryNextTopicIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryNextTopicIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNextTopicIdResponse.Merge(m, src)
+func (m *QueryReputerCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerCommissionResponse.Merge(m, src)
 }
-func (m *QueryNextTopicIdResponse) XXX_Size() int {
+func (m *QueryReputerCommissionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNextTopicIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNextTopicIdResponse.DiscardUnknown(m)
+func (m *QueryReputerCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerCommissionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNextTopicIdResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryReputerCommissionResponse proto.InternalMessageInfo
 
-func (m *QueryNextTopicIdResponse) GetNextTopicId() uint64 {
+func (m *QueryReputerCommissionResponse) GetCommission() ReputerCommission {
 	if m != nil {
-		return m.NextTopicId
+		return m.Commission
 	}
-	return 0
+	return ReputerCommission{}
 }
 
-type QueryTopicRequest struct {
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+type QueryRedelegationsFromReputerRequest struct {
+	ReputerAddress string `protobuf:"bytes,1,opt,name=reputer_address,json=reputerAddress,proto3" json:"reputer_address,omitempty"`
+	TopicId        uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *QueryTopicRequest) Reset()         { *m = QueryTopicRequest{} }
-func (m *QueryTopicRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTopicRequest) ProtoMessage()    {}
-func (*QueryTopicRequest) Descriptor() ([]byte, []int) {
+func (m *QueryRedelegationsFromReputerRequest) Reset()         { *m = QueryRedelegationsFromReputerRequest{} }
+func (m *QueryRedelegationsFromReputerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedelegationsFromReputerRequest) ProtoMessage()    {}
+func (*QueryRedelegationsFromReputerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{20}
 }
-func (m *QueryTopicRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRedelegationsFromReputerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRedelegationsFromReputerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTopicRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRedelegationsFromReputerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -954,43 +987,48 @@ func (m *QueryTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryTopicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTopicRequest.Merge(m, src)
+func (m *QueryRedelegationsFromReputerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedelegationsFromReputerRequest.Merge(m, src)
 }
-func (m *QueryTopicRequest) XXX_Size() int {
+func (m *QueryRedelegationsFromReputerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTopicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTopicRequest.DiscardUnknown(m)
+func (m *QueryRedelegationsFromReputerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedelegationsFromReputerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTopicRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRedelegationsFromReputerRequest proto.InternalMessageInfo
 
-func (m *QueryTopicRequest) GetTopicId() uint64 {
+func (m *QueryRedelegationsFromReputerRequest) GetReputerAddress() string {
+	if m != nil {
+		return m.ReputerAddress
+	}
+	return ""
+}
+
+func (m *QueryRedelegationsFromReputerRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-type QueryTopicResponse struct {
-	Topic            *Topic `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Weight           string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
-	EffectiveRevenue string `protobuf:"bytes,3,opt,name=effective_revenue,json=effectiveRevenue,proto3" json:"effective_revenue,omitempty"`
+type QueryRedelegationsFromReputerResponse struct {
+	Redelegations []Redelegation `protobuf:"bytes,1,rep,name=redelegations,proto3" json:"redelegations"`
 }
 
-func (m *QueryTopicResponse) Reset()         { *m = QueryTopicResponse{} }
-func (m *QueryTopicResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTopicResponse) ProtoMessage()    {}
-func (*QueryTopicResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRedelegationsFromReputerResponse) Reset()         { *m = QueryRedelegationsFromReputerResponse{} }
+func (m *QueryRedelegationsFromReputerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedelegationsFromReputerResponse) ProtoMessage()    {}
+func (*QueryRedelegationsFromReputerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{21}
 }
-func (m *QueryTopicResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRedelegationsFromReputerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRedelegationsFromReputerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTopicResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRedelegationsFromReputerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1000,55 +1038,41 @@ func (m *QueryTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTopicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTopicResponse.Merge(m, src)
+func (m *QueryRedelegationsFromReputerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedelegationsFromReputerResponse.Merge(m, src)
 }
-func (m *QueryTopicResponse) XXX_Size() int {
+func (m *QueryRedelegationsFromReputerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTopicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTopicResponse.DiscardUnknown(m)
+func (m *QueryRedelegationsFromReputerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedelegationsFromReputerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTopicResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRedelegationsFromReputerResponse proto.InternalMessageInfo
 
-func (m *QueryTopicResponse) GetTopic() *Topic {
+func (m *QueryRedelegationsFromReputerResponse) GetRedelegations() []Redelegation {
 	if m != nil {
-		return m.Topic
+		return m.Redelegations
 	}
 	return nil
 }
 
-func (m *QueryTopicResponse) GetWeight() string {
-	if m != nil {
-		return m.Weight
-	}
-	return ""
-}
-
-func (m *QueryTopicResponse) GetEffectiveRevenue() string {
-	if m != nil {
-		return m.EffectiveRevenue
-	}
-	return ""
-}
-
-type QueryActiveTopicsRequest struct {
-	Pagination *SimpleCursorPaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryReputerStakePoolRequest struct {
+	ReputerAddress string `protobuf:"bytes,1,opt,name=reputer_address,json=reputerAddress,proto3" json:"reputer_address,omitempty"`
 }
 
-func (m *QueryActiveTopicsRequest) Reset()         { *m = QueryActiveTopicsRequest{} }
-func (m *QueryActiveTopicsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryActiveTopicsRequest) ProtoMessage()    {}
-func (*QueryActiveTopicsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryReputerStakePoolRequest) Reset()         { *m = QueryReputerStakePoolRequest{} }
+func (m *QueryReputerStakePoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerStakePoolRequest) ProtoMessage()    {}
+func (*QueryReputerStakePoolRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{22}
 }
-func (m *QueryActiveTopicsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryReputerStakePoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryActiveTopicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReputerStakePoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryActiveTopicsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReputerStakePoolRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1058,42 +1082,44 @@ func (m *QueryActiveTopicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryActiveTopicsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryActiveTopicsRequest.Merge(m, src)
+func (m *QueryReputerStakePoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerStakePoolRequest.Merge(m, src)
 }
-func (m *QueryActiveTopicsRequest) XXX_Size() int {
+func (m *QueryReputerStakePoolRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryActiveTopicsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryActiveTopicsRequest.DiscardUnknown(m)
+func (m *QueryReputerStakePoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerStakePoolRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryActiveTopicsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryReputerStakePoolRequest proto.InternalMessageInfo
 
-func (m *QueryActiveTopicsRequest) GetPagination() *SimpleCursorPaginationRequest {
+func (m *QueryReputerStakePoolRequest) GetReputerAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.ReputerAddress
 	}
-	return nil
+	return ""
 }
 
-type QueryActiveTopicsResponse struct {
-	Topics     []*Topic                        `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
-	Pagination *SimpleCursorPaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryReputerStakePoolResponse struct {
+	Amount           cosmossdk_io_math.Int            `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Allocations      []ReputerStakeAllocation         `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations"`
+	PendingDecreases []ReputerStakeAllocationDecrease `protobuf:"bytes,3,rep,name=pending_decreases,json=pendingDecreases,proto3" json:"pending_decreases"`
+	PendingRemoval   *ReputerStakePoolRemoval         `protobuf:"bytes,4,opt,name=pending_removal,json=pendingRemoval,proto3" json:"pending_removal,omitempty"`
 }
 
-func (m *QueryActiveTopicsResponse) Reset()         { *m = QueryActiveTopicsResponse{} }
-func (m *QueryActiveTopicsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryActiveTopicsResponse) ProtoMessage()    {}
-func (*QueryActiveTopicsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryReputerStakePoolResponse) Reset()         { *m = QueryReputerStakePoolResponse{} }
+func (m *QueryReputerStakePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerStakePoolResponse) ProtoMessage()    {}
+func (*QueryReputerStakePoolResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{23}
 }
-func (m *QueryActiveTopicsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryReputerStakePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryActiveTopicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReputerStakePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryActiveTopicsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReputerStakePoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1103,50 +1129,55 @@ func (m *QueryActiveTopicsResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryActiveTopicsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryActiveTopicsResponse.Merge(m, src)
+func (m *QueryReputerStakePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerStakePoolResponse.Merge(m, src)
 }
-func (m *QueryActiveTopicsResponse) XXX_Size() int {
+func (m *QueryReputerStakePoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryActiveTopicsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryActiveTopicsResponse.DiscardUnknown(m)
+func (m *QueryReputerStakePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerStakePoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryActiveTopicsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryReputerStakePoolResponse proto.InternalMessageInfo
 
-func (m *QueryActiveTopicsResponse) GetTopics() []*Topic {
+func (m *QueryReputerStakePoolResponse) GetAllocations() []ReputerStakeAllocation {
 	if m != nil {
-		return m.Topics
+		return m.Allocations
 	}
 	return nil
 }
 
-func (m *QueryActiveTopicsResponse) GetPagination() *SimpleCursorPaginationResponse {
+func (m *QueryReputerStakePoolResponse) GetPendingDecreases() []ReputerStakeAllocationDecrease {
 	if m != nil {
-		return m.Pagination
+		return m.PendingDecreases
 	}
 	return nil
 }
 
-// Returns the inferences on a topic posted at a block height
-type QueryInferencesAtBlockRequest struct {
-	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+func (m *QueryReputerStakePoolResponse) GetPendingRemoval() *ReputerStakePoolRemoval {
+	if m != nil {
+		return m.PendingRemoval
+	}
+	return nil
 }
 
-func (m *QueryInferencesAtBlockRequest) Reset()         { *m = QueryInferencesAtBlockRequest{} }
-func (m *QueryInferencesAtBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryInferencesAtBlockRequest) ProtoMessage()    {}
-func (*QueryInferencesAtBlockRequest) Descriptor() ([]byte, []int) {
+type QueryTopicStakeRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+}
+
+func (m *QueryTopicStakeRequest) Reset()         { *m = QueryTopicStakeRequest{} }
+func (m *QueryTopicStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicStakeRequest) ProtoMessage()    {}
+func (*QueryTopicStakeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{24}
 }
-func (m *QueryInferencesAtBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicStakeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryInferencesAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryInferencesAtBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicStakeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1156,52 +1187,41 @@ func (m *QueryInferencesAtBlockRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryInferencesAtBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryInferencesAtBlockRequest.Merge(m, src)
+func (m *QueryTopicStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicStakeRequest.Merge(m, src)
 }
-func (m *QueryInferencesAtBlockRequest) XXX_Size() int {
+func (m *QueryTopicStakeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryInferencesAtBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryInferencesAtBlockRequest.DiscardUnknown(m)
+func (m *QueryTopicStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicStakeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryInferencesAtBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicStakeRequest proto.InternalMessageInfo
 
-func (m *QueryInferencesAtBlockRequest) GetTopicId() uint64 {
+func (m *QueryTopicStakeRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *QueryInferencesAtBlockRequest) GetBlockHeight() int64 {
-	if m != nil {
-		return m.BlockHeight
-	}
-	return 0
-}
-
-// Returns the inferences on a topic posted at a block height
-//
-// NOTE: The amount field is a Uint which implements the custom method
-// signatures required by gogoproto.
-type QueryInferencesAtBlockResponse struct {
-	Inferences *Inferences `protobuf:"bytes,1,opt,name=inferences,proto3" json:"inferences,omitempty"`
+type QueryTopicStakeResponse struct {
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *QueryInferencesAtBlockResponse) Reset()         { *m = QueryInferencesAtBlockResponse{} }
-func (m *QueryInferencesAtBlockResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryInferencesAtBlockResponse) ProtoMessage()    {}
-func (*QueryInferencesAtBlockResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTopicStakeResponse) Reset()         { *m = QueryTopicStakeResponse{} }
+func (m *QueryTopicStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicStakeResponse) ProtoMessage()    {}
+func (*QueryTopicStakeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{25}
 }
-func (m *QueryInferencesAtBlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryInferencesAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryInferencesAtBlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicStakeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1211,43 +1231,35 @@ func (m *QueryInferencesAtBlockResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryInferencesAtBlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryInferencesAtBlockResponse.Merge(m, src)
+func (m *QueryTopicStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicStakeResponse.Merge(m, src)
 }
-func (m *QueryInferencesAtBlockResponse) XXX_Size() int {
+func (m *QueryTopicStakeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryInferencesAtBlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryInferencesAtBlockResponse.DiscardUnknown(m)
+func (m *QueryTopicStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicStakeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryInferencesAtBlockResponse proto.InternalMessageInfo
-
-func (m *QueryInferencesAtBlockResponse) GetInferences() *Inferences {
-	if m != nil {
-		return m.Inferences
-	}
-	return nil
-}
+var xxx_messageInfo_QueryTopicStakeResponse proto.InternalMessageInfo
 
-// Returns the forecasts on a topic posted at a block height
-type QueryForecastsAtBlockRequest struct {
+type QueryNetworkLossBundleAtBlockRequest struct {
 	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *QueryForecastsAtBlockRequest) Reset()         { *m = QueryForecastsAtBlockRequest{} }
-func (m *QueryForecastsAtBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryForecastsAtBlockRequest) ProtoMessage()    {}
-func (*QueryForecastsAtBlockRequest) Descriptor() ([]byte, []int) {
+func (m *QueryNetworkLossBundleAtBlockRequest) Reset()         { *m = QueryNetworkLossBundleAtBlockRequest{} }
+func (m *QueryNetworkLossBundleAtBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkLossBundleAtBlockRequest) ProtoMessage()    {}
+func (*QueryNetworkLossBundleAtBlockRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{26}
 }
-func (m *QueryForecastsAtBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryForecastsAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryForecastsAtBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNetworkLossBundleAtBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1257,52 +1269,48 @@ func (m *QueryForecastsAtBlockRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryForecastsAtBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryForecastsAtBlockRequest.Merge(m, src)
+func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkLossBundleAtBlockRequest.Merge(m, src)
 }
-func (m *QueryForecastsAtBlockRequest) XXX_Size() int {
+func (m *QueryNetworkLossBundleAtBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryForecastsAtBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryForecastsAtBlockRequest.DiscardUnknown(m)
+func (m *QueryNetworkLossBundleAtBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkLossBundleAtBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryForecastsAtBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryNetworkLossBundleAtBlockRequest proto.InternalMessageInfo
 
-func (m *QueryForecastsAtBlockRequest) GetTopicId() uint64 {
+func (m *QueryNetworkLossBundleAtBlockRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *QueryForecastsAtBlockRequest) GetBlockHeight() int64 {
+func (m *QueryNetworkLossBundleAtBlockRequest) GetBlockHeight() int64 {
 	if m != nil {
 		return m.BlockHeight
 	}
 	return 0
 }
 
-// Returns the forecasts on a topic posted at a block height
-//
-// NOTE: The amount field is a Uint which implements the custom method
-// signatures required by gogoproto.
-type QueryForecastsAtBlockResponse struct {
-	Forecasts *Forecasts `protobuf:"bytes,1,opt,name=forecasts,proto3" json:"forecasts,omitempty"`
+type QueryNetworkLossBundleAtBlockResponse struct {
+	LossBundle *ValueBundle `protobuf:"bytes,1,opt,name=loss_bundle,json=lossBundle,proto3" json:"loss_bundle,omitempty"`
 }
 
-func (m *QueryForecastsAtBlockResponse) Reset()         { *m = QueryForecastsAtBlockResponse{} }
-func (m *QueryForecastsAtBlockResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryForecastsAtBlockResponse) ProtoMessage()    {}
-func (*QueryForecastsAtBlockResponse) Descriptor() ([]byte, []int) {
+func (m *QueryNetworkLossBundleAtBlockResponse) Reset()         { *m = QueryNetworkLossBundleAtBlockResponse{} }
+func (m *QueryNetworkLossBundleAtBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkLossBundleAtBlockResponse) ProtoMessage()    {}
+func (*QueryNetworkLossBundleAtBlockResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{27}
 }
-func (m *QueryForecastsAtBlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryForecastsAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryForecastsAtBlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNetworkLossBundleAtBlockResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1312,42 +1320,40 @@ func (m *QueryForecastsAtBlockResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryForecastsAtBlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryForecastsAtBlockResponse.Merge(m, src)
+func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkLossBundleAtBlockResponse.Merge(m, src)
 }
-func (m *QueryForecastsAtBlockResponse) XXX_Size() int {
+func (m *QueryNetworkLossBundleAtBlockResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryForecastsAtBlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryForecastsAtBlockResponse.DiscardUnknown(m)
+func (m *QueryNetworkLossBundleAtBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkLossBundleAtBlockResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryForecastsAtBlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNetworkLossBundleAtBlockResponse proto.InternalMessageInfo
 
-func (m *QueryForecastsAtBlockResponse) GetForecasts() *Forecasts {
+func (m *QueryNetworkLossBundleAtBlockResponse) GetLossBundle() *ValueBundle {
 	if m != nil {
-		return m.Forecasts
+		return m.LossBundle
 	}
 	return nil
 }
 
-type QueryWorkerLatestInferenceRequest struct {
-	TopicId       uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	WorkerAddress string `protobuf:"bytes,2,opt,name=worker_address,json=workerAddress,proto3" json:"worker_address,omitempty"`
+type QueryNextTopicIdRequest struct {
 }
 
-func (m *QueryWorkerLatestInferenceRequest) Reset()         { *m = QueryWorkerLatestInferenceRequest{} }
-func (m *QueryWorkerLatestInferenceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkerLatestInferenceRequest) ProtoMessage()    {}
-func (*QueryWorkerLatestInferenceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryNextTopicIdRequest) Reset()         { *m = QueryNextTopicIdRequest{} }
+func (m *QueryNextTopicIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextTopicIdRequest) ProtoMessage()    {}
+func (*QueryNextTopicIdRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{28}
 }
-func (m *QueryWorkerLatestInferenceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryNextTopicIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkerLatestInferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNextTopicIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkerLatestInferenceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNextTopicIdRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1357,48 +1363,34 @@ func (m *QueryWorkerLatestInferenceRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkerLatestInferenceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkerLatestInferenceRequest.Merge(m, src)
+func (m *QueryNextTopicIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextTopicIdRequest.Merge(m, src)
 }
-func (m *QueryWorkerLatestInferenceRequest) XXX_Size() int {
+func (m *QueryNextTopicIdRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkerLatestInferenceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkerLatestInferenceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryWorkerLatestInferenceRequest proto.InternalMessageInfo
-
-func (m *QueryWorkerLatestInferenceRequest) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
-	}
-	return 0
+func (m *QueryNextTopicIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextTopicIdRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWorkerLatestInferenceRequest) GetWorkerAddress() string {
-	if m != nil {
-		return m.WorkerAddress
-	}
-	return ""
-}
+var xxx_messageInfo_QueryNextTopicIdRequest proto.InternalMessageInfo
 
-type QueryWorkerLatestInferenceResponse struct {
-	LatestInference *Inference `protobuf:"bytes,1,opt,name=latest_inference,json=latestInference,proto3" json:"latest_inference,omitempty"`
+type QueryNextTopicIdResponse struct {
+	NextTopicId uint64 `protobuf:"varint,1,opt,name=next_topic_id,json=nextTopicId,proto3" json:"next_topic_id,omitempty"`
 }
 
-func (m *QueryWorkerLatestInferenceResponse) Reset()         { *m = QueryWorkerLatestInferenceResponse{} }
-func (m *QueryWorkerLatestInferenceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkerLatestInferenceResponse) ProtoMessage()    {}
-func (*QueryWorkerLatestInferenceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryNextTopicIdResponse) Reset()         { *m = QueryNextTopicIdResponse{} }
+func (m *QueryNextTopicIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextTopicIdResponse) ProtoMessage()    {}
+func (*QueryNextTopicIdResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{29}
 }
-func (m *QueryWorkerLatestInferenceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryNextTopicIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkerLatestInferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNextTopicIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkerLatestInferenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNextTopicIdResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1408,41 +1400,41 @@ func (m *QueryWorkerLatestInferenceResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkerLatestInferenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkerLatestInferenceResponse.Merge(m, src)
+func (m *QueryNextTopicIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextTopicIdResponse.Merge(m, src)
 }
-func (m *QueryWorkerLatestInferenceResponse) XXX_Size() int {
+func (m *QueryNextTopicIdResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkerLatestInferenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkerLatestInferenceResponse.DiscardUnknown(m)
+func (m *QueryNextTopicIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextTopicIdResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWorkerLatestInferenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNextTopicIdResponse proto.InternalMessageInfo
 
-func (m *QueryWorkerLatestInferenceResponse) GetLatestInference() *Inference {
+func (m *QueryNextTopicIdResponse) GetNextTopicId() uint64 {
 	if m != nil {
-		return m.LatestInference
+		return m.NextTopicId
 	}
-	return nil
+	return 0
 }
 
-type QueryWorkerNodeInfoRequest struct {
-	Libp2PKey string `protobuf:"bytes,1,opt,name=libp2p_key,json=libp2pKey,proto3" json:"libp2p_key,omitempty"`
+type QueryTopicRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *QueryWorkerNodeInfoRequest) Reset()         { *m = QueryWorkerNodeInfoRequest{} }
-func (m *QueryWorkerNodeInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkerNodeInfoRequest) ProtoMessage()    {}
-func (*QueryWorkerNodeInfoRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTopicRequest) Reset()         { *m = QueryTopicRequest{} }
+func (m *QueryTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicRequest) ProtoMessage()    {}
+func (*QueryTopicRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{30}
 }
-func (m *QueryWorkerNodeInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkerNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkerNodeInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1452,41 +1444,43 @@ func (m *QueryWorkerNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkerNodeInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkerNodeInfoRequest.Merge(m, src)
+func (m *QueryTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicRequest.Merge(m, src)
 }
-func (m *QueryWorkerNodeInfoRequest) XXX_Size() int {
+func (m *QueryTopicRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkerNodeInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkerNodeInfoRequest.DiscardUnknown(m)
+func (m *QueryTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWorkerNodeInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicRequest proto.InternalMessageInfo
 
-func (m *QueryWorkerNodeInfoRequest) GetLibp2PKey() string {
+func (m *QueryTopicRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.Libp2PKey
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-type QueryWorkerNodeInfoResponse struct {
-	NodeInfo *OffchainNode `protobuf:"bytes,1,opt,name=node_info,json=nodeInfo,proto3" json:"node_info,omitempty"`
+type QueryTopicResponse struct {
+	Topic            *Topic `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Weight           string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	EffectiveRevenue string `protobuf:"bytes,3,opt,name=effective_revenue,json=effectiveRevenue,proto3" json:"effective_revenue,omitempty"`
 }
 
-func (m *QueryWorkerNodeInfoResponse) Reset()         { *m = QueryWorkerNodeInfoResponse{} }
-func (m *QueryWorkerNodeInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkerNodeInfoResponse) ProtoMessage()    {}
-func (*QueryWorkerNodeInfoResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTopicResponse) Reset()         { *m = QueryTopicResponse{} }
+func (m *QueryTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicResponse) ProtoMessage()    {}
+func (*QueryTopicResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{31}
 }
-func (m *QueryWorkerNodeInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkerNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkerNodeInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1496,41 +1490,55 @@ func (m *QueryWorkerNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkerNodeInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkerNodeInfoResponse.Merge(m, src)
+func (m *QueryTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicResponse.Merge(m, src)
 }
-func (m *QueryWorkerNodeInfoResponse) XXX_Size() int {
+func (m *QueryTopicResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkerNodeInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkerNodeInfoResponse.DiscardUnknown(m)
+func (m *QueryTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWorkerNodeInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicResponse proto.InternalMessageInfo
 
-func (m *QueryWorkerNodeInfoResponse) GetNodeInfo() *OffchainNode {
+func (m *QueryTopicResponse) GetTopic() *Topic {
 	if m != nil {
-		return m.NodeInfo
+		return m.Topic
 	}
 	return nil
 }
 
-type QueryReputerNodeInfoRequest struct {
-	Libp2PKey string `protobuf:"bytes,1,opt,name=libp2p_key,json=libp2pKey,proto3" json:"libp2p_key,omitempty"`
+func (m *QueryTopicResponse) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
 }
 
-func (m *QueryReputerNodeInfoRequest) Reset()         { *m = QueryReputerNodeInfoRequest{} }
-func (m *QueryReputerNodeInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryReputerNodeInfoRequest) ProtoMessage()    {}
-func (*QueryReputerNodeInfoRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTopicResponse) GetEffectiveRevenue() string {
+	if m != nil {
+		return m.EffectiveRevenue
+	}
+	return ""
+}
+
+type QueryTopicParamsRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+}
+
+func (m *QueryTopicParamsRequest) Reset()         { *m = QueryTopicParamsRequest{} }
+func (m *QueryTopicParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicParamsRequest) ProtoMessage()    {}
+func (*QueryTopicParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{32}
 }
-func (m *QueryReputerNodeInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReputerNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReputerNodeInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1540,41 +1548,44 @@ func (m *QueryReputerNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryReputerNodeInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReputerNodeInfoRequest.Merge(m, src)
+func (m *QueryTopicParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicParamsRequest.Merge(m, src)
 }
-func (m *QueryReputerNodeInfoRequest) XXX_Size() int {
+func (m *QueryTopicParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReputerNodeInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReputerNodeInfoRequest.DiscardUnknown(m)
+func (m *QueryTopicParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReputerNodeInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicParamsRequest proto.InternalMessageInfo
 
-func (m *QueryReputerNodeInfoRequest) GetLibp2PKey() string {
+func (m *QueryTopicParamsRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.Libp2PKey
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-type QueryReputerNodeInfoResponse struct {
-	NodeInfo *OffchainNode `protobuf:"bytes,1,opt,name=node_info,json=nodeInfo,proto3" json:"node_info,omitempty"`
+type QueryTopicParamsResponse struct {
+	// overrides set by the topic creator
+	TopicParams TopicParams `protobuf:"bytes,1,opt,name=topic_params,json=topicParams,proto3" json:"topic_params"`
+	// global params with the overrides of the topic applied
+	EffectiveParams Params `protobuf:"bytes,2,opt,name=effective_params,json=effectiveParams,proto3" json:"effective_params"`
 }
 
-func (m *QueryReputerNodeInfoResponse) Reset()         { *m = QueryReputerNodeInfoResponse{} }
-func (m *QueryReputerNodeInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryReputerNodeInfoResponse) ProtoMessage()    {}
-func (*QueryReputerNodeInfoResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTopicParamsResponse) Reset()         { *m = QueryTopicParamsResponse{} }
+func (m *QueryTopicParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicParamsResponse) ProtoMessage()    {}
+func (*QueryTopicParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{33}
 }
-func (m *QueryReputerNodeInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopicParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReputerNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopicParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReputerNodeInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopicParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1584,41 +1595,48 @@ func (m *QueryReputerNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryReputerNodeInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReputerNodeInfoResponse.Merge(m, src)
+func (m *QueryTopicParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicParamsResponse.Merge(m, src)
 }
-func (m *QueryReputerNodeInfoResponse) XXX_Size() int {
+func (m *QueryTopicParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReputerNodeInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReputerNodeInfoResponse.DiscardUnknown(m)
+func (m *QueryTopicParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReputerNodeInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopicParamsResponse proto.InternalMessageInfo
 
-func (m *QueryReputerNodeInfoResponse) GetNodeInfo() *OffchainNode {
+func (m *QueryTopicParamsResponse) GetTopicParams() TopicParams {
 	if m != nil {
-		return m.NodeInfo
+		return m.TopicParams
 	}
-	return nil
+	return TopicParams{}
 }
 
-type QueryWorkerAddressByP2PKeyRequest struct {
-	Libp2PKey string `protobuf:"bytes,1,opt,name=libp2p_key,json=libp2pKey,proto3" json:"libp2p_key,omitempty"`
+func (m *QueryTopicParamsResponse) GetEffectiveParams() Params {
+	if m != nil {
+		return m.EffectiveParams
+	}
+	return Params{}
 }
 
-func (m *QueryWorkerAddressByP2PKeyRequest) Reset()         { *m = QueryWorkerAddressByP2PKeyRequest{} }
-func (m *QueryWorkerAddressByP2PKeyRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkerAddressByP2PKeyRequest) ProtoMessage()    {}
-func (*QueryWorkerAddressByP2PKeyRequest) Descriptor() ([]byte, []int) {
+type QueryActiveTopicsRequest struct {
+	Pagination *SimpleCursorPaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActiveTopicsRequest) Reset()         { *m = QueryActiveTopicsRequest{} }
+func (m *QueryActiveTopicsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveTopicsRequest) ProtoMessage()    {}
+func (*QueryActiveTopicsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{34}
 }
-func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryActiveTopicsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryActiveTopicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkerAddressByP2PKeyRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryActiveTopicsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1628,41 +1646,42 @@ func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkerAddressByP2PKeyRequest.Merge(m, src)
+func (m *QueryActiveTopicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveTopicsRequest.Merge(m, src)
 }
-func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Size() int {
+func (m *QueryActiveTopicsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkerAddressByP2PKeyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkerAddressByP2PKeyRequest.DiscardUnknown(m)
+func (m *QueryActiveTopicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveTopicsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWorkerAddressByP2PKeyRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryActiveTopicsRequest proto.InternalMessageInfo
 
-func (m *QueryWorkerAddressByP2PKeyRequest) GetLibp2PKey() string {
+func (m *QueryActiveTopicsRequest) GetPagination() *SimpleCursorPaginationRequest {
 	if m != nil {
-		return m.Libp2PKey
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QueryWorkerAddressByP2PKeyResponse struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type QueryActiveTopicsResponse struct {
+	Topics     []*Topic                        `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	Pagination *SimpleCursorPaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) Reset()         { *m = QueryWorkerAddressByP2PKeyResponse{} }
-func (m *QueryWorkerAddressByP2PKeyResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkerAddressByP2PKeyResponse) ProtoMessage()    {}
-func (*QueryWorkerAddressByP2PKeyResponse) Descriptor() ([]byte, []int) {
+func (m *QueryActiveTopicsResponse) Reset()         { *m = QueryActiveTopicsResponse{} }
+func (m *QueryActiveTopicsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveTopicsResponse) ProtoMessage()    {}
+func (*QueryActiveTopicsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{35}
 }
-func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryActiveTopicsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryActiveTopicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkerAddressByP2PKeyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryActiveTopicsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1672,41 +1691,50 @@ func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkerAddressByP2PKeyResponse.Merge(m, src)
+func (m *QueryActiveTopicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveTopicsResponse.Merge(m, src)
 }
-func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Size() int {
+func (m *QueryActiveTopicsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkerAddressByP2PKeyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkerAddressByP2PKeyResponse.DiscardUnknown(m)
+func (m *QueryActiveTopicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveTopicsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWorkerAddressByP2PKeyResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryActiveTopicsResponse proto.InternalMessageInfo
 
-func (m *QueryWorkerAddressByP2PKeyResponse) GetAddress() string {
+func (m *QueryActiveTopicsResponse) GetTopics() []*Topic {
 	if m != nil {
-		return m.Address
+		return m.Topics
 	}
-	return ""
+	return nil
 }
 
-type QueryReputerAddressByP2PKeyRequest struct {
-	Libp2PKey string `protobuf:"bytes,1,opt,name=libp2p_key,json=libp2pKey,proto3" json:"libp2p_key,omitempty"`
+func (m *QueryActiveTopicsResponse) GetPagination() *SimpleCursorPaginationResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) Reset()         { *m = QueryReputerAddressByP2PKeyRequest{} }
-func (m *QueryReputerAddressByP2PKeyRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryReputerAddressByP2PKeyRequest) ProtoMessage()    {}
-func (*QueryReputerAddressByP2PKeyRequest) Descriptor() ([]byte, []int) {
+// Returns the inferences on a topic posted at a block height
+type QueryInferencesAtBlockRequest struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *QueryInferencesAtBlockRequest) Reset()         { *m = QueryInferencesAtBlockRequest{} }
+func (m *QueryInferencesAtBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInferencesAtBlockRequest) ProtoMessage()    {}
+func (*QueryInferencesAtBlockRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{36}
 }
-func (m *QueryReputerAddressByP2PKeyRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryInferencesAtBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReputerAddressByP2PKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryInferencesAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReputerAddressByP2PKeyRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryInferencesAtBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1716,41 +1744,52 @@ func (m *QueryReputerAddressByP2PKeyRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryReputerAddressByP2PKeyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReputerAddressByP2PKeyRequest.Merge(m, src)
+func (m *QueryInferencesAtBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInferencesAtBlockRequest.Merge(m, src)
 }
-func (m *QueryReputerAddressByP2PKeyRequest) XXX_Size() int {
+func (m *QueryInferencesAtBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReputerAddressByP2PKeyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReputerAddressByP2PKeyRequest.DiscardUnknown(m)
+func (m *QueryInferencesAtBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInferencesAtBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReputerAddressByP2PKeyRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryInferencesAtBlockRequest proto.InternalMessageInfo
 
-func (m *QueryReputerAddressByP2PKeyRequest) GetLibp2PKey() string {
+func (m *QueryInferencesAtBlockRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.Libp2PKey
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-type QueryReputerAddressByP2PKeyResponse struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *QueryInferencesAtBlockRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *QueryReputerAddressByP2PKeyResponse) Reset()         { *m = QueryReputerAddressByP2PKeyResponse{} }
-func (m *QueryReputerAddressByP2PKeyResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryReputerAddressByP2PKeyResponse) ProtoMessage()    {}
-func (*QueryReputerAddressByP2PKeyResponse) Descriptor() ([]byte, []int) {
+// Returns the inferences on a topic posted at a block height
+//
+// NOTE: The amount field is a Uint which implements the custom method
+// signatures required by gogoproto.
+type QueryInferencesAtBlockResponse struct {
+	Inferences *Inferences `protobuf:"bytes,1,opt,name=inferences,proto3" json:"inferences,omitempty"`
+}
+
+func (m *QueryInferencesAtBlockResponse) Reset()         { *m = QueryInferencesAtBlockResponse{} }
+func (m *QueryInferencesAtBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInferencesAtBlockResponse) ProtoMessage()    {}
+func (*QueryInferencesAtBlockResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{37}
 }
-func (m *QueryReputerAddressByP2PKeyResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryInferencesAtBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReputerAddressByP2PKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryInferencesAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReputerAddressByP2PKeyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryInferencesAtBlockResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1760,43 +1799,43 @@ func (m *QueryReputerAddressByP2PKeyResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryReputerAddressByP2PKeyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReputerAddressByP2PKeyResponse.Merge(m, src)
+func (m *QueryInferencesAtBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInferencesAtBlockResponse.Merge(m, src)
 }
-func (m *QueryReputerAddressByP2PKeyResponse) XXX_Size() int {
+func (m *QueryInferencesAtBlockResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReputerAddressByP2PKeyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReputerAddressByP2PKeyResponse.DiscardUnknown(m)
+func (m *QueryInferencesAtBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInferencesAtBlockResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReputerAddressByP2PKeyResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryInferencesAtBlockResponse proto.InternalMessageInfo
 
-func (m *QueryReputerAddressByP2PKeyResponse) GetAddress() string {
+func (m *QueryInferencesAtBlockResponse) GetInferences() *Inferences {
 	if m != nil {
-		return m.Address
+		return m.Inferences
 	}
-	return ""
+	return nil
 }
 
-type QueryNetworkInferencesAtBlockRequest struct {
-	TopicId                  uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeightLastInference int64  `protobuf:"varint,2,opt,name=block_height_last_inference,json=blockHeightLastInference,proto3" json:"block_height_last_inference,omitempty"`
-	BlockHeightLastReward    int64  `protobuf:"varint,3,opt,name=block_height_last_reward,json=blockHeightLastReward,proto3" json:"block_height_last_reward,omitempty"`
+// Returns the forecasts on a topic posted at a block height
+type QueryForecastsAtBlockRequest struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *QueryNetworkInferencesAtBlockRequest) Reset()         { *m = QueryNetworkInferencesAtBlockRequest{} }
-func (m *QueryNetworkInferencesAtBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryNetworkInferencesAtBlockRequest) ProtoMessage()    {}
-func (*QueryNetworkInferencesAtBlockRequest) Descriptor() ([]byte, []int) {
+func (m *QueryForecastsAtBlockRequest) Reset()         { *m = QueryForecastsAtBlockRequest{} }
+func (m *QueryForecastsAtBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForecastsAtBlockRequest) ProtoMessage()    {}
+func (*QueryForecastsAtBlockRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{38}
 }
-func (m *QueryNetworkInferencesAtBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryForecastsAtBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNetworkInferencesAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryForecastsAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNetworkInferencesAtBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryForecastsAtBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1806,55 +1845,52 @@ func (m *QueryNetworkInferencesAtBlockRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryNetworkInferencesAtBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNetworkInferencesAtBlockRequest.Merge(m, src)
+func (m *QueryForecastsAtBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForecastsAtBlockRequest.Merge(m, src)
 }
-func (m *QueryNetworkInferencesAtBlockRequest) XXX_Size() int {
+func (m *QueryForecastsAtBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNetworkInferencesAtBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNetworkInferencesAtBlockRequest.DiscardUnknown(m)
+func (m *QueryForecastsAtBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForecastsAtBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNetworkInferencesAtBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryForecastsAtBlockRequest proto.InternalMessageInfo
 
-func (m *QueryNetworkInferencesAtBlockRequest) GetTopicId() uint64 {
+func (m *QueryForecastsAtBlockRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *QueryNetworkInferencesAtBlockRequest) GetBlockHeightLastInference() int64 {
-	if m != nil {
-		return m.BlockHeightLastInference
-	}
-	return 0
-}
-
-func (m *QueryNetworkInferencesAtBlockRequest) GetBlockHeightLastReward() int64 {
+func (m *QueryForecastsAtBlockRequest) GetBlockHeight() int64 {
 	if m != nil {
-		return m.BlockHeightLastReward
+		return m.BlockHeight
 	}
 	return 0
 }
 
-type QueryNetworkInferencesAtBlockResponse struct {
-	NetworkInferences *ValueBundle `protobuf:"bytes,1,opt,name=network_inferences,json=networkInferences,proto3" json:"network_inferences,omitempty"`
+// Returns the forecasts on a topic posted at a block height
+//
+// NOTE: The amount field is a Uint which implements the custom method
+// signatures required by gogoproto.
+type QueryForecastsAtBlockResponse struct {
+	Forecasts *Forecasts `protobuf:"bytes,1,opt,name=forecasts,proto3" json:"forecasts,omitempty"`
 }
 
-func (m *QueryNetworkInferencesAtBlockResponse) Reset()         { *m = QueryNetworkInferencesAtBlockResponse{} }
-func (m *QueryNetworkInferencesAtBlockResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryNetworkInferencesAtBlockResponse) ProtoMessage()    {}
-func (*QueryNetworkInferencesAtBlockResponse) Descriptor() ([]byte, []int) {
+func (m *QueryForecastsAtBlockResponse) Reset()         { *m = QueryForecastsAtBlockResponse{} }
+func (m *QueryForecastsAtBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForecastsAtBlockResponse) ProtoMessage()    {}
+func (*QueryForecastsAtBlockResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{39}
 }
-func (m *QueryNetworkInferencesAtBlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryForecastsAtBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNetworkInferencesAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryForecastsAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNetworkInferencesAtBlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryForecastsAtBlockResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1864,44 +1900,43 @@ func (m *QueryNetworkInferencesAtBlockResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryNetworkInferencesAtBlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNetworkInferencesAtBlockResponse.Merge(m, src)
+func (m *QueryForecastsAtBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForecastsAtBlockResponse.Merge(m, src)
 }
-func (m *QueryNetworkInferencesAtBlockResponse) XXX_Size() int {
+func (m *QueryForecastsAtBlockResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNetworkInferencesAtBlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNetworkInferencesAtBlockResponse.DiscardUnknown(m)
+func (m *QueryForecastsAtBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForecastsAtBlockResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNetworkInferencesAtBlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryForecastsAtBlockResponse proto.InternalMessageInfo
 
-func (m *QueryNetworkInferencesAtBlockResponse) GetNetworkInferences() *ValueBundle {
+func (m *QueryForecastsAtBlockResponse) GetForecasts() *Forecasts {
 	if m != nil {
-		return m.NetworkInferences
+		return m.Forecasts
 	}
 	return nil
 }
 
-type QueryIsWorkerRegisteredInTopicIdRequest struct {
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+type QueryWorkerCommitmentRequest struct {
+	TopicId       uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	WorkerAddress string `protobuf:"bytes,3,opt,name=worker_address,json=workerAddress,proto3" json:"worker_address,omitempty"`
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) Reset() {
-	*m = QueryIsWorkerRegisteredInTopicIdRequest{}
-}
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryIsWorkerRegisteredInTopicIdRequest) ProtoMessage()    {}
-func (*QueryIsWorkerRegisteredInTopicIdRequest) Descriptor() ([]byte, []int) {
+func (m *QueryWorkerCommitmentRequest) Reset()         { *m = QueryWorkerCommitmentRequest{} }
+func (m *QueryWorkerCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerCommitmentRequest) ProtoMessage()    {}
+func (*QueryWorkerCommitmentRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{40}
 }
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryWorkerCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWorkerCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWorkerCommitmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1911,50 +1946,55 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdRequest.Merge(m, src)
+func (m *QueryWorkerCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerCommitmentRequest.Merge(m, src)
 }
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Size() int {
+func (m *QueryWorkerCommitmentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdRequest.DiscardUnknown(m)
+func (m *QueryWorkerCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerCommitmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryWorkerCommitmentRequest proto.InternalMessageInfo
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) GetTopicId() uint64 {
+func (m *QueryWorkerCommitmentRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) GetAddress() string {
+func (m *QueryWorkerCommitmentRequest) GetBlockHeight() int64 {
 	if m != nil {
-		return m.Address
+		return m.BlockHeight
 	}
-	return ""
+	return 0
 }
 
-type QueryIsWorkerRegisteredInTopicIdResponse struct {
-	IsRegistered bool `protobuf:"varint,1,opt,name=is_registered,json=isRegistered,proto3" json:"is_registered,omitempty"`
+func (m *QueryWorkerCommitmentRequest) GetWorkerAddress() string {
+	if m != nil {
+		return m.WorkerAddress
+	}
+	return ""
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) Reset() {
-	*m = QueryIsWorkerRegisteredInTopicIdResponse{}
+type QueryWorkerCommitmentResponse struct {
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryIsWorkerRegisteredInTopicIdResponse) ProtoMessage()    {}
-func (*QueryIsWorkerRegisteredInTopicIdResponse) Descriptor() ([]byte, []int) {
+
+func (m *QueryWorkerCommitmentResponse) Reset()         { *m = QueryWorkerCommitmentResponse{} }
+func (m *QueryWorkerCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerCommitmentResponse) ProtoMessage()    {}
+func (*QueryWorkerCommitmentResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{41}
 }
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryWorkerCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWorkerCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWorkerCommitmentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1964,44 +2004,42 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdResponse.Merge(m, src)
+func (m *QueryWorkerCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerCommitmentResponse.Merge(m, src)
 }
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Size() int {
+func (m *QueryWorkerCommitmentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdResponse.DiscardUnknown(m)
+func (m *QueryWorkerCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerCommitmentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryWorkerCommitmentResponse proto.InternalMessageInfo
 
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) GetIsRegistered() bool {
+func (m *QueryWorkerCommitmentResponse) GetCommitment() []byte {
 	if m != nil {
-		return m.IsRegistered
+		return m.Commitment
 	}
-	return false
+	return nil
 }
 
-type QueryIsReputerRegisteredInTopicIdRequest struct {
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+type QueryWorkerLatestInferenceRequest struct {
+	TopicId       uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	WorkerAddress string `protobuf:"bytes,2,opt,name=worker_address,json=workerAddress,proto3" json:"worker_address,omitempty"`
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdRequest) Reset() {
-	*m = QueryIsReputerRegisteredInTopicIdRequest{}
-}
-func (m *QueryIsReputerRegisteredInTopicIdRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryIsReputerRegisteredInTopicIdRequest) ProtoMessage()    {}
-func (*QueryIsReputerRegisteredInTopicIdRequest) Descriptor() ([]byte, []int) {
+func (m *QueryWorkerLatestInferenceRequest) Reset()         { *m = QueryWorkerLatestInferenceRequest{} }
+func (m *QueryWorkerLatestInferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerLatestInferenceRequest) ProtoMessage()    {}
+func (*QueryWorkerLatestInferenceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{42}
 }
-func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryWorkerLatestInferenceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWorkerLatestInferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryIsReputerRegisteredInTopicIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWorkerLatestInferenceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2011,52 +2049,48 @@ func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryIsReputerRegisteredInTopicIdRequest.Merge(m, src)
+func (m *QueryWorkerLatestInferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerLatestInferenceRequest.Merge(m, src)
 }
-func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Size() int {
+func (m *QueryWorkerLatestInferenceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryIsReputerRegisteredInTopicIdRequest.DiscardUnknown(m)
+func (m *QueryWorkerLatestInferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerLatestInferenceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryIsReputerRegisteredInTopicIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryWorkerLatestInferenceRequest proto.InternalMessageInfo
 
-func (m *QueryIsReputerRegisteredInTopicIdRequest) GetTopicId() uint64 {
+func (m *QueryWorkerLatestInferenceRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdRequest) GetAddress() string {
+func (m *QueryWorkerLatestInferenceRequest) GetWorkerAddress() string {
 	if m != nil {
-		return m.Address
+		return m.WorkerAddress
 	}
 	return ""
 }
 
-type QueryIsReputerRegisteredInTopicIdResponse struct {
-	IsRegistered bool `protobuf:"varint,1,opt,name=is_registered,json=isRegistered,proto3" json:"is_registered,omitempty"`
+type QueryWorkerLatestInferenceResponse struct {
+	LatestInference *Inference `protobuf:"bytes,1,opt,name=latest_inference,json=latestInference,proto3" json:"latest_inference,omitempty"`
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdResponse) Reset() {
-	*m = QueryIsReputerRegisteredInTopicIdResponse{}
-}
-func (m *QueryIsReputerRegisteredInTopicIdResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryIsReputerRegisteredInTopicIdResponse) ProtoMessage() {}
-func (*QueryIsReputerRegisteredInTopicIdResponse) Descriptor() ([]byte, []int) {
+func (m *QueryWorkerLatestInferenceResponse) Reset()         { *m = QueryWorkerLatestInferenceResponse{} }
+func (m *QueryWorkerLatestInferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerLatestInferenceResponse) ProtoMessage()    {}
+func (*QueryWorkerLatestInferenceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{43}
 }
-func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryWorkerLatestInferenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWorkerLatestInferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryIsReputerRegisteredInTopicIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWorkerLatestInferenceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2066,41 +2100,41 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryIsReputerRegisteredInTopicIdResponse.Merge(m, src)
+func (m *QueryWorkerLatestInferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerLatestInferenceResponse.Merge(m, src)
 }
-func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Size() int {
+func (m *QueryWorkerLatestInferenceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryIsReputerRegisteredInTopicIdResponse.DiscardUnknown(m)
+func (m *QueryWorkerLatestInferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerLatestInferenceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryIsReputerRegisteredInTopicIdResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryWorkerLatestInferenceResponse proto.InternalMessageInfo
 
-func (m *QueryIsReputerRegisteredInTopicIdResponse) GetIsRegistered() bool {
+func (m *QueryWorkerLatestInferenceResponse) GetLatestInference() *Inference {
 	if m != nil {
-		return m.IsRegistered
+		return m.LatestInference
 	}
-	return false
+	return nil
 }
 
-type QueryIsWhitelistAdminRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type QueryWorkerNodeInfoRequest struct {
+	Libp2PKey string `protobuf:"bytes,1,opt,name=libp2p_key,json=libp2pKey,proto3" json:"libp2p_key,omitempty"`
 }
 
-func (m *QueryIsWhitelistAdminRequest) Reset()         { *m = QueryIsWhitelistAdminRequest{} }
-func (m *QueryIsWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryIsWhitelistAdminRequest) ProtoMessage()    {}
-func (*QueryIsWhitelistAdminRequest) Descriptor() ([]byte, []int) {
+func (m *QueryWorkerNodeInfoRequest) Reset()         { *m = QueryWorkerNodeInfoRequest{} }
+func (m *QueryWorkerNodeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerNodeInfoRequest) ProtoMessage()    {}
+func (*QueryWorkerNodeInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{44}
 }
-func (m *QueryIsWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryWorkerNodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryIsWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWorkerNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryIsWhitelistAdminRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWorkerNodeInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2110,41 +2144,41 @@ func (m *QueryIsWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryIsWhitelistAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryIsWhitelistAdminRequest.Merge(m, src)
+func (m *QueryWorkerNodeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerNodeInfoRequest.Merge(m, src)
 }
-func (m *QueryIsWhitelistAdminRequest) XXX_Size() int {
+func (m *QueryWorkerNodeInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryIsWhitelistAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryIsWhitelistAdminRequest.DiscardUnknown(m)
+func (m *QueryWorkerNodeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerNodeInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryIsWhitelistAdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryWorkerNodeInfoRequest proto.InternalMessageInfo
 
-func (m *QueryIsWhitelistAdminRequest) GetAddress() string {
+func (m *QueryWorkerNodeInfoRequest) GetLibp2PKey() string {
 	if m != nil {
-		return m.Address
+		return m.Libp2PKey
 	}
 	return ""
 }
 
-type QueryIsWhitelistAdminResponse struct {
-	IsAdmin bool `protobuf:"varint,1,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+type QueryWorkerNodeInfoResponse struct {
+	NodeInfo *OffchainNode `protobuf:"bytes,1,opt,name=node_info,json=nodeInfo,proto3" json:"node_info,omitempty"`
 }
 
-func (m *QueryIsWhitelistAdminResponse) Reset()         { *m = QueryIsWhitelistAdminResponse{} }
-func (m *QueryIsWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryIsWhitelistAdminResponse) ProtoMessage()    {}
-func (*QueryIsWhitelistAdminResponse) Descriptor() ([]byte, []int) {
+func (m *QueryWorkerNodeInfoResponse) Reset()         { *m = QueryWorkerNodeInfoResponse{} }
+func (m *QueryWorkerNodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerNodeInfoResponse) ProtoMessage()    {}
+func (*QueryWorkerNodeInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c426af2f1e908986, []int{45}
 }
-func (m *QueryIsWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryWorkerNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryIsWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWorkerNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryIsWhitelistAdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWorkerNodeInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2154,3410 +2188,10306 @@ func (m *QueryIsWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryIsWhitelistAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryIsWhitelistAdminResponse.Merge(m, src)
+func (m *QueryWorkerNodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerNodeInfoResponse.Merge(m, src)
 }
-func (m *QueryIsWhitelistAdminResponse) XXX_Size() int {
+func (m *QueryWorkerNodeInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryIsWhitelistAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryIsWhitelistAdminResponse.DiscardUnknown(m)
+func (m *QueryWorkerNodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerNodeInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryIsWhitelistAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryWorkerNodeInfoResponse proto.InternalMessageInfo
 
-func (m *QueryIsWhitelistAdminResponse) GetIsAdmin() bool {
+func (m *QueryWorkerNodeInfoResponse) GetNodeInfo() *OffchainNode {
 	if m != nil {
-		return m.IsAdmin
+		return m.NodeInfo
 	}
-	return false
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "emissions.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "emissions.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryTotalStakeRequest)(nil), "emissions.v1.QueryTotalStakeRequest")
-	proto.RegisterType((*QueryTotalStakeResponse)(nil), "emissions.v1.QueryTotalStakeResponse")
-	proto.RegisterType((*QueryReputerStakeInTopicRequest)(nil), "emissions.v1.QueryReputerStakeInTopicRequest")
-	proto.RegisterType((*QueryReputerStakeInTopicResponse)(nil), "emissions.v1.QueryReputerStakeInTopicResponse")
-	proto.RegisterType((*QueryMultiReputerStakeInTopicRequest)(nil), "emissions.v1.QueryMultiReputerStakeInTopicRequest")
-	proto.RegisterType((*QueryMultiReputerStakeInTopicResponse)(nil), "emissions.v1.QueryMultiReputerStakeInTopicResponse")
-	proto.RegisterType((*QueryDelegateStakeInTopicInReputerRequest)(nil), "emissions.v1.QueryDelegateStakeInTopicInReputerRequest")
-	proto.RegisterType((*QueryDelegateStakeInTopicInReputerResponse)(nil), "emissions.v1.QueryDelegateStakeInTopicInReputerResponse")
-	proto.RegisterType((*QueryStakeFromDelegatorInTopicInReputerRequest)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicInReputerRequest")
-	proto.RegisterType((*QueryStakeFromDelegatorInTopicInReputerResponse)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicInReputerResponse")
-	proto.RegisterType((*QueryStakeFromDelegatorInTopicRequest)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicRequest")
-	proto.RegisterType((*QueryStakeFromDelegatorInTopicResponse)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicResponse")
-	proto.RegisterType((*QueryTopicStakeRequest)(nil), "emissions.v1.QueryTopicStakeRequest")
-	proto.RegisterType((*QueryTopicStakeResponse)(nil), "emissions.v1.QueryTopicStakeResponse")
-	proto.RegisterType((*QueryNetworkLossBundleAtBlockRequest)(nil), "emissions.v1.QueryNetworkLossBundleAtBlockRequest")
-	proto.RegisterType((*QueryNetworkLossBundleAtBlockResponse)(nil), "emissions.v1.QueryNetworkLossBundleAtBlockResponse")
-	proto.RegisterType((*QueryNextTopicIdRequest)(nil), "emissions.v1.QueryNextTopicIdRequest")
-	proto.RegisterType((*QueryNextTopicIdResponse)(nil), "emissions.v1.QueryNextTopicIdResponse")
-	proto.RegisterType((*QueryTopicRequest)(nil), "emissions.v1.QueryTopicRequest")
-	proto.RegisterType((*QueryTopicResponse)(nil), "emissions.v1.QueryTopicResponse")
-	proto.RegisterType((*QueryActiveTopicsRequest)(nil), "emissions.v1.QueryActiveTopicsRequest")
-	proto.RegisterType((*QueryActiveTopicsResponse)(nil), "emissions.v1.QueryActiveTopicsResponse")
-	proto.RegisterType((*QueryInferencesAtBlockRequest)(nil), "emissions.v1.QueryInferencesAtBlockRequest")
-	proto.RegisterType((*QueryInferencesAtBlockResponse)(nil), "emissions.v1.QueryInferencesAtBlockResponse")
-	proto.RegisterType((*QueryForecastsAtBlockRequest)(nil), "emissions.v1.QueryForecastsAtBlockRequest")
-	proto.RegisterType((*QueryForecastsAtBlockResponse)(nil), "emissions.v1.QueryForecastsAtBlockResponse")
-	proto.RegisterType((*QueryWorkerLatestInferenceRequest)(nil), "emissions.v1.QueryWorkerLatestInferenceRequest")
-	proto.RegisterType((*QueryWorkerLatestInferenceResponse)(nil), "emissions.v1.QueryWorkerLatestInferenceResponse")
-	proto.RegisterType((*QueryWorkerNodeInfoRequest)(nil), "emissions.v1.QueryWorkerNodeInfoRequest")
-	proto.RegisterType((*QueryWorkerNodeInfoResponse)(nil), "emissions.v1.QueryWorkerNodeInfoResponse")
-	proto.RegisterType((*QueryReputerNodeInfoRequest)(nil), "emissions.v1.QueryReputerNodeInfoRequest")
-	proto.RegisterType((*QueryReputerNodeInfoResponse)(nil), "emissions.v1.QueryReputerNodeInfoResponse")
-	proto.RegisterType((*QueryWorkerAddressByP2PKeyRequest)(nil), "emissions.v1.QueryWorkerAddressByP2PKeyRequest")
-	proto.RegisterType((*QueryWorkerAddressByP2PKeyResponse)(nil), "emissions.v1.QueryWorkerAddressByP2PKeyResponse")
-	proto.RegisterType((*QueryReputerAddressByP2PKeyRequest)(nil), "emissions.v1.QueryReputerAddressByP2PKeyRequest")
-	proto.RegisterType((*QueryReputerAddressByP2PKeyResponse)(nil), "emissions.v1.QueryReputerAddressByP2PKeyResponse")
-	proto.RegisterType((*QueryNetworkInferencesAtBlockRequest)(nil), "emissions.v1.QueryNetworkInferencesAtBlockRequest")
-	proto.RegisterType((*QueryNetworkInferencesAtBlockResponse)(nil), "emissions.v1.QueryNetworkInferencesAtBlockResponse")
-	proto.RegisterType((*QueryIsWorkerRegisteredInTopicIdRequest)(nil), "emissions.v1.QueryIsWorkerRegisteredInTopicIdRequest")
-	proto.RegisterType((*QueryIsWorkerRegisteredInTopicIdResponse)(nil), "emissions.v1.QueryIsWorkerRegisteredInTopicIdResponse")
-	proto.RegisterType((*QueryIsReputerRegisteredInTopicIdRequest)(nil), "emissions.v1.QueryIsReputerRegisteredInTopicIdRequest")
-	proto.RegisterType((*QueryIsReputerRegisteredInTopicIdResponse)(nil), "emissions.v1.QueryIsReputerRegisteredInTopicIdResponse")
-	proto.RegisterType((*QueryIsWhitelistAdminRequest)(nil), "emissions.v1.QueryIsWhitelistAdminRequest")
-	proto.RegisterType((*QueryIsWhitelistAdminResponse)(nil), "emissions.v1.QueryIsWhitelistAdminResponse")
+type QueryReputerNodeInfoRequest struct {
+	Libp2PKey string `protobuf:"bytes,1,opt,name=libp2p_key,json=libp2pKey,proto3" json:"libp2p_key,omitempty"`
 }
 
-func init() { proto.RegisterFile("emissions/v1/query.proto", fileDescriptor_c426af2f1e908986) }
-
-var fileDescriptor_c426af2f1e908986 = []byte{
-	// 2168 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0x3b, 0xbb, 0x8e, 0xe7, 0x39, 0x5f, 0xae, 0x78, 0x93, 0x71, 0xdb, 0xf1, 0x47, 0x27,
-	0x4e, 0xfc, 0x91, 0x4c, 0xc7, 0x76, 0x12, 0x47, 0xd9, 0x4d, 0x56, 0x9e, 0xa0, 0x75, 0x46, 0xc9,
-	0x3a, 0xa6, 0x59, 0x39, 0x52, 0x04, 0x8c, 0xda, 0x33, 0xe5, 0x71, 0xcb, 0x3d, 0xdd, 0x93, 0xee,
-	0x1a, 0x27, 0x96, 0xb1, 0x60, 0xd1, 0x1e, 0x58, 0x09, 0x24, 0x24, 0xa4, 0x15, 0x07, 0x0e, 0x70,
-	0xe3, 0x84, 0x10, 0x02, 0xa4, 0x3d, 0x70, 0x5f, 0x4e, 0xac, 0xc8, 0x05, 0x71, 0x88, 0x50, 0x02,
-	0x82, 0xbf, 0x81, 0x13, 0x9a, 0xaa, 0xea, 0x8f, 0xea, 0xa9, 0xee, 0x99, 0x09, 0xf1, 0xc5, 0x72,
-	0x57, 0xbd, 0x8f, 0xdf, 0xef, 0x55, 0xd5, 0xab, 0x57, 0xcf, 0x86, 0x3c, 0xae, 0x5b, 0xbe, 0x6f,
-	0xb9, 0x8e, 0xaf, 0xef, 0x2e, 0xe8, 0x4f, 0x9b, 0xd8, 0xdb, 0x2b, 0x34, 0x3c, 0x97, 0xb8, 0xe8,
-	0x78, 0x38, 0x53, 0xd8, 0x5d, 0x50, 0xe7, 0x2a, 0xae, 0x5f, 0x77, 0x7d, 0x7d, 0xd3, 0xf4, 0x31,
-	0x13, 0xd3, 0x77, 0x17, 0x36, 0x31, 0x31, 0x17, 0xf4, 0x86, 0x59, 0xb3, 0x1c, 0x93, 0x58, 0xae,
-	0xc3, 0x34, 0x55, 0xd1, 0x26, 0xd9, 0x6b, 0x60, 0x9f, 0xcf, 0x8c, 0xd5, 0x5c, 0xb7, 0x66, 0x63,
-	0xdd, 0x6c, 0x58, 0xba, 0xe9, 0x38, 0x2e, 0xa1, 0x6a, 0xc1, 0xec, 0x28, 0xf7, 0x11, 0x98, 0x8f,
-	0xc3, 0x51, 0x87, 0xcc, 0xba, 0xe5, 0xb8, 0x3a, 0xfd, 0xc9, 0x87, 0x86, 0x6b, 0x6e, 0xcd, 0xa5,
-	0xbf, 0xea, 0xad, 0xdf, 0xf8, 0xe8, 0x08, 0xb3, 0x52, 0x66, 0x13, 0xec, 0x23, 0x98, 0x12, 0x80,
-	0x35, 0x4c, 0xcf, 0xac, 0x07, 0x53, 0xe7, 0x84, 0x29, 0xc7, 0xad, 0x62, 0xa9, 0xce, 0x33, 0xd7,
-	0xdb, 0xc1, 0x1e, 0x9f, 0x52, 0x85, 0x29, 0x0f, 0x37, 0x9a, 0x24, 0x9c, 0x4b, 0xc4, 0xc0, 0x6d,
-	0x58, 0x15, 0xe9, 0x8c, 0x4f, 0xcc, 0x1d, 0xee, 0x4a, 0x1b, 0x06, 0xf4, 0xcd, 0x16, 0xe3, 0x75,
-	0x0a, 0xcc, 0xc0, 0x4f, 0x9b, 0xd8, 0x27, 0xda, 0x1a, 0x9c, 0x11, 0x46, 0xfd, 0x86, 0xeb, 0xf8,
-	0x18, 0x2d, 0x43, 0x3f, 0x23, 0x90, 0x57, 0x26, 0x95, 0x99, 0xc1, 0xc5, 0xe1, 0x42, 0x7c, 0xbd,
-	0x0a, 0x4c, 0xba, 0x98, 0xfb, 0xea, 0xe5, 0xc4, 0x91, 0x5f, 0xff, 0xfb, 0xb7, 0x73, 0x8a, 0xc1,
-	0xc5, 0xb5, 0x3c, 0x9c, 0xa5, 0xf6, 0x3e, 0x71, 0x89, 0x69, 0x7f, 0xab, 0xe5, 0x3e, 0xf0, 0x64,
-	0xc1, 0xb9, 0xb6, 0x19, 0xee, 0xed, 0x3e, 0xf4, 0x9b, 0x75, 0xb7, 0xe9, 0x10, 0xea, 0x2d, 0x57,
-	0xbc, 0xd6, 0xb2, 0xfb, 0xf7, 0x97, 0x13, 0xef, 0xb1, 0xf8, 0xfa, 0xd5, 0x9d, 0x82, 0xe5, 0xea,
-	0x75, 0x93, 0x6c, 0x17, 0x4a, 0x0e, 0xf9, 0xeb, 0xef, 0xaf, 0x02, 0x0f, 0x7c, 0xc9, 0x21, 0xdc,
-	0x3d, 0xd3, 0xbf, 0xfd, 0xce, 0x7f, 0x7e, 0x39, 0xa1, 0x68, 0x1b, 0x30, 0x41, 0x5d, 0x19, 0x2c,
-	0x68, 0xd4, 0x59, 0xc9, 0xf9, 0xa4, 0x15, 0x26, 0x8e, 0x06, 0xe5, 0xe1, 0x98, 0x59, 0xad, 0x7a,
-	0xd8, 0x67, 0x0c, 0x73, 0x46, 0xf0, 0x89, 0x46, 0x60, 0x80, 0x06, 0xb4, 0x6c, 0x55, 0xf3, 0x7d,
-	0x93, 0xca, 0xcc, 0x3b, 0xc6, 0x31, 0xfa, 0x5d, 0xaa, 0x6a, 0x1e, 0x4c, 0xa6, 0xdb, 0x3d, 0x24,
-	0x2e, 0x65, 0xb8, 0x48, 0x7d, 0x7e, 0xdc, 0xb4, 0x89, 0x95, 0x41, 0x68, 0x0c, 0x72, 0x9c, 0x01,
-	0x6e, 0x51, 0x3a, 0x3a, 0x93, 0x33, 0xa2, 0x81, 0x2c, 0x52, 0x65, 0x98, 0xee, 0xe0, 0x80, 0x33,
-	0xbb, 0x09, 0xc7, 0x18, 0x32, 0x66, 0x7f, 0x70, 0x71, 0x4c, 0xdc, 0x14, 0x54, 0x69, 0xdd, 0x36,
-	0x2b, 0xb8, 0x8e, 0x1d, 0x62, 0x04, 0xc2, 0x9a, 0x0b, 0xb3, 0xd4, 0xc1, 0x37, 0xb0, 0x8d, 0x6b,
-	0x26, 0xc1, 0x71, 0xe3, 0x25, 0x87, 0x7b, 0x0c, 0x68, 0x5c, 0x86, 0x53, 0x7c, 0xab, 0x97, 0xc5,
-	0xf5, 0x39, 0xc9, 0x87, 0x57, 0x3a, 0x2f, 0xd3, 0xf7, 0x60, 0xae, 0x1b, 0x87, 0x87, 0xb4, 0x60,
-	0xbf, 0x52, 0xa0, 0x40, 0xdd, 0x53, 0xb7, 0x1f, 0x79, 0x6e, 0x9d, 0xe3, 0x70, 0xbd, 0x34, 0xd2,
-	0xf3, 0x30, 0x54, 0x0d, 0x64, 0x12, 0xb4, 0x4f, 0x87, 0x13, 0x01, 0x71, 0x49, 0x84, 0xfa, 0x3a,
-	0x46, 0xe8, 0xa8, 0x18, 0xa1, 0x4f, 0x15, 0xd0, 0xbb, 0xc6, 0x78, 0x48, 0x71, 0x72, 0xf9, 0xbe,
-	0x4b, 0x85, 0xf0, 0x46, 0xd1, 0xc9, 0xd8, 0x16, 0xcf, 0xe1, 0x52, 0x27, 0x87, 0x87, 0x44, 0x75,
-	0x29, 0x4c, 0x8a, 0x0d, 0xab, 0x12, 0x4f, 0x8a, 0x02, 0x5c, 0x45, 0x84, 0x1b, 0xe5, 0xcb, 0x48,
-	0xe9, 0x90, 0xf0, 0x55, 0x79, 0x8e, 0x59, 0xc3, 0xa4, 0x75, 0x05, 0x3d, 0x74, 0x7d, 0xbf, 0xd8,
-	0x74, 0xaa, 0x36, 0x5e, 0x21, 0x45, 0xdb, 0xad, 0xec, 0x74, 0x46, 0x8b, 0xa6, 0xe0, 0xf8, 0x66,
-	0x4b, 0xb4, 0xbc, 0x8d, 0xad, 0xda, 0x36, 0xa1, 0xb1, 0x3f, 0x6a, 0x0c, 0xd2, 0xb1, 0xfb, 0x74,
-	0x48, 0xab, 0xf0, 0x05, 0x4f, 0xf7, 0xc2, 0xe9, 0xdd, 0x86, 0x41, 0xdb, 0xf5, 0xfd, 0xf2, 0x26,
-	0x9d, 0xe5, 0x37, 0xd0, 0x88, 0x98, 0x6c, 0x36, 0x4c, 0xbb, 0x89, 0x99, 0xba, 0x01, 0x76, 0x68,
-	0x4a, 0x1b, 0xe1, 0x51, 0x5b, 0xc3, 0xcf, 0x09, 0xdb, 0xc8, 0xd5, 0xe0, 0x02, 0xba, 0x0b, 0xf9,
-	0xf6, 0x29, 0xee, 0x52, 0x83, 0x13, 0x0e, 0x7e, 0x4e, 0xca, 0x09, 0x7a, 0x83, 0x4e, 0x24, 0xab,
-	0x15, 0x60, 0x28, 0x5a, 0x90, 0x2e, 0x16, 0xf0, 0x33, 0x85, 0xdf, 0xb8, 0xe2, 0xe6, 0x9a, 0x85,
-	0x77, 0xa9, 0x04, 0xe7, 0x75, 0x46, 0xe4, 0xc5, 0x64, 0x99, 0x04, 0x3a, 0x0b, 0xfd, 0xcf, 0xa2,
-	0x70, 0xe6, 0x0c, 0xfe, 0xd5, 0x3a, 0x11, 0x78, 0x6b, 0x0b, 0x57, 0x88, 0xb5, 0x8b, 0xcb, 0x1e,
-	0xde, 0xc5, 0x4e, 0x13, 0xd3, 0x23, 0x9e, 0x33, 0x4e, 0x87, 0x13, 0x06, 0x1b, 0xd7, 0x6a, 0x9c,
-	0xf6, 0x0a, 0x1d, 0xa5, 0xf6, 0x83, 0xdb, 0x1f, 0x3d, 0x00, 0x88, 0xea, 0x2b, 0x0e, 0x68, 0x3e,
-	0x91, 0xd5, 0xad, 0x7a, 0xc3, 0xc6, 0xf7, 0x9a, 0x9e, 0xef, 0x7a, 0xeb, 0xa1, 0x2c, 0x37, 0x60,
-	0xc4, 0xd4, 0xb5, 0x2f, 0x14, 0x18, 0x91, 0x78, 0xe2, 0xb4, 0xe7, 0xa1, 0x9f, 0x92, 0x0a, 0x2e,
-	0x0f, 0x29, 0x6f, 0x2e, 0x82, 0x1e, 0x0a, 0xb8, 0xfa, 0x28, 0xae, 0x2b, 0xdd, 0xe1, 0x62, 0xee,
-	0x04, 0x60, 0xdf, 0x81, 0xf3, 0x14, 0x57, 0xc9, 0xd9, 0xc2, 0x1e, 0x76, 0x2a, 0xd8, 0x7f, 0xab,
-	0xfb, 0xfa, 0x09, 0x8c, 0xa7, 0x99, 0xe7, 0xdc, 0x6f, 0x01, 0x58, 0xe1, 0x24, 0x0f, 0x73, 0x5e,
-	0xa4, 0x13, 0x29, 0x1b, 0x31, 0x59, 0xed, 0xdb, 0x30, 0x46, 0x6d, 0x7f, 0xe4, 0x7a, 0xb8, 0x62,
-	0xfa, 0xe4, 0xed, 0x22, 0xdf, 0xe0, 0x81, 0x69, 0xb7, 0xce, 0x81, 0xdf, 0x80, 0xdc, 0x56, 0x30,
-	0xc7, 0x71, 0x9f, 0x13, 0x71, 0x87, 0xaa, 0x46, 0x24, 0xa9, 0x61, 0x98, 0xa2, 0x76, 0x1f, 0xd3,
-	0x7a, 0xf6, 0xa1, 0x49, 0xb0, 0x4f, 0x42, 0x82, 0x5d, 0x40, 0x9f, 0x86, 0x93, 0xac, 0x14, 0x4e,
-	0xdc, 0x70, 0x27, 0xd8, 0x28, 0xcf, 0xf5, 0xda, 0x36, 0x68, 0x59, 0x6e, 0x38, 0x87, 0x22, 0x9c,
-	0xb6, 0xe9, 0x54, 0x39, 0x8c, 0xab, 0x9c, 0x4a, 0xa4, 0x7a, 0xca, 0x16, 0x6d, 0x69, 0xef, 0x83,
-	0x1a, 0xf3, 0xb4, 0xe6, 0x56, 0x71, 0xc9, 0xd9, 0x72, 0x03, 0x26, 0xe7, 0x01, 0x6c, 0x6b, 0xb3,
-	0xb1, 0xd8, 0x28, 0xef, 0xe0, 0x3d, 0x7e, 0x33, 0xe5, 0xd8, 0xc8, 0x03, 0xbc, 0xa7, 0x6d, 0xc0,
-	0xa8, 0x54, 0x39, 0x2c, 0xb5, 0x73, 0xad, 0x07, 0x41, 0x0b, 0x9d, 0xcb, 0x81, 0xa9, 0x22, 0xb0,
-	0x47, 0x5b, 0x5b, 0x95, 0x6d, 0xd3, 0x72, 0x5a, 0xaa, 0xc6, 0x80, 0xc3, 0x0d, 0x68, 0x1f, 0x70,
-	0xbb, 0xfc, 0xa2, 0xee, 0x11, 0xd5, 0x63, 0xbe, 0xb3, 0xda, 0xb4, 0xff, 0x5f, 0x58, 0x45, 0x61,
-	0xf1, 0xf9, 0x5a, 0x15, 0xf7, 0xd6, 0x17, 0xd7, 0x1f, 0xe0, 0xbd, 0x2e, 0xc1, 0xdd, 0x15, 0x56,
-	0xb6, 0xcd, 0x06, 0x87, 0x98, 0x5a, 0xc3, 0x6b, 0xf7, 0xb8, 0xbe, 0x21, 0x54, 0x44, 0x3d, 0x82,
-	0xf8, 0x10, 0x2e, 0x64, 0x1a, 0xe9, 0x88, 0xe2, 0x4b, 0x45, 0xbc, 0x57, 0xdf, 0x24, 0xff, 0xdc,
-	0x81, 0xd1, 0xf8, 0x29, 0x2e, 0xdb, 0xa6, 0xb0, 0x91, 0xd9, 0xa1, 0xce, 0xc7, 0x0e, 0xf5, 0x43,
-	0x33, 0xb6, 0x71, 0xd1, 0x32, 0xe4, 0xdb, 0xd5, 0x3d, 0xfc, 0xcc, 0xf4, 0x58, 0x4d, 0x78, 0xd4,
-	0x78, 0x2f, 0xa1, 0x6b, 0xd0, 0x49, 0xed, 0xa9, 0x78, 0x59, 0xa7, 0xe7, 0xb6, 0xfb, 0x80, 0x1c,
-	0x26, 0x53, 0x6e, 0xcb, 0x71, 0x19, 0x77, 0xf6, 0x90, 0x93, 0x34, 0xac, 0x7d, 0x17, 0x2e, 0xb3,
-	0x3c, 0xea, 0xb3, 0x65, 0x37, 0x70, 0xcd, 0xf2, 0x09, 0xf6, 0x70, 0x35, 0xa8, 0x49, 0xab, 0x5d,
-	0x04, 0x2c, 0xb6, 0x1c, 0x7d, 0xe2, 0x72, 0x3c, 0x82, 0x99, 0xce, 0xf6, 0x39, 0xab, 0x0b, 0x70,
-	0xc2, 0xf2, 0xcb, 0x5e, 0x28, 0x41, 0xbd, 0x0c, 0x18, 0xc7, 0x2d, 0x3f, 0xd2, 0xd2, 0xca, 0xa1,
-	0xc1, 0xb0, 0x56, 0x7e, 0xbb, 0x88, 0xd7, 0xf9, 0xcb, 0x29, 0xdb, 0x41, 0x2f, 0x90, 0x6f, 0xf1,
-	0x53, 0x5f, 0xf2, 0x1f, 0x6f, 0x5b, 0x04, 0xdb, 0x96, 0x4f, 0x56, 0xaa, 0x75, 0xcb, 0xe9, 0xf8,
-	0x2c, 0xd6, 0x6e, 0x07, 0x97, 0x68, 0x9b, 0x26, 0xf7, 0x3f, 0x02, 0x03, 0x96, 0x5f, 0x36, 0x5b,
-	0x63, 0xdc, 0xf5, 0x31, 0xcb, 0xa7, 0x22, 0x8b, 0xff, 0x9d, 0x84, 0x77, 0xa9, 0x32, 0xda, 0x81,
-	0x7e, 0xd6, 0x3b, 0x40, 0x93, 0xe2, 0xde, 0x68, 0x6f, 0x4d, 0xa8, 0x53, 0x19, 0x12, 0xcc, 0xa7,
-	0x36, 0xf6, 0xc3, 0x17, 0xff, 0xfc, 0x59, 0xdf, 0x59, 0x34, 0xac, 0x4b, 0x7a, 0x2f, 0xe8, 0x73,
-	0x05, 0x4e, 0xae, 0x62, 0x12, 0xab, 0xf7, 0xd0, 0xb4, 0xc4, 0x66, 0x7b, 0xa9, 0xa8, 0x5e, 0xea,
-	0x24, 0xc6, 0xfd, 0xcf, 0xfc, 0xa8, 0x55, 0x4d, 0x53, 0x10, 0xe7, 0xd1, 0xa8, 0x08, 0x42, 0xa8,
-	0x27, 0xd1, 0x3e, 0x0c, 0xac, 0x62, 0xa6, 0x8f, 0x26, 0x24, 0xd6, 0xe3, 0x45, 0xa5, 0x3a, 0x99,
-	0x2e, 0xc0, 0x1d, 0x5f, 0x89, 0x1c, 0x4f, 0xa1, 0x09, 0xbd, 0xbd, 0x1d, 0xe4, 0xeb, 0xfb, 0x81,
-	0xef, 0x03, 0xf4, 0x63, 0x05, 0x4e, 0xad, 0x62, 0x12, 0xaf, 0xcb, 0x90, 0x8c, 0xa2, 0xa4, 0x44,
-	0x54, 0x2f, 0x77, 0x94, 0xeb, 0x22, 0x16, 0x26, 0x2b, 0x55, 0x79, 0x75, 0xf7, 0x52, 0x81, 0xa9,
-	0x55, 0x4c, 0xa4, 0xd7, 0x76, 0x71, 0x2f, 0x58, 0x2a, 0x5d, 0xe2, 0x38, 0xab, 0xa0, 0x50, 0xaf,
-	0x75, 0xaf, 0xc0, 0x21, 0x6f, 0x44, 0x90, 0x1f, 0xa0, 0x52, 0x87, 0x28, 0xf2, 0xee, 0x9c, 0xaf,
-	0xef, 0x8b, 0xb5, 0xc9, 0x81, 0x9e, 0x2c, 0x2f, 0xd0, 0xef, 0x14, 0x18, 0x5e, 0xc5, 0xa4, 0x2d,
-	0x69, 0xa2, 0x79, 0x09, 0xc4, 0xb4, 0x5b, 0x41, 0xbd, 0xd2, 0x9d, 0x30, 0xe7, 0xf2, 0x61, 0xc4,
-	0xe5, 0x3a, 0x5a, 0x14, 0xb9, 0x44, 0x89, 0x39, 0xce, 0x67, 0x3f, 0x7e, 0x33, 0x1c, 0xa0, 0xdf,
-	0x28, 0x70, 0x66, 0x15, 0x93, 0x64, 0x2d, 0x88, 0xe6, 0x24, 0x30, 0x52, 0xca, 0x51, 0x75, 0xbe,
-	0x2b, 0x59, 0x8e, 0xf8, 0x6e, 0x84, 0x78, 0x09, 0x2d, 0x88, 0x88, 0xc3, 0x5a, 0x32, 0x03, 0xf0,
-	0x9f, 0x15, 0x18, 0xa5, 0xc7, 0x5b, 0xfe, 0x9c, 0x44, 0x8b, 0xd2, 0x43, 0x9c, 0xf9, 0xc2, 0x55,
-	0x97, 0x7a, 0xd2, 0xe1, 0x44, 0x56, 0x22, 0x22, 0x37, 0xd1, 0xf5, 0x64, 0x16, 0x60, 0x77, 0x63,
-	0xeb, 0x89, 0x9a, 0xc1, 0xe5, 0x33, 0x05, 0x4e, 0xd0, 0xfc, 0x10, 0xf4, 0x46, 0xd1, 0x45, 0x69,
-	0x0e, 0x48, 0x34, 0x55, 0xd5, 0xe9, 0x0e, 0x52, 0x1c, 0xe1, 0xa5, 0x08, 0xe1, 0x28, 0x1a, 0x49,
-	0x6e, 0x74, 0x62, 0xda, 0x65, 0xda, 0x29, 0x46, 0x7f, 0x54, 0xe0, 0xec, 0x2a, 0x26, 0x92, 0x2e,
-	0x20, 0xba, 0x2a, 0xf1, 0x94, 0xde, 0x8e, 0x54, 0x0b, 0xdd, 0x8a, 0x73, 0x84, 0x77, 0x22, 0x84,
-	0x8b, 0xe8, 0x9a, 0x2e, 0xeb, 0x7d, 0x33, 0x8c, 0xfa, 0x7e, 0x78, 0xee, 0x62, 0x19, 0xee, 0x4f,
-	0x6c, 0x2f, 0xa4, 0xf5, 0x30, 0xa5, 0x7b, 0xa1, 0x43, 0x47, 0x55, 0xba, 0x17, 0x3a, 0x35, 0x49,
-	0xb5, 0x1b, 0x11, 0x8f, 0x39, 0x34, 0x23, 0xe5, 0xe1, 0x33, 0x22, 0x42, 0x86, 0xfe, 0x97, 0x02,
-	0x93, 0xab, 0x98, 0x64, 0x76, 0x2c, 0xd1, 0xb2, 0x04, 0x50, 0x37, 0x4d, 0x55, 0xf5, 0x56, 0xef,
-	0x8a, 0x9c, 0xce, 0x5a, 0x44, 0xe7, 0x1e, 0x5a, 0x91, 0x2f, 0x0b, 0xef, 0xc1, 0xe1, 0x60, 0x7d,
-	0x12, 0xdd, 0x49, 0x61, 0x9d, 0x7e, 0xd0, 0x07, 0xd3, 0xab, 0x98, 0x74, 0x6e, 0x3b, 0xa2, 0x0f,
-	0x24, 0x98, 0xbb, 0xee, 0xa8, 0xaa, 0x77, 0xde, 0x50, 0x9b, 0xd3, 0x7e, 0x12, 0xd1, 0x7e, 0x84,
-	0x3e, 0x16, 0x69, 0x27, 0xe9, 0xb6, 0xf5, 0x26, 0x0f, 0xb2, 0x43, 0xf0, 0x42, 0x81, 0xb1, 0xac,
-	0x10, 0xa0, 0xa5, 0x5e, 0xb0, 0x07, 0x84, 0xaf, 0xf7, 0xa6, 0xc4, 0x79, 0xde, 0x8f, 0x78, 0xde,
-	0x41, 0xef, 0xf7, 0xce, 0x33, 0x62, 0xf5, 0x79, 0x90, 0xc0, 0x82, 0x66, 0x65, 0x4a, 0x02, 0x4b,
-	0x34, 0x40, 0x53, 0x12, 0x58, 0xb2, 0xe3, 0xa9, 0xcd, 0x47, 0x40, 0x27, 0xd1, 0xb8, 0xde, 0xfe,
-	0x47, 0xae, 0x38, 0x96, 0x9f, 0x2b, 0x30, 0x14, 0xd6, 0x17, 0xc1, 0xc3, 0x16, 0xcd, 0xa4, 0x96,
-	0x07, 0x89, 0x97, 0xb3, 0x3a, 0xdb, 0x85, 0x24, 0xc7, 0x55, 0x88, 0x70, 0x5d, 0x40, 0x53, 0xba,
-	0xe4, 0xaf, 0x79, 0xfa, 0x7e, 0xf4, 0xc6, 0x3c, 0x40, 0xbf, 0x50, 0x00, 0x45, 0x09, 0x36, 0xc4,
-	0x36, 0x9b, 0x9e, 0x2d, 0x93, 0xe0, 0xe6, 0xba, 0x11, 0xe5, 0xe8, 0xf4, 0x08, 0xdd, 0x45, 0xa4,
-	0x49, 0x4f, 0xaf, 0x08, 0xef, 0x0f, 0x0a, 0xe4, 0xc3, 0xc8, 0x25, 0x1e, 0xbc, 0x19, 0x05, 0x99,
-	0xfc, 0x7d, 0x9d, 0x51, 0x90, 0xa5, 0xbc, 0xa5, 0xb5, 0x9b, 0x11, 0xe0, 0x79, 0x34, 0x2b, 0x0b,
-	0x67, 0xb0, 0xf9, 0x44, 0xdc, 0x5f, 0x2a, 0x30, 0x12, 0x85, 0x35, 0x09, 0xfc, 0x5a, 0x7a, 0xc8,
-	0x52, 0x90, 0x2f, 0xf4, 0xa0, 0xc1, 0xa1, 0x2f, 0x47, 0xd0, 0xaf, 0xa0, 0x39, 0x79, 0xa6, 0x94,
-	0x62, 0xff, 0x8b, 0x02, 0xa3, 0x19, 0x4f, 0x52, 0x74, 0x43, 0x56, 0x06, 0x76, 0x7c, 0x22, 0xab,
-	0x37, 0x7b, 0x55, 0xeb, 0xa2, 0x98, 0xe1, 0x4b, 0x10, 0xbd, 0x31, 0x85, 0x8a, 0x26, 0x48, 0x0b,
-	0x34, 0xc3, 0x65, 0x3d, 0x59, 0x91, 0x1c, 0x5b, 0xc7, 0x47, 0xb4, 0xba, 0xdc, 0xb3, 0x1e, 0x27,
-	0x55, 0x8c, 0x48, 0x2d, 0xa3, 0x1b, 0xf2, 0xc5, 0xe9, 0xc4, 0xea, 0x8b, 0xbe, 0x78, 0xb9, 0xd9,
-	0x5e, 0xdb, 0x67, 0x94, 0x9b, 0xa9, 0x25, 0xfe, 0x52, 0x4f, 0x3a, 0x9c, 0xcc, 0x4f, 0x94, 0x88,
-	0xcd, 0xa7, 0x0a, 0xfa, 0xbe, 0xbc, 0xe0, 0x94, 0xd7, 0xfc, 0x62, 0xfb, 0x48, 0xac, 0x42, 0x13,
-	0xbd, 0x25, 0x2e, 0xcc, 0x9a, 0x45, 0x32, 0x49, 0x36, 0x43, 0x73, 0xda, 0xe9, 0x64, 0x57, 0x40,
-	0xfa, 0x6a, 0x48, 0x69, 0x3a, 0x48, 0x5f, 0x0d, 0x69, 0x6d, 0x06, 0x4d, 0xa7, 0xbc, 0x67, 0xd1,
-	0xe5, 0xc4, 0xd6, 0x0c, 0xa4, 0x59, 0x07, 0x22, 0x5a, 0xb7, 0xa2, 0xf1, 0xd5, 0xab, 0x71, 0xe5,
-	0xeb, 0x57, 0xe3, 0xca, 0x3f, 0x5e, 0x8d, 0x2b, 0x3f, 0x7d, 0x3d, 0x7e, 0xe4, 0xeb, 0xd7, 0xe3,
-	0x47, 0xfe, 0xf6, 0x7a, 0xfc, 0xc8, 0x93, 0x5b, 0x35, 0x8b, 0x6c, 0x37, 0x37, 0x0b, 0x15, 0xb7,
-	0xae, 0x9b, 0xb6, 0xed, 0x7a, 0xe6, 0x55, 0x1e, 0xbd, 0xe0, 0x93, 0xf6, 0x37, 0xf5, 0xe7, 0x31,
-	0x57, 0xf4, 0xff, 0x4d, 0x36, 0xfb, 0xe9, 0xbf, 0x54, 0x2c, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0x34, 0xde, 0xce, 0x1e, 0xe0, 0x22, 0x00, 0x00,
+func (m *QueryReputerNodeInfoRequest) Reset()         { *m = QueryReputerNodeInfoRequest{} }
+func (m *QueryReputerNodeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerNodeInfoRequest) ProtoMessage()    {}
+func (*QueryReputerNodeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{46}
 }
-
-func (this *QueryTotalStakeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*QueryTotalStakeResponse)
-	if !ok {
-		that2, ok := that.(QueryTotalStakeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryReputerNodeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReputerNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReputerNodeInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
-func (this *QueryReputerStakeInTopicResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryReputerNodeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerNodeInfoRequest.Merge(m, src)
+}
+func (m *QueryReputerNodeInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReputerNodeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerNodeInfoRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryReputerStakeInTopicResponse)
-	if !ok {
-		that2, ok := that.(QueryReputerStakeInTopicResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
+var xxx_messageInfo_QueryReputerNodeInfoRequest proto.InternalMessageInfo
+
+func (m *QueryReputerNodeInfoRequest) GetLibp2PKey() string {
+	if m != nil {
+		return m.Libp2PKey
 	}
-	return true
+	return ""
 }
-func (this *QueryDelegateStakeInTopicInReputerResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryDelegateStakeInTopicInReputerResponse)
-	if !ok {
-		that2, ok := that.(QueryDelegateStakeInTopicInReputerResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
+type QueryReputerNodeInfoResponse struct {
+	NodeInfo *OffchainNode `protobuf:"bytes,1,opt,name=node_info,json=nodeInfo,proto3" json:"node_info,omitempty"`
 }
-func (this *QueryStakeFromDelegatorInTopicInReputerResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryStakeFromDelegatorInTopicInReputerResponse)
-	if !ok {
-		that2, ok := that.(QueryStakeFromDelegatorInTopicInReputerResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryReputerNodeInfoResponse) Reset()         { *m = QueryReputerNodeInfoResponse{} }
+func (m *QueryReputerNodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerNodeInfoResponse) ProtoMessage()    {}
+func (*QueryReputerNodeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{47}
+}
+func (m *QueryReputerNodeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReputerNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReputerNodeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
-func (this *QueryStakeFromDelegatorInTopicResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryReputerNodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerNodeInfoResponse.Merge(m, src)
+}
+func (m *QueryReputerNodeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReputerNodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerNodeInfoResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryStakeFromDelegatorInTopicResponse)
-	if !ok {
-		that2, ok := that.(QueryStakeFromDelegatorInTopicResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
+var xxx_messageInfo_QueryReputerNodeInfoResponse proto.InternalMessageInfo
+
+func (m *QueryReputerNodeInfoResponse) GetNodeInfo() *OffchainNode {
+	if m != nil {
+		return m.NodeInfo
 	}
-	return true
+	return nil
 }
-func (this *QueryTopicStakeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryTopicStakeResponse)
-	if !ok {
-		that2, ok := that.(QueryTopicStakeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type QueryWorkerAddressByP2PKeyRequest struct {
+	Libp2PKey string `protobuf:"bytes,1,opt,name=libp2p_key,json=libp2pKey,proto3" json:"libp2p_key,omitempty"`
+}
+
+func (m *QueryWorkerAddressByP2PKeyRequest) Reset()         { *m = QueryWorkerAddressByP2PKeyRequest{} }
+func (m *QueryWorkerAddressByP2PKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerAddressByP2PKeyRequest) ProtoMessage()    {}
+func (*QueryWorkerAddressByP2PKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{48}
+}
+func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkerAddressByP2PKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error)
-	GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error)
-	GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error)
-	GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error)
-	GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error)
-	GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error)
-	GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error)
-	GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error)
-	GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error)
-	GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error)
-	GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error)
-	GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
-	GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error)
-	GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error)
-	GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error)
-	GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error)
-	GetWorkerAddressByP2PKey(ctx context.Context, in *QueryWorkerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryWorkerAddressByP2PKeyResponse, error)
-	GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error)
-	IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
-	IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error)
-	GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error)
-	IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error)
+func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerAddressByP2PKeyRequest.Merge(m, src)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryWorkerAddressByP2PKeyRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryWorkerAddressByP2PKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerAddressByP2PKeyRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryWorkerAddressByP2PKeyRequest proto.InternalMessageInfo
 
-func (c *queryClient) GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error) {
-	out := new(QueryNextTopicIdResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNextTopicId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWorkerAddressByP2PKeyRequest) GetLibp2PKey() string {
+	if m != nil {
+		return m.Libp2PKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error) {
-	out := new(QueryTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryWorkerAddressByP2PKeyResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error) {
-	out := new(QueryActiveTopicsResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetActiveTopics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryWorkerAddressByP2PKeyResponse) Reset()         { *m = QueryWorkerAddressByP2PKeyResponse{} }
+func (m *QueryWorkerAddressByP2PKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerAddressByP2PKeyResponse) ProtoMessage()    {}
+func (*QueryWorkerAddressByP2PKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{49}
 }
-
-func (c *queryClient) GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error) {
-	out := new(QueryWorkerLatestInferenceResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error) {
-	out := new(QueryInferencesAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetInferencesAtBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkerAddressByP2PKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error) {
-	out := new(QueryForecastsAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetForecastsAtBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerAddressByP2PKeyResponse.Merge(m, src)
 }
-
-func (c *queryClient) GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error) {
-	out := new(QueryNetworkLossBundleAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNetworkLossBundleAtBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryWorkerAddressByP2PKeyResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error) {
-	out := new(QueryTotalStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTotalStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryWorkerAddressByP2PKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerAddressByP2PKeyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error) {
-	out := new(QueryReputerStakeInTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerStakeInTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryWorkerAddressByP2PKeyResponse proto.InternalMessageInfo
 
-func (c *queryClient) GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error) {
-	out := new(QueryMultiReputerStakeInTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetMultiReputerStakeInTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWorkerAddressByP2PKeyResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error) {
-	out := new(QueryDelegateStakeInTopicInReputerResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakeInTopicInReputer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryReputerAddressByP2PKeyRequest struct {
+	Libp2PKey string `protobuf:"bytes,1,opt,name=libp2p_key,json=libp2pKey,proto3" json:"libp2p_key,omitempty"`
 }
 
-func (c *queryClient) GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error) {
-	out := new(QueryStakeFromDelegatorInTopicInReputerResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReputerAddressByP2PKeyRequest) Reset()         { *m = QueryReputerAddressByP2PKeyRequest{} }
+func (m *QueryReputerAddressByP2PKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerAddressByP2PKeyRequest) ProtoMessage()    {}
+func (*QueryReputerAddressByP2PKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{50}
+}
+func (m *QueryReputerAddressByP2PKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReputerAddressByP2PKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReputerAddressByP2PKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryReputerAddressByP2PKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerAddressByP2PKeyRequest.Merge(m, src)
+}
+func (m *QueryReputerAddressByP2PKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReputerAddressByP2PKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerAddressByP2PKeyRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error) {
-	out := new(QueryStakeFromDelegatorInTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromDelegatorInTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReputerAddressByP2PKeyRequest proto.InternalMessageInfo
+
+func (m *QueryReputerAddressByP2PKeyRequest) GetLibp2PKey() string {
+	if m != nil {
+		return m.Libp2PKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error) {
-	out := new(QueryTopicStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryReputerAddressByP2PKeyResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error) {
-	out := new(QueryWorkerNodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerNodeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryReputerAddressByP2PKeyResponse) Reset()         { *m = QueryReputerAddressByP2PKeyResponse{} }
+func (m *QueryReputerAddressByP2PKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerAddressByP2PKeyResponse) ProtoMessage()    {}
+func (*QueryReputerAddressByP2PKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{51}
 }
-
-func (c *queryClient) GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error) {
-	out := new(QueryReputerNodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerNodeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReputerAddressByP2PKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReputerAddressByP2PKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReputerAddressByP2PKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryReputerAddressByP2PKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerAddressByP2PKeyResponse.Merge(m, src)
+}
+func (m *QueryReputerAddressByP2PKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReputerAddressByP2PKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerAddressByP2PKeyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetWorkerAddressByP2PKey(ctx context.Context, in *QueryWorkerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryWorkerAddressByP2PKeyResponse, error) {
-	out := new(QueryWorkerAddressByP2PKeyResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerAddressByP2PKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReputerAddressByP2PKeyResponse proto.InternalMessageInfo
+
+func (m *QueryReputerAddressByP2PKeyResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error) {
-	out := new(QueryReputerAddressByP2PKeyResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerAddressByP2PKey", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryNetworkInferencesAtBlockRequest struct {
+	TopicId                  uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeightLastInference int64  `protobuf:"varint,2,opt,name=block_height_last_inference,json=blockHeightLastInference,proto3" json:"block_height_last_inference,omitempty"`
+	BlockHeightLastReward    int64  `protobuf:"varint,3,opt,name=block_height_last_reward,json=blockHeightLastReward,proto3" json:"block_height_last_reward,omitempty"`
 }
 
-func (c *queryClient) IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error) {
-	out := new(QueryIsWorkerRegisteredInTopicIdResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsWorkerRegisteredInTopicId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNetworkInferencesAtBlockRequest) Reset()         { *m = QueryNetworkInferencesAtBlockRequest{} }
+func (m *QueryNetworkInferencesAtBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkInferencesAtBlockRequest) ProtoMessage()    {}
+func (*QueryNetworkInferencesAtBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{52}
+}
+func (m *QueryNetworkInferencesAtBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkInferencesAtBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkInferencesAtBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNetworkInferencesAtBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkInferencesAtBlockRequest.Merge(m, src)
+}
+func (m *QueryNetworkInferencesAtBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkInferencesAtBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkInferencesAtBlockRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
-	out := new(QueryIsReputerRegisteredInTopicIdResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsReputerRegisteredInTopicId", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNetworkInferencesAtBlockRequest proto.InternalMessageInfo
+
+func (m *QueryNetworkInferencesAtBlockRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error) {
-	out := new(QueryNetworkInferencesAtBlockResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNetworkInferencesAtBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNetworkInferencesAtBlockRequest) GetBlockHeightLastInference() int64 {
+	if m != nil {
+		return m.BlockHeightLastInference
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error) {
-	out := new(QueryIsWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNetworkInferencesAtBlockRequest) GetBlockHeightLastReward() int64 {
+	if m != nil {
+		return m.BlockHeightLastReward
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	GetNextTopicId(context.Context, *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error)
-	GetTopic(context.Context, *QueryTopicRequest) (*QueryTopicResponse, error)
-	GetActiveTopics(context.Context, *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error)
-	GetWorkerLatestInferenceByTopicId(context.Context, *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error)
-	GetInferencesAtBlock(context.Context, *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error)
-	GetForecastsAtBlock(context.Context, *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error)
-	GetNetworkLossBundleAtBlock(context.Context, *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error)
-	GetTotalStake(context.Context, *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error)
-	GetReputerStakeInTopic(context.Context, *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error)
-	GetMultiReputerStakeInTopic(context.Context, *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error)
-	GetDelegateStakeInTopicInReputer(context.Context, *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error)
-	GetStakeFromDelegatorInTopicInReputer(context.Context, *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
-	GetStakeFromDelegatorInTopic(context.Context, *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error)
-	GetTopicStake(context.Context, *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error)
-	GetWorkerNodeInfo(context.Context, *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error)
-	GetReputerNodeInfo(context.Context, *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error)
-	GetWorkerAddressByP2PKey(context.Context, *QueryWorkerAddressByP2PKeyRequest) (*QueryWorkerAddressByP2PKeyResponse, error)
-	GetReputerAddressByP2PKey(context.Context, *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error)
-	IsWorkerRegisteredInTopicId(context.Context, *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
-	IsReputerRegisteredInTopicId(context.Context, *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error)
-	GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error)
-	IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error)
+type QueryNetworkInferencesAtBlockResponse struct {
+	NetworkInferences *ValueBundle `protobuf:"bytes,1,opt,name=network_inferences,json=networkInferences,proto3" json:"network_inferences,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryNetworkInferencesAtBlockResponse) Reset()         { *m = QueryNetworkInferencesAtBlockResponse{} }
+func (m *QueryNetworkInferencesAtBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkInferencesAtBlockResponse) ProtoMessage()    {}
+func (*QueryNetworkInferencesAtBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{53}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryNetworkInferencesAtBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetNextTopicId(ctx context.Context, req *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNextTopicId not implemented")
+func (m *QueryNetworkInferencesAtBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkInferencesAtBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetTopic(ctx context.Context, req *QueryTopicRequest) (*QueryTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
+func (m *QueryNetworkInferencesAtBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkInferencesAtBlockResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetActiveTopics(ctx context.Context, req *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetActiveTopics not implemented")
+func (m *QueryNetworkInferencesAtBlockResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetWorkerLatestInferenceByTopicId(ctx context.Context, req *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLatestInferenceByTopicId not implemented")
+func (m *QueryNetworkInferencesAtBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkInferencesAtBlockResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetInferencesAtBlock(ctx context.Context, req *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInferencesAtBlock not implemented")
+
+var xxx_messageInfo_QueryNetworkInferencesAtBlockResponse proto.InternalMessageInfo
+
+func (m *QueryNetworkInferencesAtBlockResponse) GetNetworkInferences() *ValueBundle {
+	if m != nil {
+		return m.NetworkInferences
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetForecastsAtBlock(ctx context.Context, req *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForecastsAtBlock not implemented")
+
+type QueryIsWorkerRegisteredInTopicIdRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
-func (*UnimplementedQueryServer) GetNetworkLossBundleAtBlock(ctx context.Context, req *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkLossBundleAtBlock not implemented")
+
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) Reset() {
+	*m = QueryIsWorkerRegisteredInTopicIdRequest{}
 }
-func (*UnimplementedQueryServer) GetTotalStake(ctx context.Context, req *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalStake not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsWorkerRegisteredInTopicIdRequest) ProtoMessage()    {}
+func (*QueryIsWorkerRegisteredInTopicIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{54}
 }
-func (*UnimplementedQueryServer) GetReputerStakeInTopic(ctx context.Context, req *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReputerStakeInTopic not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetMultiReputerStakeInTopic(ctx context.Context, req *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMultiReputerStakeInTopic not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetDelegateStakeInTopicInReputer(ctx context.Context, req *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeInTopicInReputer not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetStakeFromDelegatorInTopicInReputer(ctx context.Context, req *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopicInReputer not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetStakeFromDelegatorInTopic(ctx context.Context, req *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopic not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetTopicStake(ctx context.Context, req *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopicStake not implemented")
+
+var xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdRequest proto.InternalMessageInfo
+
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetWorkerNodeInfo(ctx context.Context, req *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerNodeInfo not implemented")
+
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetReputerNodeInfo(ctx context.Context, req *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReputerNodeInfo not implemented")
+
+type QueryIsWorkerRegisteredInTopicIdResponse struct {
+	IsRegistered bool `protobuf:"varint,1,opt,name=is_registered,json=isRegistered,proto3" json:"is_registered,omitempty"`
 }
-func (*UnimplementedQueryServer) GetWorkerAddressByP2PKey(ctx context.Context, req *QueryWorkerAddressByP2PKeyRequest) (*QueryWorkerAddressByP2PKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerAddressByP2PKey not implemented")
+
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) Reset() {
+	*m = QueryIsWorkerRegisteredInTopicIdResponse{}
 }
-func (*UnimplementedQueryServer) GetReputerAddressByP2PKey(ctx context.Context, req *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReputerAddressByP2PKey not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsWorkerRegisteredInTopicIdResponse) ProtoMessage()    {}
+func (*QueryIsWorkerRegisteredInTopicIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{55}
 }
-func (*UnimplementedQueryServer) IsWorkerRegisteredInTopicId(ctx context.Context, req *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsWorkerRegisteredInTopicId not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsReputerRegisteredInTopicId not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetNetworkInferencesAtBlock(ctx context.Context, req *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInferencesAtBlock not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) IsWhitelistAdmin(ctx context.Context, req *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistAdmin not implemented")
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryIsWorkerRegisteredInTopicIdResponse proto.InternalMessageInfo
 
-func _Query_GetNextTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextTopicIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNextTopicId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetNextTopicId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNextTopicId(ctx, req.(*QueryNextTopicIdRequest))
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) GetIsRegistered() bool {
+	if m != nil {
+		return m.IsRegistered
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTopic(ctx, req.(*QueryTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryIsReputerRegisteredInTopicIdRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_GetActiveTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveTopicsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetActiveTopics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetActiveTopics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetActiveTopics(ctx, req.(*QueryActiveTopicsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryIsReputerRegisteredInTopicIdRequest) Reset() {
+	*m = QueryIsReputerRegisteredInTopicIdRequest{}
 }
-
-func _Query_GetWorkerLatestInferenceByTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWorkerLatestInferenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWorkerLatestInferenceByTopicId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWorkerLatestInferenceByTopicId(ctx, req.(*QueryWorkerLatestInferenceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryIsReputerRegisteredInTopicIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsReputerRegisteredInTopicIdRequest) ProtoMessage()    {}
+func (*QueryIsReputerRegisteredInTopicIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{56}
 }
-
-func _Query_GetInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInferencesAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetInferencesAtBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetInferencesAtBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetInferencesAtBlock(ctx, req.(*QueryInferencesAtBlockRequest))
+func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsReputerRegisteredInTopicIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsReputerRegisteredInTopicIdRequest.Merge(m, src)
+}
+func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsReputerRegisteredInTopicIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsReputerRegisteredInTopicIdRequest.DiscardUnknown(m)
 }
 
-func _Query_GetForecastsAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryForecastsAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetForecastsAtBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetForecastsAtBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetForecastsAtBlock(ctx, req.(*QueryForecastsAtBlockRequest))
+var xxx_messageInfo_QueryIsReputerRegisteredInTopicIdRequest proto.InternalMessageInfo
+
+func (m *QueryIsReputerRegisteredInTopicIdRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetNetworkLossBundleAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNetworkLossBundleAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNetworkLossBundleAtBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetNetworkLossBundleAtBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNetworkLossBundleAtBlock(ctx, req.(*QueryNetworkLossBundleAtBlockRequest))
+func (m *QueryIsReputerRegisteredInTopicIdRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetTotalStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTotalStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetTotalStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTotalStake(ctx, req.(*QueryTotalStakeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryIsReputerRegisteredInTopicIdResponse struct {
+	IsRegistered bool `protobuf:"varint,1,opt,name=is_registered,json=isRegistered,proto3" json:"is_registered,omitempty"`
 }
 
-func _Query_GetReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReputerStakeInTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetReputerStakeInTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetReputerStakeInTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetReputerStakeInTopic(ctx, req.(*QueryReputerStakeInTopicRequest))
+func (m *QueryIsReputerRegisteredInTopicIdResponse) Reset() {
+	*m = QueryIsReputerRegisteredInTopicIdResponse{}
+}
+func (m *QueryIsReputerRegisteredInTopicIdResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryIsReputerRegisteredInTopicIdResponse) ProtoMessage() {}
+func (*QueryIsReputerRegisteredInTopicIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{57}
+}
+func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsReputerRegisteredInTopicIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsReputerRegisteredInTopicIdResponse.Merge(m, src)
+}
+func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsReputerRegisteredInTopicIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsReputerRegisteredInTopicIdResponse.DiscardUnknown(m)
 }
 
-func _Query_GetMultiReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMultiReputerStakeInTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMultiReputerStakeInTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetMultiReputerStakeInTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMultiReputerStakeInTopic(ctx, req.(*QueryMultiReputerStakeInTopicRequest))
+var xxx_messageInfo_QueryIsReputerRegisteredInTopicIdResponse proto.InternalMessageInfo
+
+func (m *QueryIsReputerRegisteredInTopicIdResponse) GetIsRegistered() bool {
+	if m != nil {
+		return m.IsRegistered
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_GetDelegateStakeInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegateStakeInTopicInReputerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDelegateStakeInTopicInReputer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetDelegateStakeInTopicInReputer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDelegateStakeInTopicInReputer(ctx, req.(*QueryDelegateStakeInTopicInReputerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryWorkerLivenessRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_GetStakeFromDelegatorInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakeFromDelegatorInTopicInReputerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStakeFromDelegatorInTopicInReputer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStakeFromDelegatorInTopicInReputer(ctx, req.(*QueryStakeFromDelegatorInTopicInReputerRequest))
+func (m *QueryWorkerLivenessRequest) Reset()         { *m = QueryWorkerLivenessRequest{} }
+func (m *QueryWorkerLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerLivenessRequest) ProtoMessage()    {}
+func (*QueryWorkerLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{58}
+}
+func (m *QueryWorkerLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorkerLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkerLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryWorkerLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerLivenessRequest.Merge(m, src)
+}
+func (m *QueryWorkerLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorkerLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerLivenessRequest.DiscardUnknown(m)
 }
 
-func _Query_GetStakeFromDelegatorInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakeFromDelegatorInTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStakeFromDelegatorInTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetStakeFromDelegatorInTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStakeFromDelegatorInTopic(ctx, req.(*QueryStakeFromDelegatorInTopicRequest))
+var xxx_messageInfo_QueryWorkerLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryWorkerLivenessRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetTopicStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTopicStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTopicStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetTopicStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTopicStake(ctx, req.(*QueryTopicStakeRequest))
+func (m *QueryWorkerLivenessRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetWorkerNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWorkerNodeInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWorkerNodeInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetWorkerNodeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWorkerNodeInfo(ctx, req.(*QueryWorkerNodeInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryWorkerLivenessResponse struct {
+	Liveness *ActorLiveness `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	// fraction of the tracked nonces that the worker did not miss
+	Uptime   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=uptime,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"uptime"`
+	IsJailed bool                                            `protobuf:"varint,3,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
 }
 
-func _Query_GetReputerNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReputerNodeInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetReputerNodeInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetReputerNodeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetReputerNodeInfo(ctx, req.(*QueryReputerNodeInfoRequest))
+func (m *QueryWorkerLivenessResponse) Reset()         { *m = QueryWorkerLivenessResponse{} }
+func (m *QueryWorkerLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkerLivenessResponse) ProtoMessage()    {}
+func (*QueryWorkerLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{59}
+}
+func (m *QueryWorkerLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorkerLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkerLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryWorkerLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkerLivenessResponse.Merge(m, src)
+}
+func (m *QueryWorkerLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorkerLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkerLivenessResponse.DiscardUnknown(m)
 }
 
-func _Query_GetWorkerAddressByP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWorkerAddressByP2PKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWorkerAddressByP2PKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetWorkerAddressByP2PKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWorkerAddressByP2PKey(ctx, req.(*QueryWorkerAddressByP2PKeyRequest))
+var xxx_messageInfo_QueryWorkerLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryWorkerLivenessResponse) GetLiveness() *ActorLiveness {
+	if m != nil {
+		return m.Liveness
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetReputerAddressByP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReputerAddressByP2PKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetReputerAddressByP2PKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetReputerAddressByP2PKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetReputerAddressByP2PKey(ctx, req.(*QueryReputerAddressByP2PKeyRequest))
+func (m *QueryWorkerLivenessResponse) GetIsJailed() bool {
+	if m != nil {
+		return m.IsJailed
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_IsWorkerRegisteredInTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsWorkerRegisteredInTopicIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsWorkerRegisteredInTopicId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/IsWorkerRegisteredInTopicId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsWorkerRegisteredInTopicId(ctx, req.(*QueryIsWorkerRegisteredInTopicIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryReputerLivenessRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_IsReputerRegisteredInTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsReputerRegisteredInTopicIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsReputerRegisteredInTopicId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/IsReputerRegisteredInTopicId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsReputerRegisteredInTopicId(ctx, req.(*QueryIsReputerRegisteredInTopicIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryReputerLivenessRequest) Reset()         { *m = QueryReputerLivenessRequest{} }
+func (m *QueryReputerLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerLivenessRequest) ProtoMessage()    {}
+func (*QueryReputerLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{60}
 }
-
-func _Query_GetNetworkInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNetworkInferencesAtBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNetworkInferencesAtBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/GetNetworkInferencesAtBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNetworkInferencesAtBlock(ctx, req.(*QueryNetworkInferencesAtBlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryReputerLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_IsWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsWhitelistAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsWhitelistAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Query/IsWhitelistAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsWhitelistAdmin(ctx, req.(*QueryIsWhitelistAdminRequest))
+func (m *QueryReputerLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReputerLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "emissions.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetNextTopicId",
-			Handler:    _Query_GetNextTopicId_Handler,
-		},
-		{
-			MethodName: "GetTopic",
-			Handler:    _Query_GetTopic_Handler,
-		},
-		{
-			MethodName: "GetActiveTopics",
-			Handler:    _Query_GetActiveTopics_Handler,
-		},
-		{
-			MethodName: "GetWorkerLatestInferenceByTopicId",
-			Handler:    _Query_GetWorkerLatestInferenceByTopicId_Handler,
-		},
-		{
-			MethodName: "GetInferencesAtBlock",
-			Handler:    _Query_GetInferencesAtBlock_Handler,
-		},
-		{
-			MethodName: "GetForecastsAtBlock",
-			Handler:    _Query_GetForecastsAtBlock_Handler,
-		},
-		{
-			MethodName: "GetNetworkLossBundleAtBlock",
-			Handler:    _Query_GetNetworkLossBundleAtBlock_Handler,
-		},
-		{
-			MethodName: "GetTotalStake",
-			Handler:    _Query_GetTotalStake_Handler,
-		},
-		{
-			MethodName: "GetReputerStakeInTopic",
-			Handler:    _Query_GetReputerStakeInTopic_Handler,
-		},
-		{
-			MethodName: "GetMultiReputerStakeInTopic",
-			Handler:    _Query_GetMultiReputerStakeInTopic_Handler,
-		},
-		{
-			MethodName: "GetDelegateStakeInTopicInReputer",
-			Handler:    _Query_GetDelegateStakeInTopicInReputer_Handler,
-		},
-		{
-			MethodName: "GetStakeFromDelegatorInTopicInReputer",
-			Handler:    _Query_GetStakeFromDelegatorInTopicInReputer_Handler,
-		},
-		{
-			MethodName: "GetStakeFromDelegatorInTopic",
-			Handler:    _Query_GetStakeFromDelegatorInTopic_Handler,
-		},
-		{
-			MethodName: "GetTopicStake",
-			Handler:    _Query_GetTopicStake_Handler,
-		},
-		{
-			MethodName: "GetWorkerNodeInfo",
-			Handler:    _Query_GetWorkerNodeInfo_Handler,
-		},
-		{
-			MethodName: "GetReputerNodeInfo",
-			Handler:    _Query_GetReputerNodeInfo_Handler,
-		},
-		{
-			MethodName: "GetWorkerAddressByP2PKey",
-			Handler:    _Query_GetWorkerAddressByP2PKey_Handler,
-		},
-		{
-			MethodName: "GetReputerAddressByP2PKey",
-			Handler:    _Query_GetReputerAddressByP2PKey_Handler,
-		},
-		{
-			MethodName: "IsWorkerRegisteredInTopicId",
-			Handler:    _Query_IsWorkerRegisteredInTopicId_Handler,
-		},
-		{
-			MethodName: "IsReputerRegisteredInTopicId",
-			Handler:    _Query_IsReputerRegisteredInTopicId_Handler,
-		},
-		{
-			MethodName: "GetNetworkInferencesAtBlock",
-			Handler:    _Query_GetNetworkInferencesAtBlock_Handler,
-		},
-		{
-			MethodName: "IsWhitelistAdmin",
-			Handler:    _Query_IsWhitelistAdmin_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "emissions/v1/query.proto",
+func (m *QueryReputerLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerLivenessRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryReputerLivenessRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReputerLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerLivenessRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryReputerLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryReputerLivenessRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReputerLivenessRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryReputerLivenessResponse struct {
+	Liveness *ActorLiveness `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	// fraction of the tracked nonces that the reputer did not miss
+	Uptime   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=uptime,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"uptime"`
+	IsJailed bool                                            `protobuf:"varint,3,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryReputerLivenessResponse) Reset()         { *m = QueryReputerLivenessResponse{} }
+func (m *QueryReputerLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReputerLivenessResponse) ProtoMessage()    {}
+func (*QueryReputerLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{61}
+}
+func (m *QueryReputerLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReputerLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReputerLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTotalStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryReputerLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReputerLivenessResponse.Merge(m, src)
 }
-
-func (m *QueryTotalStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReputerLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReputerLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReputerLivenessResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryReputerLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryReputerLivenessResponse) GetLiveness() *ActorLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return nil
 }
 
-func (m *QueryTotalStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReputerLivenessResponse) GetIsJailed() bool {
+	if m != nil {
+		return m.IsJailed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryTotalStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryIsWhitelistAdminRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryTotalStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryIsWhitelistAdminRequest) Reset()         { *m = QueryIsWhitelistAdminRequest{} }
+func (m *QueryIsWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsWhitelistAdminRequest) ProtoMessage()    {}
+func (*QueryIsWhitelistAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{62}
+}
+func (m *QueryIsWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsWhitelistAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryIsWhitelistAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsWhitelistAdminRequest.Merge(m, src)
+}
+func (m *QueryIsWhitelistAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsWhitelistAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsWhitelistAdminRequest.DiscardUnknown(m)
 }
 
-func (m *QueryReputerStakeInTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIsWhitelistAdminRequest proto.InternalMessageInfo
+
+func (m *QueryIsWhitelistAdminRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryReputerStakeInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryIsWhitelistAdminResponse struct {
+	IsAdmin bool `protobuf:"varint,1,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
 }
 
-func (m *QueryReputerStakeInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryIsWhitelistAdminResponse) Reset()         { *m = QueryIsWhitelistAdminResponse{} }
+func (m *QueryIsWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsWhitelistAdminResponse) ProtoMessage()    {}
+func (*QueryIsWhitelistAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{63}
+}
+func (m *QueryIsWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsWhitelistAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryIsWhitelistAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsWhitelistAdminResponse.Merge(m, src)
+}
+func (m *QueryIsWhitelistAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsWhitelistAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsWhitelistAdminResponse.DiscardUnknown(m)
 }
 
-func (m *QueryReputerStakeInTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIsWhitelistAdminResponse proto.InternalMessageInfo
+
+func (m *QueryIsWhitelistAdminResponse) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryReputerStakeInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTopicAllowlistRequest struct {
+	TopicId   uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	IsReputer bool   `protobuf:"varint,2,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
 }
 
-func (m *QueryReputerStakeInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryTopicAllowlistRequest) Reset()         { *m = QueryTopicAllowlistRequest{} }
+func (m *QueryTopicAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicAllowlistRequest) ProtoMessage()    {}
+func (*QueryTopicAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{64}
+}
+func (m *QueryTopicAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTopicAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicAllowlistRequest.Merge(m, src)
+}
+func (m *QueryTopicAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicAllowlistRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMultiReputerStakeInTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopicAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryTopicAllowlistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryMultiReputerStakeInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTopicAllowlistRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
 }
 
-func (m *QueryMultiReputerStakeInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+type QueryTopicAllowlistResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryTopicAllowlistResponse) Reset()         { *m = QueryTopicAllowlistResponse{} }
+func (m *QueryTopicAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicAllowlistResponse) ProtoMessage()    {}
+func (*QueryTopicAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{65}
+}
+func (m *QueryTopicAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTopicAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicAllowlistResponse.Merge(m, src)
+}
+func (m *QueryTopicAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMultiReputerStakeInTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopicAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryTopicAllowlistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMultiReputerStakeInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTopicCreatorAllowlistRequest struct {
 }
 
-func (m *QueryMultiReputerStakeInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTopicCreatorAllowlistRequest) Reset()         { *m = QueryTopicCreatorAllowlistRequest{} }
+func (m *QueryTopicCreatorAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicCreatorAllowlistRequest) ProtoMessage()    {}
+func (*QueryTopicCreatorAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{66}
+}
+func (m *QueryTopicCreatorAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicCreatorAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicCreatorAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDelegateStakeInTopicInReputerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTopicCreatorAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicCreatorAllowlistRequest.Merge(m, src)
+}
+func (m *QueryTopicCreatorAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicCreatorAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicCreatorAllowlistRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegateStakeInTopicInReputerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTopicCreatorAllowlistRequest proto.InternalMessageInfo
+
+type QueryTopicCreatorAllowlistResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (m *QueryDelegateStakeInTopicInReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ReputerAddress) > 0 {
-		i -= len(m.ReputerAddress)
-		copy(dAtA[i:], m.ReputerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryTopicCreatorAllowlistResponse) Reset()         { *m = QueryTopicCreatorAllowlistResponse{} }
+func (m *QueryTopicCreatorAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicCreatorAllowlistResponse) ProtoMessage()    {}
+func (*QueryTopicCreatorAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{67}
+}
+func (m *QueryTopicCreatorAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicCreatorAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicCreatorAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTopicCreatorAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicCreatorAllowlistResponse.Merge(m, src)
+}
+func (m *QueryTopicCreatorAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicCreatorAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicCreatorAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegateStakeInTopicInReputerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopicCreatorAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryTopicCreatorAllowlistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDelegateStakeInTopicInReputerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryScheduledParamsUpdatesRequest struct {
 }
 
-func (m *QueryDelegateStakeInTopicInReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryScheduledParamsUpdatesRequest) Reset()         { *m = QueryScheduledParamsUpdatesRequest{} }
+func (m *QueryScheduledParamsUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsUpdatesRequest) ProtoMessage()    {}
+func (*QueryScheduledParamsUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{68}
+}
+func (m *QueryScheduledParamsUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledParamsUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledParamsUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryScheduledParamsUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsUpdatesRequest.Merge(m, src)
+}
+func (m *QueryScheduledParamsUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledParamsUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsUpdatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryScheduledParamsUpdatesRequest proto.InternalMessageInfo
+
+type QueryScheduledParamsUpdatesResponse struct {
+	Updates []*ScheduledParamsUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ReputerAddress) > 0 {
-		i -= len(m.ReputerAddress)
-		copy(dAtA[i:], m.ReputerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryScheduledParamsUpdatesResponse) Reset()         { *m = QueryScheduledParamsUpdatesResponse{} }
+func (m *QueryScheduledParamsUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsUpdatesResponse) ProtoMessage()    {}
+func (*QueryScheduledParamsUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{69}
+}
+func (m *QueryScheduledParamsUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledParamsUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledParamsUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryScheduledParamsUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsUpdatesResponse.Merge(m, src)
+}
+func (m *QueryScheduledParamsUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledParamsUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsUpdatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryScheduledParamsUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledParamsUpdatesResponse) GetUpdates() []*ScheduledParamsUpdate {
+	if m != nil {
+		return m.Updates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTopicRewardReportRequest struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryTopicRewardReportRequest) Reset()         { *m = QueryTopicRewardReportRequest{} }
+func (m *QueryTopicRewardReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicRewardReportRequest) ProtoMessage()    {}
+func (*QueryTopicRewardReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{70}
+}
+func (m *QueryTopicRewardReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicRewardReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicRewardReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStakeFromDelegatorInTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTopicRewardReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicRewardReportRequest.Merge(m, src)
 }
-
-func (m *QueryStakeFromDelegatorInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTopicRewardReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicRewardReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicRewardReportRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakeFromDelegatorInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryTopicRewardReportRequest proto.InternalMessageInfo
+
+func (m *QueryTopicRewardReportRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryStakeFromDelegatorInTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTopicRewardReportRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStakeFromDelegatorInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTopicRewardReportResponse struct {
+	Report *TopicRewardReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (m *QueryStakeFromDelegatorInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryTopicRewardReportResponse) Reset()         { *m = QueryTopicRewardReportResponse{} }
+func (m *QueryTopicRewardReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopicRewardReportResponse) ProtoMessage()    {}
+func (*QueryTopicRewardReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{71}
+}
+func (m *QueryTopicRewardReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopicRewardReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopicRewardReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTopicRewardReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopicRewardReportResponse.Merge(m, src)
+}
+func (m *QueryTopicRewardReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopicRewardReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopicRewardReportResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTopicStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopicRewardReportResponse proto.InternalMessageInfo
+
+func (m *QueryTopicRewardReportResponse) GetReport() *TopicRewardReport {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTopicStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySimulateTopicRewardsRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	// reward nonce to simulate; the pending reward nonce of the topic if zero
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *QueryTopicStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySimulateTopicRewardsRequest) Reset()         { *m = QuerySimulateTopicRewardsRequest{} }
+func (m *QuerySimulateTopicRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateTopicRewardsRequest) ProtoMessage()    {}
+func (*QuerySimulateTopicRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{72}
+}
+func (m *QuerySimulateTopicRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateTopicRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateTopicRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateTopicRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateTopicRewardsRequest.Merge(m, src)
+}
+func (m *QuerySimulateTopicRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateTopicRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateTopicRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTopicStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateTopicRewardsRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateTopicRewardsRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTopicStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateTopicRewardsRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *QueryTopicStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type QuerySimulateTopicRewardsResponse struct {
+	Report *TopicRewardReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (m *QuerySimulateTopicRewardsResponse) Reset()         { *m = QuerySimulateTopicRewardsResponse{} }
+func (m *QuerySimulateTopicRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateTopicRewardsResponse) ProtoMessage()    {}
+func (*QuerySimulateTopicRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{73}
+}
+func (m *QuerySimulateTopicRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateTopicRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateTopicRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateTopicRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateTopicRewardsResponse.Merge(m, src)
+}
+func (m *QuerySimulateTopicRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateTopicRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateTopicRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNetworkLossBundleAtBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateTopicRewardsResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateTopicRewardsResponse) GetReport() *TopicRewardReport {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNetworkLossBundleAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClaimableRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryNetworkLossBundleAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryClaimableRewardsRequest) Reset()         { *m = QueryClaimableRewardsRequest{} }
+func (m *QueryClaimableRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRewardsRequest) ProtoMessage()    {}
+func (*QueryClaimableRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{74}
+}
+func (m *QueryClaimableRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimableRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRewardsRequest.Merge(m, src)
+}
+func (m *QueryClaimableRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNetworkLossBundleAtBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryNetworkLossBundleAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClaimableRewardsResponse struct {
+	Rewards         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	WithdrawAddress string                                   `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *QueryNetworkLossBundleAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LossBundle != nil {
-		{
-			size, err := m.LossBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryClaimableRewardsResponse) Reset()         { *m = QueryClaimableRewardsResponse{} }
+func (m *QueryClaimableRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRewardsResponse) ProtoMessage()    {}
+func (*QueryClaimableRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{75}
+}
+func (m *QueryClaimableRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimableRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRewardsResponse.Merge(m, src)
+}
+func (m *QueryClaimableRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNextTopicIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNextTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClaimableRewardsResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
 }
 
-func (m *QueryNextTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryStateRetentionRequest struct {
 }
 
-func (m *QueryNextTopicIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStateRetentionRequest) Reset()         { *m = QueryStateRetentionRequest{} }
+func (m *QueryStateRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStateRetentionRequest) ProtoMessage()    {}
+func (*QueryStateRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{76}
+}
+func (m *QueryStateRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateRetentionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryStateRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateRetentionRequest.Merge(m, src)
+}
+func (m *QueryStateRetentionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateRetentionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNextTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryStateRetentionRequest proto.InternalMessageInfo
+
+type QueryStateRetentionResponse struct {
+	MaxRecordsPerBlock uint64                 `protobuf:"varint,1,opt,name=max_records_per_block,json=maxRecordsPerBlock,proto3" json:"max_records_per_block,omitempty"`
+	Cursor             *PruningCursor         `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Collections        []*CollectionRetention `protobuf:"bytes,3,rep,name=collections,proto3" json:"collections,omitempty"`
 }
 
-func (m *QueryNextTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextTopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NextTopicId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryStateRetentionResponse) Reset()         { *m = QueryStateRetentionResponse{} }
+func (m *QueryStateRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStateRetentionResponse) ProtoMessage()    {}
+func (*QueryStateRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c426af2f1e908986, []int{77}
+}
+func (m *QueryStateRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStateRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateRetentionResponse.Merge(m, src)
+}
+func (m *QueryStateRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateRetentionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStateRetentionResponse proto.InternalMessageInfo
+
+func (m *QueryStateRetentionResponse) GetMaxRecordsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRecordsPerBlock
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStateRetentionResponse) GetCursor() *PruningCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
 }
 
-func (m *QueryTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryStateRetentionResponse) GetCollections() []*CollectionRetention {
+	if m != nil {
+		return m.Collections
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "emissions.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "emissions.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTotalStakeRequest)(nil), "emissions.v1.QueryTotalStakeRequest")
+	proto.RegisterType((*QueryTotalStakeResponse)(nil), "emissions.v1.QueryTotalStakeResponse")
+	proto.RegisterType((*QueryReputerStakeInTopicRequest)(nil), "emissions.v1.QueryReputerStakeInTopicRequest")
+	proto.RegisterType((*QueryReputerStakeInTopicResponse)(nil), "emissions.v1.QueryReputerStakeInTopicResponse")
+	proto.RegisterType((*QueryMultiReputerStakeInTopicRequest)(nil), "emissions.v1.QueryMultiReputerStakeInTopicRequest")
+	proto.RegisterType((*QueryMultiReputerStakeInTopicResponse)(nil), "emissions.v1.QueryMultiReputerStakeInTopicResponse")
+	proto.RegisterType((*QueryDelegateStakeInTopicInReputerRequest)(nil), "emissions.v1.QueryDelegateStakeInTopicInReputerRequest")
+	proto.RegisterType((*QueryDelegateStakeInTopicInReputerResponse)(nil), "emissions.v1.QueryDelegateStakeInTopicInReputerResponse")
+	proto.RegisterType((*QueryStakeFromDelegatorInTopicInReputerRequest)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicInReputerRequest")
+	proto.RegisterType((*QueryStakeFromDelegatorInTopicInReputerResponse)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicInReputerResponse")
+	proto.RegisterType((*QueryStakeFromDelegatorInTopicRequest)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicRequest")
+	proto.RegisterType((*QueryStakeFromDelegatorInTopicResponse)(nil), "emissions.v1.QueryStakeFromDelegatorInTopicResponse")
+	proto.RegisterType((*QueryDelegateStakePendingRewardRequest)(nil), "emissions.v1.QueryDelegateStakePendingRewardRequest")
+	proto.RegisterType((*QueryDelegateStakePendingRewardResponse)(nil), "emissions.v1.QueryDelegateStakePendingRewardResponse")
+	proto.RegisterType((*QueryDelegatorPendingRewardsRequest)(nil), "emissions.v1.QueryDelegatorPendingRewardsRequest")
+	proto.RegisterType((*QueryDelegatorPendingRewardsResponse)(nil), "emissions.v1.QueryDelegatorPendingRewardsResponse")
+	proto.RegisterType((*QueryReputerCommissionRequest)(nil), "emissions.v1.QueryReputerCommissionRequest")
+	proto.RegisterType((*QueryReputerCommissionResponse)(nil), "emissions.v1.QueryReputerCommissionResponse")
+	proto.RegisterType((*QueryRedelegationsFromReputerRequest)(nil), "emissions.v1.QueryRedelegationsFromReputerRequest")
+	proto.RegisterType((*QueryRedelegationsFromReputerResponse)(nil), "emissions.v1.QueryRedelegationsFromReputerResponse")
+	proto.RegisterType((*QueryReputerStakePoolRequest)(nil), "emissions.v1.QueryReputerStakePoolRequest")
+	proto.RegisterType((*QueryReputerStakePoolResponse)(nil), "emissions.v1.QueryReputerStakePoolResponse")
+	proto.RegisterType((*QueryTopicStakeRequest)(nil), "emissions.v1.QueryTopicStakeRequest")
+	proto.RegisterType((*QueryTopicStakeResponse)(nil), "emissions.v1.QueryTopicStakeResponse")
+	proto.RegisterType((*QueryNetworkLossBundleAtBlockRequest)(nil), "emissions.v1.QueryNetworkLossBundleAtBlockRequest")
+	proto.RegisterType((*QueryNetworkLossBundleAtBlockResponse)(nil), "emissions.v1.QueryNetworkLossBundleAtBlockResponse")
+	proto.RegisterType((*QueryNextTopicIdRequest)(nil), "emissions.v1.QueryNextTopicIdRequest")
+	proto.RegisterType((*QueryNextTopicIdResponse)(nil), "emissions.v1.QueryNextTopicIdResponse")
+	proto.RegisterType((*QueryTopicRequest)(nil), "emissions.v1.QueryTopicRequest")
+	proto.RegisterType((*QueryTopicResponse)(nil), "emissions.v1.QueryTopicResponse")
+	proto.RegisterType((*QueryTopicParamsRequest)(nil), "emissions.v1.QueryTopicParamsRequest")
+	proto.RegisterType((*QueryTopicParamsResponse)(nil), "emissions.v1.QueryTopicParamsResponse")
+	proto.RegisterType((*QueryActiveTopicsRequest)(nil), "emissions.v1.QueryActiveTopicsRequest")
+	proto.RegisterType((*QueryActiveTopicsResponse)(nil), "emissions.v1.QueryActiveTopicsResponse")
+	proto.RegisterType((*QueryInferencesAtBlockRequest)(nil), "emissions.v1.QueryInferencesAtBlockRequest")
+	proto.RegisterType((*QueryInferencesAtBlockResponse)(nil), "emissions.v1.QueryInferencesAtBlockResponse")
+	proto.RegisterType((*QueryForecastsAtBlockRequest)(nil), "emissions.v1.QueryForecastsAtBlockRequest")
+	proto.RegisterType((*QueryForecastsAtBlockResponse)(nil), "emissions.v1.QueryForecastsAtBlockResponse")
+	proto.RegisterType((*QueryWorkerCommitmentRequest)(nil), "emissions.v1.QueryWorkerCommitmentRequest")
+	proto.RegisterType((*QueryWorkerCommitmentResponse)(nil), "emissions.v1.QueryWorkerCommitmentResponse")
+	proto.RegisterType((*QueryWorkerLatestInferenceRequest)(nil), "emissions.v1.QueryWorkerLatestInferenceRequest")
+	proto.RegisterType((*QueryWorkerLatestInferenceResponse)(nil), "emissions.v1.QueryWorkerLatestInferenceResponse")
+	proto.RegisterType((*QueryWorkerNodeInfoRequest)(nil), "emissions.v1.QueryWorkerNodeInfoRequest")
+	proto.RegisterType((*QueryWorkerNodeInfoResponse)(nil), "emissions.v1.QueryWorkerNodeInfoResponse")
+	proto.RegisterType((*QueryReputerNodeInfoRequest)(nil), "emissions.v1.QueryReputerNodeInfoRequest")
+	proto.RegisterType((*QueryReputerNodeInfoResponse)(nil), "emissions.v1.QueryReputerNodeInfoResponse")
+	proto.RegisterType((*QueryWorkerAddressByP2PKeyRequest)(nil), "emissions.v1.QueryWorkerAddressByP2PKeyRequest")
+	proto.RegisterType((*QueryWorkerAddressByP2PKeyResponse)(nil), "emissions.v1.QueryWorkerAddressByP2PKeyResponse")
+	proto.RegisterType((*QueryReputerAddressByP2PKeyRequest)(nil), "emissions.v1.QueryReputerAddressByP2PKeyRequest")
+	proto.RegisterType((*QueryReputerAddressByP2PKeyResponse)(nil), "emissions.v1.QueryReputerAddressByP2PKeyResponse")
+	proto.RegisterType((*QueryNetworkInferencesAtBlockRequest)(nil), "emissions.v1.QueryNetworkInferencesAtBlockRequest")
+	proto.RegisterType((*QueryNetworkInferencesAtBlockResponse)(nil), "emissions.v1.QueryNetworkInferencesAtBlockResponse")
+	proto.RegisterType((*QueryIsWorkerRegisteredInTopicIdRequest)(nil), "emissions.v1.QueryIsWorkerRegisteredInTopicIdRequest")
+	proto.RegisterType((*QueryIsWorkerRegisteredInTopicIdResponse)(nil), "emissions.v1.QueryIsWorkerRegisteredInTopicIdResponse")
+	proto.RegisterType((*QueryIsReputerRegisteredInTopicIdRequest)(nil), "emissions.v1.QueryIsReputerRegisteredInTopicIdRequest")
+	proto.RegisterType((*QueryIsReputerRegisteredInTopicIdResponse)(nil), "emissions.v1.QueryIsReputerRegisteredInTopicIdResponse")
+	proto.RegisterType((*QueryWorkerLivenessRequest)(nil), "emissions.v1.QueryWorkerLivenessRequest")
+	proto.RegisterType((*QueryWorkerLivenessResponse)(nil), "emissions.v1.QueryWorkerLivenessResponse")
+	proto.RegisterType((*QueryReputerLivenessRequest)(nil), "emissions.v1.QueryReputerLivenessRequest")
+	proto.RegisterType((*QueryReputerLivenessResponse)(nil), "emissions.v1.QueryReputerLivenessResponse")
+	proto.RegisterType((*QueryIsWhitelistAdminRequest)(nil), "emissions.v1.QueryIsWhitelistAdminRequest")
+	proto.RegisterType((*QueryIsWhitelistAdminResponse)(nil), "emissions.v1.QueryIsWhitelistAdminResponse")
+	proto.RegisterType((*QueryTopicAllowlistRequest)(nil), "emissions.v1.QueryTopicAllowlistRequest")
+	proto.RegisterType((*QueryTopicAllowlistResponse)(nil), "emissions.v1.QueryTopicAllowlistResponse")
+	proto.RegisterType((*QueryTopicCreatorAllowlistRequest)(nil), "emissions.v1.QueryTopicCreatorAllowlistRequest")
+	proto.RegisterType((*QueryTopicCreatorAllowlistResponse)(nil), "emissions.v1.QueryTopicCreatorAllowlistResponse")
+	proto.RegisterType((*QueryScheduledParamsUpdatesRequest)(nil), "emissions.v1.QueryScheduledParamsUpdatesRequest")
+	proto.RegisterType((*QueryScheduledParamsUpdatesResponse)(nil), "emissions.v1.QueryScheduledParamsUpdatesResponse")
+	proto.RegisterType((*QueryTopicRewardReportRequest)(nil), "emissions.v1.QueryTopicRewardReportRequest")
+	proto.RegisterType((*QueryTopicRewardReportResponse)(nil), "emissions.v1.QueryTopicRewardReportResponse")
+	proto.RegisterType((*QuerySimulateTopicRewardsRequest)(nil), "emissions.v1.QuerySimulateTopicRewardsRequest")
+	proto.RegisterType((*QuerySimulateTopicRewardsResponse)(nil), "emissions.v1.QuerySimulateTopicRewardsResponse")
+	proto.RegisterType((*QueryClaimableRewardsRequest)(nil), "emissions.v1.QueryClaimableRewardsRequest")
+	proto.RegisterType((*QueryClaimableRewardsResponse)(nil), "emissions.v1.QueryClaimableRewardsResponse")
+	proto.RegisterType((*QueryStateRetentionRequest)(nil), "emissions.v1.QueryStateRetentionRequest")
+	proto.RegisterType((*QueryStateRetentionResponse)(nil), "emissions.v1.QueryStateRetentionResponse")
 }
 
-func (m *QueryTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("emissions/v1/query.proto", fileDescriptor_c426af2f1e908986) }
+
+var fileDescriptor_c426af2f1e908986 = []byte{
+	// 3507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5b, 0x6f, 0x1c, 0xc7,
+	0x95, 0x56, 0x53, 0x32, 0x45, 0x1e, 0xdd, 0xcb, 0x92, 0x4c, 0x36, 0xef, 0x4d, 0x51, 0xe2, 0x45,
+	0xe2, 0x88, 0xa4, 0x6e, 0xb0, 0x2d, 0xd9, 0x24, 0x6d, 0x51, 0xb4, 0x64, 0x89, 0x6e, 0x79, 0xa5,
+	0x5d, 0xc3, 0xeb, 0xde, 0xe6, 0x4c, 0x91, 0x6c, 0x6b, 0xa6, 0x7b, 0xdc, 0xdd, 0xa3, 0x0b, 0xb4,
+	0xc2, 0xda, 0xbb, 0x7e, 0x58, 0x03, 0xbb, 0x48, 0x00, 0x07, 0x46, 0x02, 0xe4, 0x21, 0x79, 0x09,
+	0x12, 0x23, 0x77, 0xc4, 0x06, 0x8c, 0x20, 0xc8, 0x4b, 0x82, 0x38, 0x01, 0x82, 0x18, 0xf1, 0x4b,
+	0xe0, 0x07, 0x27, 0xb0, 0x13, 0xc4, 0xaf, 0xf9, 0x07, 0x41, 0x57, 0x9d, 0xbe, 0x54, 0x4f, 0x75,
+	0x4f, 0x8f, 0x44, 0x21, 0xc8, 0x8b, 0xad, 0xa9, 0x3a, 0x97, 0xef, 0x9c, 0x53, 0x75, 0xba, 0xea,
+	0x9c, 0x22, 0xf4, 0xd0, 0x9a, 0xe5, 0x79, 0x96, 0x63, 0x7b, 0xa5, 0x9b, 0x33, 0xa5, 0xd7, 0x1a,
+	0xd4, 0xbd, 0x33, 0x5d, 0x77, 0x1d, 0xdf, 0x21, 0x3b, 0xa3, 0x99, 0xe9, 0x9b, 0x33, 0xea, 0x64,
+	0xd9, 0xf1, 0x6a, 0x8e, 0x57, 0x5a, 0x35, 0x3d, 0xca, 0xc9, 0x4a, 0x37, 0x67, 0x56, 0xa9, 0x6f,
+	0xce, 0x94, 0xea, 0xe6, 0xba, 0x65, 0x9b, 0xbe, 0xe5, 0xd8, 0x9c, 0x53, 0x15, 0x65, 0xfa, 0x77,
+	0xea, 0xd4, 0xc3, 0x99, 0xfe, 0x75, 0xc7, 0x59, 0xaf, 0xd2, 0x92, 0x59, 0xb7, 0x4a, 0xa6, 0x6d,
+	0x3b, 0x3e, 0x63, 0x0b, 0x67, 0xfb, 0x50, 0x47, 0x28, 0x3e, 0x09, 0x47, 0xdd, 0x67, 0xd6, 0x2c,
+	0xdb, 0x29, 0xb1, 0xff, 0xe2, 0xd0, 0xfe, 0x75, 0x67, 0xdd, 0x61, 0xff, 0x2c, 0x05, 0xff, 0xc2,
+	0xd1, 0x5e, 0x2e, 0xc5, 0xe0, 0x13, 0xfc, 0x47, 0x38, 0x25, 0x00, 0xab, 0x9b, 0xae, 0x59, 0x0b,
+	0xa7, 0x54, 0x71, 0xca, 0x6d, 0xd8, 0x96, 0xbd, 0x8e, 0x73, 0x8f, 0x09, 0x73, 0xb6, 0x53, 0xa1,
+	0x52, 0x79, 0xb7, 0x1c, 0xf7, 0x06, 0x75, 0xa5, 0xf2, 0x5c, 0x5a, 0x6f, 0xf8, 0xd4, 0x95, 0xfb,
+	0xc7, 0xa9, 0x5b, 0x65, 0xe9, 0x8c, 0xe7, 0x9b, 0x37, 0x42, 0x55, 0x07, 0x44, 0x9e, 0xdb, 0x38,
+	0x3c, 0x9c, 0x52, 0x73, 0xcb, 0x74, 0x2b, 0x86, 0x4b, 0xeb, 0x8e, 0xeb, 0x23, 0xc5, 0x60, 0x32,
+	0x70, 0x61, 0xc8, 0xca, 0x8e, 0x85, 0xc1, 0xd2, 0xf6, 0x03, 0x79, 0x21, 0x70, 0xf3, 0x0a, 0xf3,
+	0x86, 0x4e, 0x5f, 0x6b, 0x50, 0xcf, 0xd7, 0x2e, 0xc3, 0xa3, 0xc2, 0xa8, 0x57, 0x77, 0x6c, 0x8f,
+	0x92, 0xd3, 0xd0, 0xc9, 0xbd, 0xd6, 0xa3, 0x0c, 0x2b, 0xe3, 0x3b, 0x66, 0xf7, 0x4f, 0x27, 0x17,
+	0xc9, 0x34, 0xa7, 0x5e, 0xe8, 0xfe, 0xf0, 0xd3, 0xa1, 0x2d, 0xdf, 0xfe, 0xeb, 0x0f, 0x27, 0x15,
+	0x1d, 0xc9, 0xb5, 0x1e, 0x38, 0xc8, 0xe4, 0xbd, 0xe8, 0xf8, 0x66, 0xf5, 0x6a, 0x60, 0x57, 0xa8,
+	0xc9, 0x82, 0xc7, 0x9a, 0x66, 0x50, 0xdb, 0x05, 0xe8, 0x34, 0x6b, 0x4e, 0xc3, 0xf6, 0x99, 0xb6,
+	0xee, 0x85, 0xe3, 0x81, 0xdc, 0x4f, 0x3e, 0x1d, 0x3a, 0xc0, 0x4d, 0xf2, 0x2a, 0x37, 0xa6, 0x2d,
+	0xa7, 0x54, 0x33, 0xfd, 0x8d, 0xe9, 0x65, 0xdb, 0xff, 0xfd, 0x4f, 0x8e, 0x01, 0x46, 0x7b, 0xd9,
+	0xf6, 0x51, 0x3d, 0xe7, 0x7f, 0x7c, 0xdb, 0x17, 0xdf, 0x18, 0x52, 0xb4, 0x6b, 0x30, 0xc4, 0x54,
+	0xe9, 0x3c, 0x1a, 0x4c, 0xd9, 0xb2, 0xfd, 0x62, 0xe0, 0x7f, 0x44, 0x43, 0x7a, 0x60, 0xbb, 0x59,
+	0xa9, 0xb8, 0xd4, 0xe3, 0x16, 0x76, 0xeb, 0xe1, 0x4f, 0xd2, 0x0b, 0x5d, 0x2c, 0x52, 0x86, 0x55,
+	0xe9, 0xe9, 0x18, 0x56, 0xc6, 0xb7, 0xe9, 0xdb, 0xd9, 0xef, 0xe5, 0x8a, 0xe6, 0xc2, 0x70, 0xb6,
+	0xdc, 0x87, 0x64, 0x8b, 0x01, 0x87, 0x98, 0xce, 0xe7, 0x1b, 0x55, 0xdf, 0xca, 0x31, 0xa8, 0x1f,
+	0xba, 0xd1, 0x02, 0x1a, 0x98, 0xb4, 0x75, 0xbc, 0x5b, 0x8f, 0x07, 0xf2, 0x8c, 0x32, 0x60, 0xac,
+	0x85, 0x02, 0xb4, 0xec, 0x14, 0x6c, 0xe7, 0xc8, 0xb8, 0xfc, 0x1d, 0xb3, 0xfd, 0xe2, 0xa2, 0x60,
+	0x4c, 0x2b, 0x55, 0xb3, 0x4c, 0x6b, 0xd4, 0xf6, 0xf5, 0x90, 0x58, 0x73, 0x60, 0x82, 0x29, 0x78,
+	0x86, 0x56, 0xe9, 0xba, 0xe9, 0xd3, 0xa4, 0xf0, 0x65, 0x1b, 0x35, 0x86, 0x66, 0x1c, 0x81, 0x3d,
+	0xb8, 0x87, 0x0c, 0x31, 0x3e, 0xbb, 0x71, 0x78, 0xbe, 0x75, 0x98, 0xfe, 0x13, 0x26, 0x8b, 0x28,
+	0x7c, 0x48, 0x01, 0xfb, 0xa6, 0x02, 0xd3, 0x4c, 0x3d, 0x53, 0x7b, 0xde, 0x75, 0x6a, 0x88, 0xc3,
+	0x71, 0xb3, 0x8c, 0x9e, 0x82, 0x7d, 0x95, 0x90, 0x26, 0x65, 0xf6, 0xde, 0x68, 0x22, 0x34, 0x5c,
+	0xe2, 0xa1, 0x8e, 0x96, 0x1e, 0xda, 0x2a, 0x7a, 0xe8, 0x0d, 0x05, 0x4a, 0x85, 0x31, 0x3e, 0x24,
+	0x3f, 0x39, 0x30, 0x96, 0x0f, 0xe1, 0xbe, 0xbc, 0x93, 0xb3, 0x2c, 0x6e, 0xc3, 0xe1, 0x56, 0x0a,
+	0x1f, 0x92, 0xa9, 0x5f, 0x53, 0x50, 0xb5, 0xb0, 0x22, 0x57, 0xa8, 0x5d, 0xb1, 0xec, 0x75, 0x9d,
+	0xe5, 0xf2, 0x7f, 0xd8, 0x52, 0x78, 0x4b, 0x81, 0x23, 0x2d, 0xb1, 0xa1, 0x5f, 0x5e, 0x81, 0xdd,
+	0x75, 0x3e, 0x61, 0xf0, 0x2f, 0x10, 0xfa, 0xe7, 0x34, 0xfa, 0xa7, 0xb4, 0x6e, 0xf9, 0x1b, 0x8d,
+	0xd5, 0xe9, 0xb2, 0x53, 0x2b, 0x99, 0xd5, 0xaa, 0xe3, 0x9a, 0xc7, 0x6c, 0xea, 0x07, 0x9f, 0xcb,
+	0xf0, 0x67, 0x79, 0xc3, 0xb4, 0x6c, 0xee, 0xb9, 0x67, 0x68, 0x59, 0xdf, 0x55, 0x4f, 0xea, 0xd1,
+	0x74, 0x18, 0x4d, 0x42, 0x71, 0x5c, 0x01, 0x86, 0x77, 0x3f, 0x3e, 0xd2, 0x5e, 0xef, 0x80, 0x43,
+	0xf9, 0x42, 0xa3, 0xa0, 0x77, 0xd7, 0x1d, 0xcf, 0x62, 0xe7, 0x14, 0x4c, 0x70, 0x87, 0xc4, 0x04,
+	0x27, 0x97, 0xb0, 0xb0, 0x2d, 0xb0, 0x5e, 0x8f, 0x99, 0x89, 0x05, 0xfb, 0xfd, 0xe0, 0x23, 0x67,
+	0xa4, 0x9c, 0xd5, 0xf1, 0x60, 0xce, 0x22, 0x4c, 0xa8, 0xa0, 0x9b, 0x8c, 0xc2, 0x2e, 0xb3, 0xe1,
+	0x3b, 0x46, 0xd9, 0xa9, 0xd5, 0x9d, 0x86, 0xcd, 0xa3, 0xdb, 0xa5, 0xef, 0x0c, 0x06, 0x17, 0x71,
+	0x4c, 0xbb, 0x00, 0x03, 0xc9, 0xcf, 0xd6, 0xa2, 0x53, 0x43, 0xab, 0xda, 0x4d, 0xba, 0xda, 0x3a,
+	0x0c, 0x66, 0x49, 0x42, 0x2f, 0x3e, 0x0b, 0x50, 0x8e, 0x46, 0xf1, 0xf0, 0x30, 0x24, 0xba, 0xb1,
+	0x89, 0x19, 0x3d, 0x98, 0x60, 0xd4, 0x5e, 0xc5, 0xa0, 0xe9, 0x14, 0x03, 0x1a, 0x30, 0x07, 0x7b,
+	0xf6, 0x21, 0x7c, 0x2e, 0xc2, 0x44, 0x94, 0xad, 0x0b, 0x6d, 0x3b, 0x0f, 0xbb, 0xdc, 0x24, 0x0d,
+	0xae, 0x12, 0x35, 0x6d, 0x5e, 0x4c, 0x82, 0x96, 0x89, 0x6c, 0xda, 0x12, 0xf4, 0x37, 0x1d, 0x23,
+	0x56, 0x1c, 0xa7, 0xda, 0x76, 0x38, 0xbe, 0xe8, 0x80, 0x81, 0x0c, 0x49, 0x9b, 0x9d, 0xc9, 0xc8,
+	0x25, 0xd8, 0x11, 0x2c, 0xcb, 0x32, 0x9a, 0xde, 0x21, 0xdb, 0x20, 0x49, 0x18, 0xf3, 0x11, 0x31,
+	0x3a, 0x21, 0xc9, 0x4e, 0x0c, 0xd8, 0x17, 0x6e, 0x8e, 0x0a, 0x2d, 0xbb, 0xd4, 0x0c, 0x4e, 0x2d,
+	0x5b, 0x99, 0xcc, 0xa3, 0x45, 0x64, 0x3e, 0x83, 0x4c, 0x28, 0x7b, 0x2f, 0x0a, 0x0b, 0x87, 0x3d,
+	0x72, 0x19, 0xf6, 0xc4, 0xbb, 0xaf, 0xe6, 0xdc, 0x34, 0xab, 0x3d, 0xdb, 0xd8, 0x62, 0x1c, 0xcb,
+	0x16, 0xcf, 0x3d, 0xc7, 0x88, 0xf5, 0xdd, 0x51, 0x66, 0x62, 0xbf, 0xb5, 0xb9, 0xe8, 0x5c, 0x5b,
+	0xb7, 0xca, 0xc9, 0x73, 0xad, 0xb0, 0xb2, 0x14, 0x71, 0x65, 0xc5, 0x47, 0xde, 0x98, 0xe9, 0x21,
+	0x7d, 0x62, 0x2a, 0xb8, 0x61, 0x2e, 0xf3, 0x14, 0x72, 0xc9, 0xf1, 0xbc, 0x85, 0x86, 0x5d, 0xa9,
+	0xd2, 0x79, 0x7f, 0xa1, 0xea, 0x94, 0x6f, 0xb4, 0x46, 0x4b, 0x46, 0x60, 0xe7, 0x6a, 0x40, 0x6a,
+	0x6c, 0x50, 0x6b, 0x7d, 0xc3, 0x67, 0xdb, 0x64, 0xab, 0xbe, 0x83, 0x8d, 0x5d, 0x60, 0x43, 0x5a,
+	0x19, 0xc6, 0x5a, 0x68, 0x41, 0xf3, 0x1e, 0x87, 0x1d, 0x55, 0xc7, 0xf3, 0x8c, 0x55, 0x36, 0x8b,
+	0x79, 0xa0, 0x57, 0x74, 0xfd, 0x35, 0xb3, 0xda, 0xa0, 0x9c, 0x5d, 0x87, 0x6a, 0x24, 0x4a, 0xeb,
+	0x45, 0xaf, 0x5d, 0xa6, 0xb7, 0x7d, 0x7e, 0x16, 0x09, 0xbf, 0x8e, 0xda, 0x39, 0xe8, 0x69, 0x9e,
+	0x42, 0x95, 0x1a, 0xec, 0xb2, 0xe9, 0x6d, 0xdf, 0x48, 0x99, 0xb7, 0xc3, 0x8e, 0x69, 0xb5, 0x69,
+	0xd8, 0x17, 0x07, 0xa4, 0x40, 0x00, 0xdf, 0x54, 0x80, 0x24, 0x19, 0x50, 0xd5, 0x04, 0x3c, 0xc2,
+	0x28, 0xd0, 0xae, 0x47, 0x45, 0xbb, 0x38, 0x2d, 0xa7, 0x20, 0x07, 0xa1, 0xf3, 0x56, 0xec, 0xce,
+	0x6e, 0x1d, 0x7f, 0x05, 0xdf, 0x30, 0xba, 0xb6, 0x46, 0xcb, 0xbe, 0x75, 0x93, 0x1a, 0x2e, 0xbd,
+	0x49, 0xed, 0x06, 0x65, 0xc9, 0xbb, 0x5b, 0xdf, 0x1b, 0x4d, 0xe8, 0x7c, 0x5c, 0x3b, 0x91, 0x5c,
+	0x47, 0xc2, 0xfd, 0x2d, 0x0f, 0xfc, 0xbb, 0x0a, 0xf4, 0x34, 0xb3, 0xa1, 0x09, 0x0b, 0xb0, 0x93,
+	0xf3, 0x09, 0xd7, 0xbc, 0x5e, 0x89, 0x25, 0x78, 0xd7, 0xc3, 0x4d, 0xec, 0xc7, 0x43, 0xe4, 0x39,
+	0x88, 0xa1, 0x86, 0x72, 0x3a, 0x8a, 0x5d, 0x17, 0xf7, 0x44, 0x8c, 0x7c, 0x4e, 0x5b, 0x47, 0xac,
+	0xf3, 0x6c, 0x90, 0x29, 0x8e, 0x6c, 0xbc, 0x08, 0x10, 0x97, 0x1e, 0x10, 0xe9, 0x54, 0xea, 0xee,
+	0x61, 0xd5, 0xea, 0x55, 0xba, 0xd8, 0x70, 0x3d, 0xc7, 0x5d, 0x89, 0x68, 0x51, 0x80, 0x9e, 0x60,
+	0xd7, 0xde, 0x51, 0xa0, 0x57, 0xa2, 0x09, 0xdd, 0x32, 0x05, 0x9d, 0xcc, 0xc2, 0x30, 0xb7, 0x4b,
+	0x43, 0x8b, 0x24, 0xe4, 0x92, 0x80, 0x8b, 0x5b, 0x7e, 0xb4, 0x18, 0x2e, 0xae, 0x4e, 0x00, 0xf6,
+	0xef, 0x98, 0xcb, 0x97, 0xed, 0x35, 0xea, 0x52, 0xbb, 0x4c, 0xbd, 0x4d, 0xdd, 0xba, 0x2f, 0xc1,
+	0x60, 0x96, 0x78, 0xb4, 0xfd, 0x0c, 0x80, 0x15, 0x4d, 0xa2, 0x9b, 0x7b, 0x44, 0x73, 0x62, 0x66,
+	0x3d, 0x41, 0xab, 0xbd, 0x8c, 0x1f, 0xb4, 0xf3, 0x8e, 0x4b, 0xcb, 0xa6, 0xe7, 0x6f, 0x2e, 0xf2,
+	0x6b, 0x30, 0x90, 0x21, 0x1d, 0x81, 0x9f, 0x84, 0xee, 0xb5, 0x70, 0x0e, 0x71, 0x3f, 0x26, 0xe2,
+	0x8e, 0x58, 0xf5, 0x98, 0x52, 0xfb, 0x1f, 0x05, 0x61, 0x5f, 0x67, 0xf5, 0x1c, 0x76, 0x1e, 0xf1,
+	0xd9, 0xd5, 0x75, 0x33, 0x60, 0x93, 0x31, 0xd8, 0xcd, 0x0b, 0x45, 0xd1, 0x47, 0x9c, 0x6f, 0xef,
+	0x5d, 0x7c, 0x34, 0xfc, 0x86, 0x3f, 0x05, 0x03, 0x19, 0x20, 0xd0, 0xba, 0x41, 0x3c, 0x51, 0xb1,
+	0x51, 0x86, 0x63, 0xa7, 0x9e, 0x18, 0xd1, 0x28, 0x8c, 0x24, 0x04, 0x5c, 0x32, 0x7d, 0xea, 0xf9,
+	0x51, 0x9c, 0x0a, 0x98, 0xd2, 0x8c, 0xb3, 0x43, 0x86, 0x73, 0x03, 0xb4, 0x3c, 0x35, 0x51, 0x5a,
+	0xd9, 0x5b, 0x65, 0x53, 0x46, 0xb4, 0x3c, 0xe4, 0x11, 0x89, 0x59, 0xf7, 0x54, 0x45, 0x59, 0xda,
+	0x13, 0xa0, 0x26, 0x34, 0x5d, 0x76, 0x2a, 0x74, 0xd9, 0x5e, 0x73, 0x42, 0x4b, 0x06, 0x00, 0xaa,
+	0xd6, 0x6a, 0x7d, 0xb6, 0x6e, 0xdc, 0xa0, 0x77, 0xf0, 0x5c, 0xd4, 0xcd, 0x47, 0x2e, 0xd2, 0x3b,
+	0xda, 0x35, 0xe8, 0x93, 0x32, 0x47, 0x75, 0xad, 0x6e, 0xdb, 0xa9, 0xd0, 0x00, 0x9d, 0x83, 0xc0,
+	0x52, 0xc7, 0xb7, 0x2b, 0x6b, 0x6b, 0xec, 0xdc, 0x1d, 0xb0, 0xea, 0x5d, 0x36, 0x0a, 0xd0, 0x9e,
+	0x44, 0xb9, 0x78, 0x5e, 0x68, 0x13, 0xd5, 0x75, 0xe8, 0x97, 0x73, 0x3f, 0x28, 0xac, 0x05, 0x21,
+	0xf8, 0x18, 0xab, 0x85, 0x3b, 0x2b, 0xb3, 0x2b, 0x17, 0xe9, 0x9d, 0x82, 0xe0, 0xce, 0x81, 0x96,
+	0x27, 0x03, 0x21, 0x66, 0x16, 0xcc, 0xb4, 0x45, 0xd0, 0x92, 0xc6, 0xdd, 0x1f, 0x88, 0xa7, 0x60,
+	0x34, 0x57, 0x48, 0x4b, 0x14, 0x1f, 0x28, 0xe2, 0x09, 0xe8, 0x7e, 0xd2, 0xe8, 0x59, 0xe8, 0x4b,
+	0xee, 0x6a, 0xa3, 0x6a, 0x0a, 0x0b, 0x99, 0x6f, 0xf2, 0x9e, 0xc4, 0x26, 0xbf, 0x64, 0x26, 0x16,
+	0x2e, 0x39, 0x0d, 0x3d, 0xcd, 0xec, 0x78, 0xf7, 0xdb, 0xca, 0x78, 0x0f, 0xa4, 0x78, 0xf1, 0xde,
+	0xfb, 0x9a, 0x78, 0xac, 0xca, 0x4e, 0xd1, 0x17, 0x80, 0xe0, 0x1d, 0xd1, 0x68, 0x4a, 0xd5, 0x39,
+	0xa7, 0xab, 0x7d, 0x76, 0x5a, 0xb0, 0xf6, 0x0a, 0xde, 0xfa, 0x97, 0x3d, 0x1e, 0x76, 0x9d, 0xae,
+	0x5b, 0x9e, 0x4f, 0x5d, 0x5a, 0x59, 0xb6, 0xc5, 0x43, 0x57, 0x9e, 0xc3, 0x12, 0xe1, 0xe8, 0x10,
+	0xc3, 0x71, 0x05, 0xc6, 0x5b, 0xcb, 0x47, 0xab, 0x46, 0x61, 0x97, 0xe5, 0x19, 0x6e, 0x44, 0xc1,
+	0xb4, 0x74, 0xe9, 0x3b, 0x2d, 0x2f, 0xe6, 0xd2, 0x8c, 0x48, 0x60, 0x74, 0x2d, 0xdb, 0x5c, 0xc4,
+	0x2b, 0x30, 0x51, 0x40, 0x41, 0x3b, 0x90, 0x5f, 0x10, 0x12, 0xd9, 0x25, 0xeb, 0x26, 0xb5, 0xa9,
+	0xe7, 0x3d, 0x10, 0xc8, 0x5f, 0x28, 0xd0, 0x27, 0x95, 0x19, 0x25, 0x92, 0xae, 0x2a, 0x8e, 0xe1,
+	0xb2, 0xe8, 0x13, 0x97, 0xc5, 0x7c, 0xd9, 0x77, 0x62, 0xb6, 0x88, 0x98, 0x5c, 0x81, 0xce, 0x46,
+	0xdd, 0xb7, 0x6a, 0xf4, 0x41, 0xab, 0x14, 0x28, 0x86, 0xf4, 0x41, 0xb7, 0xe5, 0x19, 0xaf, 0x9a,
+	0x56, 0x95, 0x86, 0x55, 0x89, 0x2e, 0xcb, 0x7b, 0x8e, 0xfd, 0xd6, 0x74, 0x31, 0x9b, 0x6e, 0x8a,
+	0x6b, 0x7e, 0xa9, 0x40, 0xbf, 0x5c, 0xe8, 0x3f, 0x97, 0x6f, 0xce, 0xa0, 0x19, 0xcb, 0xde, 0xf5,
+	0x0d, 0xcb, 0xa7, 0x55, 0xcb, 0xf3, 0xe7, 0x2b, 0x35, 0xcb, 0x6e, 0xd9, 0xb9, 0xd0, 0x1e, 0x87,
+	0x81, 0x0c, 0x4e, 0xf4, 0x40, 0x2f, 0x74, 0x59, 0x9e, 0x61, 0x06, 0x63, 0xb8, 0x60, 0xb7, 0x5b,
+	0x1e, 0x23, 0xd1, 0xae, 0xe1, 0x5a, 0x65, 0x0b, 0x3d, 0xb8, 0x67, 0xdf, 0x0a, 0xd8, 0x0b, 0x04,
+	0x64, 0x00, 0x80, 0xed, 0x04, 0xe6, 0x73, 0xe6, 0xa0, 0x2e, 0xbd, 0xdb, 0x0a, 0xf7, 0x90, 0xf6,
+	0x04, 0xf4, 0x49, 0xe5, 0x22, 0xa2, 0xdc, 0xae, 0x85, 0x36, 0x0a, 0x23, 0x31, 0xf3, 0xa2, 0x4b,
+	0x59, 0x5d, 0x2f, 0x85, 0x4d, 0x5b, 0x00, 0x2d, 0x8f, 0xa8, 0x90, 0xa2, 0x43, 0x28, 0xe3, 0x6a,
+	0x79, 0x83, 0x56, 0x1a, 0x55, 0x5a, 0xe1, 0xb7, 0x92, 0x7f, 0xa9, 0x57, 0x4c, 0x9f, 0x46, 0xbd,
+	0xb2, 0x0a, 0x8c, 0xe6, 0x52, 0xa1, 0xaa, 0xb3, 0xb0, 0xbd, 0xc1, 0x87, 0xf0, 0x12, 0x31, 0x9a,
+	0xba, 0x13, 0xc8, 0xd8, 0xf5, 0x90, 0x27, 0xba, 0x07, 0xe0, 0x95, 0x93, 0x17, 0x60, 0xeb, 0x8e,
+	0xbb, 0x39, 0xc7, 0x52, 0xed, 0xdf, 0x60, 0x30, 0x4b, 0x7c, 0xdc, 0xfb, 0xe3, 0x8d, 0x45, 0x79,
+	0xf9, 0xae, 0x99, 0x11, 0xc9, 0xb5, 0xff, 0xc0, 0xf6, 0xd8, 0x55, 0xab, 0xd6, 0x08, 0x0e, 0x75,
+	0x09, 0x4a, 0x6f, 0x73, 0xc0, 0xbf, 0x0c, 0x23, 0x39, 0x1a, 0x1e, 0x14, 0x7f, 0xb8, 0xf3, 0x16,
+	0xab, 0xa6, 0x55, 0x33, 0x57, 0xab, 0x34, 0x85, 0x3d, 0x7b, 0xe7, 0xbd, 0xaf, 0xc0, 0x40, 0x06,
+	0x2b, 0x82, 0x7a, 0x15, 0xb6, 0xf3, 0x93, 0x40, 0xb8, 0x28, 0x7a, 0xa7, 0xb1, 0xa0, 0x13, 0xf4,
+	0x6b, 0xa7, 0xb1, 0x5f, 0x3b, 0xbd, 0xe8, 0x58, 0xf6, 0xc2, 0xc9, 0x20, 0xbf, 0xbc, 0xfb, 0xc7,
+	0xa1, 0xf1, 0x44, 0x7e, 0xe1, 0xc4, 0xf8, 0xbf, 0x63, 0x5e, 0xe5, 0x06, 0xb6, 0xdb, 0x03, 0x06,
+	0x8f, 0xdf, 0xa9, 0x43, 0x05, 0x64, 0x02, 0xf6, 0xde, 0xb2, 0xfc, 0x8d, 0x8a, 0x6b, 0xde, 0x4a,
+	0x9d, 0xe9, 0xf7, 0x84, 0xe3, 0xe1, 0xa9, 0xbe, 0x1f, 0xb7, 0xfd, 0x55, 0x3f, 0x58, 0x83, 0xd4,
+	0xa7, 0x76, 0xe2, 0xde, 0xac, 0xfd, 0x26, 0xfc, 0xda, 0xa4, 0xa7, 0xd1, 0xa8, 0x19, 0x38, 0x50,
+	0x33, 0x6f, 0x1b, 0x2e, 0x2d, 0x3b, 0x6e, 0xc5, 0x33, 0xea, 0xd4, 0x35, 0x58, 0xb4, 0x30, 0xb2,
+	0xa4, 0x66, 0xde, 0xd6, 0xf9, 0xdc, 0x0a, 0x75, 0xd9, 0x49, 0x86, 0xcc, 0x41, 0x67, 0x99, 0xdd,
+	0x86, 0x7b, 0x3a, 0x64, 0x29, 0x78, 0x85, 0xf7, 0xe3, 0xf9, 0x85, 0x59, 0x47, 0x52, 0xb2, 0x08,
+	0x3b, 0xca, 0x4e, 0xb5, 0x4a, 0xcb, 0xbc, 0xf6, 0xc8, 0xeb, 0x84, 0x23, 0x22, 0xe7, 0x62, 0x44,
+	0x10, 0xe3, 0x4c, 0x72, 0xcd, 0x7e, 0xe5, 0x0c, 0x3c, 0xc2, 0x8c, 0x21, 0x37, 0xa0, 0x13, 0x2b,
+	0x18, 0xc3, 0xa2, 0x8c, 0xe6, 0xfe, 0xb8, 0x3a, 0x92, 0x43, 0xc1, 0xbd, 0xa0, 0xf5, 0xff, 0xf7,
+	0xc7, 0x7f, 0x7e, 0xbb, 0xe3, 0x20, 0xd9, 0x5f, 0x92, 0xbc, 0x3a, 0x20, 0x6f, 0x29, 0xb0, 0x7b,
+	0x89, 0xfa, 0x89, 0x8a, 0x15, 0x19, 0x93, 0xc8, 0x6c, 0x2e, 0x76, 0xa9, 0x87, 0x5b, 0x91, 0xa1,
+	0xfe, 0xf1, 0xff, 0x0d, 0xc2, 0xcf, 0x40, 0x0c, 0x90, 0x3e, 0x11, 0x84, 0x50, 0x11, 0x23, 0x77,
+	0xa1, 0x6b, 0x89, 0x72, 0x7e, 0x32, 0x24, 0x91, 0x9e, 0x2c, 0x8b, 0xa9, 0xc3, 0xd9, 0x04, 0xa8,
+	0xf8, 0x68, 0xac, 0x78, 0x84, 0x0c, 0x95, 0x9a, 0x1f, 0x3b, 0x78, 0xa5, 0xbb, 0xa1, 0xee, 0x7b,
+	0xe4, 0x6d, 0xee, 0x88, 0x44, 0x4d, 0x49, 0xea, 0x88, 0xe6, 0x1a, 0x97, 0x7a, 0xb8, 0x15, 0x19,
+	0xe2, 0x99, 0x8d, 0xf1, 0x1c, 0x21, 0x63, 0x12, 0x3c, 0x58, 0xa4, 0x4a, 0xa2, 0xfa, 0x3f, 0x05,
+	0xf6, 0x2c, 0x51, 0x3f, 0x59, 0x0c, 0x22, 0x32, 0x7d, 0x92, 0xba, 0x94, 0x7a, 0xa4, 0x25, 0x5d,
+	0x81, 0x08, 0x99, 0x8c, 0xc1, 0xc0, 0x92, 0xd2, 0xa7, 0x0a, 0x8c, 0x2c, 0x51, 0x5f, 0x7a, 0xc9,
+	0x5e, 0xb8, 0x13, 0x2e, 0xa0, 0x92, 0x44, 0x71, 0xde, 0xf5, 0x5f, 0x3d, 0x5e, 0x9c, 0x01, 0x21,
+	0x5f, 0x8b, 0x21, 0x5f, 0x24, 0xcb, 0x2d, 0x62, 0x8b, 0x2f, 0x62, 0xbc, 0xd2, 0x5d, 0xb1, 0x92,
+	0x70, 0xaf, 0x94, 0x2e, 0x06, 0x90, 0x1f, 0x2b, 0xb0, 0x7f, 0x89, 0xfa, 0x4d, 0x57, 0x1c, 0x32,
+	0x25, 0x81, 0x98, 0x75, 0x87, 0x53, 0x8f, 0x16, 0x23, 0x46, 0x5b, 0x9e, 0x8a, 0x6d, 0x39, 0x41,
+	0x66, 0x45, 0x5b, 0x22, 0x64, 0x82, 0x3d, 0x77, 0x93, 0x1f, 0xa2, 0x7b, 0xe4, 0xfb, 0x0a, 0x3c,
+	0xba, 0x44, 0xfd, 0x74, 0x01, 0x8a, 0x4c, 0x4a, 0x60, 0x64, 0xd4, 0xc0, 0xd4, 0xa9, 0x42, 0xb4,
+	0x88, 0xf8, 0x5c, 0x8c, 0x78, 0x8e, 0xcc, 0x88, 0x88, 0xa3, 0x02, 0x56, 0x0e, 0xe0, 0x5f, 0x71,
+	0xc0, 0xe9, 0x9a, 0x92, 0x14, 0x70, 0x46, 0xf5, 0x4b, 0x9d, 0x2a, 0x44, 0x8b, 0x80, 0xaf, 0xc7,
+	0x80, 0x2f, 0x91, 0xe7, 0x4a, 0x92, 0xe7, 0x52, 0x46, 0x5c, 0xb2, 0xca, 0x06, 0xde, 0xb4, 0x80,
+	0xc8, 0xaf, 0x15, 0xe8, 0x63, 0xe9, 0x53, 0xde, 0x70, 0x20, 0xb3, 0xd2, 0x24, 0x99, 0xdb, 0x03,
+	0x51, 0xe7, 0xda, 0xe2, 0x41, 0x0b, 0xe7, 0x63, 0x0b, 0x4f, 0x91, 0x13, 0xe9, 0x2c, 0xcb, 0xef,
+	0xe4, 0x55, 0xc7, 0xcb, 0x8b, 0xca, 0x9b, 0x0a, 0xec, 0x62, 0x19, 0x30, 0x7c, 0x00, 0x45, 0x0e,
+	0x49, 0x33, 0x5b, 0xea, 0xe5, 0x94, 0x3a, 0xd6, 0x82, 0x0a, 0x11, 0x1e, 0x8e, 0x11, 0xf6, 0x91,
+	0xde, 0xf4, 0x96, 0x0d, 0xfa, 0xd1, 0xec, 0x9d, 0x19, 0x79, 0x5f, 0x81, 0x83, 0x4b, 0xd4, 0x97,
+	0x3c, 0xf5, 0x21, 0xc7, 0x24, 0x9a, 0xb2, 0xdf, 0x1c, 0xa9, 0xd3, 0x45, 0xc9, 0x11, 0xe1, 0xd9,
+	0x18, 0xe1, 0x2c, 0x39, 0x5e, 0x92, 0xbd, 0x9c, 0xe3, 0x18, 0x4b, 0x77, 0xa3, 0x0c, 0x92, 0xc8,
+	0xd5, 0x3f, 0xe3, 0x6b, 0x21, 0xeb, 0xa1, 0x92, 0x74, 0x2d, 0xb4, 0x78, 0x36, 0xa5, 0xce, 0xb5,
+	0xc5, 0x83, 0x76, 0x9c, 0x8c, 0xed, 0x98, 0x24, 0xe3, 0x52, 0x3b, 0x3c, 0x6e, 0x88, 0xf0, 0xad,
+	0xf9, 0x8b, 0x02, 0xc3, 0x4b, 0xd4, 0xcf, 0x7d, 0x96, 0x44, 0x4e, 0x4b, 0x00, 0x15, 0x79, 0x39,
+	0xa5, 0x9e, 0x69, 0x9f, 0x11, 0xcd, 0xb9, 0x1c, 0x9b, 0xb3, 0x48, 0xe6, 0xe5, 0x61, 0xc1, 0xfe,
+	0x35, 0x0d, 0xe3, 0x93, 0x6a, 0x50, 0x0b, 0x71, 0x7a, 0xbd, 0x03, 0xc6, 0x96, 0xa8, 0xdf, 0xfa,
+	0x6d, 0x11, 0x79, 0x52, 0x82, 0xb9, 0xf0, 0xb3, 0x29, 0xf5, 0xec, 0x7d, 0x72, 0xa3, 0xd9, 0x2f,
+	0xc5, 0x66, 0x5f, 0x21, 0xcf, 0x8b, 0x66, 0xa7, 0xcd, 0x6d, 0x7a, 0x6f, 0x72, 0x2f, 0xdf, 0x05,
+	0x1f, 0x2b, 0xd0, 0x9f, 0xe7, 0x02, 0x32, 0xd7, 0x0e, 0xf6, 0xd0, 0xe0, 0x13, 0xed, 0x31, 0xa1,
+	0x9d, 0x17, 0x62, 0x3b, 0xcf, 0x92, 0x27, 0xda, 0xb7, 0x33, 0xb6, 0xea, 0x6f, 0x0a, 0x0c, 0xa4,
+	0x17, 0xb0, 0xf8, 0x1e, 0xe5, 0x44, 0xab, 0x45, 0x28, 0x7b, 0xf4, 0xa4, 0x9e, 0x6c, 0x93, 0x0b,
+	0x0d, 0x2b, 0xc7, 0x86, 0xfd, 0x2b, 0xb9, 0x96, 0x61, 0x98, 0xf8, 0x06, 0xa7, 0xfd, 0x48, 0xfe,
+	0x56, 0x01, 0x35, 0xb6, 0x39, 0xfd, 0x7a, 0x88, 0xcc, 0x64, 0x43, 0xcf, 0x78, 0xbe, 0xa4, 0xce,
+	0xb6, 0xc3, 0x52, 0x3c, 0x86, 0x8e, 0x9b, 0xb2, 0xd5, 0x93, 0x19, 0x1b, 0x1e, 0xc0, 0x9a, 0x1e,
+	0xe1, 0x48, 0x0f, 0x60, 0x59, 0x2f, 0x86, 0xd4, 0xa3, 0xc5, 0x88, 0x0b, 0x1c, 0xc0, 0x42, 0xdf,
+	0xc7, 0x8f, 0x7f, 0x9a, 0xe3, 0x41, 0x3e, 0xe1, 0x99, 0x3f, 0xeb, 0x85, 0x8e, 0x34, 0xf3, 0xb7,
+	0x78, 0x3a, 0xa4, 0xce, 0xb5, 0xc5, 0x83, 0x96, 0xe8, 0xb1, 0x25, 0x4b, 0xe4, 0xd9, 0xb4, 0x25,
+	0x09, 0x66, 0x63, 0xcd, 0x75, 0x6a, 0x61, 0x59, 0x2c, 0x7f, 0x85, 0xfd, 0x80, 0x1f, 0xd6, 0xd2,
+	0x2f, 0x51, 0xa4, 0x87, 0xb5, 0x8c, 0x27, 0x43, 0xea, 0x54, 0x21, 0xda, 0x36, 0xc2, 0xc1, 0xf2,
+	0x81, 0x51, 0x77, 0x9c, 0xaa, 0x24, 0x1c, 0x3f, 0xe5, 0x6b, 0xa8, 0xa9, 0x92, 0x22, 0x5d, 0x43,
+	0x59, 0x75, 0x2c, 0xf5, 0x68, 0x31, 0xe2, 0x02, 0x3b, 0x80, 0xfb, 0x52, 0x78, 0x14, 0x9f, 0x73,
+	0x0c, 0xfb, 0xb9, 0x02, 0xfb, 0x65, 0x05, 0x24, 0x22, 0x3b, 0xce, 0xe4, 0xd4, 0xb2, 0xd4, 0x52,
+	0x61, 0x7a, 0xb4, 0xe1, 0x3c, 0x83, 0xff, 0x34, 0x39, 0x27, 0xc2, 0xf7, 0x90, 0xc7, 0x48, 0xda,
+	0x91, 0x77, 0x90, 0xfc, 0x16, 0x5f, 0x31, 0xe9, 0x62, 0x93, 0x74, 0xc5, 0x64, 0x14, 0xb3, 0xd4,
+	0xa9, 0x42, 0xb4, 0x08, 0xfc, 0x54, 0xec, 0xfc, 0x29, 0x32, 0x21, 0xa2, 0x2f, 0x87, 0x4c, 0x31,
+	0xf0, 0x68, 0xa1, 0xbc, 0x15, 0x9e, 0x78, 0xc3, 0xf7, 0x4f, 0x19, 0x27, 0xde, 0xd4, 0x9b, 0x2a,
+	0x75, 0xac, 0x05, 0x15, 0xc2, 0x9a, 0x8a, 0x61, 0x0d, 0x93, 0xc1, 0x52, 0xf3, 0xdf, 0x54, 0x24,
+	0xb7, 0xd9, 0x57, 0x15, 0xd8, 0x17, 0xdd, 0x89, 0xc2, 0x0e, 0x2c, 0x19, 0xcf, 0xbc, 0xe5, 0xa4,
+	0x5a, 0xbc, 0xea, 0x44, 0x01, 0x4a, 0xc4, 0x35, 0x1d, 0xe3, 0x1a, 0x25, 0x23, 0xb2, 0xdb, 0x50,
+	0xe9, 0x6e, 0xdc, 0x0c, 0xbd, 0x47, 0xbe, 0xae, 0x00, 0x89, 0x33, 0x40, 0x84, 0x6d, 0x22, 0x7b,
+	0x53, 0xa7, 0xc1, 0x4d, 0x16, 0x21, 0x45, 0x74, 0xa5, 0x18, 0xdd, 0x21, 0xa2, 0x49, 0xb7, 0xbf,
+	0x08, 0xef, 0x3d, 0x05, 0x7a, 0x22, 0xcf, 0xa5, 0x3a, 0xb3, 0x39, 0xb5, 0x08, 0x79, 0x23, 0x58,
+	0x3d, 0x5e, 0x9c, 0xa1, 0xc0, 0xea, 0x13, 0xef, 0x8b, 0x22, 0xee, 0x0f, 0x14, 0xe8, 0x8d, 0xdd,
+	0x9a, 0x06, 0x7e, 0x3c, 0xdb, 0x65, 0x19, 0xc8, 0x67, 0xda, 0xe0, 0x40, 0xe8, 0xa7, 0x63, 0xe8,
+	0x47, 0xc9, 0xa4, 0x3c, 0xd5, 0x4a, 0xb1, 0xff, 0x4e, 0x81, 0xbe, 0x9c, 0xde, 0x29, 0x91, 0x1d,
+	0x99, 0x5a, 0xf7, 0x72, 0xd5, 0x53, 0xed, 0xb2, 0x15, 0xb8, 0xfd, 0x62, 0x08, 0xe2, 0x66, 0xa8,
+	0x90, 0xb9, 0xa2, 0x5c, 0x10, 0x1c, 0x89, 0xf3, 0x7a, 0xab, 0x44, 0x8e, 0xad, 0x65, 0xb7, 0x57,
+	0x3d, 0xdd, 0x36, 0x1f, 0x1a, 0xb5, 0x10, 0x1b, 0x75, 0x9a, 0x9c, 0x94, 0x07, 0xa7, 0x95, 0x55,
+	0xdf, 0x49, 0x66, 0x95, 0xb0, 0x77, 0x98, 0x93, 0x55, 0x52, 0xad, 0x4e, 0x75, 0xa2, 0x00, 0x65,
+	0x81, 0xcf, 0x36, 0xc6, 0x20, 0x6c, 0x59, 0xca, 0xb1, 0x7e, 0x4f, 0x48, 0x33, 0x11, 0xd8, 0x9c,
+	0x34, 0x93, 0x46, 0x3b, 0x59, 0x84, 0x14, 0xe1, 0x3e, 0x1d, 0xc3, 0x3d, 0x49, 0xe6, 0xe4, 0xde,
+	0xcd, 0xc7, 0xfb, 0x4e, 0x47, 0xb2, 0xf6, 0xd3, 0x5c, 0x32, 0xcc, 0xa9, 0xfd, 0x64, 0x56, 0x0e,
+	0xe7, 0xda, 0xe2, 0x41, 0x53, 0xfe, 0x5f, 0x89, 0x6d, 0x79, 0x43, 0x21, 0xff, 0x25, 0xaf, 0xfe,
+	0xc8, 0x4b, 0x89, 0xe2, 0x1b, 0x92, 0xd2, 0xdd, 0xe6, 0x17, 0x22, 0xd1, 0x24, 0x12, 0x87, 0x37,
+	0x95, 0xac, 0xb7, 0x24, 0xec, 0x7b, 0xb1, 0x37, 0xdd, 0xe4, 0x95, 0x7e, 0xfc, 0x33, 0x7a, 0xc8,
+	0xea, 0x54, 0x21, 0xda, 0xf0, 0x7b, 0xc1, 0xec, 0x9e, 0x20, 0x47, 0x52, 0x4b, 0x2e, 0xa4, 0xe6,
+	0x0d, 0xe5, 0x44, 0xdc, 0xbe, 0xcb, 0xf7, 0x84, 0xd8, 0xf2, 0x95, 0xee, 0x09, 0x69, 0xb7, 0x59,
+	0x9d, 0x28, 0x40, 0x59, 0x60, 0x0b, 0xf3, 0x18, 0x98, 0x21, 0x8f, 0xb0, 0xc6, 0xe2, 0x96, 0xf5,
+	0x3d, 0xf2, 0x23, 0xfe, 0x79, 0x93, 0xf6, 0x8f, 0xa5, 0x9f, 0xb7, 0xbc, 0x76, 0xb4, 0x7a, 0xbc,
+	0x38, 0x43, 0xe1, 0xb6, 0x45, 0x99, 0xb3, 0xc6, 0xb6, 0x90, 0xf7, 0xf8, 0xa7, 0x4d, 0xde, 0x89,
+	0x96, 0x7e, 0xda, 0x72, 0x5b, 0xdb, 0xea, 0x4c, 0x1b, 0x1c, 0x08, 0x7b, 0x2e, 0x86, 0x3d, 0x4e,
+	0x0e, 0x8b, 0xb0, 0xbd, 0x90, 0x15, 0x3b, 0x2e, 0x06, 0x36, 0xb7, 0xc9, 0x97, 0xf8, 0xd2, 0x10,
+	0xfb, 0x89, 0xd2, 0xa5, 0x21, 0xed, 0x48, 0xaa, 0x13, 0x05, 0x28, 0x11, 0xdf, 0x18, 0x83, 0x36,
+	0x44, 0x06, 0x9a, 0xce, 0x85, 0x7e, 0x70, 0x54, 0x45, 0xf2, 0x05, 0xfd, 0xc3, 0xcf, 0x06, 0x95,
+	0x8f, 0x3e, 0x1b, 0x54, 0xfe, 0xf4, 0xd9, 0xa0, 0xf2, 0xe5, 0xcf, 0x07, 0xb7, 0x7c, 0xf4, 0xf9,
+	0xe0, 0x96, 0x3f, 0x7c, 0x3e, 0xb8, 0xe5, 0xa5, 0x33, 0x05, 0x9f, 0x77, 0xdc, 0x4e, 0x28, 0x60,
+	0x4d, 0xd9, 0xd5, 0x4e, 0xf6, 0x17, 0xb7, 0x73, 0x7f, 0x1f, 0x00, 0x94, 0xd4, 0x62, 0x75, 0x74,
+	0x3d, 0x00, 0x00,
 }
 
-func (m *QueryTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EffectiveRevenue) > 0 {
-		i -= len(m.EffectiveRevenue)
-		copy(dAtA[i:], m.EffectiveRevenue)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EffectiveRevenue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
+func (this *QueryTotalStakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+
+	that1, ok := that.(*QueryTotalStakeResponse)
+	if !ok {
+		that2, ok := that.(QueryTotalStakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryActiveTopicsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
 }
+func (this *QueryReputerStakeInTopicResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryActiveTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	that1, ok := that.(*QueryReputerStakeInTopicResponse)
+	if !ok {
+		that2, ok := that.(QueryReputerStakeInTopicResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryActiveTopicsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
 }
+func (this *QueryDelegateStakeInTopicInReputerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryActiveTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	that1, ok := that.(*QueryDelegateStakeInTopicInReputerResponse)
+	if !ok {
+		that2, ok := that.(QueryDelegateStakeInTopicInReputerResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *QueryStakeFromDelegatorInTopicInReputerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*QueryStakeFromDelegatorInTopicInReputerResponse)
+	if !ok {
+		that2, ok := that.(QueryStakeFromDelegatorInTopicInReputerResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
 }
+func (this *QueryStakeFromDelegatorInTopicResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*QueryStakeFromDelegatorInTopicResponse)
+	if !ok {
+		that2, ok := that.(QueryStakeFromDelegatorInTopicResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
 }
+func (this *QueryTopicStakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryTopicStakeResponse)
+	if !ok {
+		that2, ok := that.(QueryTopicStakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
 }
 
-func (m *QueryInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error)
+	GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error)
+	GetTopicParams(ctx context.Context, in *QueryTopicParamsRequest, opts ...grpc.CallOption) (*QueryTopicParamsResponse, error)
+	GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error)
+	GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error)
+	GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error)
+	GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error)
+	GetWorkerCommitment(ctx context.Context, in *QueryWorkerCommitmentRequest, opts ...grpc.CallOption) (*QueryWorkerCommitmentResponse, error)
+	GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error)
+	GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error)
+	GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error)
+	GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error)
+	GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error)
+	GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
+	GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error)
+	GetDelegateStakePendingReward(ctx context.Context, in *QueryDelegateStakePendingRewardRequest, opts ...grpc.CallOption) (*QueryDelegateStakePendingRewardResponse, error)
+	GetDelegatorPendingRewards(ctx context.Context, in *QueryDelegatorPendingRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingRewardsResponse, error)
+	GetReputerCommission(ctx context.Context, in *QueryReputerCommissionRequest, opts ...grpc.CallOption) (*QueryReputerCommissionResponse, error)
+	GetRedelegationsFromReputer(ctx context.Context, in *QueryRedelegationsFromReputerRequest, opts ...grpc.CallOption) (*QueryRedelegationsFromReputerResponse, error)
+	GetReputerStakePool(ctx context.Context, in *QueryReputerStakePoolRequest, opts ...grpc.CallOption) (*QueryReputerStakePoolResponse, error)
+	GetTopicRewardReport(ctx context.Context, in *QueryTopicRewardReportRequest, opts ...grpc.CallOption) (*QueryTopicRewardReportResponse, error)
+	// Runs the reward pipeline of a topic for a reward nonce without writing state
+	SimulateTopicRewards(ctx context.Context, in *QuerySimulateTopicRewardsRequest, opts ...grpc.CallOption) (*QuerySimulateTopicRewardsResponse, error)
+	GetClaimableRewards(ctx context.Context, in *QueryClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryClaimableRewardsResponse, error)
+	GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error)
+	GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error)
+	GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error)
+	GetWorkerAddressByP2PKey(ctx context.Context, in *QueryWorkerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryWorkerAddressByP2PKeyResponse, error)
+	GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error)
+	IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
+	IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error)
+	GetWorkerLiveness(ctx context.Context, in *QueryWorkerLivenessRequest, opts ...grpc.CallOption) (*QueryWorkerLivenessResponse, error)
+	GetReputerLiveness(ctx context.Context, in *QueryReputerLivenessRequest, opts ...grpc.CallOption) (*QueryReputerLivenessResponse, error)
+	GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error)
+	IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error)
+	GetTopicAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error)
+	GetTopicCreatorAllowlist(ctx context.Context, in *QueryTopicCreatorAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicCreatorAllowlistResponse, error)
+	GetScheduledParamsUpdates(ctx context.Context, in *QueryScheduledParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsUpdatesResponse, error)
+	// Walks every pruned collection to measure it, so it is expensive on a large state
+	GetStateRetention(ctx context.Context, in *QueryStateRetentionRequest, opts ...grpc.CallOption) (*QueryStateRetentionResponse, error)
 }
 
-func (m *QueryInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Inferences != nil {
-		{
-			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryForecastsAtBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryForecastsAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetNextTopicId(ctx context.Context, in *QueryNextTopicIdRequest, opts ...grpc.CallOption) (*QueryNextTopicIdResponse, error) {
+	out := new(QueryNextTopicIdResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNextTopicId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryForecastsAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetTopic(ctx context.Context, in *QueryTopicRequest, opts ...grpc.CallOption) (*QueryTopicResponse, error) {
+	out := new(QueryTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryForecastsAtBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTopicParams(ctx context.Context, in *QueryTopicParamsRequest, opts ...grpc.CallOption) (*QueryTopicParamsResponse, error) {
+	out := new(QueryTopicParamsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryForecastsAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetActiveTopics(ctx context.Context, in *QueryActiveTopicsRequest, opts ...grpc.CallOption) (*QueryActiveTopicsResponse, error) {
+	out := new(QueryActiveTopicsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetActiveTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryForecastsAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Forecasts != nil {
-		{
-			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetWorkerLatestInferenceByTopicId(ctx context.Context, in *QueryWorkerLatestInferenceRequest, opts ...grpc.CallOption) (*QueryWorkerLatestInferenceResponse, error) {
+	out := new(QueryWorkerLatestInferenceResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWorkerLatestInferenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetInferencesAtBlock(ctx context.Context, in *QueryInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryInferencesAtBlockResponse, error) {
+	out := new(QueryInferencesAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetInferencesAtBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWorkerLatestInferenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetForecastsAtBlock(ctx context.Context, in *QueryForecastsAtBlockRequest, opts ...grpc.CallOption) (*QueryForecastsAtBlockResponse, error) {
+	out := new(QueryForecastsAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetForecastsAtBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWorkerLatestInferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerAddress) > 0 {
-		i -= len(m.WorkerAddress)
-		copy(dAtA[i:], m.WorkerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkerAddress)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) GetWorkerCommitment(ctx context.Context, in *QueryWorkerCommitmentRequest, opts ...grpc.CallOption) (*QueryWorkerCommitmentResponse, error) {
+	out := new(QueryWorkerCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) GetNetworkLossBundleAtBlock(ctx context.Context, in *QueryNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkLossBundleAtBlockResponse, error) {
+	out := new(QueryNetworkLossBundleAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNetworkLossBundleAtBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWorkerLatestInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTotalStake(ctx context.Context, in *QueryTotalStakeRequest, opts ...grpc.CallOption) (*QueryTotalStakeResponse, error) {
+	out := new(QueryTotalStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTotalStake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWorkerLatestInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetReputerStakeInTopic(ctx context.Context, in *QueryReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryReputerStakeInTopicResponse, error) {
+	out := new(QueryReputerStakeInTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerStakeInTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWorkerLatestInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LatestInference != nil {
-		{
-			size, err := m.LatestInference.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetMultiReputerStakeInTopic(ctx context.Context, in *QueryMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*QueryMultiReputerStakeInTopicResponse, error) {
+	out := new(QueryMultiReputerStakeInTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetMultiReputerStakeInTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWorkerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDelegateStakeInTopicInReputer(ctx context.Context, in *QueryDelegateStakeInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryDelegateStakeInTopicInReputerResponse, error) {
+	out := new(QueryDelegateStakeInTopicInReputerResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakeInTopicInReputer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWorkerNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStakeFromDelegatorInTopicInReputer(ctx context.Context, in *QueryStakeFromDelegatorInTopicInReputerRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicInReputerResponse, error) {
+	out := new(QueryStakeFromDelegatorInTopicInReputerResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWorkerNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Libp2PKey) > 0 {
-		i -= len(m.Libp2PKey)
-		copy(dAtA[i:], m.Libp2PKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetStakeFromDelegatorInTopic(ctx context.Context, in *QueryStakeFromDelegatorInTopicRequest, opts ...grpc.CallOption) (*QueryStakeFromDelegatorInTopicResponse, error) {
+	out := new(QueryStakeFromDelegatorInTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStakeFromDelegatorInTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWorkerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDelegateStakePendingReward(ctx context.Context, in *QueryDelegateStakePendingRewardRequest, opts ...grpc.CallOption) (*QueryDelegateStakePendingRewardResponse, error) {
+	out := new(QueryDelegateStakePendingRewardResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegateStakePendingReward", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWorkerNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDelegatorPendingRewards(ctx context.Context, in *QueryDelegatorPendingRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingRewardsResponse, error) {
+	out := new(QueryDelegatorPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetDelegatorPendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWorkerNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NodeInfo != nil {
-		{
-			size, err := m.NodeInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetReputerCommission(ctx context.Context, in *QueryReputerCommissionRequest, opts ...grpc.CallOption) (*QueryReputerCommissionResponse, error) {
+	out := new(QueryReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryReputerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRedelegationsFromReputer(ctx context.Context, in *QueryRedelegationsFromReputerRequest, opts ...grpc.CallOption) (*QueryRedelegationsFromReputerResponse, error) {
+	out := new(QueryRedelegationsFromReputerResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetRedelegationsFromReputer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryReputerNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetReputerStakePool(ctx context.Context, in *QueryReputerStakePoolRequest, opts ...grpc.CallOption) (*QueryReputerStakePoolResponse, error) {
+	out := new(QueryReputerStakePoolResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerStakePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryReputerNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Libp2PKey) > 0 {
-		i -= len(m.Libp2PKey)
-		copy(dAtA[i:], m.Libp2PKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetTopicRewardReport(ctx context.Context, in *QueryTopicRewardReportRequest, opts ...grpc.CallOption) (*QueryTopicRewardReportResponse, error) {
+	out := new(QueryTopicRewardReportResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicRewardReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryReputerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateTopicRewards(ctx context.Context, in *QuerySimulateTopicRewardsRequest, opts ...grpc.CallOption) (*QuerySimulateTopicRewardsResponse, error) {
+	out := new(QuerySimulateTopicRewardsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/SimulateTopicRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryReputerNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetClaimableRewards(ctx context.Context, in *QueryClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryClaimableRewardsResponse, error) {
+	out := new(QueryClaimableRewardsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetClaimableRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryReputerNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NodeInfo != nil {
-		{
-			size, err := m.NodeInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetTopicStake(ctx context.Context, in *QueryTopicStakeRequest, opts ...grpc.CallOption) (*QueryTopicStakeResponse, error) {
+	out := new(QueryTopicStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetWorkerNodeInfo(ctx context.Context, in *QueryWorkerNodeInfoRequest, opts ...grpc.CallOption) (*QueryWorkerNodeInfoResponse, error) {
+	out := new(QueryWorkerNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerNodeInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetReputerNodeInfo(ctx context.Context, in *QueryReputerNodeInfoRequest, opts ...grpc.CallOption) (*QueryReputerNodeInfoResponse, error) {
+	out := new(QueryReputerNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Libp2PKey) > 0 {
-		i -= len(m.Libp2PKey)
-		copy(dAtA[i:], m.Libp2PKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetWorkerAddressByP2PKey(ctx context.Context, in *QueryWorkerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryWorkerAddressByP2PKeyResponse, error) {
+	out := new(QueryWorkerAddressByP2PKeyResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerAddressByP2PKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetReputerAddressByP2PKey(ctx context.Context, in *QueryReputerAddressByP2PKeyRequest, opts ...grpc.CallOption) (*QueryReputerAddressByP2PKeyResponse, error) {
+	out := new(QueryReputerAddressByP2PKeyResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerAddressByP2PKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error) {
+	out := new(QueryIsWorkerRegisteredInTopicIdResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsWorkerRegisteredInTopicId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
+	out := new(QueryIsReputerRegisteredInTopicIdResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsReputerRegisteredInTopicId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetWorkerLiveness(ctx context.Context, in *QueryWorkerLivenessRequest, opts ...grpc.CallOption) (*QueryWorkerLivenessResponse, error) {
+	out := new(QueryWorkerLivenessResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetWorkerLiveness", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetReputerLiveness(ctx context.Context, in *QueryReputerLivenessRequest, opts ...grpc.CallOption) (*QueryReputerLivenessResponse, error) {
+	out := new(QueryReputerLivenessResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetReputerLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Libp2PKey) > 0 {
-		i -= len(m.Libp2PKey)
-		copy(dAtA[i:], m.Libp2PKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryReputerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error) {
+	out := new(QueryNetworkInferencesAtBlockResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetNetworkInferencesAtBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryReputerAddressByP2PKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryReputerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNetworkInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error) {
+	out := new(QueryIsWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/IsWhitelistAdmin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNetworkInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNetworkInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeightLastReward != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeightLastReward))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BlockHeightLastInference != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeightLastInference))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetTopicAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error) {
+	out := new(QueryTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNetworkInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTopicCreatorAllowlist(ctx context.Context, in *QueryTopicCreatorAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicCreatorAllowlistResponse, error) {
+	out := new(QueryTopicCreatorAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetTopicCreatorAllowlist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNetworkInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNetworkInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NetworkInferences != nil {
-		{
-			size, err := m.NetworkInferences.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetScheduledParamsUpdates(ctx context.Context, in *QueryScheduledParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsUpdatesResponse, error) {
+	out := new(QueryScheduledParamsUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetScheduledParamsUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStateRetention(ctx context.Context, in *QueryStateRetentionRequest, opts ...grpc.CallOption) (*QueryStateRetentionResponse, error) {
+	out := new(QueryStateRetentionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Query/GetStateRetention", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	GetNextTopicId(context.Context, *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error)
+	GetTopic(context.Context, *QueryTopicRequest) (*QueryTopicResponse, error)
+	GetTopicParams(context.Context, *QueryTopicParamsRequest) (*QueryTopicParamsResponse, error)
+	GetActiveTopics(context.Context, *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error)
+	GetWorkerLatestInferenceByTopicId(context.Context, *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error)
+	GetInferencesAtBlock(context.Context, *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error)
+	GetForecastsAtBlock(context.Context, *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error)
+	GetWorkerCommitment(context.Context, *QueryWorkerCommitmentRequest) (*QueryWorkerCommitmentResponse, error)
+	GetNetworkLossBundleAtBlock(context.Context, *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error)
+	GetTotalStake(context.Context, *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error)
+	GetReputerStakeInTopic(context.Context, *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error)
+	GetMultiReputerStakeInTopic(context.Context, *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error)
+	GetDelegateStakeInTopicInReputer(context.Context, *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error)
+	GetStakeFromDelegatorInTopicInReputer(context.Context, *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error)
+	GetStakeFromDelegatorInTopic(context.Context, *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error)
+	GetDelegateStakePendingReward(context.Context, *QueryDelegateStakePendingRewardRequest) (*QueryDelegateStakePendingRewardResponse, error)
+	GetDelegatorPendingRewards(context.Context, *QueryDelegatorPendingRewardsRequest) (*QueryDelegatorPendingRewardsResponse, error)
+	GetReputerCommission(context.Context, *QueryReputerCommissionRequest) (*QueryReputerCommissionResponse, error)
+	GetRedelegationsFromReputer(context.Context, *QueryRedelegationsFromReputerRequest) (*QueryRedelegationsFromReputerResponse, error)
+	GetReputerStakePool(context.Context, *QueryReputerStakePoolRequest) (*QueryReputerStakePoolResponse, error)
+	GetTopicRewardReport(context.Context, *QueryTopicRewardReportRequest) (*QueryTopicRewardReportResponse, error)
+	// Runs the reward pipeline of a topic for a reward nonce without writing state
+	SimulateTopicRewards(context.Context, *QuerySimulateTopicRewardsRequest) (*QuerySimulateTopicRewardsResponse, error)
+	GetClaimableRewards(context.Context, *QueryClaimableRewardsRequest) (*QueryClaimableRewardsResponse, error)
+	GetTopicStake(context.Context, *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error)
+	GetWorkerNodeInfo(context.Context, *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error)
+	GetReputerNodeInfo(context.Context, *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error)
+	GetWorkerAddressByP2PKey(context.Context, *QueryWorkerAddressByP2PKeyRequest) (*QueryWorkerAddressByP2PKeyResponse, error)
+	GetReputerAddressByP2PKey(context.Context, *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error)
+	IsWorkerRegisteredInTopicId(context.Context, *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
+	IsReputerRegisteredInTopicId(context.Context, *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error)
+	GetWorkerLiveness(context.Context, *QueryWorkerLivenessRequest) (*QueryWorkerLivenessResponse, error)
+	GetReputerLiveness(context.Context, *QueryReputerLivenessRequest) (*QueryReputerLivenessResponse, error)
+	GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error)
+	IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error)
+	GetTopicAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error)
+	GetTopicCreatorAllowlist(context.Context, *QueryTopicCreatorAllowlistRequest) (*QueryTopicCreatorAllowlistResponse, error)
+	GetScheduledParamsUpdates(context.Context, *QueryScheduledParamsUpdatesRequest) (*QueryScheduledParamsUpdatesResponse, error)
+	// Walks every pruned collection to measure it, so it is expensive on a large state
+	GetStateRetention(context.Context, *QueryStateRetentionRequest) (*QueryStateRetentionResponse, error)
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetNextTopicId(ctx context.Context, req *QueryNextTopicIdRequest) (*QueryNextTopicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextTopicId not implemented")
 }
-
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsRegistered {
-		i--
-		if m.IsRegistered {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetTopic(ctx context.Context, req *QueryTopicRequest) (*QueryTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
 }
-
-func (m *QueryIsReputerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetTopicParams(ctx context.Context, req *QueryTopicParamsRequest) (*QueryTopicParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicParams not implemented")
 }
-
-func (m *QueryIsReputerRegisteredInTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetActiveTopics(ctx context.Context, req *QueryActiveTopicsRequest) (*QueryActiveTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveTopics not implemented")
 }
-
-func (m *QueryIsReputerRegisteredInTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetWorkerLatestInferenceByTopicId(ctx context.Context, req *QueryWorkerLatestInferenceRequest) (*QueryWorkerLatestInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLatestInferenceByTopicId not implemented")
 }
-
-func (m *QueryIsReputerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetInferencesAtBlock(ctx context.Context, req *QueryInferencesAtBlockRequest) (*QueryInferencesAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInferencesAtBlock not implemented")
 }
-
-func (m *QueryIsReputerRegisteredInTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetForecastsAtBlock(ctx context.Context, req *QueryForecastsAtBlockRequest) (*QueryForecastsAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForecastsAtBlock not implemented")
 }
-
-func (m *QueryIsReputerRegisteredInTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsRegistered {
-		i--
-		if m.IsRegistered {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetWorkerCommitment(ctx context.Context, req *QueryWorkerCommitmentRequest) (*QueryWorkerCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerCommitment not implemented")
 }
-
-func (m *QueryIsWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetNetworkLossBundleAtBlock(ctx context.Context, req *QueryNetworkLossBundleAtBlockRequest) (*QueryNetworkLossBundleAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkLossBundleAtBlock not implemented")
 }
-
-func (m *QueryIsWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetTotalStake(ctx context.Context, req *QueryTotalStakeRequest) (*QueryTotalStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalStake not implemented")
 }
-
-func (m *QueryIsWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetReputerStakeInTopic(ctx context.Context, req *QueryReputerStakeInTopicRequest) (*QueryReputerStakeInTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerStakeInTopic not implemented")
 }
-
-func (m *QueryIsWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetMultiReputerStakeInTopic(ctx context.Context, req *QueryMultiReputerStakeInTopicRequest) (*QueryMultiReputerStakeInTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMultiReputerStakeInTopic not implemented")
 }
-
-func (m *QueryIsWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetDelegateStakeInTopicInReputer(ctx context.Context, req *QueryDelegateStakeInTopicInReputerRequest) (*QueryDelegateStakeInTopicInReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeInTopicInReputer not implemented")
 }
-
-func (m *QueryIsWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsAdmin {
-		i--
-		if m.IsAdmin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetStakeFromDelegatorInTopicInReputer(ctx context.Context, req *QueryStakeFromDelegatorInTopicInReputerRequest) (*QueryStakeFromDelegatorInTopicInReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopicInReputer not implemented")
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) GetStakeFromDelegatorInTopic(ctx context.Context, req *QueryStakeFromDelegatorInTopicRequest) (*QueryStakeFromDelegatorInTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeFromDelegatorInTopic not implemented")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) GetDelegateStakePendingReward(ctx context.Context, req *QueryDelegateStakePendingRewardRequest) (*QueryDelegateStakePendingRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakePendingReward not implemented")
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) GetDelegatorPendingRewards(ctx context.Context, req *QueryDelegatorPendingRewardsRequest) (*QueryDelegatorPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorPendingRewards not implemented")
 }
-
-func (m *QueryTotalStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) GetReputerCommission(ctx context.Context, req *QueryReputerCommissionRequest) (*QueryReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerCommission not implemented")
+}
+func (*UnimplementedQueryServer) GetRedelegationsFromReputer(ctx context.Context, req *QueryRedelegationsFromReputerRequest) (*QueryRedelegationsFromReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedelegationsFromReputer not implemented")
+}
+func (*UnimplementedQueryServer) GetReputerStakePool(ctx context.Context, req *QueryReputerStakePoolRequest) (*QueryReputerStakePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerStakePool not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicRewardReport(ctx context.Context, req *QueryTopicRewardReportRequest) (*QueryTopicRewardReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicRewardReport not implemented")
+}
+func (*UnimplementedQueryServer) SimulateTopicRewards(ctx context.Context, req *QuerySimulateTopicRewardsRequest) (*QuerySimulateTopicRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTopicRewards not implemented")
+}
+func (*UnimplementedQueryServer) GetClaimableRewards(ctx context.Context, req *QueryClaimableRewardsRequest) (*QueryClaimableRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimableRewards not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicStake(ctx context.Context, req *QueryTopicStakeRequest) (*QueryTopicStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicStake not implemented")
+}
+func (*UnimplementedQueryServer) GetWorkerNodeInfo(ctx context.Context, req *QueryWorkerNodeInfoRequest) (*QueryWorkerNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerNodeInfo not implemented")
+}
+func (*UnimplementedQueryServer) GetReputerNodeInfo(ctx context.Context, req *QueryReputerNodeInfoRequest) (*QueryReputerNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerNodeInfo not implemented")
+}
+func (*UnimplementedQueryServer) GetWorkerAddressByP2PKey(ctx context.Context, req *QueryWorkerAddressByP2PKeyRequest) (*QueryWorkerAddressByP2PKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerAddressByP2PKey not implemented")
+}
+func (*UnimplementedQueryServer) GetReputerAddressByP2PKey(ctx context.Context, req *QueryReputerAddressByP2PKeyRequest) (*QueryReputerAddressByP2PKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerAddressByP2PKey not implemented")
+}
+func (*UnimplementedQueryServer) IsWorkerRegisteredInTopicId(ctx context.Context, req *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsWorkerRegisteredInTopicId not implemented")
+}
+func (*UnimplementedQueryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsReputerRegisteredInTopicId not implemented")
+}
+func (*UnimplementedQueryServer) GetWorkerLiveness(ctx context.Context, req *QueryWorkerLivenessRequest) (*QueryWorkerLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLiveness not implemented")
+}
+func (*UnimplementedQueryServer) GetReputerLiveness(ctx context.Context, req *QueryReputerLivenessRequest) (*QueryReputerLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerLiveness not implemented")
+}
+func (*UnimplementedQueryServer) GetNetworkInferencesAtBlock(ctx context.Context, req *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInferencesAtBlock not implemented")
+}
+func (*UnimplementedQueryServer) IsWhitelistAdmin(ctx context.Context, req *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistAdmin not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicAllowlist(ctx context.Context, req *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) GetTopicCreatorAllowlist(ctx context.Context, req *QueryTopicCreatorAllowlistRequest) (*QueryTopicCreatorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicCreatorAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) GetScheduledParamsUpdates(ctx context.Context, req *QueryScheduledParamsUpdatesRequest) (*QueryScheduledParamsUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledParamsUpdates not implemented")
+}
+func (*UnimplementedQueryServer) GetStateRetention(ctx context.Context, req *QueryStateRetentionRequest) (*QueryStateRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateRetention not implemented")
 }
 
-func (m *QueryTotalStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryReputerStakeInTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/Params",
 	}
-	return n
-}
-
-func (m *QueryReputerStakeInTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMultiReputerStakeInTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetNextTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextTopicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNextTopicId(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetNextTopicId",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNextTopicId(ctx, req.(*QueryNextTopicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMultiReputerStakeInTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopic(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopic(ctx, req.(*QueryTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegateStakeInTopicInReputerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTopicParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ReputerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicParams(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicParams",
 	}
-	return n
-}
-
-func (m *QueryDelegateStakeInTopicInReputerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicParams(ctx, req.(*QueryTopicParamsRequest))
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetActiveTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetActiveTopics(ctx, in)
 	}
-	l = len(m.ReputerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetActiveTopics",
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetActiveTopics(ctx, req.(*QueryActiveTopicsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetWorkerLatestInferenceByTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerLatestInferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryStakeFromDelegatorInTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerLatestInferenceByTopicId(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetWorkerLatestInferenceByTopicId",
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerLatestInferenceByTopicId(ctx, req.(*QueryWorkerLatestInferenceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStakeFromDelegatorInTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferencesAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryTopicStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GetInferencesAtBlock(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetInferencesAtBlock",
 	}
-	return n
-}
-
-func (m *QueryTopicStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetInferencesAtBlock(ctx, req.(*QueryInferencesAtBlockRequest))
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNetworkLossBundleAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetForecastsAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForecastsAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetForecastsAtBlock(ctx, in)
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetForecastsAtBlock",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetForecastsAtBlock(ctx, req.(*QueryForecastsAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNetworkLossBundleAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetWorkerCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.LossBundle != nil {
-		l = m.LossBundle.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerCommitment(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryNextTopicIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetWorkerCommitment",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerCommitment(ctx, req.(*QueryWorkerCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNextTopicIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetNetworkLossBundleAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkLossBundleAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.NextTopicId != 0 {
-		n += 1 + sovQuery(uint64(m.NextTopicId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworkLossBundleAtBlock(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetNetworkLossBundleAtBlock",
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworkLossBundleAtBlock(ctx, req.(*QueryNetworkLossBundleAtBlockRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTotalStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Topic != nil {
-		l = m.Topic.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetTotalStake(ctx, in)
 	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTotalStake",
 	}
-	l = len(m.EffectiveRevenue)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTotalStake(ctx, req.(*QueryTotalStakeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveTopicsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerStakeInTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerStakeInTopic(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerStakeInTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerStakeInTopic(ctx, req.(*QueryReputerStakeInTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveTopicsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func _Query_GetMultiReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiReputerStakeInTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetMultiReputerStakeInTopic(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetMultiReputerStakeInTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMultiReputerStakeInTopic(ctx, req.(*QueryMultiReputerStakeInTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInferencesAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDelegateStakeInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateStakeInTopicInReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateStakeInTopicInReputer(ctx, in)
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetDelegateStakeInTopicInReputer",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateStakeInTopicInReputer(ctx, req.(*QueryDelegateStakeInTopicInReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInferencesAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetStakeFromDelegatorInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeFromDelegatorInTopicInReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Inferences != nil {
-		l = m.Inferences.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetStakeFromDelegatorInTopicInReputer(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetStakeFromDelegatorInTopicInReputer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStakeFromDelegatorInTopicInReputer(ctx, req.(*QueryStakeFromDelegatorInTopicInReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryForecastsAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetStakeFromDelegatorInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeFromDelegatorInTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetStakeFromDelegatorInTopic(ctx, in)
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetStakeFromDelegatorInTopic",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStakeFromDelegatorInTopic(ctx, req.(*QueryStakeFromDelegatorInTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryForecastsAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDelegateStakePendingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateStakePendingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Forecasts != nil {
-		l = m.Forecasts.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateStakePendingReward(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetDelegateStakePendingReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateStakePendingReward(ctx, req.(*QueryDelegateStakePendingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerLatestInferenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDelegatorPendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegatorPendingRewards(ctx, in)
 	}
-	l = len(m.WorkerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetDelegatorPendingRewards",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegatorPendingRewards(ctx, req.(*QueryDelegatorPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerLatestInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.LatestInference != nil {
-		l = m.LatestInference.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerCommission(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryWorkerNodeInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerCommission",
 	}
-	var l int
-	_ = l
-	l = len(m.Libp2PKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerCommission(ctx, req.(*QueryReputerCommissionRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerNodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetRedelegationsFromReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationsFromReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.NodeInfo != nil {
-		l = m.NodeInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetRedelegationsFromReputer(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryReputerNodeInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetRedelegationsFromReputer",
 	}
-	var l int
-	_ = l
-	l = len(m.Libp2PKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRedelegationsFromReputer(ctx, req.(*QueryRedelegationsFromReputerRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReputerNodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetReputerStakePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerStakePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.NodeInfo != nil {
-		l = m.NodeInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerStakePool(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryWorkerAddressByP2PKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerStakePool",
 	}
-	var l int
-	_ = l
-	l = len(m.Libp2PKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerStakePool(ctx, req.(*QueryReputerStakePoolRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWorkerAddressByP2PKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTopicRewardReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicRewardReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicRewardReport(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicRewardReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicRewardReport(ctx, req.(*QueryTopicRewardReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReputerAddressByP2PKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SimulateTopicRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTopicRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Libp2PKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTopicRewards(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/SimulateTopicRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTopicRewards(ctx, req.(*QuerySimulateTopicRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReputerAddressByP2PKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetClaimableRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetClaimableRewards(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetClaimableRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetClaimableRewards(ctx, req.(*QueryClaimableRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNetworkInferencesAtBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTopicStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicStake(ctx, in)
 	}
-	if m.BlockHeightLastInference != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeightLastInference))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicStake",
 	}
-	if m.BlockHeightLastReward != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeightLastReward))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicStake(ctx, req.(*QueryTopicStakeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNetworkInferencesAtBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetWorkerNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.NetworkInferences != nil {
-		l = m.NetworkInferences.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerNodeInfo(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetWorkerNodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerNodeInfo(ctx, req.(*QueryWorkerNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetReputerNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerNodeInfo(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerNodeInfo",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerNodeInfo(ctx, req.(*QueryReputerNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetWorkerAddressByP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerAddressByP2PKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.IsRegistered {
-		n += 2
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerAddressByP2PKey(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetWorkerAddressByP2PKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerAddressByP2PKey(ctx, req.(*QueryWorkerAddressByP2PKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIsReputerRegisteredInTopicIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetReputerAddressByP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerAddressByP2PKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovQuery(uint64(m.TopicId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerAddressByP2PKey(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerAddressByP2PKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerAddressByP2PKey(ctx, req.(*QueryReputerAddressByP2PKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsWorkerRegisteredInTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsWorkerRegisteredInTopicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsWorkerRegisteredInTopicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/IsWorkerRegisteredInTopicId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsWorkerRegisteredInTopicId(ctx, req.(*QueryIsWorkerRegisteredInTopicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsReputerRegisteredInTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsReputerRegisteredInTopicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsReputerRegisteredInTopicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/IsReputerRegisteredInTopicId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsReputerRegisteredInTopicId(ctx, req.(*QueryIsReputerRegisteredInTopicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetWorkerLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetWorkerLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerLiveness(ctx, req.(*QueryWorkerLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReputerLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetReputerLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerLiveness(ctx, req.(*QueryReputerLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNetworkInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkInferencesAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworkInferencesAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetNetworkInferencesAtBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworkInferencesAtBlock(ctx, req.(*QueryNetworkInferencesAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsWhitelistAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsWhitelistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/IsWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsWhitelistAdmin(ctx, req.(*QueryIsWhitelistAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicAllowlist(ctx, req.(*QueryTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicCreatorAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicCreatorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetTopicCreatorAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicCreatorAllowlist(ctx, req.(*QueryTopicCreatorAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetScheduledParamsUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetScheduledParamsUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetScheduledParamsUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetScheduledParamsUpdates(ctx, req.(*QueryScheduledParamsUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStateRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStateRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Query/GetStateRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStateRetention(ctx, req.(*QueryStateRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "emissions.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetNextTopicId",
+			Handler:    _Query_GetNextTopicId_Handler,
+		},
+		{
+			MethodName: "GetTopic",
+			Handler:    _Query_GetTopic_Handler,
+		},
+		{
+			MethodName: "GetTopicParams",
+			Handler:    _Query_GetTopicParams_Handler,
+		},
+		{
+			MethodName: "GetActiveTopics",
+			Handler:    _Query_GetActiveTopics_Handler,
+		},
+		{
+			MethodName: "GetWorkerLatestInferenceByTopicId",
+			Handler:    _Query_GetWorkerLatestInferenceByTopicId_Handler,
+		},
+		{
+			MethodName: "GetInferencesAtBlock",
+			Handler:    _Query_GetInferencesAtBlock_Handler,
+		},
+		{
+			MethodName: "GetForecastsAtBlock",
+			Handler:    _Query_GetForecastsAtBlock_Handler,
+		},
+		{
+			MethodName: "GetWorkerCommitment",
+			Handler:    _Query_GetWorkerCommitment_Handler,
+		},
+		{
+			MethodName: "GetNetworkLossBundleAtBlock",
+			Handler:    _Query_GetNetworkLossBundleAtBlock_Handler,
+		},
+		{
+			MethodName: "GetTotalStake",
+			Handler:    _Query_GetTotalStake_Handler,
+		},
+		{
+			MethodName: "GetReputerStakeInTopic",
+			Handler:    _Query_GetReputerStakeInTopic_Handler,
+		},
+		{
+			MethodName: "GetMultiReputerStakeInTopic",
+			Handler:    _Query_GetMultiReputerStakeInTopic_Handler,
+		},
+		{
+			MethodName: "GetDelegateStakeInTopicInReputer",
+			Handler:    _Query_GetDelegateStakeInTopicInReputer_Handler,
+		},
+		{
+			MethodName: "GetStakeFromDelegatorInTopicInReputer",
+			Handler:    _Query_GetStakeFromDelegatorInTopicInReputer_Handler,
+		},
+		{
+			MethodName: "GetStakeFromDelegatorInTopic",
+			Handler:    _Query_GetStakeFromDelegatorInTopic_Handler,
+		},
+		{
+			MethodName: "GetDelegateStakePendingReward",
+			Handler:    _Query_GetDelegateStakePendingReward_Handler,
+		},
+		{
+			MethodName: "GetDelegatorPendingRewards",
+			Handler:    _Query_GetDelegatorPendingRewards_Handler,
+		},
+		{
+			MethodName: "GetReputerCommission",
+			Handler:    _Query_GetReputerCommission_Handler,
+		},
+		{
+			MethodName: "GetRedelegationsFromReputer",
+			Handler:    _Query_GetRedelegationsFromReputer_Handler,
+		},
+		{
+			MethodName: "GetReputerStakePool",
+			Handler:    _Query_GetReputerStakePool_Handler,
+		},
+		{
+			MethodName: "GetTopicRewardReport",
+			Handler:    _Query_GetTopicRewardReport_Handler,
+		},
+		{
+			MethodName: "SimulateTopicRewards",
+			Handler:    _Query_SimulateTopicRewards_Handler,
+		},
+		{
+			MethodName: "GetClaimableRewards",
+			Handler:    _Query_GetClaimableRewards_Handler,
+		},
+		{
+			MethodName: "GetTopicStake",
+			Handler:    _Query_GetTopicStake_Handler,
+		},
+		{
+			MethodName: "GetWorkerNodeInfo",
+			Handler:    _Query_GetWorkerNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetReputerNodeInfo",
+			Handler:    _Query_GetReputerNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetWorkerAddressByP2PKey",
+			Handler:    _Query_GetWorkerAddressByP2PKey_Handler,
+		},
+		{
+			MethodName: "GetReputerAddressByP2PKey",
+			Handler:    _Query_GetReputerAddressByP2PKey_Handler,
+		},
+		{
+			MethodName: "IsWorkerRegisteredInTopicId",
+			Handler:    _Query_IsWorkerRegisteredInTopicId_Handler,
+		},
+		{
+			MethodName: "IsReputerRegisteredInTopicId",
+			Handler:    _Query_IsReputerRegisteredInTopicId_Handler,
+		},
+		{
+			MethodName: "GetWorkerLiveness",
+			Handler:    _Query_GetWorkerLiveness_Handler,
+		},
+		{
+			MethodName: "GetReputerLiveness",
+			Handler:    _Query_GetReputerLiveness_Handler,
+		},
+		{
+			MethodName: "GetNetworkInferencesAtBlock",
+			Handler:    _Query_GetNetworkInferencesAtBlock_Handler,
+		},
+		{
+			MethodName: "IsWhitelistAdmin",
+			Handler:    _Query_IsWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "GetTopicAllowlist",
+			Handler:    _Query_GetTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "GetTopicCreatorAllowlist",
+			Handler:    _Query_GetTopicCreatorAllowlist_Handler,
+		},
+		{
+			MethodName: "GetScheduledParamsUpdates",
+			Handler:    _Query_GetScheduledParamsUpdates_Handler,
+		},
+		{
+			MethodName: "GetStateRetention",
+			Handler:    _Query_GetStateRetention_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "emissions/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerStakeInTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerStakeInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerStakeInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerStakeInTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerStakeInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerStakeInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiReputerStakeInTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiReputerStakeInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiReputerStakeInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiReputerStakeInTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiReputerStakeInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiReputerStakeInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateStakeInTopicInReputerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateStakeInTopicInReputerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateStakeInTopicInReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateStakeInTopicInReputerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateStakeInTopicInReputerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateStakeInTopicInReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeFromDelegatorInTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeFromDelegatorInTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeFromDelegatorInTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeFromDelegatorInTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeFromDelegatorInTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeFromDelegatorInTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateStakePendingRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateStakePendingRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateStakePendingRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateStakePendingRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateStakePendingRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateStakePendingRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PendingReward.Size()
+		i -= size
+		if _, err := m.PendingReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalPendingReward.Size()
+		i -= size
+		if _, err := m.TotalPendingReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedelegationsFromReputerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationsFromReputerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationsFromReputerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedelegationsFromReputerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationsFromReputerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationsFromReputerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerStakePoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerStakePoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerStakePoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReputerAddress) > 0 {
+		i -= len(m.ReputerAddress)
+		copy(dAtA[i:], m.ReputerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerStakePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerStakePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerStakePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingRemoval != nil {
+		{
+			size, err := m.PendingRemoval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PendingDecreases) > 0 {
+		for iNdEx := len(m.PendingDecreases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDecreases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkLossBundleAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkLossBundleAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkLossBundleAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkLossBundleAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkLossBundleAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkLossBundleAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LossBundle != nil {
+		{
+			size, err := m.LossBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextTopicIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextTopicIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextTopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextTopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EffectiveRevenue) > 0 {
+		i -= len(m.EffectiveRevenue)
+		copy(dAtA[i:], m.EffectiveRevenue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EffectiveRevenue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EffectiveParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TopicParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveTopicsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveTopicsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inferences != nil {
+		{
+			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForecastsAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForecastsAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForecastsAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForecastsAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForecastsAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForecastsAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Forecasts != nil {
+		{
+			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerAddress) > 0 {
+		i -= len(m.WorkerAddress)
+		copy(dAtA[i:], m.WorkerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerLatestInferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerLatestInferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerLatestInferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerAddress) > 0 {
+		i -= len(m.WorkerAddress)
+		copy(dAtA[i:], m.WorkerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerLatestInferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerLatestInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerLatestInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestInference != nil {
+		{
+			size, err := m.LatestInference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Libp2PKey) > 0 {
+		i -= len(m.Libp2PKey)
+		copy(dAtA[i:], m.Libp2PKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NodeInfo != nil {
+		{
+			size, err := m.NodeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerNodeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Libp2PKey) > 0 {
+		i -= len(m.Libp2PKey)
+		copy(dAtA[i:], m.Libp2PKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NodeInfo != nil {
+		{
+			size, err := m.NodeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerAddressByP2PKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerAddressByP2PKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Libp2PKey) > 0 {
+		i -= len(m.Libp2PKey)
+		copy(dAtA[i:], m.Libp2PKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerAddressByP2PKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerAddressByP2PKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerAddressByP2PKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerAddressByP2PKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Libp2PKey) > 0 {
+		i -= len(m.Libp2PKey)
+		copy(dAtA[i:], m.Libp2PKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Libp2PKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerAddressByP2PKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerAddressByP2PKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerAddressByP2PKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkInferencesAtBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkInferencesAtBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkInferencesAtBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeightLastReward != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeightLastReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeightLastInference != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeightLastInference))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkInferencesAtBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkInferencesAtBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkInferencesAtBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NetworkInferences != nil {
+		{
+			size, err := m.NetworkInferences.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsRegistered {
+		i--
+		if m.IsRegistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsRegistered {
+		i--
+		if m.IsRegistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkerLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkerLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkerLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Liveness != nil {
+		{
+			size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReputerLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReputerLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReputerLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Liveness != nil {
+		{
+			size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsAdmin {
+		i--
+		if m.IsAdmin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicCreatorAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicCreatorAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicCreatorAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicCreatorAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicCreatorAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicCreatorAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicRewardReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicRewardReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicRewardReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopicRewardReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopicRewardReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopicRewardReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateTopicRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateTopicRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateTopicRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateTopicRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateTopicRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateTopicRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRetentionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRetentionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRetentionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collections) > 0 {
+		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Cursor != nil {
+		{
+			size, err := m.Cursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxRecordsPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxRecordsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReputerStakeInTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryReputerStakeInTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMultiReputerStakeInTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryMultiReputerStakeInTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegateStakeInTopicInReputerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryDelegateStakeInTopicInReputerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakeFromDelegatorInTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryStakeFromDelegatorInTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegateStakePendingRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryDelegateStakePendingRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalPendingReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AutoCompound {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryReputerCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedelegationsFromReputerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryRedelegationsFromReputerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReputerStakePoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReputerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerStakePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingDecreases) > 0 {
+		for _, e := range m.PendingDecreases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PendingRemoval != nil {
+		l = m.PendingRemoval.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopicStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNetworkLossBundleAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryNetworkLossBundleAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LossBundle != nil {
+		l = m.LossBundle.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextTopicIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextTopicIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextTopicId != 0 {
+		n += 1 + sovQuery(uint64(m.NextTopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EffectiveRevenue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopicParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *QueryTopicParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TopicParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActiveTopicsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveTopicsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInferencesAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryInferencesAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inferences != nil {
+		l = m.Inferences.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForecastsAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryForecastsAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Forecasts != nil {
+		l = m.Forecasts.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = len(m.WorkerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerLatestInferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.WorkerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerLatestInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestInference != nil {
+		l = m.LatestInference.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerNodeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Libp2PKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeInfo != nil {
+		l = m.NodeInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerNodeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Libp2PKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeInfo != nil {
+		l = m.NodeInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerAddressByP2PKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Libp2PKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerAddressByP2PKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerAddressByP2PKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Libp2PKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerAddressByP2PKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNetworkInferencesAtBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeightLastInference != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeightLastInference))
+	}
+	if m.BlockHeightLastReward != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeightLastReward))
+	}
+	return n
+}
+
+func (m *QueryNetworkInferencesAtBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NetworkInferences != nil {
+		l = m.NetworkInferences.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRegistered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsReputerRegisteredInTopicIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRegistered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryWorkerLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkerLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Liveness != nil {
+		l = m.Liveness.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsJailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryReputerLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReputerLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Liveness != nil {
+		l = m.Liveness.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsJailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIsWhitelistAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsAdmin {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopicAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopicAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopicCreatorAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTopicCreatorAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledParamsUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduledParamsUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopicRewardReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryTopicRewardReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateTopicRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovQuery(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QuerySimulateTopicRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStateRetentionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStateRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRecordsPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxRecordsPerBlock))
+	}
+	if m.Cursor != nil {
+		l = m.Cursor.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Collections) > 0 {
+		for _, e := range m.Collections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReputerStakeInTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReputerStakeInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReputerStakeInTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReputerStakeInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, &StakePlacement{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegateStakePendingRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegateStakePendingRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegateStakePendingRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegateStakePendingRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegateStakePendingRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegateStakePendingRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorPendingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorPendingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorPendingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorPendingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, DelegatorPendingReward{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPendingReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPendingReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReputerCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReputerCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReputerCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReputerCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReputerCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReputerCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedelegationsFromReputerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedelegationsFromReputerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedelegationsFromReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedelegationsFromReputerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedelegationsFromReputerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedelegationsFromReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReputerStakePoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReputerStakePoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReputerStakePoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReputerStakePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReputerStakePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReputerStakePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, ReputerStakeAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecreases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDecreases = append(m.PendingDecreases, ReputerStakeAllocationDecrease{})
+			if err := m.PendingDecreases[len(m.PendingDecreases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRemoval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRemoval == nil {
+				m.PendingRemoval = &ReputerStakePoolRemoval{}
+			}
+			if err := m.PendingRemoval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LossBundle == nil {
+				m.LossBundle = &ValueBundle{}
+			}
+			if err := m.LossBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextTopicIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextTopicIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextTopicIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+			}
+			m.NextTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveRevenue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryIsReputerRegisteredInTopicIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsRegistered {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryIsWhitelistAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTopicParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopicParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryIsWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsAdmin {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5580,12 +12510,78 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TopicParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5607,7 +12603,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5630,15 +12626,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveTopicsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5665,7 +12661,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &SimpleCursorPaginationRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5690,7 +12689,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5713,67 +12712,51 @@ func (m *QueryTotalStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveTopicsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Topics = append(m.Topics, &Topic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5783,23 +12766,25 @@ func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &SimpleCursorPaginationResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5824,7 +12809,7 @@ func (m *QueryTotalStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5847,17 +12832,17 @@ func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerStakeInTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInferencesAtBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerStakeInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5867,29 +12852,16 @@ func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.TopicId = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5899,7 +12871,7 @@ func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5925,7 +12897,7 @@ func (m *QueryReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5948,17 +12920,17 @@ func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerStakeInTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInferencesAtBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerStakeInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5968,23 +12940,25 @@ func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Inferences == nil {
+				m.Inferences = &Inferences{}
+			}
+			if err := m.Inferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6009,7 +12983,7 @@ func (m *QueryReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6032,17 +13006,17 @@ func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForecastsAtBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForecastsAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6052,29 +13026,16 @@ func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.TopicId = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6084,7 +13045,7 @@ func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6110,7 +13071,7 @@ func (m *QueryMultiReputerStakeInTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6133,15 +13094,15 @@ func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForecastsAtBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMultiReputerStakeInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForecastsAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6168,8 +13129,10 @@ func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, &StakePlacement{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Forecasts == nil {
+				m.Forecasts = &Forecasts{}
+			}
+			if err := m.Forecasts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6194,7 +13157,7 @@ func (m *QueryMultiReputerStakeInTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6213,19 +13176,57 @@ func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkerCommitmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkerCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6253,27 +13254,8 @@ func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			m.WorkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6295,7 +13277,7 @@ func (m *QueryDelegateStakeInTopicInReputerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6318,17 +13300,17 @@ func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateStakeInTopicInReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6338,24 +13320,24 @@ func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6379,7 +13361,7 @@ func (m *QueryDelegateStakeInTopicInReputerResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6402,17 +13384,17 @@ func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerLatestInferenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerLatestInferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6422,27 +13404,14 @@ func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6470,27 +13439,8 @@ func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerAddress = string(dAtA[iNdEx:postIndex])
+			m.WorkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6512,7 +13462,7 @@ func (m *QueryStakeFromDelegatorInTopicInReputerRequest) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6535,17 +13485,17 @@ func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerLatestInferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicInReputerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerLatestInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInference", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6555,23 +13505,25 @@ func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LatestInference == nil {
+				m.LatestInference = &Inference{}
+			}
+			if err := m.LatestInference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6596,7 +13548,7 @@ func (m *QueryStakeFromDelegatorInTopicInReputerResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6619,15 +13571,15 @@ func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerNodeInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6655,27 +13607,8 @@ func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6697,7 +13630,7 @@ func (m *QueryStakeFromDelegatorInTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6720,17 +13653,17 @@ func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerNodeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeFromDelegatorInTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6740,23 +13673,25 @@ func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NodeInfo == nil {
+				m.NodeInfo = &OffchainNode{}
+			}
+			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6781,7 +13716,7 @@ func (m *QueryStakeFromDelegatorInTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6804,17 +13739,17 @@ func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerNodeInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6824,11 +13759,24 @@ func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6850,7 +13798,7 @@ func (m *QueryTopicStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6873,17 +13821,17 @@ func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerNodeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6893,23 +13841,25 @@ func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NodeInfo == nil {
+				m.NodeInfo = &OffchainNode{}
+			}
+			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6934,7 +13884,7 @@ func (m *QueryTopicStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6957,36 +13907,17 @@ func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6996,11 +13927,24 @@ func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7022,7 +13966,7 @@ func (m *QueryNetworkLossBundleAtBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7045,17 +13989,17 @@ func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkLossBundleAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7065,27 +14009,23 @@ func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LossBundle == nil {
-				m.LossBundle = &ValueBundle{}
-			}
-			if err := m.LossBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7108,7 +14048,7 @@ func (m *QueryNetworkLossBundleAtBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextTopicIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7131,12 +14071,44 @@ func (m *QueryNextTopicIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextTopicIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7158,7 +14130,7 @@ func (m *QueryNextTopicIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7181,17 +14153,17 @@ func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextTopicIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.NextTopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7201,11 +14173,24 @@ func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextTopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7227,7 +14212,7 @@ func (m *QueryNextTopicIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7250,10 +14235,10 @@ func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7275,6 +14260,44 @@ func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightLastInference", wireType)
+			}
+			m.BlockHeightLastInference = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeightLastInference |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightLastReward", wireType)
+			}
+			m.BlockHeightLastReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeightLastReward |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7296,7 +14319,7 @@ func (m *QueryTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7319,15 +14342,15 @@ func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7354,77 +14377,13 @@ func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &Topic{}
+			if m.NetworkInferences == nil {
+				m.NetworkInferences = &ValueBundle{}
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetworkInferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveRevenue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EffectiveRevenue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7446,7 +14405,7 @@ func (m *QueryTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7469,17 +14428,36 @@ func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveTopicsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7489,27 +14467,23 @@ func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &SimpleCursorPaginationRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7532,7 +14506,7 @@ func (m *QueryActiveTopicsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7555,51 +14529,17 @@ func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveTopicsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Topics = append(m.Topics, &Topic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7609,28 +14549,12 @@ func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &SimpleCursorPaginationResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsRegistered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7652,7 +14576,7 @@ func (m *QueryActiveTopicsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7675,10 +14599,10 @@ func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAtBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7701,10 +14625,10 @@ func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7714,11 +14638,24 @@ func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7740,7 +14677,7 @@ func (m *QueryInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7763,17 +14700,17 @@ func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInferencesAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7783,28 +14720,12 @@ func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Inferences == nil {
-				m.Inferences = &Inferences{}
-			}
-			if err := m.Inferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsRegistered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7826,7 +14747,7 @@ func (m *QueryInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7849,10 +14770,10 @@ func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryForecastsAtBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryForecastsAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7875,10 +14796,10 @@ func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7888,11 +14809,24 @@ func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7914,7 +14848,7 @@ func (m *QueryForecastsAtBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkerLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7937,15 +14871,15 @@ func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryForecastsAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkerLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryForecastsAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkerLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7972,13 +14906,67 @@ func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Forecasts == nil {
-				m.Forecasts = &Forecasts{}
+			if m.Liveness == nil {
+				m.Liveness = &ActorLiveness{}
 			}
-			if err := m.Forecasts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8000,7 +14988,7 @@ func (m *QueryForecastsAtBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8023,10 +15011,10 @@ func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerLatestInferenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerLatestInferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8050,7 +15038,7 @@ func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8078,7 +15066,7 @@ func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8101,7 +15089,7 @@ func (m *QueryWorkerLatestInferenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReputerLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8124,17 +15112,53 @@ func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerLatestInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReputerLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerLatestInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReputerLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Liveness == nil {
+				m.Liveness = &ActorLiveness{}
+			}
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8144,28 +15168,46 @@ func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestInference == nil {
-				m.LatestInference = &Inference{}
-			}
-			if err := m.LatestInference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8187,7 +15229,7 @@ func (m *QueryWorkerLatestInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8210,15 +15252,15 @@ func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerNodeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsWhitelistAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8246,7 +15288,7 @@ func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8269,7 +15311,7 @@ func (m *QueryWorkerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8292,17 +15334,17 @@ func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerNodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAdmin", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8312,28 +15354,12 @@ func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NodeInfo == nil {
-				m.NodeInfo = &OffchainNode{}
-			}
-			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsAdmin = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8355,7 +15381,7 @@ func (m *QueryWorkerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicAllowlistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8378,17 +15404,17 @@ func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerNodeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicAllowlistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8398,24 +15424,31 @@ func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsReputer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8437,7 +15470,7 @@ func (m *QueryReputerNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8460,17 +15493,17 @@ func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerNodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8480,27 +15513,23 @@ func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeInfo == nil {
-				m.NodeInfo = &OffchainNode{}
-			}
-			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8523,7 +15552,7 @@ func (m *QueryReputerNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicCreatorAllowlistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8546,44 +15575,12 @@ func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicCreatorAllowlistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicCreatorAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8605,7 +15602,7 @@ func (m *QueryWorkerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicCreatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8628,15 +15625,15 @@ func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicCreatorAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkerAddressByP2PKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicCreatorAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8664,7 +15661,7 @@ func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8687,7 +15684,7 @@ func (m *QueryWorkerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledParamsUpdatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8710,44 +15707,12 @@ func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledParamsUpdatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledParamsUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libp2PKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Libp2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8769,7 +15734,7 @@ func (m *QueryReputerAddressByP2PKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledParamsUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8792,17 +15757,17 @@ func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledParamsUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReputerAddressByP2PKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledParamsUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8812,23 +15777,25 @@ func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Updates = append(m.Updates, &ScheduledParamsUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8851,7 +15818,7 @@ func (m *QueryReputerAddressByP2PKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicRewardReportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8874,36 +15841,17 @@ func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			return fmt.Errorf("proto: QueryTopicRewardReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopicRewardReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightLastInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockHeightLastInference = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8913,16 +15861,16 @@ func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeightLastInference |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightLastReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.BlockHeightLastReward = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8932,7 +15880,7 @@ func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeightLastReward |= int64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8958,7 +15906,7 @@ func (m *QueryNetworkInferencesAtBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopicRewardReportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8981,15 +15929,15 @@ func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopicRewardReportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkInferencesAtBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopicRewardReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9016,10 +15964,10 @@ func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkInferences == nil {
-				m.NetworkInferences = &ValueBundle{}
+			if m.Report == nil {
+				m.Report = &TopicRewardReport{}
 			}
-			if err := m.NetworkInferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9044,7 +15992,7 @@ func (m *QueryNetworkInferencesAtBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateTopicRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9067,10 +16015,10 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateTopicRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateTopicRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9093,10 +16041,10 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9106,24 +16054,11 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9145,7 +16080,7 @@ func (m *QueryIsWorkerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateTopicRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9168,17 +16103,17 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateTopicRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsWorkerRegisteredInTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateTopicRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9188,12 +16123,28 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRegistered = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &TopicRewardReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9215,7 +16166,7 @@ func (m *QueryIsWorkerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9238,32 +16189,13 @@ func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -9316,7 +16248,7 @@ func (m *QueryIsReputerRegisteredInTopicIdRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9339,17 +16271,17 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsReputerRegisteredInTopicIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9359,12 +16291,58 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRegistered = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9386,7 +16364,7 @@ func (m *QueryIsReputerRegisteredInTopicIdResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStateRetentionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9409,44 +16387,12 @@ func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsWhitelistAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStateRetentionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStateRetentionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9468,7 +16414,7 @@ func (m *QueryIsWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStateRetentionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9491,17 +16437,17 @@ func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStateRetentionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStateRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPerBlock", wireType)
 			}
-			var v int
+			m.MaxRecordsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9511,12 +16457,81 @@ func (m *QueryIsWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxRecordsPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsAdmin = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cursor == nil {
+				m.Cursor = &PruningCursor{}
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collections = append(m.Collections, &CollectionRetention{})
+			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
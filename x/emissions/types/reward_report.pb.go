@@ -0,0 +1,1194 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: emissions/v1/reward_report.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// How the reward of a topic was split between its participants for a reward nonce,
+// keeping the intermediates of the reward pipeline so each payout can be explained
+type TopicRewardReport struct {
+	TopicId               uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight           int64                                           `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TopicReward           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=topic_reward,json=topicReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_reward"`
+	InferenceEntropy      github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=inference_entropy,json=inferenceEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"inference_entropy"`
+	ForecastingEntropy    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=forecasting_entropy,json=forecastingEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"forecasting_entropy"`
+	ReputerEntropy        github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,opt,name=reputer_entropy,json=reputerEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_entropy"`
+	InferenceTaskReward   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,opt,name=inference_task_reward,json=inferenceTaskReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"inference_task_reward"`
+	ForecastingTaskReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,8,opt,name=forecasting_task_reward,json=forecastingTaskReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"forecasting_task_reward"`
+	ReputerTaskReward     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,9,opt,name=reputer_task_reward,json=reputerTaskReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_task_reward"`
+	// forecasting utility χ and normalization factor γ splitting the worker reward between inferers and forecasters
+	Chi    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,opt,name=chi,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"chi"`
+	Gamma  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,11,opt,name=gamma,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"gamma"`
+	Actors []ActorRewardReport                             `protobuf:"bytes,12,rep,name=actors,proto3" json:"actors"`
+}
+
+func (m *TopicRewardReport) Reset()         { *m = TopicRewardReport{} }
+func (m *TopicRewardReport) String() string { return proto.CompactTextString(m) }
+func (*TopicRewardReport) ProtoMessage()    {}
+func (*TopicRewardReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e57925447579382, []int{0}
+}
+func (m *TopicRewardReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicRewardReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicRewardReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicRewardReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicRewardReport.Merge(m, src)
+}
+func (m *TopicRewardReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicRewardReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicRewardReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicRewardReport proto.InternalMessageInfo
+
+func (m *TopicRewardReport) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicRewardReport) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TopicRewardReport) GetActors() []ActorRewardReport {
+	if m != nil {
+		return m.Actors
+	}
+	return nil
+}
+
+type ActorRewardReport struct {
+	ActorType      ActorType                                       `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
+	Address        string                                          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Score          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=score,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"score"`
+	RewardFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=reward_fraction,json=rewardFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward_fraction"`
+	// paid to the actor itself; for reputers this leaves out the reward of the stake delegated to them
+	Reward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=reward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward"`
+}
+
+func (m *ActorRewardReport) Reset()         { *m = ActorRewardReport{} }
+func (m *ActorRewardReport) String() string { return proto.CompactTextString(m) }
+func (*ActorRewardReport) ProtoMessage()    {}
+func (*ActorRewardReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e57925447579382, []int{1}
+}
+func (m *ActorRewardReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorRewardReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorRewardReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorRewardReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorRewardReport.Merge(m, src)
+}
+func (m *ActorRewardReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorRewardReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorRewardReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorRewardReport proto.InternalMessageInfo
+
+func (m *ActorRewardReport) GetActorType() ActorType {
+	if m != nil {
+		return m.ActorType
+	}
+	return ActorType_INFERER
+}
+
+func (m *ActorRewardReport) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TopicRewardReport)(nil), "emissions.v1.TopicRewardReport")
+	proto.RegisterType((*ActorRewardReport)(nil), "emissions.v1.ActorRewardReport")
+}
+
+func init() { proto.RegisterFile("emissions/v1/reward_report.proto", fileDescriptor_6e57925447579382) }
+
+var fileDescriptor_6e57925447579382 = []byte{
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xb5, 0x6b, 0xd7, 0xaf, 0x55, 0xa1, 0x2e, 0xd3, 0xb2, 0x1d, 0xd2, 0xb2, 0x53,
+	0x2f, 0x24, 0xda, 0x90, 0x80, 0x0b, 0x07, 0x2a, 0x40, 0xec, 0x80, 0x90, 0xa2, 0x9e, 0x76, 0x29,
+	0xae, 0xe3, 0x26, 0x56, 0xdb, 0x38, 0xb2, 0xbd, 0x8e, 0xbe, 0x05, 0x8f, 0xb5, 0xe3, 0x8e, 0x88,
+	0xc3, 0x84, 0xda, 0x03, 0x8f, 0x01, 0x8a, 0x93, 0xb4, 0x41, 0x70, 0x40, 0xf2, 0xcd, 0xf6, 0x67,
+	0xff, 0x7e, 0x8e, 0x1d, 0xff, 0x61, 0x40, 0x97, 0x4c, 0x4a, 0xc6, 0x63, 0xe9, 0xad, 0x2e, 0x3c,
+	0x41, 0x6f, 0xb1, 0x08, 0x26, 0x82, 0x26, 0x5c, 0x28, 0x37, 0x11, 0x5c, 0x71, 0xd4, 0xde, 0xcd,
+	0x70, 0x57, 0x17, 0x67, 0x4f, 0x42, 0x1e, 0x72, 0x5d, 0xf0, 0xd2, 0x56, 0x36, 0xe7, 0xec, 0xf4,
+	0x0f, 0x0a, 0x5d, 0xd1, 0x58, 0xc9, 0xac, 0x74, 0xfe, 0xab, 0x01, 0xdd, 0x31, 0x4f, 0x18, 0xf1,
+	0x35, 0xdb, 0xd7, 0x68, 0x74, 0x0a, 0x47, 0x2a, 0x1d, 0x9c, 0xb0, 0xc0, 0xb6, 0x06, 0xd6, 0xb0,
+	0xe6, 0x37, 0x74, 0xff, 0x2a, 0x40, 0x4f, 0xa1, 0x3d, 0x5d, 0x70, 0x32, 0x9f, 0x44, 0x94, 0x85,
+	0x91, 0xb2, 0x0f, 0x06, 0xd6, 0xb0, 0xea, 0xb7, 0xf4, 0xd8, 0x07, 0x3d, 0x84, 0xae, 0xa1, 0x9d,
+	0xad, 0xce, 0xf6, 0x6b, 0x57, 0x07, 0xd6, 0xb0, 0x39, 0x7a, 0x79, 0xf7, 0xd0, 0xaf, 0x7c, 0x7f,
+	0xe8, 0x7b, 0x21, 0x53, 0xd1, 0xcd, 0xd4, 0x25, 0x7c, 0xe9, 0xe1, 0xc5, 0x82, 0x0b, 0xfc, 0x2c,
+	0xa6, 0xea, 0x96, 0x8b, 0x79, 0xd1, 0x25, 0x11, 0x66, 0xb1, 0xb7, 0xc4, 0x2a, 0x72, 0xdf, 0x52,
+	0xe2, 0xb7, 0xd4, 0x7e, 0x7f, 0x28, 0x80, 0x2e, 0x8b, 0x67, 0x54, 0xd0, 0x98, 0xd0, 0x09, 0x8d,
+	0x95, 0xe0, 0xc9, 0xda, 0xae, 0x99, 0x09, 0x1e, 0xef, 0x88, 0xef, 0x32, 0x20, 0x8a, 0xa0, 0x37,
+	0xe3, 0x82, 0x12, 0x2c, 0x15, 0x8b, 0xc3, 0x9d, 0xe7, 0xd0, 0xcc, 0x83, 0x4a, 0xcc, 0xc2, 0xf4,
+	0x19, 0x1e, 0x09, 0x9a, 0xdc, 0x28, 0x2a, 0x76, 0x96, 0xba, 0x99, 0xa5, 0x93, 0xf3, 0x0a, 0xc3,
+	0x1c, 0x8e, 0xf7, 0x27, 0xa6, 0xb0, 0x9c, 0x17, 0xd7, 0xd2, 0x30, 0xf3, 0xf4, 0x76, 0xd4, 0x31,
+	0x96, 0xf3, 0xfc, 0x7a, 0x38, 0x9c, 0x94, 0x0f, 0xae, 0xac, 0x3b, 0x32, 0xd3, 0x1d, 0x97, 0xb8,
+	0x25, 0x61, 0x08, 0xbd, 0xe2, 0xfc, 0xca, 0xb2, 0xa6, 0x99, 0xac, 0x9b, 0x33, 0x4b, 0xa2, 0x2b,
+	0xa8, 0x92, 0x88, 0xd9, 0x60, 0x06, 0x4e, 0x19, 0xe8, 0x23, 0x1c, 0x86, 0x78, 0xb9, 0xc4, 0x76,
+	0xcb, 0x0c, 0x96, 0x51, 0xd0, 0x6b, 0xa8, 0x63, 0xa2, 0xb8, 0x90, 0x76, 0x7b, 0x50, 0x1d, 0xb6,
+	0x2e, 0xfb, 0x6e, 0x39, 0x12, 0xdc, 0x37, 0x69, 0xad, 0xfc, 0xba, 0x47, 0xb5, 0x54, 0xe8, 0xe7,
+	0x8b, 0xce, 0x7f, 0x1e, 0x40, 0xf7, 0xaf, 0x39, 0xe8, 0x05, 0x80, 0xae, 0x4f, 0xd4, 0x3a, 0xa1,
+	0x3a, 0x03, 0x3a, 0x97, 0x27, 0xff, 0x00, 0x8f, 0xd7, 0x09, 0xf5, 0x9b, 0xb8, 0x68, 0x22, 0x1b,
+	0x1a, 0x38, 0x08, 0x04, 0x95, 0x52, 0x27, 0x43, 0xd3, 0x2f, 0xba, 0xe9, 0x57, 0x4b, 0xc2, 0x05,
+	0x35, 0x8d, 0x83, 0x8c, 0x92, 0x3d, 0x1c, 0x1d, 0x87, 0x33, 0x81, 0x89, 0x62, 0x3c, 0x36, 0x8d,
+	0x81, 0x4e, 0xc6, 0x7b, 0x9f, 0xe3, 0xd0, 0x27, 0xa8, 0xe7, 0x7f, 0x93, 0xe1, 0xbb, 0xcf, 0x31,
+	0x23, 0xff, 0x6e, 0xe3, 0x58, 0xf7, 0x1b, 0xc7, 0xfa, 0xb1, 0x71, 0xac, 0xaf, 0x5b, 0xa7, 0x72,
+	0xbf, 0x75, 0x2a, 0xdf, 0xb6, 0x4e, 0xe5, 0xfa, 0xd5, 0x7f, 0x22, 0xbf, 0x78, 0xfb, 0x24, 0x4f,
+	0x2f, 0x46, 0x4e, 0xeb, 0x3a, 0xc6, 0x9f, 0xff, 0x1e, 0x00, 0x59, 0x54, 0xdc, 0xd5, 0x29, 0x06,
+	0x00, 0x00,
+}
+
+func (m *TopicRewardReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicRewardReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicRewardReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actors) > 0 {
+		for iNdEx := len(m.Actors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardReport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.Gamma.Size()
+		i -= size
+		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Chi.Size()
+		i -= size
+		if _, err := m.Chi.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ReputerTaskReward.Size()
+		i -= size
+		if _, err := m.ReputerTaskReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ForecastingTaskReward.Size()
+		i -= size
+		if _, err := m.ForecastingTaskReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.InferenceTaskReward.Size()
+		i -= size
+		if _, err := m.InferenceTaskReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ReputerEntropy.Size()
+		i -= size
+		if _, err := m.ReputerEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ForecastingEntropy.Size()
+		i -= size
+		if _, err := m.ForecastingEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InferenceEntropy.Size()
+		i -= size
+		if _, err := m.InferenceEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TopicReward.Size()
+		i -= size
+		if _, err := m.TopicReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintRewardReport(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintRewardReport(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActorRewardReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorRewardReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorRewardReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardFraction.Size()
+		i -= size
+		if _, err := m.RewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRewardReport(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintRewardReport(dAtA, i, uint64(m.ActorType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewardReport(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewardReport(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TopicRewardReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovRewardReport(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovRewardReport(uint64(m.BlockHeight))
+	}
+	l = m.TopicReward.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.InferenceEntropy.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.ForecastingEntropy.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.ReputerEntropy.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.InferenceTaskReward.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.ForecastingTaskReward.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.ReputerTaskReward.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.Chi.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	if len(m.Actors) > 0 {
+		for _, e := range m.Actors {
+			l = e.Size()
+			n += 1 + l + sovRewardReport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActorRewardReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovRewardReport(uint64(m.ActorType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRewardReport(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.RewardFraction.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	l = m.Reward.Size()
+	n += 1 + l + sovRewardReport(uint64(l))
+	return n
+}
+
+func sovRewardReport(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewardReport(x uint64) (n int) {
+	return sovRewardReport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TopicRewardReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicRewardReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicRewardReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TopicReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InferenceEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastingEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForecastingEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceTaskReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InferenceTaskReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastingTaskReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForecastingTaskReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerTaskReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerTaskReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actors = append(m.Actors, ActorRewardReport{})
+			if err := m.Actors[len(m.Actors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorRewardReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorRewardReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorRewardReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewardReport(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewardReport
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewardReport
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewardReport
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewardReport
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewardReport        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewardReport          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewardReport = fmt.Errorf("proto: unexpected end of group")
+)
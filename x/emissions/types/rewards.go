@@ -10,9 +10,25 @@ const (
 	WorkerForecastRewardType
 )
 
+func (t TaskRewardType) String() string {
+	switch t {
+	case ReputerAndDelegatorRewardType:
+		return "reputer_and_delegator"
+	case WorkerInferenceRewardType:
+		return "worker_inference"
+	case WorkerForecastRewardType:
+		return "worker_forecast"
+	default:
+		return "unknown"
+	}
+}
+
 type TaskReward struct {
 	Address string
 	Reward  alloraMath.Dec
 	TopicId TopicId
 	Type    TaskRewardType
+	// Only set for reputers: their commission rate and the part of Reward they took from their delegators with it
+	CommissionRate alloraMath.Dec
+	Commission     alloraMath.Dec
 }
@@ -283,48 +283,473 @@ func (m *DelegatorInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorInfo proto.InternalMessageInfo
 
+// Reward accrued by the stake a delegator placed on a reputer in a topic and not paid out yet
+type DelegatorPendingReward struct {
+	TopicId       uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer       string                                          `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount        github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"amount"`
+	PendingReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=pending_reward,json=pendingReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"pending_reward"`
+}
+
+func (m *DelegatorPendingReward) Reset()         { *m = DelegatorPendingReward{} }
+func (m *DelegatorPendingReward) String() string { return proto.CompactTextString(m) }
+func (*DelegatorPendingReward) ProtoMessage()    {}
+func (*DelegatorPendingReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{5}
+}
+func (m *DelegatorPendingReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorPendingReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorPendingReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorPendingReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorPendingReward.Merge(m, src)
+}
+func (m *DelegatorPendingReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorPendingReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorPendingReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorPendingReward proto.InternalMessageInfo
+
+func (m *DelegatorPendingReward) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *DelegatorPendingReward) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+// Share of the rewards of the stake delegated to a reputer that the reputer keeps, modeled after x/staking validators
+type ReputerCommission struct {
+	Rate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"rate"`
+	// the rate may never exceed it, and it may not be changed once set
+	MaxRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_rate"`
+	// the rate may change by at most this much per day, and it may not be changed once set
+	MaxChangeRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_change_rate"`
+	// unix time in seconds of the last change of the rate
+	UpdateTime int64 `protobuf:"varint,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (m *ReputerCommission) Reset()         { *m = ReputerCommission{} }
+func (m *ReputerCommission) String() string { return proto.CompactTextString(m) }
+func (*ReputerCommission) ProtoMessage()    {}
+func (*ReputerCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{6}
+}
+func (m *ReputerCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputerCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerCommission.Merge(m, src)
+}
+func (m *ReputerCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerCommission proto.InternalMessageInfo
+
+func (m *ReputerCommission) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
+// Delegate stake moved from one reputer or topic to another. The record is kept for the stake removal delay
+// window so that the source position stays accountable for the stake it had when it was moved away.
+type Redelegation struct {
+	Delegator    string                `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	SrcTopicId   uint64                `protobuf:"varint,2,opt,name=src_topic_id,json=srcTopicId,proto3" json:"src_topic_id,omitempty"`
+	SrcReputer   string                `protobuf:"bytes,3,opt,name=src_reputer,json=srcReputer,proto3" json:"src_reputer,omitempty"`
+	DstTopicId   uint64                `protobuf:"varint,4,opt,name=dst_topic_id,json=dstTopicId,proto3" json:"dst_topic_id,omitempty"`
+	DstReputer   string                `protobuf:"bytes,5,opt,name=dst_reputer,json=dstReputer,proto3" json:"dst_reputer,omitempty"`
+	Amount       cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockStarted int64                 `protobuf:"varint,7,opt,name=block_started,json=blockStarted,proto3" json:"block_started,omitempty"`
+}
+
+func (m *Redelegation) Reset()         { *m = Redelegation{} }
+func (m *Redelegation) String() string { return proto.CompactTextString(m) }
+func (*Redelegation) ProtoMessage()    {}
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{7}
+}
+func (m *Redelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegation.Merge(m, src)
+}
+func (m *Redelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redelegation proto.InternalMessageInfo
+
+func (m *Redelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *Redelegation) GetSrcTopicId() uint64 {
+	if m != nil {
+		return m.SrcTopicId
+	}
+	return 0
+}
+
+func (m *Redelegation) GetSrcReputer() string {
+	if m != nil {
+		return m.SrcReputer
+	}
+	return ""
+}
+
+func (m *Redelegation) GetDstTopicId() uint64 {
+	if m != nil {
+		return m.DstTopicId
+	}
+	return 0
+}
+
+func (m *Redelegation) GetDstReputer() string {
+	if m != nil {
+		return m.DstReputer
+	}
+	return ""
+}
+
+func (m *Redelegation) GetBlockStarted() int64 {
+	if m != nil {
+		return m.BlockStarted
+	}
+	return 0
+}
+
+type Redelegations struct {
+	Redelegations []Redelegation `protobuf:"bytes,1,rep,name=redelegations,proto3" json:"redelegations"`
+}
+
+func (m *Redelegations) Reset()         { *m = Redelegations{} }
+func (m *Redelegations) String() string { return proto.CompactTextString(m) }
+func (*Redelegations) ProtoMessage()    {}
+func (*Redelegations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{8}
+}
+func (m *Redelegations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redelegations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redelegations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redelegations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegations.Merge(m, src)
+}
+func (m *Redelegations) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redelegations) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redelegations proto.InternalMessageInfo
+
+func (m *Redelegations) GetRedelegations() []Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+// Fraction of its topic-agnostic stake pool that a reputer allocates to a topic
+type ReputerStakeAllocation struct {
+	TopicId  uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Fraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"fraction"`
+}
+
+func (m *ReputerStakeAllocation) Reset()         { *m = ReputerStakeAllocation{} }
+func (m *ReputerStakeAllocation) String() string { return proto.CompactTextString(m) }
+func (*ReputerStakeAllocation) ProtoMessage()    {}
+func (*ReputerStakeAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{9}
+}
+func (m *ReputerStakeAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerStakeAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerStakeAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputerStakeAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerStakeAllocation.Merge(m, src)
+}
+func (m *ReputerStakeAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerStakeAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerStakeAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerStakeAllocation proto.InternalMessageInfo
+
+func (m *ReputerStakeAllocation) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+// Lower allocation of a reputer to a topic, waiting for the stake removal delay before it applies
+type ReputerStakeAllocationDecrease struct {
+	BlockRemovalStarted int64                  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	Reputer             string                 `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Allocation          ReputerStakeAllocation `protobuf:"bytes,3,opt,name=allocation,proto3" json:"allocation"`
+}
+
+func (m *ReputerStakeAllocationDecrease) Reset()         { *m = ReputerStakeAllocationDecrease{} }
+func (m *ReputerStakeAllocationDecrease) String() string { return proto.CompactTextString(m) }
+func (*ReputerStakeAllocationDecrease) ProtoMessage()    {}
+func (*ReputerStakeAllocationDecrease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{10}
+}
+func (m *ReputerStakeAllocationDecrease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerStakeAllocationDecrease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerStakeAllocationDecrease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputerStakeAllocationDecrease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerStakeAllocationDecrease.Merge(m, src)
+}
+func (m *ReputerStakeAllocationDecrease) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerStakeAllocationDecrease) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerStakeAllocationDecrease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerStakeAllocationDecrease proto.InternalMessageInfo
+
+func (m *ReputerStakeAllocationDecrease) GetBlockRemovalStarted() int64 {
+	if m != nil {
+		return m.BlockRemovalStarted
+	}
+	return 0
+}
+
+func (m *ReputerStakeAllocationDecrease) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *ReputerStakeAllocationDecrease) GetAllocation() ReputerStakeAllocation {
+	if m != nil {
+		return m.Allocation
+	}
+	return ReputerStakeAllocation{}
+}
+
+// Stake leaving the stake pool of a reputer, waiting for the stake removal delay
+type ReputerStakePoolRemoval struct {
+	BlockRemovalStarted int64                 `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	Reputer             string                `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount              cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ReputerStakePoolRemoval) Reset()         { *m = ReputerStakePoolRemoval{} }
+func (m *ReputerStakePoolRemoval) String() string { return proto.CompactTextString(m) }
+func (*ReputerStakePoolRemoval) ProtoMessage()    {}
+func (*ReputerStakePoolRemoval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{11}
+}
+func (m *ReputerStakePoolRemoval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerStakePoolRemoval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerStakePoolRemoval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputerStakePoolRemoval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerStakePoolRemoval.Merge(m, src)
+}
+func (m *ReputerStakePoolRemoval) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerStakePoolRemoval) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerStakePoolRemoval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerStakePoolRemoval proto.InternalMessageInfo
+
+func (m *ReputerStakePoolRemoval) GetBlockRemovalStarted() int64 {
+	if m != nil {
+		return m.BlockRemovalStarted
+	}
+	return 0
+}
+
+func (m *ReputerStakePoolRemoval) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StakePlacement)(nil), "emissions.v1.StakePlacement")
 	proto.RegisterType((*StakeRemoval)(nil), "emissions.v1.StakeRemoval")
 	proto.RegisterType((*DelegateStakePlacement)(nil), "emissions.v1.DelegateStakePlacement")
 	proto.RegisterType((*DelegateStakeRemoval)(nil), "emissions.v1.DelegateStakeRemoval")
 	proto.RegisterType((*DelegatorInfo)(nil), "emissions.v1.DelegatorInfo")
+	proto.RegisterType((*DelegatorPendingReward)(nil), "emissions.v1.DelegatorPendingReward")
+	proto.RegisterType((*ReputerCommission)(nil), "emissions.v1.ReputerCommission")
+	proto.RegisterType((*Redelegation)(nil), "emissions.v1.Redelegation")
+	proto.RegisterType((*Redelegations)(nil), "emissions.v1.Redelegations")
+	proto.RegisterType((*ReputerStakeAllocation)(nil), "emissions.v1.ReputerStakeAllocation")
+	proto.RegisterType((*ReputerStakeAllocationDecrease)(nil), "emissions.v1.ReputerStakeAllocationDecrease")
+	proto.RegisterType((*ReputerStakePoolRemoval)(nil), "emissions.v1.ReputerStakePoolRemoval")
 }
 
 func init() { proto.RegisterFile("emissions/v1/stake.proto", fileDescriptor_f03692b073d250c3) }
 
 var fileDescriptor_f03692b073d250c3 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0xb4, 0x6a, 0xc9, 0xb5, 0x20, 0x61, 0x5a, 0xe4, 0x56, 0x95, 0x5b, 0x45, 0x0c,
-	0x15, 0x52, 0x6d, 0x5a, 0x06, 0x10, 0x63, 0x94, 0x81, 0x4c, 0x20, 0x77, 0x41, 0x2c, 0xd6, 0xd9,
-	0x7e, 0x24, 0x56, 0xec, 0x7b, 0xd6, 0xdd, 0x4b, 0x0a, 0x0b, 0x1f, 0x01, 0xf8, 0x18, 0x8c, 0x0c,
-	0xb0, 0xf0, 0x09, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0xc0, 0xd7, 0x40, 0xb9, 0xb3, 0x9b,
-	0x44, 0x30, 0x20, 0xa5, 0x8b, 0xe5, 0xf7, 0xfe, 0xcf, 0xcf, 0xff, 0xff, 0x4f, 0x7a, 0xdc, 0x85,
-	0x22, 0xd3, 0x3a, 0x43, 0xa9, 0x83, 0xd1, 0x71, 0xa0, 0x49, 0x0c, 0xc0, 0x2f, 0x15, 0x12, 0x3a,
-	0x9b, 0x57, 0x8a, 0x3f, 0x3a, 0xde, 0xdd, 0x49, 0x50, 0x17, 0xa8, 0x23, 0xa3, 0x05, 0xb6, 0xb0,
-	0x83, 0xbb, 0x77, 0x44, 0x91, 0x49, 0x0c, 0xcc, 0xb3, 0x6a, 0x6d, 0xf5, 0xb0, 0x87, 0x76, 0x74,
-	0xfa, 0x66, 0xbb, 0xad, 0x0f, 0x8c, 0xdf, 0x3e, 0x9d, 0xfe, 0xe1, 0x45, 0x2e, 0x12, 0x28, 0x40,
-	0x92, 0xb3, 0xc3, 0x6f, 0x12, 0x96, 0x59, 0x12, 0x65, 0xa9, 0xcb, 0x0e, 0xd8, 0xe1, 0x6a, 0xb8,
-	0x6e, 0xea, 0x6e, 0xea, 0xb8, 0x7c, 0x5d, 0x41, 0x39, 0x24, 0x50, 0xee, 0x8d, 0x03, 0x76, 0xd8,
-	0x0c, 0xeb, 0xd2, 0x79, 0xc6, 0xd7, 0x44, 0x81, 0x43, 0x49, 0xee, 0xca, 0x54, 0x68, 0x3f, 0x3c,
-	0xbf, 0xdc, 0x6f, 0xfc, 0xbc, 0xdc, 0xdf, 0xb6, 0xb6, 0x74, 0x3a, 0xf0, 0x33, 0x0c, 0x0a, 0x41,
-	0x7d, 0xbf, 0x2b, 0xe9, 0xfb, 0x97, 0x23, 0x5e, 0xf9, 0xed, 0x4a, 0xfa, 0xf4, 0xfb, 0xf3, 0x03,
-	0x16, 0x56, 0xdf, 0xb7, 0xde, 0xf1, 0x4d, 0x63, 0x28, 0x84, 0x02, 0x47, 0x22, 0x77, 0x4e, 0xf8,
-	0x76, 0x9c, 0x63, 0x32, 0x88, 0x94, 0x6d, 0x44, 0x9a, 0x84, 0x22, 0xb0, 0xde, 0x56, 0xc2, 0xbb,
-	0x46, 0xac, 0x86, 0x4f, 0xad, 0xe4, 0x3c, 0xe5, 0xcd, 0xb2, 0xce, 0x63, 0x9c, 0x6e, 0x9c, 0xec,
-	0xf9, 0xf3, 0xec, 0xfc, 0xc5, 0xcc, 0xe1, 0x6c, 0xbc, 0xf5, 0x95, 0xf1, 0x7b, 0x1d, 0xc8, 0xa1,
-	0x27, 0x08, 0xae, 0x83, 0xcc, 0x1e, 0x6f, 0xa6, 0x76, 0x1d, 0x2a, 0x0b, 0x27, 0x9c, 0x35, 0xe6,
-	0xb8, 0xad, 0x2e, 0xc9, 0xed, 0x3d, 0xe3, 0x5b, 0x0b, 0xbe, 0x97, 0x01, 0xd8, 0xfe, 0x1b, 0xe0,
-	0xfd, 0x45, 0x80, 0xff, 0x46, 0x34, 0x0f, 0xf2, 0x1b, 0xe3, 0xb7, 0x3a, 0x75, 0xd0, 0xae, 0x7c,
-	0x8d, 0xce, 0xf3, 0xab, 0xb0, 0xcc, 0x84, 0x7d, 0x5c, 0x85, 0x0d, 0x7a, 0x19, 0xf5, 0x87, 0xb1,
-	0x9f, 0x60, 0x11, 0x88, 0x3c, 0x47, 0x25, 0x8e, 0x24, 0xd0, 0x19, 0xaa, 0x41, 0x5d, 0x26, 0x7d,
-	0x91, 0x49, 0x8b, 0xa1, 0x03, 0x49, 0x9d, 0xd9, 0x79, 0xc9, 0x37, 0x14, 0x9c, 0x09, 0x95, 0x46,
-	0x29, 0xc4, 0xd6, 0xe8, 0x12, 0x5b, 0xb9, 0xdd, 0xd5, 0x81, 0x98, 0xda, 0xe1, 0xf9, 0xd8, 0x63,
-	0x17, 0x63, 0x8f, 0xfd, 0x1a, 0x7b, 0xec, 0xe3, 0xc4, 0x6b, 0x5c, 0x4c, 0xbc, 0xc6, 0x8f, 0x89,
-	0xd7, 0x78, 0xf5, 0xe4, 0x3f, 0xd7, 0xbe, 0x09, 0x66, 0x67, 0x4c, 0x6f, 0x4b, 0xd0, 0xf1, 0x9a,
-	0x39, 0xb9, 0x47, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x25, 0xc3, 0xee, 0x36, 0xe0, 0x03, 0x00,
-	0x00,
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0xf3, 0x44,
+	0x10, 0xcd, 0x26, 0xa1, 0x69, 0x27, 0xc9, 0x87, 0x3e, 0xd3, 0x16, 0xb7, 0xaa, 0x92, 0xc8, 0x70,
+	0xa8, 0x90, 0x1a, 0xd3, 0x72, 0x00, 0x71, 0x23, 0x8d, 0x10, 0x81, 0x43, 0xab, 0x6d, 0x25, 0x10,
+	0x07, 0xac, 0x8d, 0xbd, 0x4d, 0xac, 0xd8, 0x5e, 0x6b, 0x77, 0xd3, 0x86, 0x0b, 0x67, 0x4e, 0xc0,
+	0x5f, 0xe0, 0xc6, 0x11, 0x24, 0xe0, 0xc0, 0x2f, 0xe8, 0xb1, 0xe2, 0x84, 0x38, 0x54, 0xa8, 0x3d,
+	0xf0, 0x2f, 0x10, 0xf2, 0xae, 0x9d, 0x38, 0x6d, 0x40, 0xa8, 0x46, 0xdf, 0xa5, 0xea, 0xce, 0xcc,
+	0x3e, 0xbf, 0x79, 0x33, 0x7e, 0x31, 0x98, 0x34, 0xf4, 0x85, 0xf0, 0x59, 0x24, 0xec, 0xcb, 0x43,
+	0x5b, 0x48, 0x32, 0xa1, 0xdd, 0x98, 0x33, 0xc9, 0x8c, 0xc6, 0x3c, 0xd3, 0xbd, 0x3c, 0xdc, 0xdd,
+	0x71, 0x99, 0x08, 0x99, 0x70, 0x54, 0xce, 0xd6, 0x07, 0x5d, 0xb8, 0xfb, 0x9c, 0x84, 0x7e, 0xc4,
+	0x6c, 0xf5, 0x37, 0x0d, 0x6d, 0x8e, 0xd8, 0x88, 0xe9, 0xd2, 0xe4, 0x3f, 0x1d, 0xb5, 0xbe, 0x46,
+	0xf0, 0xec, 0x2c, 0x79, 0xc2, 0x69, 0x40, 0x5c, 0x1a, 0xd2, 0x48, 0x1a, 0x3b, 0xb0, 0x2e, 0x59,
+	0xec, 0xbb, 0x8e, 0xef, 0x99, 0xa8, 0x83, 0xf6, 0xab, 0xb8, 0xa6, 0xce, 0x03, 0xcf, 0x30, 0xa1,
+	0xc6, 0x69, 0x3c, 0x95, 0x94, 0x9b, 0xe5, 0x0e, 0xda, 0xdf, 0xc0, 0xd9, 0xd1, 0xf8, 0x00, 0xd6,
+	0x48, 0xc8, 0xa6, 0x91, 0x34, 0x2b, 0x49, 0xa2, 0xf7, 0xe6, 0xf5, 0x6d, 0xbb, 0xf4, 0xfb, 0x6d,
+	0x7b, 0x4b, 0xd3, 0x12, 0xde, 0xa4, 0xeb, 0x33, 0x3b, 0x24, 0x72, 0xdc, 0x1d, 0x44, 0xf2, 0xd7,
+	0x1f, 0x0f, 0x20, 0xe5, 0x3b, 0x88, 0xe4, 0x77, 0x7f, 0x7e, 0xff, 0x06, 0xc2, 0xe9, 0x7d, 0xeb,
+	0x0b, 0x68, 0x28, 0x42, 0x98, 0x86, 0xec, 0x92, 0x04, 0xc6, 0x11, 0x6c, 0x0d, 0x03, 0xe6, 0x4e,
+	0x1c, 0xae, 0x03, 0x8e, 0x90, 0x84, 0x4b, 0xaa, 0xb9, 0x55, 0xf0, 0x2b, 0x2a, 0x99, 0x16, 0x9f,
+	0xe9, 0x94, 0xf1, 0x2e, 0x6c, 0xc4, 0x59, 0x3f, 0x8a, 0x69, 0xfd, 0x68, 0xaf, 0x9b, 0xd7, 0xae,
+	0xbb, 0xdc, 0x33, 0x5e, 0x94, 0x5b, 0x3f, 0x21, 0xd8, 0xee, 0xd3, 0x80, 0x8e, 0x88, 0xa4, 0xff,
+	0x87, 0x32, 0x7b, 0xb0, 0xe1, 0x69, 0x38, 0xc6, 0xb5, 0x38, 0x78, 0x11, 0xc8, 0xe9, 0x56, 0x2d,
+	0xa8, 0xdb, 0x57, 0x08, 0x36, 0x97, 0x78, 0x17, 0x11, 0xb0, 0xf7, 0x58, 0xc0, 0xd7, 0x97, 0x05,
+	0x5c, 0x2d, 0x51, 0x5e, 0xc8, 0x5f, 0x10, 0x34, 0xfb, 0x59, 0xa3, 0x83, 0xe8, 0x82, 0x19, 0x27,
+	0xf3, 0x66, 0x91, 0x6a, 0xf6, 0xed, 0xb4, 0x59, 0x7b, 0xe4, 0xcb, 0xf1, 0x74, 0xd8, 0x75, 0x59,
+	0x68, 0x93, 0x20, 0x60, 0x9c, 0x1c, 0x44, 0x54, 0x5e, 0x31, 0x3e, 0xc9, 0x8e, 0xee, 0x98, 0xf8,
+	0x91, 0x96, 0xa1, 0x4f, 0xdd, 0xac, 0x67, 0xe3, 0x13, 0xa8, 0x73, 0x7a, 0x45, 0xb8, 0xe7, 0x78,
+	0x74, 0xa8, 0x89, 0x16, 0x40, 0x05, 0x8d, 0xd5, 0xa7, 0x43, 0x69, 0xfd, 0xb5, 0xd8, 0x02, 0xc6,
+	0x4f, 0x69, 0xe4, 0xf9, 0xd1, 0x08, 0xab, 0xf4, 0xd3, 0xb6, 0xe0, 0xe4, 0xc1, 0xfb, 0x51, 0xb8,
+	0xf5, 0xcf, 0xe0, 0x59, 0xac, 0x69, 0x39, 0x9a, 0xb6, 0x59, 0x2d, 0x06, 0xdc, 0x8c, 0xf3, 0x5d,
+	0x5a, 0x3f, 0x97, 0xe1, 0x39, 0xd6, 0xe4, 0x8f, 0x59, 0x98, 0x4e, 0xde, 0xf8, 0x08, 0xaa, 0x9c,
+	0x48, 0x5a, 0x74, 0x7e, 0x0a, 0xc4, 0xc0, 0xb0, 0x1e, 0x92, 0x99, 0xa3, 0x00, 0x0b, 0x8e, 0xae,
+	0x16, 0x92, 0x19, 0x4e, 0x30, 0x1d, 0x78, 0x39, 0xc1, 0x74, 0xc7, 0x24, 0x1a, 0x51, 0x0d, 0x5d,
+	0x50, 0xf0, 0x66, 0x48, 0x66, 0xc7, 0x0a, 0x4e, 0x3d, 0xa0, 0x0d, 0xf5, 0x69, 0xec, 0x11, 0x49,
+	0x1d, 0xe9, 0x87, 0x54, 0x89, 0x5e, 0xc1, 0xa0, 0x43, 0xe7, 0x7e, 0x48, 0xad, 0x6f, 0xcb, 0xd0,
+	0xc0, 0x34, 0x7d, 0xc3, 0x13, 0xcd, 0x96, 0x0c, 0x00, 0x3d, 0x34, 0x80, 0x0e, 0x34, 0x04, 0x77,
+	0x9d, 0xf9, 0x46, 0x95, 0xd5, 0x46, 0x81, 0xe0, 0xee, 0x79, 0xba, 0x54, 0x6d, 0xa8, 0x27, 0x15,
+	0xd9, 0x62, 0x69, 0x0b, 0x49, 0x0a, 0xd2, 0xf1, 0x24, 0x10, 0x9e, 0x90, 0x0b, 0x88, 0xaa, 0x86,
+	0xf0, 0x84, 0xcc, 0x41, 0x24, 0x15, 0x19, 0xc4, 0x4b, 0x1a, 0xc2, 0x13, 0x12, 0x3f, 0xb2, 0xef,
+	0xb5, 0x62, 0x36, 0x64, 0xbc, 0x06, 0x4d, 0xed, 0x36, 0x99, 0xcb, 0xd4, 0x94, 0x42, 0x0d, 0x15,
+	0x4c, 0xed, 0xc5, 0xfa, 0x18, 0x9a, 0x79, 0x89, 0x84, 0xf1, 0x3e, 0x34, 0x79, 0x3e, 0x60, 0xa2,
+	0x4e, 0x65, 0xbf, 0x7e, 0xb4, 0xbb, 0xec, 0x39, 0xf9, 0x3b, 0xbd, 0x6a, 0x42, 0x11, 0x2f, 0x5f,
+	0xb3, 0xbe, 0x44, 0xb0, 0x9d, 0xf6, 0xa4, 0x8c, 0xe9, 0xbd, 0x20, 0x60, 0xae, 0x1e, 0xc3, 0xbf,
+	0xbc, 0xb6, 0x67, 0xb0, 0x7e, 0xc1, 0x89, 0x9b, 0x94, 0x15, 0x5d, 0xc4, 0x39, 0x50, 0x62, 0x7f,
+	0xad, 0xd5, 0x54, 0xfa, 0xd4, 0xe5, 0x94, 0x08, 0xfa, 0x24, 0x67, 0xfe, 0x67, 0x8b, 0xf9, 0x10,
+	0x80, 0xcc, 0x9f, 0x61, 0x56, 0x56, 0x99, 0xf6, 0x6a, 0x3e, 0xa9, 0x94, 0xb9, 0xdb, 0xd6, 0x0f,
+	0x08, 0x5e, 0xcd, 0x17, 0x9f, 0x32, 0x16, 0x14, 0xf9, 0x3d, 0x79, 0x01, 0x1f, 0x0e, 0x3d, 0x7c,
+	0x7d, 0xd7, 0x42, 0x37, 0x77, 0x2d, 0xf4, 0xc7, 0x5d, 0x0b, 0x7d, 0x73, 0xdf, 0x2a, 0xdd, 0xdc,
+	0xb7, 0x4a, 0xbf, 0xdd, 0xb7, 0x4a, 0x9f, 0xbe, 0xf3, 0x1f, 0xa7, 0x38, 0xb3, 0x17, 0x5f, 0x5e,
+	0xf2, 0xf3, 0x98, 0x8a, 0xe1, 0x9a, 0xfa, 0x4a, 0x7a, 0xeb, 0xef, 0x01, 0x00, 0x6a, 0x08, 0xeb,
+	0xe2, 0x93, 0x09, 0x00, 0x00,
 }
 
 func (m *StakePlacement) Marshal() (dAtA []byte, err error) {
@@ -547,142 +972,1677 @@ func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStake(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DelegatorPendingReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StakePlacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
+
+func (m *DelegatorPendingReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorPendingReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PendingReward.Size()
+		i -= size
+		if _, err := m.PendingReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StakeRemoval) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReputerCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	if m.UpdateTime != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.UpdateTime))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Placement != nil {
-		l = m.Placement.Size()
-		n += 1 + l + sovStake(uint64(l))
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegateStakePlacement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
+	if m.BlockStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockStarted))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.DstReputer) > 0 {
+		i -= len(m.DstReputer)
+		copy(dAtA[i:], m.DstReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.DstReputer)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	if m.DstTopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.DstTopicId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SrcReputer) > 0 {
+		i -= len(m.SrcReputer)
+		copy(dAtA[i:], m.SrcReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.SrcReputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SrcTopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.SrcTopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegateStakeRemoval) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Redelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
-	}
-	if m.Placement != nil {
-		l = m.Placement.Size()
-		n += 1 + l + sovStake(uint64(l))
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReputerStakeAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerStakeAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerStakeAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	l = m.RewardDebt.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovStake(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ReputerStakeAllocationDecrease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozStake(x uint64) (n int) {
-	return sovStake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ReputerStakeAllocationDecrease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *StakePlacement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStake
+
+func (m *ReputerStakeAllocationDecrease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReputerStakePoolRemoval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerStakePoolRemoval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerStakePoolRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStake(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StakePlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *StakeRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.Placement != nil {
+		l = m.Placement.Size()
+		n += 1 + l + sovStake(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateStakePlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *DelegateStakeRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.Placement != nil {
+		l = m.Placement.Size()
+		n += 1 + l + sovStake(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.RewardDebt.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *DelegatorPendingReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.PendingReward.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *ReputerCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.UpdateTime != 0 {
+		n += 1 + sovStake(uint64(m.UpdateTime))
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.SrcTopicId != 0 {
+		n += 1 + sovStake(uint64(m.SrcTopicId))
+	}
+	l = len(m.SrcReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.DstTopicId != 0 {
+		n += 1 + sovStake(uint64(m.DstTopicId))
+	}
+	l = len(m.DstReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockStarted))
+	}
+	return n
+}
+
+func (m *Redelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovStake(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReputerStakeAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *ReputerStakeAllocationDecrease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Allocation.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *ReputerStakePoolRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func sovStake(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStake(x uint64) (n int) {
+	return sovStake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StakePlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakePlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeRemoval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeRemoval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeRemoval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Placement == nil {
+				m.Placement = &StakePlacement{}
+			}
+			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakePlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakeRemoval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakeRemoval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakeRemoval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Placement == nil {
+				m.Placement = &DelegateStakePlacement{}
+			}
+			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorPendingReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorPendingReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorPendingReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReputerCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReputerCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReputerCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			m.UpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcTopicId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.SrcTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcReputer", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StakePlacement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstTopicId", wireType)
 			}
-			m.TopicId = 0
+			m.DstTopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -692,14 +2652,14 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				m.DstTopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -727,9 +2687,9 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.DstReputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -763,6 +2723,25 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockStarted", wireType)
+			}
+			m.BlockStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -784,7 +2763,7 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeRemoval) Unmarshal(dAtA []byte) error {
+func (m *Redelegations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -807,34 +2786,15 @@ func (m *StakeRemoval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeRemoval: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeRemoval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
-			}
-			m.BlockRemovalStarted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -861,10 +2821,8 @@ func (m *StakeRemoval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Placement == nil {
-				m.Placement = &StakePlacement{}
-			}
-			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegations = append(m.Redelegations, Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -889,7 +2847,7 @@ func (m *StakeRemoval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
+func (m *ReputerStakeAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -912,10 +2870,10 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakePlacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerStakeAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerStakeAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -939,71 +2897,7 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1031,7 +2925,7 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1056,7 +2950,7 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakeRemoval) Unmarshal(dAtA []byte) error {
+func (m *ReputerStakeAllocationDecrease) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1079,10 +2973,10 @@ func (m *DelegateStakeRemoval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakeRemoval: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerStakeAllocationDecrease: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakeRemoval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerStakeAllocationDecrease: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1106,7 +3000,39 @@ func (m *DelegateStakeRemoval) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1133,10 +3059,7 @@ func (m *DelegateStakeRemoval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Placement == nil {
-				m.Placement = &DelegateStakePlacement{}
-			}
-			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1161,7 +3084,7 @@ func (m *DelegateStakeRemoval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
+func (m *ReputerStakePoolRemoval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1184,15 +3107,34 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerStakePoolRemoval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerStakePoolRemoval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1220,13 +3162,11 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1254,7 +3194,7 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
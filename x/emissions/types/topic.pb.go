@@ -42,6 +42,11 @@ type Topic struct {
 	PNorm           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm"`
 	AlphaRegret     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
 	AllowNegative   bool                                            `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
+	// number of blocks after a worker nonce is opened during which workers may
+	// commit to their payloads. 0 disables commit-reveal for the topic
+	CommitRevealWindow int64 `protobuf:"varint,15,opt,name=commit_reveal_window,json=commitRevealWindow,proto3" json:"commit_reveal_window,omitempty"`
+	// only workers and reputers in the allowlist of the topic may register to it
+	IsPermissioned bool `protobuf:"varint,16,opt,name=is_permissioned,json=isPermissioned,proto3" json:"is_permissioned,omitempty"`
 }
 
 func (m *Topic) Reset()         { *m = Topic{} }
@@ -161,6 +166,20 @@ func (m *Topic) GetAllowNegative() bool {
 	return false
 }
 
+func (m *Topic) GetCommitRevealWindow() int64 {
+	if m != nil {
+		return m.CommitRevealWindow
+	}
+	return 0
+}
+
+func (m *Topic) GetIsPermissioned() bool {
+	if m != nil {
+		return m.IsPermissioned
+	}
+	return false
+}
+
 type TopicList struct {
 	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
@@ -260,43 +279,46 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/topic.proto", fileDescriptor_ae5610c9d5deb158) }
 
 var fileDescriptor_ae5610c9d5deb158 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc6, 0xe3, 0xa6, 0x49, 0x9b, 0x4d, 0x9a, 0xf6, 0xbf, 0xff, 0x22, 0x2d, 0x95, 0x70, 0x43,
-	0x25, 0x84, 0x01, 0xd5, 0xa6, 0x70, 0xa0, 0x57, 0x2a, 0x40, 0x2a, 0x0a, 0x3d, 0x58, 0x3d, 0xf5,
-	0x62, 0x6d, 0xed, 0xe9, 0x7a, 0x55, 0x7b, 0xd7, 0xda, 0xdd, 0xa4, 0xf0, 0x16, 0x3c, 0x06, 0x47,
-	0x0e, 0x3c, 0x44, 0x0f, 0x1c, 0x2a, 0x4e, 0x88, 0x43, 0x85, 0xda, 0x03, 0xaf, 0x81, 0xbc, 0xeb,
-	0x24, 0x1c, 0x91, 0xb8, 0x58, 0x9e, 0xdf, 0xf7, 0xf9, 0xb3, 0x66, 0x34, 0x83, 0x08, 0x94, 0x5c,
-	0x6b, 0x2e, 0x85, 0x8e, 0xa6, 0x7b, 0x91, 0x91, 0x15, 0x4f, 0xc3, 0x4a, 0x49, 0x23, 0xf1, 0x60,
-	0xae, 0x84, 0xd3, 0xbd, 0xad, 0xbb, 0xa9, 0xd4, 0xa5, 0xd4, 0x89, 0xd5, 0x22, 0x57, 0x38, 0xe3,
-	0xd6, 0x7f, 0xb4, 0xe4, 0x42, 0x46, 0xf6, 0xd9, 0xa0, 0x4d, 0x26, 0x99, 0x74, 0xd6, 0xfa, 0xcd,
-	0xd1, 0x9d, 0xaf, 0xcb, 0xa8, 0x73, 0x5c, 0xff, 0x01, 0x0f, 0xd1, 0x12, 0xcf, 0x88, 0x37, 0xf2,
-	0x82, 0xe5, 0x78, 0x89, 0x67, 0x98, 0xa0, 0x95, 0x54, 0x01, 0x35, 0x52, 0x91, 0xa5, 0x91, 0x17,
-	0xf4, 0xe2, 0x59, 0x89, 0xb7, 0xd0, 0x6a, 0x09, 0x86, 0x66, 0xd4, 0x50, 0xd2, 0xb6, 0xd2, 0xbc,
-	0xc6, 0xf7, 0x10, 0x2a, 0xa4, 0xd6, 0x49, 0x21, 0x19, 0x4f, 0xc9, 0xb2, 0x55, 0x7b, 0x35, 0x19,
-	0xd7, 0x00, 0x6f, 0xa3, 0xbe, 0x95, 0x4b, 0x30, 0xb9, 0xcc, 0x48, 0xc7, 0xea, 0xf6, 0x8b, 0x77,
-	0x96, 0xe0, 0x87, 0x68, 0x9d, 0x8b, 0x33, 0x50, 0x20, 0x52, 0x68, 0x42, 0xba, 0xd6, 0x34, 0x9c,
-	0x63, 0x97, 0xf4, 0x08, 0x6d, 0x2c, 0x8c, 0x4d, 0xdc, 0x8a, 0x75, 0x2e, 0x02, 0x9a, 0xcc, 0x00,
-	0x6d, 0x40, 0x25, 0xd3, 0x3c, 0x29, 0xa8, 0x36, 0x09, 0x88, 0x0c, 0x32, 0xb2, 0x3a, 0xf2, 0x82,
-	0x76, 0x3c, 0xb4, 0x7c, 0x4c, 0xb5, 0x79, 0x5d, 0x53, 0x7c, 0x1f, 0x0d, 0x1a, 0x27, 0x08, 0x66,
-	0x72, 0xd2, 0xb3, 0xae, 0xbe, 0x73, 0x59, 0x54, 0x87, 0x31, 0x25, 0x27, 0x22, 0x4b, 0x8c, 0x9a,
-	0x98, 0x3a, 0x93, 0x11, 0xe4, 0xc2, 0x1c, 0x3f, 0xae, 0xf1, 0x98, 0xb2, 0xba, 0xd7, 0x0c, 0xce,
-	0xe8, 0xa4, 0x30, 0x09, 0x55, 0x8c, 0xf4, 0x5d, 0xaf, 0x0d, 0x7a, 0xa9, 0x18, 0x3e, 0x42, 0xdd,
-	0x2a, 0x11, 0x52, 0x95, 0x64, 0x50, 0x6b, 0x07, 0x2f, 0x2e, 0xaf, 0xb7, 0x5b, 0x3f, 0xae, 0xb7,
-	0x23, 0xc6, 0x4d, 0x3e, 0x39, 0x0d, 0x53, 0x59, 0x46, 0xb4, 0x28, 0xa4, 0xa2, 0xbb, 0x02, 0xcc,
-	0x85, 0x54, 0xe7, 0xb3, 0x32, 0xcd, 0x29, 0x17, 0x51, 0x49, 0x4d, 0x1e, 0xbe, 0x82, 0x34, 0xee,
-	0x54, 0x47, 0x52, 0x95, 0xf8, 0x04, 0x0d, 0x68, 0x51, 0xe5, 0x34, 0x51, 0xc0, 0x14, 0x18, 0xb2,
-	0xf6, 0x6f, 0xa9, 0x7d, 0x1b, 0x16, 0xdb, 0x2c, 0xfc, 0x00, 0x0d, 0x6b, 0xd7, 0x45, 0x22, 0x80,
-	0x51, 0xc3, 0xa7, 0x40, 0x86, 0x23, 0x2f, 0x58, 0x8d, 0xd7, 0x2c, 0x3d, 0x6a, 0xe0, 0xce, 0x3e,
-	0xea, 0xd9, 0x6d, 0x1a, 0x73, 0x6d, 0xf0, 0x13, 0xd4, 0xb5, 0xcb, 0xab, 0x89, 0x37, 0x6a, 0x07,
-	0xfd, 0x67, 0xff, 0x87, 0x7f, 0xae, 0x6f, 0x68, 0x8d, 0x71, 0x63, 0xd9, 0xd1, 0x68, 0xdd, 0x82,
-	0x37, 0x00, 0x31, 0x4c, 0x41, 0x4c, 0x00, 0x6f, 0xa2, 0x8e, 0x9d, 0xbc, 0x5d, 0xca, 0x76, 0xec,
-	0x0a, 0xfc, 0x16, 0xad, 0x28, 0x67, 0x70, 0x7b, 0x79, 0xf0, 0xb4, 0x69, 0xf0, 0x8e, 0xbb, 0x00,
-	0x9d, 0x9d, 0x87, 0x5c, 0xba, 0x36, 0x0e, 0x85, 0xf9, 0xf6, 0x65, 0x17, 0x35, 0xa7, 0x71, 0x28,
-	0xcc, 0xa7, 0x5f, 0x9f, 0x1f, 0x7b, 0xf1, 0x2c, 0xe0, 0x20, 0xbe, 0xbc, 0xf1, 0xbd, 0xab, 0x1b,
-	0xdf, 0xfb, 0x79, 0xe3, 0x7b, 0x1f, 0x6f, 0xfd, 0xd6, 0xd5, 0xad, 0xdf, 0xfa, 0x7e, 0xeb, 0xb7,
-	0x4e, 0xf6, 0xff, 0x72, 0x5a, 0xef, 0xa3, 0xc5, 0xb1, 0x9a, 0x0f, 0x15, 0xe8, 0xd3, 0xae, 0x3d,
-	0xac, 0xe7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x72, 0x59, 0x3f, 0x19, 0xc6, 0x03, 0x00, 0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xa6, 0x49, 0x9b, 0x49, 0x9a, 0xf6, 0x9b, 0xaf, 0x48, 0x43, 0x25, 0xd2, 0x50,
+	0x09, 0x11, 0x40, 0xb5, 0x5b, 0x58, 0xd0, 0x2d, 0x15, 0x20, 0x15, 0x85, 0x0a, 0x59, 0x95, 0x90,
+	0xba, 0xb1, 0xa6, 0xf6, 0xad, 0x3d, 0xaa, 0x3d, 0x63, 0xcd, 0x4c, 0x12, 0x78, 0x0b, 0xde, 0x02,
+	0x96, 0x2c, 0x78, 0x88, 0x2e, 0x2b, 0x56, 0x88, 0x45, 0x85, 0xda, 0x05, 0xaf, 0x81, 0x7c, 0xc7,
+	0xfd, 0xb3, 0x44, 0x62, 0x63, 0xf9, 0xfe, 0xce, 0xf1, 0x19, 0xcd, 0xcc, 0xbd, 0x26, 0x0c, 0x0a,
+	0x61, 0x8c, 0x50, 0xd2, 0x04, 0xd3, 0xed, 0xc0, 0xaa, 0x52, 0xc4, 0x7e, 0xa9, 0x95, 0x55, 0xb4,
+	0x77, 0xad, 0xf8, 0xd3, 0xed, 0xb5, 0xbb, 0xb1, 0x32, 0x85, 0x32, 0x11, 0x6a, 0x81, 0x2b, 0x9c,
+	0x71, 0xed, 0x3f, 0x5e, 0x08, 0xa9, 0x02, 0x7c, 0xd6, 0x68, 0x35, 0x55, 0xa9, 0x72, 0xd6, 0xea,
+	0xcd, 0xd1, 0x8d, 0xcf, 0x2d, 0xd2, 0x3a, 0xa8, 0x56, 0xa0, 0x7d, 0x32, 0x27, 0x12, 0xe6, 0x0d,
+	0xbd, 0xd1, 0x7c, 0x38, 0x27, 0x12, 0xca, 0xc8, 0x42, 0xac, 0x81, 0x5b, 0xa5, 0xd9, 0xdc, 0xd0,
+	0x1b, 0x75, 0xc2, 0xab, 0x92, 0xae, 0x91, 0xc5, 0x02, 0x2c, 0x4f, 0xb8, 0xe5, 0xac, 0x89, 0xd2,
+	0x75, 0x4d, 0xef, 0x11, 0x92, 0x2b, 0x63, 0xa2, 0x5c, 0xa5, 0x22, 0x66, 0xf3, 0xa8, 0x76, 0x2a,
+	0x32, 0xae, 0x00, 0x5d, 0x27, 0x5d, 0x94, 0x0b, 0xb0, 0x99, 0x4a, 0x58, 0x0b, 0x75, 0xfc, 0xe2,
+	0x2d, 0x12, 0xfa, 0x90, 0x2c, 0x0b, 0x79, 0x0c, 0x1a, 0x64, 0x0c, 0x75, 0x48, 0x1b, 0x4d, 0xfd,
+	0x6b, 0xec, 0x92, 0x1e, 0x91, 0x95, 0x1b, 0x63, 0x1d, 0xb7, 0x80, 0xce, 0x9b, 0x80, 0x3a, 0x73,
+	0x44, 0x56, 0xa0, 0x54, 0x71, 0x16, 0xe5, 0xdc, 0xd8, 0x08, 0x64, 0x02, 0x09, 0x5b, 0x1c, 0x7a,
+	0xa3, 0x66, 0xd8, 0x47, 0x3e, 0xe6, 0xc6, 0xbe, 0xaa, 0x28, 0xbd, 0x4f, 0x7a, 0xb5, 0x13, 0x64,
+	0x6a, 0x33, 0xd6, 0x41, 0x57, 0xd7, 0xb9, 0x10, 0x55, 0x61, 0xa9, 0x56, 0x13, 0x99, 0x44, 0x56,
+	0x4f, 0x6c, 0x95, 0x99, 0x32, 0xe2, 0xc2, 0x1c, 0x3f, 0xa8, 0xf0, 0x98, 0xa7, 0xd5, 0x5e, 0x13,
+	0x38, 0xe6, 0x93, 0xdc, 0x46, 0x5c, 0xa7, 0xac, 0xeb, 0xf6, 0x5a, 0xa3, 0x17, 0x3a, 0xa5, 0xfb,
+	0xa4, 0x5d, 0x46, 0x52, 0xe9, 0x82, 0xf5, 0x2a, 0x6d, 0xf7, 0xf9, 0xe9, 0xf9, 0x7a, 0xe3, 0xe7,
+	0xf9, 0x7a, 0x90, 0x0a, 0x9b, 0x4d, 0x8e, 0xfc, 0x58, 0x15, 0x01, 0xcf, 0x73, 0xa5, 0xf9, 0xa6,
+	0x04, 0x3b, 0x53, 0xfa, 0xe4, 0xaa, 0x8c, 0x33, 0x2e, 0x64, 0x50, 0x70, 0x9b, 0xf9, 0x2f, 0x21,
+	0x0e, 0x5b, 0xe5, 0xbe, 0xd2, 0x05, 0x3d, 0x24, 0x3d, 0x9e, 0x97, 0x19, 0x8f, 0x34, 0xa4, 0x1a,
+	0x2c, 0x5b, 0xfa, 0xb7, 0xd4, 0x2e, 0x86, 0x85, 0x98, 0x45, 0x1f, 0x90, 0x7e, 0xe5, 0x9a, 0x45,
+	0x12, 0x52, 0x6e, 0xc5, 0x14, 0x58, 0x7f, 0xe8, 0x8d, 0x16, 0xc3, 0x25, 0xa4, 0xfb, 0x35, 0xa4,
+	0x5b, 0x64, 0x35, 0x56, 0x45, 0x21, 0x6c, 0xa4, 0x61, 0x0a, 0x3c, 0x8f, 0x66, 0x42, 0x26, 0x6a,
+	0xc6, 0x96, 0xf1, 0x84, 0xa8, 0xd3, 0x42, 0x94, 0xde, 0xa3, 0x82, 0x17, 0x6e, 0xa2, 0x12, 0x74,
+	0xdd, 0xd9, 0x90, 0xb0, 0x15, 0x4c, 0xee, 0x0b, 0xf3, 0xee, 0x16, 0xdd, 0xd8, 0x21, 0x1d, 0x6c,
+	0xd4, 0xb1, 0x30, 0x96, 0x3e, 0x21, 0x6d, 0x9c, 0x0b, 0xc3, 0xbc, 0x61, 0x73, 0xd4, 0x7d, 0xfa,
+	0xbf, 0x7f, 0x7b, 0x32, 0x7c, 0x34, 0x86, 0xb5, 0x65, 0xc3, 0x90, 0x65, 0x04, 0xaf, 0x01, 0xaa,
+	0xa5, 0xe5, 0x04, 0xe8, 0x2a, 0x69, 0xe1, 0xa5, 0x62, 0xbf, 0x37, 0x43, 0x57, 0xd0, 0x37, 0x64,
+	0x41, 0x3b, 0x83, 0x6b, 0xf9, 0xdd, 0xad, 0xfa, 0xec, 0xee, 0xb8, 0xe1, 0x32, 0xc9, 0x89, 0x2f,
+	0x94, 0x3b, 0xa1, 0x3d, 0x69, 0xbf, 0x7f, 0xdb, 0x24, 0xf5, 0xd4, 0xed, 0x49, 0xfb, 0xe5, 0xf7,
+	0xd7, 0xc7, 0x5e, 0x78, 0x15, 0xb0, 0x1b, 0x9e, 0x5e, 0x0c, 0xbc, 0xb3, 0x8b, 0x81, 0xf7, 0xeb,
+	0x62, 0xe0, 0x7d, 0xba, 0x1c, 0x34, 0xce, 0x2e, 0x07, 0x8d, 0x1f, 0x97, 0x83, 0xc6, 0xe1, 0xce,
+	0x5f, 0x5e, 0xc4, 0x87, 0xe0, 0xe6, 0x3f, 0x60, 0x3f, 0x96, 0x60, 0x8e, 0xda, 0x38, 0xb3, 0xcf,
+	0xfe, 0x0c, 0x00, 0xc6, 0x99, 0xf7, 0xe7, 0x21, 0x04, 0x00, 0x00,
 }
 
 func (m *Topic) Marshal() (dAtA []byte, err error) {
@@ -319,6 +341,23 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsPermissioned {
+		i--
+		if m.IsPermissioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.CommitRevealWindow != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.CommitRevealWindow))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.AllowNegative {
 		i--
 		if m.AllowNegative {
@@ -560,6 +599,12 @@ func (m *Topic) Size() (n int) {
 	if m.AllowNegative {
 		n += 2
 	}
+	if m.CommitRevealWindow != 0 {
+		n += 1 + sovTopic(uint64(m.CommitRevealWindow))
+	}
+	if m.IsPermissioned {
+		n += 3
+	}
 	return n
 }
 
@@ -1015,6 +1060,45 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowNegative = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealWindow", wireType)
+			}
+			m.CommitRevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitRevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPermissioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPermissioned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopic(dAtA[iNdEx:])
@@ -0,0 +1,157 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Params of a topic: the global params with the overrides of the topic applied
+func (tp TopicParams) ApplyTo(params Params) Params {
+	if len(tp.MaxTopInferersToReward) == 1 {
+		params.MaxTopInferersToReward = tp.MaxTopInferersToReward[0]
+	}
+	if len(tp.MaxTopReputersToReward) == 1 {
+		params.MaxTopReputersToReward = tp.MaxTopReputersToReward[0]
+	}
+	if len(tp.RequiredMinimumStake) == 1 {
+		params.RequiredMinimumStake = tp.RequiredMinimumStake[0]
+	}
+	if len(tp.RegistrationFee) == 1 {
+		params.RegistrationFee = tp.RegistrationFee[0]
+	}
+	if len(tp.BetaEntropy) == 1 {
+		params.BetaEntropy = tp.BetaEntropy[0]
+	}
+	if len(tp.PRewardInference) == 1 {
+		params.PRewardInference = tp.PRewardInference[0]
+	}
+	if len(tp.MaxUnfulfilledWorkerRequests) == 1 {
+		params.MaxUnfulfilledWorkerRequests = tp.MaxUnfulfilledWorkerRequests[0]
+	}
+	return params
+}
+
+// Checks that every override lies within the lowest and highest values governance allows for it
+func (tp TopicParams) ValidateWithinBounds(min, max TopicParams) error {
+	if err := validateOverride("MaxTopInferersToReward", tp.MaxTopInferersToReward, min.MaxTopInferersToReward, max.MaxTopInferersToReward, lteUint64); err != nil {
+		return err
+	}
+	if err := validateOverride("MaxTopReputersToReward", tp.MaxTopReputersToReward, min.MaxTopReputersToReward, max.MaxTopReputersToReward, lteUint64); err != nil {
+		return err
+	}
+	if err := validateOverride("RequiredMinimumStake", tp.RequiredMinimumStake, min.RequiredMinimumStake, max.RequiredMinimumStake, lteInt); err != nil {
+		return err
+	}
+	if err := validateOverride("RegistrationFee", tp.RegistrationFee, min.RegistrationFee, max.RegistrationFee, lteInt); err != nil {
+		return err
+	}
+	if err := validateOverride("BetaEntropy", tp.BetaEntropy, min.BetaEntropy, max.BetaEntropy, lteDec); err != nil {
+		return err
+	}
+	if err := validateOverride("PRewardInference", tp.PRewardInference, min.PRewardInference, max.PRewardInference, lteDec); err != nil {
+		return err
+	}
+	if err := validateOverride("MaxUnfulfilledWorkerRequests", tp.MaxUnfulfilledWorkerRequests, min.MaxUnfulfilledWorkerRequests, max.MaxUnfulfilledWorkerRequests, lteUint64); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Governance bounds on topic params.
+// Each field must be set in both or neither of the bounds, with the lowest value not above the highest one.
+// Both bounds are applied to the default params so that their values pass the global validation.
+func validateTopicParamsBounds(min, max TopicParams) error {
+	if err := validateBound("MaxTopInferersToReward", min.MaxTopInferersToReward, max.MaxTopInferersToReward, lteUint64); err != nil {
+		return err
+	}
+	if err := validateBound("MaxTopReputersToReward", min.MaxTopReputersToReward, max.MaxTopReputersToReward, lteUint64); err != nil {
+		return err
+	}
+	if err := validateBound("RequiredMinimumStake", min.RequiredMinimumStake, max.RequiredMinimumStake, lteInt); err != nil {
+		return err
+	}
+	if err := validateBound("RegistrationFee", min.RegistrationFee, max.RegistrationFee, lteInt); err != nil {
+		return err
+	}
+	if err := validateBound("BetaEntropy", min.BetaEntropy, max.BetaEntropy, lteDec); err != nil {
+		return err
+	}
+	if err := validateBound("PRewardInference", min.PRewardInference, max.PRewardInference, lteDec); err != nil {
+		return err
+	}
+	if err := validateBound("MaxUnfulfilledWorkerRequests", min.MaxUnfulfilledWorkerRequests, max.MaxUnfulfilledWorkerRequests, lteUint64); err != nil {
+		return err
+	}
+	for _, bound := range []TopicParams{min, max} {
+		params := bound.ApplyTo(DefaultParams())
+		if err := validateTopicOverridableParams(params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The per-field validation of the params that topics may override
+func validateTopicOverridableParams(p Params) error {
+	if err := validateMaxTopInferersToReward(p.MaxTopInferersToReward); err != nil {
+		return errorsmod.Wrap(err, "MaxTopInferersToReward")
+	}
+	if err := validateMaxTopReputersToReward(p.MaxTopReputersToReward); err != nil {
+		return errorsmod.Wrap(err, "MaxTopReputersToReward")
+	}
+	if err := validateRequiredMinimumStake(p.RequiredMinimumStake); err != nil {
+		return errorsmod.Wrap(err, "RequiredMinimumStake")
+	}
+	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
+		return errorsmod.Wrap(err, "RegistrationFee")
+	}
+	if err := validateBetaEntropy(p.BetaEntropy); err != nil {
+		return errorsmod.Wrap(err, "BetaEntropy")
+	}
+	if err := validatePRewardInference(p.PRewardInference); err != nil {
+		return errorsmod.Wrap(err, "PRewardInference")
+	}
+	if err := validateMaxUnfulfilledWorkerRequests(p.MaxUnfulfilledWorkerRequests); err != nil {
+		return errorsmod.Wrap(err, "MaxUnfulfilledWorkerRequests")
+	}
+	return nil
+}
+
+func validateOverride[T any](name string, value, min, max []T, lte func(a, b T) bool) error {
+	if len(value) == 0 {
+		return nil
+	}
+	if len(value) > 1 {
+		return errorsmod.Wrap(ErrInvalidSliceLength, name)
+	}
+	if len(min) != 1 || len(max) != 1 {
+		return errorsmod.Wrap(ErrTopicParamNotOverridable, name)
+	}
+	if !lte(min[0], value[0]) || !lte(value[0], max[0]) {
+		return errorsmod.Wrap(ErrTopicParamOutOfBounds, name)
+	}
+	return nil
+}
+
+func validateBound[T any](name string, min, max []T, lte func(a, b T) bool) error {
+	if len(min) > 1 || len(max) > 1 || len(min) != len(max) {
+		return errorsmod.Wrapf(ErrValidationParamsInconsistent, "%s must be set in both or neither of the topic params bounds", name)
+	}
+	if len(min) == 1 && !lte(min[0], max[0]) {
+		return errorsmod.Wrapf(ErrValidationParamsInconsistent, "lowest %s of topic params is above the highest", name)
+	}
+	return nil
+}
+
+func lteUint64(a, b uint64) bool {
+	return a <= b
+}
+
+func lteInt(a, b cosmosMath.Int) bool {
+	return a.LTE(b)
+}
+
+func lteDec(a, b alloraMath.Dec) bool {
+	return a.Lte(b)
+}
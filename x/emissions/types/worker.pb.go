@@ -413,6 +413,8 @@ type WorkerDataBundle struct {
 	InferenceForecastsBundle           *InferenceForecastBundle `protobuf:"bytes,2,opt,name=inference_forecasts_bundle,json=inferenceForecastsBundle,proto3" json:"inference_forecasts_bundle,omitempty"`
 	InferencesForecastsBundleSignature []byte                   `protobuf:"bytes,3,opt,name=inferences_forecasts_bundle_signature,json=inferencesForecastsBundleSignature,proto3" json:"inferences_forecasts_bundle_signature,omitempty"`
 	Pubkey                             string                   `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// salt used in the worker's commitment, only used by commit-reveal topics
+	CommitSalt []byte `protobuf:"bytes,5,opt,name=commit_salt,json=commitSalt,proto3" json:"commit_salt,omitempty"`
 }
 
 func (m *WorkerDataBundle) Reset()         { *m = WorkerDataBundle{} }
@@ -476,6 +478,13 @@ func (m *WorkerDataBundle) GetPubkey() string {
 	return ""
 }
 
+func (m *WorkerDataBundle) GetCommitSalt() []byte {
+	if m != nil {
+		return m.CommitSalt
+	}
+	return nil
+}
+
 type WorkerDataBundles struct {
 	WorkerDataBundles []*WorkerDataBundle `protobuf:"bytes,1,rep,name=worker_data_bundles,json=workerDataBundles,proto3" json:"worker_data_bundles,omitempty"`
 }
@@ -535,46 +544,47 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/worker.proto", fileDescriptor_f697c93e4bd2e89e) }
 
 var fileDescriptor_f697c93e4bd2e89e = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x26, 0xe9, 0x87, 0xa7, 0x91, 0x68, 0x97, 0xaa, 0x75, 0x2b, 0xd5, 0x0d, 0x91, 0x2a,
-	0xe5, 0x42, 0xac, 0x16, 0x50, 0x11, 0x37, 0xaa, 0x16, 0x51, 0x24, 0x90, 0x58, 0x10, 0x48, 0x5c,
-	0xac, 0x8d, 0xb3, 0x49, 0x56, 0xb5, 0xbd, 0x96, 0x77, 0xd3, 0x8f, 0x5b, 0xcf, 0x9c, 0xf8, 0x09,
-	0xfc, 0x9c, 0x1e, 0x7b, 0x04, 0x0e, 0x15, 0x6a, 0x2f, 0x5c, 0xf8, 0x0f, 0xc8, 0xeb, 0xaf, 0xc6,
-	0x25, 0x80, 0x44, 0x6f, 0x9e, 0xd9, 0xe7, 0x99, 0xf7, 0xe6, 0xed, 0x0e, 0xac, 0x30, 0x9f, 0x4b,
-	0xc9, 0x45, 0x20, 0xed, 0xc3, 0x4d, 0xfb, 0x48, 0x44, 0x07, 0x2c, 0xea, 0x84, 0x91, 0x50, 0x02,
-	0x37, 0xf2, 0xa3, 0xce, 0xe1, 0xe6, 0xea, 0xe2, 0x40, 0x0c, 0x84, 0x3e, 0xb0, 0xe3, 0xaf, 0x04,
-	0xd3, 0xfa, 0x88, 0x60, 0xfe, 0x2d, 0xf7, 0x99, 0x54, 0xd4, 0x0f, 0x59, 0xef, 0x1d, 0xf5, 0x46,
-	0x0c, 0xdf, 0x83, 0x46, 0xd7, 0x13, 0xee, 0x81, 0x33, 0x64, 0x7c, 0x30, 0x54, 0x26, 0x6a, 0xa2,
-	0x76, 0x8d, 0xcc, 0xe9, 0xdc, 0x73, 0x9d, 0xc2, 0x2f, 0x61, 0xea, 0x30, 0xc6, 0x9a, 0xd5, 0x26,
-	0x6a, 0x1b, 0x3b, 0xdb, 0x67, 0x17, 0xeb, 0x95, 0x6f, 0x17, 0xeb, 0xf6, 0x80, 0xab, 0xe1, 0xa8,
-	0xdb, 0x71, 0x85, 0x6f, 0x53, 0xcf, 0x13, 0x11, 0xbd, 0x1f, 0x30, 0x15, 0xb3, 0xca, 0x42, 0x77,
-	0x48, 0x79, 0x60, 0xfb, 0x54, 0x0d, 0x3b, 0xbb, 0xcc, 0x25, 0x49, 0x95, 0x27, 0xf5, 0x1f, 0x9f,
-	0xd7, 0x51, 0xeb, 0x27, 0x02, 0x63, 0x3f, 0xe8, 0xb3, 0x88, 0x05, 0x2e, 0xc3, 0x2b, 0x30, 0xab,
-	0x44, 0xc8, 0x5d, 0x87, 0xf7, 0x34, 0x83, 0x3a, 0x99, 0xd1, 0xf1, 0x7e, 0xef, 0x06, 0xc1, 0xea,
-	0x4d, 0x82, 0x26, 0xcc, 0x70, 0x5d, 0x2a, 0x32, 0x6b, 0x31, 0x45, 0x92, 0x85, 0x05, 0xf5, 0xfa,
-	0x6d, 0x50, 0xc7, 0x6b, 0x00, 0xec, 0x58, 0x45, 0xd4, 0xe9, 0x51, 0x45, 0xcd, 0xa9, 0x26, 0x6a,
-	0x37, 0x88, 0xa1, 0x33, 0xbb, 0x54, 0x51, 0xbc, 0x08, 0x53, 0x61, 0x24, 0x44, 0xdf, 0x9c, 0xd6,
-	0x2c, 0x92, 0x20, 0xd5, 0xbb, 0x07, 0x90, 0xcb, 0x95, 0x78, 0x1b, 0x80, 0xe7, 0x91, 0x89, 0x9a,
-	0xb5, 0xf6, 0xdc, 0xd6, 0x72, 0xe7, 0xba, 0x87, 0x9d, 0x1c, 0x4d, 0xae, 0x41, 0x5b, 0xa7, 0x08,
-	0xee, 0x3c, 0x13, 0x11, 0x73, 0xa9, 0x54, 0x7b, 0x1e, 0xf3, 0x59, 0x30, 0x26, 0xbf, 0x3a, 0x41,
-	0x7e, 0xed, 0x16, 0x9d, 0xfb, 0x8a, 0x60, 0x36, 0xa3, 0xf0, 0x9f, 0xc6, 0x59, 0x00, 0xfd, 0xb4,
-	0x52, 0xee, 0xdd, 0xb5, 0x0c, 0x7e, 0x01, 0x0b, 0x59, 0xe4, 0xb0, 0x44, 0xad, 0x34, 0xeb, 0x7a,
-	0x5a, 0x6b, 0xe3, 0xd3, 0x2a, 0xcd, 0x84, 0xcc, 0xf7, 0xc7, 0x13, 0xf2, 0x2f, 0xde, 0xa5, 0xda,
-	0x9e, 0x82, 0x91, 0x55, 0x92, 0xf8, 0x21, 0x18, 0x59, 0x95, 0xcc, 0xa3, 0xa5, 0xdf, 0x77, 0x25,
-	0x05, 0x30, 0x7e, 0x65, 0xcb, 0xb9, 0x77, 0x19, 0x60, 0x67, 0x14, 0xf4, 0x3c, 0x86, 0x1f, 0x81,
-	0x91, 0x7b, 0xa9, 0xc7, 0xf5, 0x07, 0xd7, 0x0b, 0x24, 0xde, 0x82, 0xd9, 0xac, 0xbe, 0x9e, 0xe2,
-	0x64, 0x1e, 0x39, 0x2e, 0xd5, 0x73, 0x5a, 0x85, 0xf9, 0xf7, 0x7a, 0x4f, 0xc4, 0x22, 0x53, 0x16,
-	0x4b, 0x30, 0x9d, 0xec, 0x0e, 0x4d, 0xc1, 0x20, 0x69, 0x84, 0x5d, 0x58, 0xcd, 0x7b, 0x3a, 0xb9,
-	0x20, 0xa7, 0xab, 0xff, 0x4a, 0x1b, 0x6f, 0x4c, 0xa0, 0x3b, 0x2e, 0x94, 0x98, 0xbc, 0x7c, 0x20,
-	0xd3, 0xe6, 0xaf, 0x61, 0xa3, 0xb8, 0xce, 0x37, 0xba, 0x38, 0x92, 0x0f, 0x02, 0xaa, 0x46, 0x51,
-	0x72, 0x65, 0x1b, 0xa4, 0x55, 0x80, 0x4b, 0x95, 0xde, 0x64, 0xc8, 0x58, 0x4f, 0x38, 0xea, 0x1e,
-	0xb0, 0x93, 0xe4, 0x95, 0x93, 0x34, 0x4a, 0x47, 0xe0, 0xc2, 0x42, 0x79, 0x02, 0x12, 0xbf, 0x82,
-	0xbb, 0x89, 0x68, 0x7d, 0x1b, 0xd2, 0xe6, 0x99, 0xc9, 0xd6, 0xb8, 0xc6, 0xf2, 0xdf, 0x64, 0xe1,
-	0xa8, 0x5c, 0x6f, 0x87, 0x9c, 0x5d, 0x5a, 0xe8, 0xfc, 0xd2, 0x42, 0xdf, 0x2f, 0x2d, 0xf4, 0xe9,
-	0xca, 0xaa, 0x9c, 0x5f, 0x59, 0x95, 0x2f, 0x57, 0x56, 0xe5, 0xc3, 0xe3, 0x7f, 0x7c, 0x6b, 0xc7,
-	0x76, 0xb1, 0xdc, 0xd5, 0x49, 0xc8, 0x64, 0x77, 0x5a, 0x6f, 0xed, 0x07, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x04, 0x1a, 0x33, 0xcd, 0xf6, 0x05, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x3e,
+	0x18, 0xaf, 0xdb, 0xee, 0x25, 0xcf, 0x2a, 0xfd, 0x37, 0xff, 0xa7, 0x2d, 0x9b, 0xb4, 0xb4, 0x54,
+	0x9a, 0xd4, 0x0b, 0x8d, 0x36, 0x40, 0x43, 0xdc, 0x98, 0x36, 0xc4, 0x90, 0x40, 0xc2, 0x43, 0x20,
+	0x71, 0x89, 0xdc, 0xd4, 0x6d, 0xad, 0x25, 0x71, 0x15, 0xbb, 0x7b, 0xb9, 0x71, 0xe6, 0xc4, 0x47,
+	0x80, 0x6f, 0xb3, 0xe3, 0x8e, 0xc0, 0x61, 0x42, 0xdb, 0x85, 0x0b, 0xdf, 0x01, 0xc5, 0x89, 0xd3,
+	0x35, 0x63, 0x80, 0xc4, 0x6e, 0x79, 0x1e, 0xff, 0xf2, 0xf8, 0xf7, 0x62, 0x1b, 0x56, 0x58, 0xc8,
+	0xa5, 0xe4, 0x22, 0x92, 0xee, 0xe1, 0x86, 0x7b, 0x24, 0xe2, 0x03, 0x16, 0xb7, 0x87, 0xb1, 0x50,
+	0x02, 0xd7, 0xf2, 0xa5, 0xf6, 0xe1, 0xc6, 0xea, 0x62, 0x5f, 0xf4, 0x85, 0x5e, 0x70, 0x93, 0xaf,
+	0x14, 0xd3, 0x7c, 0x8f, 0x60, 0xfe, 0x15, 0x0f, 0x99, 0x54, 0x34, 0x1c, 0xb2, 0xee, 0x6b, 0x1a,
+	0x8c, 0x18, 0xbe, 0x03, 0xb5, 0x4e, 0x20, 0xfc, 0x03, 0x6f, 0xc0, 0x78, 0x7f, 0xa0, 0x6c, 0xd4,
+	0x40, 0xad, 0x0a, 0x99, 0xd3, 0xbd, 0xa7, 0xba, 0x85, 0x9f, 0xc3, 0xd4, 0x61, 0x82, 0xb5, 0xcb,
+	0x0d, 0xd4, 0xb2, 0xb6, 0xb7, 0x4e, 0xcf, 0xeb, 0xa5, 0xaf, 0xe7, 0x75, 0xb7, 0xcf, 0xd5, 0x60,
+	0xd4, 0x69, 0xfb, 0x22, 0x74, 0x69, 0x10, 0x88, 0x98, 0xde, 0x8d, 0x98, 0x4a, 0x58, 0x99, 0xd2,
+	0x1f, 0x50, 0x1e, 0xb9, 0x21, 0x55, 0x83, 0xf6, 0x0e, 0xf3, 0x49, 0x3a, 0xe5, 0x51, 0xf5, 0xfb,
+	0xc7, 0x3a, 0x6a, 0xfe, 0x40, 0x60, 0xed, 0x45, 0x3d, 0x16, 0xb3, 0xc8, 0x67, 0x78, 0x05, 0x66,
+	0x95, 0x18, 0x72, 0xdf, 0xe3, 0x5d, 0xcd, 0xa0, 0x4a, 0x66, 0x74, 0xbd, 0xd7, 0xbd, 0x46, 0xb0,
+	0x7c, 0x9d, 0xa0, 0x0d, 0x33, 0x5c, 0x8f, 0x8a, 0xed, 0x4a, 0x42, 0x91, 0x98, 0x72, 0x4c, 0xbd,
+	0x7a, 0x1b, 0xd4, 0xf1, 0x1a, 0x00, 0x3b, 0x56, 0x31, 0xf5, 0xba, 0x54, 0x51, 0x7b, 0xaa, 0x81,
+	0x5a, 0x35, 0x62, 0xe9, 0xce, 0x0e, 0x55, 0x14, 0x2f, 0xc2, 0xd4, 0x30, 0x16, 0xa2, 0x67, 0x4f,
+	0x6b, 0x16, 0x69, 0x91, 0xe9, 0xdd, 0x05, 0xc8, 0xe5, 0x4a, 0xbc, 0x05, 0xc0, 0xf3, 0xca, 0x46,
+	0x8d, 0x4a, 0x6b, 0x6e, 0x73, 0xb9, 0x7d, 0x35, 0xc3, 0x76, 0x8e, 0x26, 0x57, 0xa0, 0xcd, 0x77,
+	0x08, 0xfe, 0x7b, 0x22, 0x62, 0xe6, 0x53, 0xa9, 0x76, 0x03, 0x16, 0xb2, 0x68, 0x42, 0x7e, 0xf9,
+	0x06, 0xf9, 0x95, 0x5b, 0x4c, 0xee, 0x0b, 0x82, 0x59, 0x43, 0xe1, 0x1f, 0x83, 0x73, 0x00, 0x7a,
+	0xd9, 0xa4, 0x3c, 0xbb, 0x2b, 0x1d, 0xfc, 0x0c, 0x16, 0x4c, 0xe5, 0xb1, 0x54, 0xad, 0xb4, 0xab,
+	0xda, 0xad, 0xb5, 0x49, 0xb7, 0x0a, 0x9e, 0x90, 0xf9, 0xde, 0x64, 0x43, 0xfe, 0x21, 0xbb, 0x4c,
+	0xdb, 0x63, 0xb0, 0xcc, 0x24, 0x89, 0xef, 0x83, 0x65, 0xa6, 0x98, 0x8c, 0x96, 0x7e, 0xbd, 0x2b,
+	0x19, 0x03, 0x93, 0x5b, 0xb6, 0x9c, 0x67, 0x67, 0x00, 0xdb, 0xa3, 0xa8, 0x1b, 0x30, 0xfc, 0x00,
+	0xac, 0x3c, 0x4b, 0x6d, 0xd7, 0x6f, 0x52, 0x1f, 0x23, 0xf1, 0x26, 0xcc, 0x9a, 0xf9, 0xda, 0xc5,
+	0x9b, 0x79, 0xe4, 0xb8, 0x4c, 0xcf, 0xa7, 0x32, 0xcc, 0xbf, 0xd1, 0xef, 0x44, 0x22, 0x32, 0x63,
+	0xb1, 0x04, 0xd3, 0xe9, 0xdb, 0xa1, 0x29, 0x58, 0x24, 0xab, 0xb0, 0x0f, 0xab, 0xf9, 0x9e, 0x5e,
+	0x2e, 0xc8, 0xeb, 0xe8, 0xbf, 0xb2, 0x8d, 0xd7, 0x6f, 0xa0, 0x3b, 0x29, 0x94, 0xd8, 0xbc, 0xb8,
+	0x20, 0xb3, 0xcd, 0x5f, 0xc2, 0x7a, 0xbe, 0x26, 0xaf, 0xed, 0xe2, 0x49, 0xde, 0x8f, 0xa8, 0x1a,
+	0xc5, 0xe9, 0x91, 0xad, 0x91, 0xe6, 0x18, 0x5c, 0x98, 0xb4, 0x6f, 0x90, 0x89, 0x9e, 0xe1, 0xa8,
+	0x73, 0xc0, 0x4e, 0xd2, 0x5b, 0x4e, 0xb2, 0x0a, 0xd7, 0x61, 0xce, 0x17, 0x61, 0xc8, 0x95, 0x27,
+	0x69, 0xa0, 0xb2, 0xc8, 0x21, 0x6d, 0xed, 0xd3, 0xc0, 0x78, 0xe4, 0xc3, 0x42, 0xd1, 0x22, 0x89,
+	0x5f, 0xc0, 0xff, 0xa9, 0x2b, 0xfa, 0xb8, 0x64, 0xec, 0xcc, 0x29, 0x70, 0x26, 0x4d, 0x28, 0xfe,
+	0x4d, 0x16, 0x8e, 0x8a, 0xf3, 0xb6, 0xc9, 0xe9, 0x85, 0x83, 0xce, 0x2e, 0x1c, 0xf4, 0xed, 0xc2,
+	0x41, 0x1f, 0x2e, 0x9d, 0xd2, 0xd9, 0xa5, 0x53, 0xfa, 0x7c, 0xe9, 0x94, 0xde, 0x3e, 0xfc, 0xcb,
+	0xcb, 0x78, 0xec, 0x8e, 0x5f, 0x7f, 0x75, 0x32, 0x64, 0xb2, 0x33, 0xad, 0x9f, 0xf5, 0x7b, 0x3f,
+	0x07, 0x00, 0xd9, 0x47, 0x31, 0x1e, 0x17, 0x06, 0x00, 0x00,
 }
 
 func (this *TimestampedValue) Equal(that interface{}) bool {
@@ -769,6 +779,9 @@ func (this *WorkerDataBundle) Equal(that interface{}) bool {
 	if this.Pubkey != that1.Pubkey {
 		return false
 	}
+	if !bytes.Equal(this.CommitSalt, that1.CommitSalt) {
+		return false
+	}
 	return true
 }
 func (m *TimestampedValue) Marshal() (dAtA []byte, err error) {
@@ -1115,6 +1128,13 @@ func (m *WorkerDataBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommitSalt) > 0 {
+		i -= len(m.CommitSalt)
+		copy(dAtA[i:], m.CommitSalt)
+		i = encodeVarintWorker(dAtA, i, uint64(len(m.CommitSalt)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Pubkey) > 0 {
 		i -= len(m.Pubkey)
 		copy(dAtA[i:], m.Pubkey)
@@ -1355,6 +1375,10 @@ func (m *WorkerDataBundle) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	l = len(m.CommitSalt)
+	if l > 0 {
+		n += 1 + l + sovWorker(uint64(l))
+	}
 	return n
 }
 
@@ -2459,6 +2483,40 @@ func (m *WorkerDataBundle) Unmarshal(dAtA []byte) error {
 			}
 			m.Pubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSalt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitSalt = append(m.CommitSalt[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitSalt == nil {
+				m.CommitSalt = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Length in bytes of a worker payload commitment
+const WorkerCommitmentLength = sha256.Size
+
+// True if workers of the topic must commit to their payloads before revealing them
+func (topic *Topic) IsCommitReveal() bool {
+	return topic.CommitRevealWindow > 0
+}
+
+// Last block at which commitments are accepted for a worker nonce.
+// Worker nonces are opened one epoch before their block height,
+// so the commit phase spans the first CommitRevealWindow blocks of the epoch.
+func (topic *Topic) CommitDeadline(nonce Nonce) int64 {
+	return nonce.BlockHeight - topic.EpochLength + topic.CommitRevealWindow
+}
+
+// Computes sha256(bundle || salt) where bundle is serialized the same way as for its signature
+func ComputeWorkerCommitment(bundle *InferenceForecastBundle, salt []byte) ([]byte, error) {
+	if bundle == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "inference forecasts bundle cannot be nil")
+	}
+	src := make([]byte, 0)
+	src, err := bundle.XXX_Marshal(src, true)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(append(src, salt...))
+	return hash[:], nil
+}
+
+// True if the revealed bundle and salt hash to the given commitment
+func (bundle *WorkerDataBundle) MatchesCommitment(commitment []byte) bool {
+	if bundle == nil || len(bundle.CommitSalt) == 0 {
+		return false
+	}
+	revealed, err := ComputeWorkerCommitment(bundle.InferenceForecastsBundle, bundle.CommitSalt)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(revealed, commitment)
+}
+
+func (msg *MsgCommitWorkerPayload) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.Nonce == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "nonce cannot be nil")
+	}
+	if len(msg.Commitment) != WorkerCommitmentLength {
+		return errors.Wrapf(ErrInvalidCommitment, "commitment must be %d bytes", WorkerCommitmentLength)
+	}
+	return nil
+}
@@ -148,9 +148,10 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 		updateEmission = true
 	}
 	// if the expected amount of emissions is greater than the balance of the ecosystem module account
+	tokensToMint := math.ZeroInt()
 	if blockEmission.GT(ecosystemBalance) {
 		// mint the amount of tokens required to pay out the emissions
-		tokensToMint := blockEmission.Sub(ecosystemBalance)
+		tokensToMint = blockEmission.Sub(ecosystemBalance)
 		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, tokensToMint))
 		err = k.MintCoins(sdkCtx, coins)
 		if err != nil {
@@ -182,6 +183,7 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	if err != nil {
 		return err
 	}
+	types.SetBlockEmissionMetrics(tokensToMint, blockEmission, validatorCut, alloraRewardsCut)
 	if updateEmission {
 		// set the previous emissions to this block's emissions
 		k.PreviousRewardEmissionPerUnitStakedToken.Set(ctx, e_i)
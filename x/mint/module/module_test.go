@@ -73,6 +73,7 @@ func (s *MintModuleTestSuite) SetupTest() {
 		"mint":                                  {"minter"},
 		emissionstypes.AlloraRewardsAccountName: nil,
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: nil,
+		emissionstypes.AlloraClaimableRewardsAccountName:          nil,
 		emissionstypes.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
 		"bonded_tokens_pool":                                      {"burner", "staking"},
 		"not_bonded_tokens_pool":                                  {"burner", "staking"},
@@ -123,6 +124,7 @@ func (s *MintModuleTestSuite) SetupTest() {
 		accountKeeper,
 		bankKeeper,
 		"fee_collector",
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	mintKeeper := keeper.NewKeeper(
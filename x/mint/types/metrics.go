@@ -0,0 +1,32 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Keys of the metrics the module exports through the telemetry of the node
+const (
+	MetricKeyBlockMinted            = "block_minted"
+	MetricKeyBlockEmission          = "block_emission"
+	MetricKeyBlockValidatorsEmitted = "block_validators_emitted"
+	MetricKeyBlockRewardsEmitted    = "block_rewards_emitted"
+)
+
+// Metrics only carry float32 values, precision is lost on large amounts
+func intMetricValue(x math.Int) float32 {
+	if x.IsNil() {
+		return 0
+	}
+	val, _ := x.BigInt().Float64()
+	return float32(val)
+}
+
+// Sets the tokens minted in a block against the tokens it emitted and how they were split
+// between the validators and the rewards of the emissions module
+func SetBlockEmissionMetrics(minted, emission, validatorsCut, rewardsCut math.Int) {
+	telemetry.SetGauge(intMetricValue(minted), ModuleName, MetricKeyBlockMinted)
+	telemetry.SetGauge(intMetricValue(emission), ModuleName, MetricKeyBlockEmission)
+	telemetry.SetGauge(intMetricValue(validatorsCut), ModuleName, MetricKeyBlockValidatorsEmitted)
+	telemetry.SetGauge(intMetricValue(rewardsCut), ModuleName, MetricKeyBlockRewardsEmitted)
+}